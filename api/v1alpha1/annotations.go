@@ -3,6 +3,17 @@ package v1alpha1
 const (
 	AnnotationKeyRefresh = "kargo.akuity.io/refresh"
 
+	// AnnotationKeyBreakGlass is set on a Promotion, with a reason as its value,
+	// to request that it be permitted despite promotion windows that would
+	// otherwise prohibit it.
+	AnnotationKeyBreakGlass = "kargo.akuity.io/break-glass"
+	// AnnotationKeyBreakGlassBy is set on a Promotion by Kargo to record which
+	// user requested a break-glass override of promotion windows.
+	AnnotationKeyBreakGlassBy = "kargo.akuity.io/break-glass-by"
+	// AnnotationKeyCreatedBy is set on a Promotion by Kargo to record which
+	// user requested it.
+	AnnotationKeyCreatedBy = "kargo.akuity.io/created-by"
+
 	AnnotationKeyOIDCEmails   = "rbac.kargo.akuity.io/email"
 	AnnotationKeyOIDCGroups   = "rbac.kargo.akuity.io/groups"
 	AnnotationKeyOIDCSubjects = "rbac.kargo.akuity.io/sub"
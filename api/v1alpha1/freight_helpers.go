@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -38,6 +39,8 @@ func GetFreight(
 //  1. No upstreamStages are specified
 //     OR
 //  2. The Freight has has been verified in ANY of the specified upstream stages
+//     (or in ALL of them, if the specified mode is UpstreamStagesModeAll) and
+//     has soaked there for any required period
 //     OR
 //  3. The Freight is approved for the specified stage
 //
@@ -47,15 +50,14 @@ func GetFreight(
 func IsFreightAvailable(
 	freight *Freight,
 	stage string,
-	upstreamStages []string,
+	upstreamStages []StageSubscription,
+	mode UpstreamStagesMode,
 ) bool {
 	if len(upstreamStages) == 0 {
 		return true
 	}
-	for _, stage := range upstreamStages {
-		if _, ok := freight.Status.VerifiedIn[stage]; ok {
-			return true
-		}
+	if IsFreightVerifiedUpstream(freight, upstreamStages, mode) {
+		return true
 	}
 	if stage != "" {
 		if _, ok := freight.Status.ApprovedFor[stage]; ok {
@@ -64,3 +66,93 @@ func IsFreightAvailable(
 	}
 	return false
 }
+
+// IsFreightVerifiedUpstream answers whether the specified Freight has been
+// verified, and has soaked for any required period, in ANY of the specified
+// upstream stages or, if the specified mode is UpstreamStagesModeAll, in ALL of
+// them.
+func IsFreightVerifiedUpstream(
+	freight *Freight,
+	upstreamStages []StageSubscription,
+	mode UpstreamStagesMode,
+) bool {
+	if len(upstreamStages) == 0 {
+		return false
+	}
+	now := time.Now()
+	for _, stageSub := range upstreamStages {
+		remaining, verified := getSoakTimeRemaining(freight, stageSub, now)
+		soaked := verified && remaining == 0
+		if mode == UpstreamStagesModeAll && !soaked {
+			return false
+		}
+		if mode != UpstreamStagesModeAll && soaked {
+			return true
+		}
+	}
+	return mode == UpstreamStagesModeAll
+}
+
+// GetSoakTimeRemaining returns how much longer the specified Freight must soak
+// in the specified upstream stages, as of the specified time, before it is
+// available downstream of them. Zero is returned if the Freight has already
+// soaked for long enough or if it has not been verified in ANY of the upstream
+// stages (or in ALL of them, if the specified mode is UpstreamStagesModeAll).
+func GetSoakTimeRemaining(
+	freight *Freight,
+	upstreamStages []StageSubscription,
+	mode UpstreamStagesMode,
+	now time.Time,
+) time.Duration {
+	var soakTimeRemaining time.Duration
+	for _, stageSub := range upstreamStages {
+		remaining, verified := getSoakTimeRemaining(freight, stageSub, now)
+		if mode == UpstreamStagesModeAll {
+			if !verified {
+				return 0
+			}
+			if remaining > soakTimeRemaining {
+				soakTimeRemaining = remaining
+			}
+			continue
+		}
+		if !verified {
+			continue
+		}
+		if remaining == 0 {
+			return 0
+		}
+		if soakTimeRemaining == 0 || remaining < soakTimeRemaining {
+			soakTimeRemaining = remaining
+		}
+	}
+	return soakTimeRemaining
+}
+
+// getSoakTimeRemaining returns how much longer the specified Freight must soak
+// in the specified upstream stage, as of the specified time, and whether it
+// has been verified there at all. Freight verified before the time of
+// verification was recorded is considered to have soaked for long enough.
+func getSoakTimeRemaining(
+	freight *Freight,
+	stageSub StageSubscription,
+	now time.Time,
+) (time.Duration, bool) {
+	verifiedStage, verified := freight.Status.VerifiedIn[stageSub.Name]
+	if !verified {
+		return 0, false
+	}
+	if stageSub.RequiredSoakTime == "" || verifiedStage.VerifiedAt == nil {
+		return 0, true
+	}
+	// The format of the soak time is validated by the CRD, so an error here
+	// should never occur. If it does, the soak time is simply disregarded.
+	soakTime, err := time.ParseDuration(stageSub.RequiredSoakTime)
+	if err != nil {
+		return 0, true
+	}
+	if remaining := verifiedStage.VerifiedAt.Add(soakTime).Sub(now); remaining > 0 {
+		return remaining, true
+	}
+	return 0, true
+}
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -64,10 +65,15 @@ func TestGetFreight(t *testing.T) {
 }
 
 func TestIsFreightAvailable(t *testing.T) {
+	recently := metav1.NewTime(time.Now().Add(-time.Hour))
 	testFreight := &Freight{
 		Status: FreightStatus{
 			VerifiedIn: map[string]VerifiedStage{
 				"fake-stage-1": {},
+				"fake-stage-4": {},
+				"fake-stage-5": {
+					VerifiedAt: &recently,
+				},
 			},
 			ApprovedFor: map[string]ApprovedStage{
 				"fake-stage-2": {},
@@ -77,7 +83,8 @@ func TestIsFreightAvailable(t *testing.T) {
 	testCases := []struct {
 		name           string
 		stage          string
-		upstreamStages []string
+		upstreamStages []StageSubscription
+		mode           UpstreamStagesMode
 		available      bool
 	}{
 		{
@@ -86,19 +93,55 @@ func TestIsFreightAvailable(t *testing.T) {
 		},
 		{
 			name:           "verified in an upstream Stage",
-			upstreamStages: []string{"fake-stage-1"},
+			upstreamStages: []StageSubscription{{Name: "fake-stage-1"}},
+			available:      true,
+		},
+		{
+			name:           "verified in only some upstream Stages",
+			upstreamStages: []StageSubscription{{Name: "fake-stage-1"}, {Name: "fake-stage-3"}},
+			mode:           UpstreamStagesModeAll,
+			available:      false,
+		},
+		{
+			name:           "verified in all upstream Stages",
+			upstreamStages: []StageSubscription{{Name: "fake-stage-1"}, {Name: "fake-stage-4"}},
+			mode:           UpstreamStagesModeAll,
 			available:      true,
 		},
+		{
+			name: "still soaking in an upstream Stage",
+			upstreamStages: []StageSubscription{{
+				Name:             "fake-stage-5",
+				RequiredSoakTime: "24h",
+			}},
+			available: false,
+		},
+		{
+			name: "soaked in an upstream Stage",
+			upstreamStages: []StageSubscription{{
+				Name:             "fake-stage-5",
+				RequiredSoakTime: "30m",
+			}},
+			available: true,
+		},
+		{
+			name: "verified before verification time was recorded",
+			upstreamStages: []StageSubscription{{
+				Name:             "fake-stage-1",
+				RequiredSoakTime: "24h",
+			}},
+			available: true,
+		},
 		{
 			name:           "approved for Stage",
 			stage:          "fake-stage-2",
-			upstreamStages: []string{"fake-stage-3"},
+			upstreamStages: []StageSubscription{{Name: "fake-stage-3"}},
 			available:      true,
 		},
 		{
 			name:           "unavailable",
 			stage:          "fake-stage-3",
-			upstreamStages: []string{"upstream-stage-2"},
+			upstreamStages: []StageSubscription{{Name: "upstream-stage-2"}},
 			available:      false,
 		},
 	}
@@ -111,6 +154,109 @@ func TestIsFreightAvailable(t *testing.T) {
 					testFreight,
 					testCase.stage,
 					testCase.upstreamStages,
+					testCase.mode,
+				),
+			)
+		})
+	}
+}
+
+func TestGetSoakTimeRemaining(t *testing.T) {
+	now := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)
+	oneHourAgo := metav1.NewTime(now.Add(-time.Hour))
+	twoHoursAgo := metav1.NewTime(now.Add(-2 * time.Hour))
+	testFreight := &Freight{
+		Status: FreightStatus{
+			VerifiedIn: map[string]VerifiedStage{
+				"fake-stage-1": {
+					VerifiedAt: &oneHourAgo,
+				},
+				"fake-stage-2": {
+					VerifiedAt: &twoHoursAgo,
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name           string
+		upstreamStages []StageSubscription
+		mode           UpstreamStagesMode
+		remaining      time.Duration
+	}{
+		{
+			name: "not verified upstream",
+			upstreamStages: []StageSubscription{{
+				Name:             "fake-stage-3",
+				RequiredSoakTime: "24h",
+			}},
+		},
+		{
+			name:           "no soak time required",
+			upstreamStages: []StageSubscription{{Name: "fake-stage-1"}},
+		},
+		{
+			name: "soaked",
+			upstreamStages: []StageSubscription{{
+				Name:             "fake-stage-1",
+				RequiredSoakTime: "1h",
+			}},
+		},
+		{
+			name: "soaking in any upstream Stage",
+			upstreamStages: []StageSubscription{
+				{
+					Name:             "fake-stage-1",
+					RequiredSoakTime: "4h",
+				},
+				{
+					Name:             "fake-stage-2",
+					RequiredSoakTime: "4h",
+				},
+				{
+					Name: "fake-stage-3",
+				},
+			},
+			remaining: 2 * time.Hour,
+		},
+		{
+			name: "soaking in all upstream Stages",
+			upstreamStages: []StageSubscription{
+				{
+					Name:             "fake-stage-1",
+					RequiredSoakTime: "4h",
+				},
+				{
+					Name:             "fake-stage-2",
+					RequiredSoakTime: "4h",
+				},
+			},
+			mode:      UpstreamStagesModeAll,
+			remaining: 3 * time.Hour,
+		},
+		{
+			name: "not verified in all upstream Stages",
+			upstreamStages: []StageSubscription{
+				{
+					Name:             "fake-stage-1",
+					RequiredSoakTime: "4h",
+				},
+				{
+					Name: "fake-stage-3",
+				},
+			},
+			mode: UpstreamStagesModeAll,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.remaining,
+				GetSoakTimeRemaining(
+					testFreight,
+					testCase.upstreamStages,
+					testCase.mode,
+					now,
 				),
 			)
 		})
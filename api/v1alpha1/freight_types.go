@@ -29,6 +29,8 @@ type Freight struct {
 	Images []Image `json:"images,omitempty"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty"`
+	// OCIArtifacts describes specific versions of specific OCI artifacts.
+	OCIArtifacts []OCIArtifact `json:"ociArtifacts,omitempty"`
 	// Status describes the current status of this Freight.
 	Status FreightStatus `json:"status,omitempty"`
 }
@@ -40,7 +42,7 @@ func (f *Freight) GetStatus() *FreightStatus {
 // UpdateID deterministically calculates a piece of Freight's ID based on its
 // contents and assigns it to the ID field.
 func (f *Freight) UpdateID() {
-	size := len(f.Commits) + len(f.Images) + len(f.Charts)
+	size := len(f.Commits) + len(f.Images) + len(f.Charts) + len(f.OCIArtifacts)
 	artifacts := make([]string, 0, size)
 	for _, commit := range f.Commits {
 		artifacts = append(
@@ -71,6 +73,13 @@ func (f *Freight) UpdateID() {
 			),
 		)
 	}
+	for _, artifact := range f.OCIArtifacts {
+		artifacts = append(
+			artifacts,
+			// As with images, incorporate BOTH tag and digest.
+			fmt.Sprintf("%s:%s@%s", artifact.RepoURL, artifact.Tag, artifact.Digest),
+		)
+	}
 	sort.Strings(artifacts)
 	f.ID = fmt.Sprintf(
 		"%x",
@@ -102,6 +111,8 @@ type GitCommit struct {
 	Message string `json:"message,omitempty"`
 	// Author is the git commit author
 	Author string `json:"author,omitempty"`
+	// CommittedAt is the time at which the commit was committed.
+	CommittedAt *metav1.Time `json:"committedAt,omitempty"`
 }
 
 // FreightStatus describes a piece of Freight's most recently observed state.
@@ -114,14 +125,40 @@ type FreightStatus struct {
 	// might wish to promote a piece of Freight to a given Stage without
 	// transiting the entire pipeline.
 	ApprovedFor map[string]ApprovedStage `json:"approvedFor,omitempty"`
+	// FailedIn describes the Stages in which this Freight has failed
+	// verification and was subsequently rolled back. Freight that has failed in
+	// a Stage is not eligible for auto-promotion to that Stage.
+	FailedIn map[string]FailedStage `json:"failedIn,omitempty"`
 }
 
 // VerifiedStage describes a Stage in which Freight has been verified.
-type VerifiedStage struct{}
+type VerifiedStage struct {
+	// VerifiedAt is the time at which the Freight was verified in the Stage.
+	VerifiedAt *metav1.Time `json:"verifiedAt,omitempty"`
+}
 
 // ApprovedStage describes a Stage for which Freight has been (manually)
 // approved.
-type ApprovedStage struct{}
+type ApprovedStage struct {
+	// Approvals records each user's approval of the Freight for the Stage.
+	Approvals []Approval `json:"approvals,omitempty"`
+}
+
+// Approval records a single user's approval of Freight for a Stage.
+type Approval struct {
+	// Subject identifies the user who approved the Freight.
+	Subject string `json:"subject"`
+	// Email is the email address of the user who approved the Freight, if
+	// known.
+	Email string `json:"email,omitempty"`
+	// ApprovedAt is the time at which the user approved the Freight.
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty"`
+	// Comment optionally explains the approval.
+	Comment string `json:"comment,omitempty"`
+}
+
+// FailedStage describes a Stage in which Freight has failed verification.
+type FailedStage struct{}
 
 //+kubebuilder:object:root=true
 
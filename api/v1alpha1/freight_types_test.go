@@ -96,6 +96,13 @@ func TestFreightUpdateID(t *testing.T) {
 				Version: "fake-chart-version",
 			},
 		},
+		OCIArtifacts: []OCIArtifact{
+			{
+				RepoURL: "fake-artifact-repo",
+				Tag:     "fake-artifact-tag",
+				Digest:  "fake-artifact-digest",
+			},
+		},
 	}
 	freight.UpdateID()
 	result := freight.ID
@@ -108,4 +115,9 @@ func TestFreightUpdateID(t *testing.T) {
 	freight.Commits[0].ID = "a-different-fake-commit"
 	freight.UpdateID()
 	require.NotEqual(t, result, freight.ID)
+	result = freight.ID
+	// Including a new digest for a mutable tag
+	freight.OCIArtifacts[0].Digest = "a-different-fake-digest"
+	freight.UpdateID()
+	require.NotEqual(t, result, freight.ID)
 }
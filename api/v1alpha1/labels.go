@@ -7,6 +7,11 @@ const (
 	ShardLabelKey   = "kargo.akuity.io/shard"
 	StageLabelKey   = "kargo.akuity.io/stage"
 
+	// PromotionHookSecretLabelKey is the key for a label that must be set to
+	// LabelTrueValue on any Secret named as the headersSecret of an HTTP
+	// promotion hook.
+	PromotionHookSecretLabelKey = "kargo.akuity.io/promotion-hook-secret"
+
 	LabelTrueValue = "true"
 
 	FinalizerName = "kargo.akuity.io/finalizer"
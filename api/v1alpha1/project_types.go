@@ -69,8 +69,113 @@ type PromotionPolicy struct {
 	// users to define Stages that are automatically updated as soon as new
 	// artifacts are detected.
 	AutoPromotionEnabled bool `json:"autoPromotionEnabled,omitempty"`
+	// QueuePolicy determines how Pending Promotions to the Stage referenced by
+	// the Stage field are treated when newer Promotions to the same Stage are
+	// created. With the default, Sequential, every Promotion is executed, one
+	// at a time, in order. With SupersedePending, any Pending Promotion is
+	// marked Superseded as soon as a newer Promotion to the same Stage exists,
+	// so that only the most recently requested Promotion is executed.
+	//
+	//+kubebuilder:validation:Enum=Sequential;SupersedePending
+	QueuePolicy PromotionQueuePolicy `json:"queuePolicy,omitempty"`
+	// Windows optionally restricts the times at which Freight may be promoted
+	// into the Stage referenced by the Stage field, whether automatically or
+	// manually. Promotion is never permitted while any Deny window is open. If
+	// any Allow windows are defined, promotion is only permitted while at least
+	// one of them is open. Users with permission to use the custom "breakglass"
+	// verb on the Stage may override these restrictions.
+	Windows []PromotionWindow `json:"windows,omitempty"`
+	// ApprovalPolicy optionally requires Freight to be approved by a number of
+	// eligible users before it may be promoted into the Stage referenced by the
+	// Stage field, whether automatically or manually.
+	ApprovalPolicy *ApprovalPolicy `json:"approvalPolicy,omitempty"`
 }
 
+// ApprovalPolicy describes the approvals Freight requires before it may be
+// promoted into a Stage.
+type ApprovalPolicy struct {
+	// RequiredApprovals is the number of distinct, eligible users who must
+	// approve Freight for the Stage before it may be promoted into it.
+	//
+	//+kubebuilder:validation:Minimum=1
+	RequiredApprovals int32 `json:"requiredApprovals"`
+	// Groups optionally restricts eligible approvers to members of any of the
+	// specified OIDC groups. If neither this field nor the Subjects field is
+	// specified, any user permitted to approve Freight is eligible.
+	Groups []string `json:"groups,omitempty"`
+	// Subjects optionally restricts eligible approvers to the specified users,
+	// identified by either OIDC subject or email address. If neither this field
+	// nor the Groups field is specified, any user permitted to approve Freight
+	// is eligible.
+	Subjects []string `json:"subjects,omitempty"`
+	// Expiry optionally limits how long each approval remains valid. e.g.
+	// "72h". If left unspecified, approvals never expire.
+	//
+	//+kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(h|m|s))+$`
+	Expiry string `json:"expiry,omitempty"`
+	// ExcludeFreightAuthors indicates whether the authors of any commit
+	// referenced by Freight are prohibited from approving it. An approver is
+	// considered to be the author of a commit if their email address matches
+	// that of the commit's author or if their subject matches either the email
+	// address or the name of the commit's author, ignoring case. Approvers
+	// without an email address may not approve Freight that references any
+	// commits.
+	ExcludeFreightAuthors bool `json:"excludeFreightAuthors,omitempty"`
+}
+
+// PromotionWindowKind indicates whether a PromotionWindow permits or denies
+// promotion while it is open.
+type PromotionWindowKind string
+
+const (
+	// PromotionWindowKindAllow denotes a window during which promotion is
+	// permitted.
+	PromotionWindowKindAllow PromotionWindowKind = "Allow"
+	// PromotionWindowKindDeny denotes a window during which promotion is not
+	// permitted, e.g. a change freeze.
+	PromotionWindowKindDeny PromotionWindowKind = "Deny"
+)
+
+// PromotionWindow describes a recurring period of time during which promotion
+// to a Stage is either permitted or not permitted.
+type PromotionWindow struct {
+	// Kind indicates whether promotion is permitted (Allow) or not permitted
+	// (Deny) while the window is open.
+	//
+	//+kubebuilder:validation:Enum=Allow;Deny
+	Kind PromotionWindowKind `json:"kind"`
+	// Schedule is a standard, five field cron expression describing when each
+	// occurrence of the window opens. e.g. "0 9 * * 1-5" opens the window at
+	// 09:00 every weekday.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// Duration is how long each occurrence of the window remains open after it
+	// opens. e.g. "7h".
+	//
+	//+kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(h|m|s))+$`
+	Duration string `json:"duration"`
+	// TimeZone is the name of the IANA time zone in which the Schedule is
+	// interpreted. e.g. "Europe/Berlin". If left unspecified, UTC is used.
+	TimeZone string `json:"timeZone,omitempty"`
+	// Reason optionally explains the window. e.g. "end of quarter freeze". It
+	// is included in messages explaining why promotion is not permitted.
+	Reason string `json:"reason,omitempty"`
+}
+
+// PromotionQueuePolicy determines how Pending Promotions to a Stage are
+// treated when newer Promotions to the same Stage are created.
+type PromotionQueuePolicy string
+
+const (
+	// PromotionQueuePolicySequential executes all Promotions to a Stage, one
+	// at a time, in order. This is the default.
+	PromotionQueuePolicySequential PromotionQueuePolicy = "Sequential"
+	// PromotionQueuePolicySupersedePending marks any Pending Promotion to a
+	// Stage as Superseded when a newer Promotion to the same Stage exists.
+	PromotionQueuePolicySupersedePending PromotionQueuePolicy = "SupersedePending"
+)
+
 // ProjectStatus describes a Project's current status.
 type ProjectStatus struct {
 	// Phase describes the Project's current phase.
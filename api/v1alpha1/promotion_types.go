@@ -26,12 +26,18 @@ const (
 	// reasons. Further information about the failure can be found in the
 	// Promotion's status.
 	PromotionPhaseErrored PromotionPhase = "Errored"
+	// PromotionPhaseSuperseded denotes a Promotion that was never executed
+	// because a newer Promotion to the same Stage was created while it was
+	// still Pending and the applicable PromotionPolicy calls for Pending
+	// Promotions to be superseded.
+	PromotionPhaseSuperseded PromotionPhase = "Superseded"
 )
 
 // IsTerminal returns true if the PromotionPhase is a terminal one.
 func (p *PromotionPhase) IsTerminal() bool {
 	switch *p {
-	case PromotionPhaseSucceeded, PromotionPhaseFailed, PromotionPhaseErrored:
+	case PromotionPhaseSucceeded, PromotionPhaseFailed, PromotionPhaseErrored,
+		PromotionPhaseSuperseded:
 		return true
 	default:
 		return false
@@ -44,6 +50,8 @@ func (p *PromotionPhase) IsTerminal() bool {
 //+kubebuilder:printcolumn:name=Stage,type=string,JSONPath=`.spec.stage`
 //+kubebuilder:printcolumn:name=Freight,type=string,JSONPath=`.spec.freight`
 //+kubebuilder:printcolumn:name=Phase,type=string,JSONPath=`.status.phase`
+//+kubebuilder:printcolumn:name=Priority,type=integer,JSONPath=`.spec.priority`,priority=1
+//+kubebuilder:printcolumn:name=Not Before,type=date,JSONPath=`.spec.notBefore`,priority=1
 //+kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Promotion represents a request to transition a particular Stage into a
@@ -80,6 +88,20 @@ type PromotionSpec struct {
 	//
 	//+kubebuilder:validation:MinLength=1
 	Freight string `json:"freight"`
+	// NotBefore optionally specifies a time before which the Promotion must not
+	// begin. Until then, the Promotion remains Pending and does not prevent
+	// other Promotions to the same Stage from proceeding.
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+	// Priority optionally specifies the priority of this Promotion relative to
+	// other Promotions to the same Stage. Pending Promotions with a higher
+	// priority begin before those with a lower priority. Promotions of equal
+	// priority begin in the order they were created. Creating a Promotion with
+	// a priority greater than zero requires permission to use the custom
+	// "prioritize" verb on the Stage referenced by the Stage field.
+	//
+	//+kubebuilder:validation:Minimum=-1000
+	//+kubebuilder:validation:Maximum=1000
+	Priority int32 `json:"priority,omitempty"`
 }
 
 // PromotionStatus describes the current state of the transition represented by
@@ -95,6 +117,68 @@ type PromotionStatus struct {
 	// Metadata holds arbitrary metadata set by promotion mechanisms
 	// (e.g. for display purposes, or internal bookkeeping)
 	Metadata map[string]string `json:"metadata,omitempty"`
+	// Hooks describes the results of any pre- or post-promotion hooks that have
+	// been executed as part of this Promotion.
+	Hooks []PromotionHookResult `json:"hooks,omitempty"`
+	// ControllerInstance identifies the controller instance that began, or has
+	// since taken over, execution of this Promotion. A controller instance that
+	// finds a Running Promotion attributed to another instance (e.g. following
+	// a restart or a change of leadership) claims it before resuming it.
+	ControllerInstance string `json:"controllerInstance,omitempty"`
+}
+
+type PromotionHookType string
+
+const (
+	// PromotionHookTypePre denotes a hook that is executed before any other
+	// promotion mechanisms.
+	PromotionHookTypePre PromotionHookType = "Pre"
+	// PromotionHookTypePost denotes a hook that is executed after all other
+	// promotion mechanisms have completed successfully.
+	PromotionHookTypePost PromotionHookType = "Post"
+)
+
+type PromotionHookPhase string
+
+const (
+	// PromotionHookPhaseRunning denotes a hook that is still being executed.
+	PromotionHookPhaseRunning PromotionHookPhase = "Running"
+	// PromotionHookPhaseSucceeded denotes a hook that has completed
+	// successfully.
+	PromotionHookPhaseSucceeded PromotionHookPhase = "Succeeded"
+	// PromotionHookPhaseFailed denotes a hook that has failed.
+	PromotionHookPhaseFailed PromotionHookPhase = "Failed"
+)
+
+// IsTerminal returns true if the PromotionHookPhase is a terminal one.
+func (p *PromotionHookPhase) IsTerminal() bool {
+	switch *p {
+	case PromotionHookPhaseSucceeded, PromotionHookPhaseFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// PromotionHookResult describes the result of executing a single pre- or
+// post-promotion hook.
+type PromotionHookResult struct {
+	// Name is the name of the hook.
+	Name string `json:"name"`
+	// Type indicates whether the hook is a pre- or post-promotion hook.
+	Type PromotionHookType `json:"type"`
+	// Phase describes where the hook currently is in its lifecycle.
+	Phase PromotionHookPhase `json:"phase,omitempty"`
+	// Message may contain additional information about the hook's result. i.e.
+	// If the Phase field has a value of Failed, this field can be expected to
+	// explain why.
+	Message string `json:"message,omitempty"`
+	// Job is the name of the Kubernetes Job executing the hook, if applicable.
+	Job string `json:"job,omitempty"`
+	// StartedAt is the time at which execution of the hook began.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// FinishedAt is the time at which execution of the hook completed.
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
 }
 
 // WithPhase returns a copy of PromotionStatus with the given phase
@@ -68,6 +68,16 @@ const (
 	ImageUpdateValueTypeDigest         ImageUpdateValueType = "Digest"
 )
 
+// +kubebuilder:validation:Enum={RepoAndTag,Tag,RepoAndDigest,Digest}
+type OCIArtifactUpdateValueType string
+
+const (
+	OCIArtifactUpdateValueTypeRepoAndTag    OCIArtifactUpdateValueType = "RepoAndTag"
+	OCIArtifactUpdateValueTypeTag           OCIArtifactUpdateValueType = "Tag"
+	OCIArtifactUpdateValueTypeRepoAndDigest OCIArtifactUpdateValueType = "RepoAndDigest"
+	OCIArtifactUpdateValueTypeDigest        OCIArtifactUpdateValueType = "Digest"
+)
+
 type HealthState string
 
 const (
@@ -153,18 +163,79 @@ type StageSpec struct {
 	// Verification describes how to verify a Stage's current Freight is fit for
 	// promotion downstream.
 	Verification *Verification `json:"verification,omitempty"`
+	// HistoryDepth is the maximum number of entries retained in the Stage's
+	// Freight history. If unspecified, the last ten Freight are retained.
+	//
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=1000
+	HistoryDepth int32 `json:"historyDepth,omitempty"`
+}
+
+// DefaultStageHistoryDepth is the number of entries retained in a Stage's
+// Freight history when its HistoryDepth is unspecified.
+const DefaultStageHistoryDepth = 10
+
+// GetHistoryDepth returns the maximum number of entries to be retained in the
+// Stage's Freight history, falling back to DefaultStageHistoryDepth if none
+// is specified.
+func (s *StageSpec) GetHistoryDepth() int {
+	if s == nil || s.HistoryDepth <= 0 {
+		return DefaultStageHistoryDepth
+	}
+	return int(s.HistoryDepth)
 }
 
 // Subscriptions describes a Stage's sources of Freight.
 type Subscriptions struct {
 	// Warehouse is a subscription to a Warehouse. This field is mutually
-	// exclusive with the UpstreamStages field.
+	// exclusive with the Warehouses and UpstreamStages fields.
 	Warehouse string `json:"warehouse,omitempty"`
+	// Warehouses is a subscription to multiple Warehouses. Freight from each
+	// Warehouse is treated as a separate stream, with each piece of Freight
+	// promoted to this Stage independently of Freight from the other
+	// Warehouses. This field is mutually exclusive with the Warehouse and
+	// UpstreamStages fields.
+	Warehouses []string `json:"warehouses,omitempty"`
 	// UpstreamStages identifies other Stages as potential sources of Freight
 	// for this Stage. This field is mutually exclusive with the Repos field.
 	UpstreamStages []StageSubscription `json:"upstreamStages,omitempty"`
+	// UpstreamStagesMode determines whether Freight must have been verified in
+	// Any (the default) or All of the Stages identified by the UpstreamStages
+	// field to be available to this Stage. Freight that has been manually
+	// approved for this Stage is available to it regardless.
+	//
+	//+kubebuilder:validation:Enum=Any;All
+	UpstreamStagesMode UpstreamStagesMode `json:"upstreamStagesMode,omitempty"`
+}
+
+// GetWarehouses returns the names of all Warehouses subscribed to, regardless
+// of whether they were specified using the Warehouse or Warehouses field.
+func (s *Subscriptions) GetWarehouses() []string {
+	if s == nil {
+		return nil
+	}
+	if len(s.Warehouses) > 0 {
+		return s.Warehouses
+	}
+	if s.Warehouse != "" {
+		return []string{s.Warehouse}
+	}
+	return nil
 }
 
+// UpstreamStagesMode determines in how many of a Stage's upstream Stages
+// Freight must have been verified to be available to the Stage.
+type UpstreamStagesMode string
+
+const (
+	// UpstreamStagesModeAny makes Freight available to a Stage once it has been
+	// verified in any one of the Stage's upstream Stages. This is the default.
+	UpstreamStagesModeAny UpstreamStagesMode = "Any"
+	// UpstreamStagesModeAll makes Freight available to a Stage only once it has
+	// been verified in all of the Stage's upstream Stages.
+	UpstreamStagesModeAll UpstreamStagesMode = "All"
+)
+
 // StageSubscription defines a subscription to Freight from another Stage.
 type StageSubscription struct {
 	// Name specifies the name of a Stage.
@@ -172,6 +243,13 @@ type StageSubscription struct {
 	//+kubebuilder:validation:MinLength=1
 	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
 	Name string `json:"name"`
+	// RequiredSoakTime optionally specifies how long Freight must have been
+	// verified in the upstream Stage before it is available to this Stage.
+	// e.g. "24h". If left unspecified, Freight is available as soon as it has
+	// been verified in the upstream Stage.
+	//
+	//+kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(h|m|s))+$`
+	RequiredSoakTime string `json:"requiredSoakTime,omitempty"`
 }
 
 // PromotionMechanisms describes how to incorporate Freight into a Stage.
@@ -186,6 +264,173 @@ type PromotionMechanisms struct {
 	// updates specified by the GitRepoUpdates field, if any, are applied BEFORE
 	// these.
 	ArgoCDAppUpdates []ArgoCDAppUpdate `json:"argoCDAppUpdates,omitempty"`
+	// JobSteps describes custom promotion steps, each of which is executed as a
+	// Kubernetes Job in the Stage's namespace. Steps are executed in order, AFTER
+	// all updates specified by the GitRepoUpdates field, if any, and BEFORE all
+	// updates specified by the ArgoCDAppUpdates field, if any.
+	JobSteps []PromotionJobStep `json:"jobSteps,omitempty"`
+	// PreHooks describes actions that should be executed, in order, before any
+	// other promotion mechanisms. If any pre-hook fails, the Promotion fails and
+	// no other promotion mechanisms are executed.
+	PreHooks []PromotionHook `json:"preHooks,omitempty"`
+	// PostHooks describes actions that should be executed, in order, after all
+	// other promotion mechanisms have completed successfully. The failure of a
+	// post-hook is recorded in the Promotion's status, but does not cause the
+	// Promotion to fail or to be undone.
+	PostHooks []PromotionHook `json:"postHooks,omitempty"`
+}
+
+// PromotionHook describes a single action to be executed before or after a
+// Stage's other promotion mechanisms. Exactly one of the HTTP or Job fields
+// must be specified.
+type PromotionHook struct {
+	// Name is a name for the hook that is unique among the Stage's pre-hooks or
+	// post-hooks. It is used to identify the hook's results in the status of a
+	// Promotion.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:MaxLength=40
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+	// HTTP describes an HTTP request to be sent as the hook's action.
+	HTTP *HTTPPromotionHook `json:"http,omitempty"`
+	// Job describes a Kubernetes Job to be executed as the hook's action.
+	Job *PromotionJob `json:"job,omitempty"`
+}
+
+// PromotionJobStep describes a custom promotion step that is executed as a
+// Kubernetes Job. The step succeeds only if the Job's container exits with a
+// zero exit code. The container may additionally write a small JSON document to
+// the file indicated by the KARGO_RESULT_FILE environment variable. Recognized
+// fields of that document are "phase", which may be set to "Failed" to fail the
+// step regardless of exit code, "message", which is reported in the
+// Promotion's status, and "healthCheckCommit", which, if the step checked out
+// a Git repository, updates the commit used for health checks of that
+// repository.
+type PromotionJobStep struct {
+	// Name is a name for the step that is unique among the Stage's Job steps.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:MaxLength=40
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+	// Git optionally describes a Git repository to be checked out into the
+	// Job's workspace before the container is executed.
+	Git *PromotionJobGitCheckout `json:"git,omitempty"`
+	// Job describes the container to execute.
+	Job PromotionJob `json:"job"`
+}
+
+// PromotionJobGitCheckout describes a Git repository to be checked out into
+// the workspace of a PromotionJobStep. The checkout is performed by a separate
+// Job, before the step's own Job is created, so that credentials for the
+// repository are never exposed to the step's container. The path of the
+// working tree is made available to the container through the KARGO_REPO_DIR
+// environment variable.
+type PromotionJobGitCheckout struct {
+	// RepoURL is the URL of the repository to check out. If the Freight being
+	// promoted references a commit from this repository, that commit is checked
+	// out.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=`^https://(\w+([\.-]\w+)*@)?\w+([\.-]\w+)*(:[\d]+)?(/.*)?$`
+	RepoURL string `json:"repoURL"`
+	// Branch specifies a branch of the repository to check out when the Freight
+	// being promoted does not reference a commit from the repository. If left
+	// unspecified, the repository's default branch is checked out.
+	//
+	//+kubebuilder:validation:Optional
+	//+kubebuilder:validation:Pattern=`^(\w+([-/]\w+)*)?$`
+	Branch string `json:"branch,omitempty"`
+}
+
+// HTTPPromotionHook describes an HTTP request to be sent before or after a
+// promotion. Any response with a 2xx status code is considered successful.
+type HTTPPromotionHook struct {
+	// URL is the URL to which the request should be sent.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+	// Method is the HTTP method to use for the request. If left unspecified,
+	// this defaults to POST.
+	//
+	//+kubebuilder:validation:Enum={GET,POST,PUT,PATCH,DELETE}
+	//+kubebuilder:default=POST
+	Method string `json:"method,omitempty"`
+	// Headers lists additional headers to include in the request.
+	Headers []HTTPHeader `json:"headers,omitempty"`
+	// HeadersSecret optionally names a Secret in the Stage's namespace. Every
+	// key/value pair in the Secret's data will be included in the request as an
+	// additional header. This is useful for supplying credentials. The Secret
+	// must be labeled kargo.akuity.io/promotion-hook-secret=true and must not
+	// hold repository credentials. Only users permitted to get the Secret may
+	// reference it.
+	HeadersSecret string `json:"headersSecret,omitempty"`
+	// Body is a Go template for the body of the request. When rendered, it must
+	// produce valid JSON. The template may reference the Promotion, Stage, and
+	// Freight being promoted as .Promotion, .Stage, and .Freight, respectively.
+	// A toJson function is also available for rendering any value as JSON.
+	Body string `json:"body,omitempty"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when sending the request. This should be used only for
+	// testing or in environments where a self-signed certificate is used.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// HTTPHeader describes a single HTTP header.
+type HTTPHeader struct {
+	// Name is the name of the header.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Value is the value of the header.
+	Value string `json:"value"`
+}
+
+// PromotionJob describes a Kubernetes Job, executing a single container, that
+// is executed in a Stage's namespace as part of a promotion. The Job succeeds
+// or fails according to the container's exit code. The names of the
+// Promotion, Stage, and Freight involved are made available to the container
+// through the KARGO_PROMOTION, KARGO_STAGE, and KARGO_FREIGHT environment
+// variables. The complete Promotion, Stage, and Freight are made available as
+// a JSON object, with keys "promotion", "stage", and "freight", through the
+// KARGO_CONTEXT environment variable.
+type PromotionJob struct {
+	// Image is the container image to execute.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// Command overrides the container image's entrypoint.
+	Command []string `json:"command,omitempty"`
+	// Args are the arguments to the entrypoint. Each is a Go template that may
+	// reference the Promotion, Stage, and Freight being promoted as .Promotion,
+	// .Stage, and .Freight, respectively.
+	Args []string `json:"args,omitempty"`
+	// Env lists additional environment variables to set in the container. Each
+	// value is a Go template with access to the same data as Args.
+	Env []PromotionJobEnvVar `json:"env,omitempty"`
+	// ServiceAccountName is the name of a ServiceAccount in the Stage's
+	// namespace to run the Job as. Only users permitted to impersonate the
+	// ServiceAccount may reference it. If left unspecified, the namespace's
+	// default ServiceAccount is used.
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// ActiveDeadlineSeconds optionally limits how long the Job may run before
+	// it is considered failed.
+	//
+	//+kubebuilder:validation:Minimum=1
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// PromotionJobEnvVar describes a single environment variable to be set in the
+// container of a PromotionJob.
+type PromotionJobEnvVar struct {
+	// Name is the name of the environment variable.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Value is a Go template for the value of the environment variable.
+	Value string `json:"value,omitempty"`
 }
 
 // GitRepoUpdate describes updates that should be applied to a Git repository
@@ -230,6 +475,11 @@ type GitRepoUpdate struct {
 	// Helm describes how to use Helm to incorporate Freight into the Stage. This
 	// is mutually exclusive with the Render and Kustomize fields.
 	Helm *HelmPromotionMechanism `json:"helm,omitempty"`
+	// OCIArtifacts describes how specific versions of OCI artifacts can be
+	// written to YAML files in the repository. These updates may be combined
+	// with any of the Render, Kustomize, or Helm fields and are applied after
+	// whichever of those is specified, if any.
+	OCIArtifacts []OCIArtifactUpdate `json:"ociArtifacts,omitempty"`
 }
 
 // PullRequestPromotionMechanism describes how to generate a pull request against the write branch during promotion
@@ -342,6 +592,42 @@ type HelmImageUpdate struct {
 	Value ImageUpdateValueType `json:"value"`
 }
 
+// OCIArtifactUpdate describes how a specific version of an OCI artifact can be
+// written to a specific key in a specific YAML file.
+type OCIArtifactUpdate struct {
+	// RepoURL identifies the artifact by the URL of its repository. This must
+	// match the RepoURL of an OCI artifact subscription. This is a required
+	// field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+	RepoURL string `json:"repoURL"`
+	// Path specifies a path to the YAML file that is to be updated. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	Path string `json:"path"`
+	// Key specifies a key within the YAML file that is to be updated. Nested
+	// keys are separated by dots. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+	// Value specifies the new value for the specified key in the specified YAML
+	// file. Valid values are:
+	//
+	// - RepoAndTag: Replaces the value of the specified key with
+	//   <repo URL>:<tag>
+	// - Tag: Replaces the value of the specified key with just the new tag
+	// - RepoAndDigest: Replaces the value of the specified key with
+	//   <repo URL>@<digest>
+	// - Digest: Replaces the value of the specified key with just the new
+	//   digest.
+	//
+	// This is a required field.
+	Value OCIArtifactUpdateValueType `json:"value"`
+}
+
 // HelmChartDependencyUpdate describes how a specific Helm chart that is used
 // as a subchart of an umbrella chart can be updated.
 type HelmChartDependencyUpdate struct {
@@ -507,7 +793,7 @@ type StageStatus struct {
 	// Freight describing what is currently deployed to the Stage.
 	CurrentFreight *FreightReference `json:"currentFreight,omitempty"`
 	// History is a stack of recent Freight. By default, the last ten Freight are
-	// stored.
+	// stored, but this can be changed using the Stage's HistoryDepth field.
 	History FreightReferenceStack `json:"history,omitempty"`
 	// Health is the Stage's last observed health.
 	Health *Health `json:"health,omitempty"`
@@ -519,6 +805,25 @@ type StageStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// CurrentPromotion is a reference to the currently Running promotion.
 	CurrentPromotion *PromotionInfo `json:"currentPromotion,omitempty"`
+	// Lock, when non-nil, indicates the Stage has been locked on its current
+	// Freight. While a Stage is locked, neither automatic nor manual Promotions
+	// to it are permitted unless explicitly overridden using the break-glass
+	// annotation.
+	Lock *StageLock `json:"lock,omitempty"`
+	// Message describes why an action the Stage controller would otherwise have
+	// taken automatically, such as rolling back Freight that failed
+	// verification, was not taken.
+	Message string `json:"message,omitempty"`
+}
+
+// StageLock describes a lock that pins a Stage to its current Freight.
+type StageLock struct {
+	// Reason is a human-readable explanation of why the Stage was locked.
+	Reason string `json:"reason,omitempty"`
+	// LockedBy identifies the user who locked the Stage.
+	LockedBy string `json:"lockedBy,omitempty"`
+	// LockedAt is the time at which the Stage was locked.
+	LockedAt *metav1.Time `json:"lockedAt,omitempty"`
 }
 
 // FreightReference is a simplified representation of a piece of Freight -- not
@@ -534,9 +839,20 @@ type FreightReference struct {
 	Images []Image `json:"images,omitempty"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty"`
+	// OCIArtifacts describes specific versions of specific OCI artifacts.
+	OCIArtifacts []OCIArtifact `json:"ociArtifacts,omitempty"`
 	// VerificationInfo is information about any verification process that was
 	// associated with this Freight for this Stage.
 	VerificationInfo *VerificationInfo `json:"verificationInfo,omitempty"`
+	// Promotion is the name of the Promotion that transitioned the Stage into
+	// this Freight.
+	Promotion string `json:"promotion,omitempty"`
+	// PromotedBy identifies the user who requested the Promotion that
+	// transitioned the Stage into this Freight.
+	PromotedBy string `json:"promotedBy,omitempty"`
+	// PromotedAt is the time at which the Stage was transitioned into this
+	// Freight.
+	PromotedAt *metav1.Time `json:"promotedAt,omitempty"`
 }
 
 type FreightReferenceStack []FreightReference
@@ -577,13 +893,23 @@ func (f FreightReferenceStack) Top() (FreightReference, bool) {
 // the new elements at the top of the stack will be equal to the order in which
 // they were passed to this function. i.e. The first new element passed will be
 // the element at the top of the stack. If resulting modification grow the depth
-// of the stack beyond 10 elements, the stack is truncated at the bottom. i.e.
-// Modified to contain only the top 10 elements.
+// of the stack beyond DefaultStageHistoryDepth elements, the stack is truncated
+// at the bottom. i.e. Modified to contain only the top
+// DefaultStageHistoryDepth elements.
 func (f *FreightReferenceStack) Push(freight ...FreightReference) {
+	f.PushWithDepth(DefaultStageHistoryDepth, freight...)
+}
+
+// PushWithDepth is like Push, but truncates the stack to the specified depth
+// instead of to DefaultStageHistoryDepth. A depth less than one is treated as
+// DefaultStageHistoryDepth.
+func (f *FreightReferenceStack) PushWithDepth(depth int, freight ...FreightReference) {
 	*f = append(freight, *f...)
-	const max = 10
-	if len(*f) > max {
-		*f = (*f)[:max]
+	if depth < 1 {
+		depth = DefaultStageHistoryDepth
+	}
+	if len(*f) > depth {
+		*f = (*f)[:depth]
 	}
 }
 
@@ -618,6 +944,21 @@ type Chart struct {
 	Version string `json:"version,omitempty"`
 }
 
+// OCIArtifact describes a specific version of an arbitrary OCI artifact.
+type OCIArtifact struct {
+	// RepoURL describes the repository in which the artifact can be found.
+	RepoURL string `json:"repoURL,omitempty"`
+	// Tag identifies a specific version of the artifact in the repository
+	// specified by RepoURL.
+	Tag string `json:"tag,omitempty"`
+	// Digest identifies a specific version of the artifact in the repository
+	// specified by RepoURL. This is a more precise identifier than Tag.
+	Digest string `json:"digest,omitempty"`
+	// ArtifactType is the type of the artifact. This is the artifactType of its
+	// manifest or, if that is not set, the media type of its config.
+	ArtifactType string `json:"artifactType,omitempty"`
+}
+
 // Equals returns a bool indicating whether two GitCommits are equivalent.
 func (g *GitCommit) Equals(rhs *GitCommit) bool {
 	if g == nil && rhs == nil {
@@ -694,6 +1035,13 @@ type Verification struct {
 	AnalysisRunMetadata *AnalysisRunMetadata `json:"analysisRunMetadata,omitempty"`
 	// Args lists arguments that should be added to all AnalysisRuns.
 	Args []AnalysisRunArgument `json:"args,omitempty"`
+	// AutoRollback specifies whether the Stage should automatically be rolled
+	// back to the last Freight from its history that was verified in the Stage
+	// when verification of its current Freight fails or errors. When this
+	// occurs, the current Freight is also marked as having failed in the Stage
+	// so that it will not be selected for auto-promotion again. This field
+	// defaults to false.
+	AutoRollback bool `json:"autoRollback,omitempty"`
 }
 
 // AnalysisTemplateReference is a reference to an AnalysisTemplate.
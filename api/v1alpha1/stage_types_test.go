@@ -6,6 +6,37 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestSubscriptionsGetWarehouses(t *testing.T) {
+	testCases := []struct {
+		name           string
+		subs           *Subscriptions
+		expectedResult []string
+	}{
+		{
+			name: "subscriptions are nil",
+		},
+		{
+			name: "no Warehouses",
+			subs: &Subscriptions{},
+		},
+		{
+			name:           "single Warehouse",
+			subs:           &Subscriptions{Warehouse: "foo"},
+			expectedResult: []string{"foo"},
+		},
+		{
+			name:           "multiple Warehouses",
+			subs:           &Subscriptions{Warehouses: []string{"foo", "bar"}},
+			expectedResult: []string{"foo", "bar"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedResult, testCase.subs.GetWarehouses())
+		})
+	}
+}
+
 func TestFreightReferenceStackEmpty(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -149,3 +180,83 @@ func TestFreightReferenceStackPush(t *testing.T) {
 		})
 	}
 }
+
+func TestFreightReferenceStackPushWithDepth(t *testing.T) {
+	testCases := []struct {
+		name          string
+		stack         FreightReferenceStack
+		depth         int
+		newFreight    []FreightReference
+		expectedStack FreightReferenceStack
+	}{
+		{
+			name:          "stack is not full",
+			stack:         FreightReferenceStack{{ID: "foo"}},
+			depth:         2,
+			newFreight:    []FreightReference{{ID: "bar"}},
+			expectedStack: FreightReferenceStack{{ID: "bar"}, {ID: "foo"}},
+		},
+		{
+			name:          "stack is full",
+			stack:         FreightReferenceStack{{ID: "foo"}, {ID: "bar"}},
+			depth:         2,
+			newFreight:    []FreightReference{{ID: "baz"}},
+			expectedStack: FreightReferenceStack{{ID: "baz"}, {ID: "foo"}},
+		},
+		{
+			name: "depth is greater than default",
+			stack: FreightReferenceStack{
+				{}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+			},
+			depth:      20,
+			newFreight: []FreightReference{{ID: "foo"}},
+			expectedStack: FreightReferenceStack{
+				{ID: "foo"}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+			},
+		},
+		{
+			name: "depth is unspecified",
+			stack: FreightReferenceStack{
+				{}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+			},
+			newFreight: []FreightReference{{ID: "foo"}},
+			expectedStack: FreightReferenceStack{
+				{ID: "foo"}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.stack.PushWithDepth(testCase.depth, testCase.newFreight...)
+			require.Equal(t, testCase.expectedStack, testCase.stack)
+		})
+	}
+}
+
+func TestStageSpecGetHistoryDepth(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     *StageSpec
+		expected int
+	}{
+		{
+			name:     "spec is nil",
+			expected: DefaultStageHistoryDepth,
+		},
+		{
+			name:     "history depth is unspecified",
+			spec:     &StageSpec{},
+			expected: DefaultStageHistoryDepth,
+		},
+		{
+			name:     "history depth is specified",
+			spec:     &StageSpec{HistoryDepth: 50},
+			expected: 50,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.spec.GetHistoryDepth())
+		})
+	}
+}
@@ -12,6 +12,15 @@ const (
 	CommitSelectionStrategySemVer           CommitSelectionStrategy = "SemVer"
 )
 
+// +kubebuilder:validation:Enum={Commits,Tags,CommitsAndTags}
+type GitSignatureRequirement string
+
+const (
+	GitSignatureRequirementCommits        GitSignatureRequirement = "Commits"
+	GitSignatureRequirementTags           GitSignatureRequirement = "Tags"
+	GitSignatureRequirementCommitsAndTags GitSignatureRequirement = "CommitsAndTags"
+)
+
 // +kubebuilder:validation:Enum={Digest,Lexical,NewestBuild,SemVer}
 type ImageSelectionStrategy string
 
@@ -22,6 +31,30 @@ const (
 	ImageSelectionStrategySemVer      ImageSelectionStrategy = "SemVer"
 )
 
+// +kubebuilder:validation:Enum={Digest,Lexical,SemVer}
+type OCIArtifactSelectionStrategy string
+
+const (
+	OCIArtifactSelectionStrategyDigest  OCIArtifactSelectionStrategy = "Digest"
+	OCIArtifactSelectionStrategyLexical OCIArtifactSelectionStrategy = "Lexical"
+	OCIArtifactSelectionStrategySemVer  OCIArtifactSelectionStrategy = "SemVer"
+)
+
+const (
+	// WarehouseConditionTypeReady is the type of a Warehouse condition that
+	// indicates whether all of the Warehouse's subscriptions were successfully
+	// polled and Freight successfully assembled from the results the last time
+	// the Warehouse was reconciled.
+	WarehouseConditionTypeReady = "Ready"
+
+	// WarehouseReasonSynced is the reason for a Ready condition with a status of
+	// True.
+	WarehouseReasonSynced = "Synced"
+	// WarehouseReasonSyncFailed is the reason for a Ready condition with a status
+	// of False.
+	WarehouseReasonSyncFailed = "SyncFailed"
+)
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -49,10 +82,92 @@ type WarehouseSpec struct {
 	//
 	//+kubebuilder:validation:MinItems=1
 	Subscriptions []RepoSubscription `json:"subscriptions"`
+	// Interval optionally specifies how often this Warehouse should poll its
+	// subscriptions for new artifacts. e.g. "10m" or "1h". It must be at least
+	// one minute. If left unspecified, subscriptions are polled every five
+	// minutes. Regardless of this value,
+	// polling can be triggered early by refreshing the Warehouse, which is what
+	// the API server's inbound webhook receiver does when a subscribed
+	// repository is pushed to.
+	//
+	//+kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(h|m|s))+$`
+	Interval string `json:"interval,omitempty"`
+	// FreightAssembly optionally specifies rules that must be satisfied by the
+	// artifacts selected from this Warehouse's subscriptions before Freight is
+	// assembled from them. This is useful for keeping related artifacts, such as
+	// an image and the chart that deploys it, in lock-step when they are not
+	// published at exactly the same time.
+	FreightAssembly *FreightAssemblyRules `json:"freightAssembly,omitempty"`
+}
+
+// FreightAssemblyRules describes rules that must be satisfied by the artifacts
+// selected from a Warehouse's subscriptions before Freight is assembled from
+// them.
+type FreightAssemblyRules struct {
+	// SettleTime optionally specifies a minimum amount of time for which the
+	// artifacts selected from the Warehouse's subscriptions must have remained
+	// unchanged before Freight is assembled from them. e.g. "2m". This debounces
+	// releases that publish several artifacts in quick succession.
+	//
+	//+kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(h|m|s))+$`
+	SettleTime string `json:"settleTime,omitempty"`
+	// Constraints optionally specifies relationships that must hold between the
+	// versions of artifacts selected from the Warehouse's subscriptions. Freight
+	// is never assembled from artifacts that violate any of these.
+	Constraints []FreightAssemblyConstraint `json:"constraints,omitempty"`
+}
+
+// FreightAssemblyConstraint requires the versions of two or more artifacts
+// selected from a Warehouse's subscriptions to be equal.
+type FreightAssemblyConstraint struct {
+	// Match references the versions that must all be equal.
+	//
+	//+kubebuilder:validation:MinItems=2
+	Match []ArtifactVersionReference `json:"match"`
+}
+
+// ArtifactVersionField specifies which version of an artifact is referenced
+// by an ArtifactVersionReference.
+//
+// +kubebuilder:validation:Enum={Tag,Commit,Version,AppVersion}
+type ArtifactVersionField string
+
+const (
+	// ArtifactVersionFieldTag references the tag of an image, of an OCI
+	// artifact, or of a Git commit.
+	ArtifactVersionFieldTag ArtifactVersionField = "Tag"
+	// ArtifactVersionFieldCommit references the ID of a Git commit.
+	ArtifactVersionFieldCommit ArtifactVersionField = "Commit"
+	// ArtifactVersionFieldVersion references the version of a Helm chart.
+	ArtifactVersionFieldVersion ArtifactVersionField = "Version"
+	// ArtifactVersionFieldAppVersion references the appVersion of a Helm chart,
+	// as specified by its Chart.yaml.
+	ArtifactVersionFieldAppVersion ArtifactVersionField = "AppVersion"
+)
+
+// ArtifactVersionReference references a version of an artifact selected from
+// one of a Warehouse's subscriptions.
+type ArtifactVersionReference struct {
+	// RepoURL identifies the subscription by the URL of its repository.
+	//
+	//+kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL"`
+	// Chart identifies the chart by name when RepoURL is the URL of a classic
+	// chart repository.
+	Chart string `json:"chart,omitempty"`
+	// Field specifies which version of the artifact is referenced. Tag is
+	// applicable to images and Git commits, Commit to Git commits, and Version
+	// and AppVersion to Helm charts.
+	Field ArtifactVersionField `json:"field"`
+	// TrimPrefix optionally specifies a prefix to remove from the version before
+	// it is compared. e.g. "v" permits a Git tag of "v1.0.0" to equal an image
+	// tag of "1.0.0".
+	TrimPrefix string `json:"trimPrefix,omitempty"`
 }
 
 // RepoSubscription describes a subscription to ONE OF a Git repository, a
-// container image repository, or a Helm chart repository.
+// container image repository, a Helm chart repository, or a repository of
+// arbitrary OCI artifacts.
 type RepoSubscription struct {
 	// Git describes a subscriptions to a Git repository.
 	Git *GitSubscription `json:"git,omitempty"`
@@ -60,6 +175,9 @@ type RepoSubscription struct {
 	Image *ImageSubscription `json:"image,omitempty"`
 	// Chart describes a subscription to a Helm chart repository.
 	Chart *ChartSubscription `json:"chart,omitempty"`
+	// OCIArtifact describes a subscription to a repository of arbitrary OCI
+	// artifacts, such as Flux OCI artifacts, WASM modules, or policy bundles.
+	OCIArtifact *OCIArtifactSubscription `json:"ociArtifact,omitempty"`
 }
 
 // GitSubscription defines a subscription to a Git repository.
@@ -111,12 +229,93 @@ type GitSubscription struct {
 	//
 	//+kubebuilder:validation:Optional
 	IgnoreTags []string `json:"ignoreTags,omitempty"`
+	// IncludePaths is a list of glob patterns that can optionally be used to
+	// limit the commits that are considered in determining the newest commit of
+	// interest to those that changed at least one matching path. Patterns are
+	// matched against paths relative to the root of the repository. Within a
+	// pattern, "*" matches any sequence of characters within a single path
+	// segment and "**" matches any sequence of characters across path segments.
+	// When left unspecified, all paths match. Only the 1000 most recent commits
+	// on the branch are considered. The value in this field only has any effect
+	// when the CommitSelectionStrategy is NewestFromBranch. This field is
+	// optional.
+	//
+	//+kubebuilder:validation:Optional
+	IncludePaths []string `json:"includePaths,omitempty"`
+	// ExcludePaths is a list of glob patterns, using the same syntax as
+	// IncludePaths, for paths that are disregarded when determining the newest
+	// commit of interest. A commit that changed only excluded paths is not
+	// considered. The value in this field only has any effect when the
+	// CommitSelectionStrategy is NewestFromBranch. This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	ExcludePaths []string `json:"excludePaths,omitempty"`
+	// IncludeMessages is a regular expression that can optionally be used to
+	// limit the commits that are considered in determining the newest commit of
+	// interest to those whose messages match it. The value in this field only
+	// has any effect when the CommitSelectionStrategy is NewestFromBranch. This
+	// field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	IncludeMessages string `json:"includeMessages,omitempty"`
+	// ExcludeMessages is a regular expression that can optionally be used to
+	// disregard commits whose messages match it when determining the newest
+	// commit of interest. e.g. "\[skip kargo\]". The value in this field only
+	// has any effect when the CommitSelectionStrategy is NewestFromBranch. This
+	// field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	ExcludeMessages string `json:"excludeMessages,omitempty"`
+	// IncludeAuthors is a list of commit authors, each identified by either name
+	// or email address, that can optionally be used to limit the commits that
+	// are considered in determining the newest commit of interest to those
+	// authored by any of them. The value in this field only has any effect when
+	// the CommitSelectionStrategy is NewestFromBranch. This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	IncludeAuthors []string `json:"includeAuthors,omitempty"`
+	// ExcludeAuthors is a list of commit authors, each identified by either name
+	// or email address, whose commits are disregarded when determining the
+	// newest commit of interest. This is useful for ignoring commits made by
+	// bots or by Kargo itself. The value in this field only has any effect when
+	// the CommitSelectionStrategy is NewestFromBranch. This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	ExcludeAuthors []string `json:"excludeAuthors,omitempty"`
+	// SignatureVerification optionally specifies a policy requiring that the
+	// commits and/or tags of interest bear valid signatures by trusted signers.
+	// Candidate commits and tags that do not are disregarded when determining the
+	// newest commit of interest.
+	//
+	//+kubebuilder:validation:Optional
+	SignatureVerification *GitSignatureVerification `json:"signatureVerification,omitempty"`
 	// InsecureSkipTLSVerify specifies whether certificate verification errors
 	// should be ignored when connecting to the repository. This should be enabled
 	// only with great caution.
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
 }
 
+// GitSignatureVerification describes a policy for verifying the signatures of
+// commits and tags from a Git repository.
+type GitSignatureVerification struct {
+	// SecretName is the name of a Secret in the Warehouse's namespace containing
+	// the key material of trusted signers. The Secret's "gpgKeys" key may contain
+	// one or more ASCII-armored GPG public keys and its "allowedSigners" key may
+	// contain an SSH allowed signers file, as described by ssh-keygen(1). This is
+	// a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+	// Require specifies whether commits, annotated tags, or both must be signed.
+	// Requiring signed tags only has any effect when the CommitSelectionStrategy
+	// is Lexical, NewestTag, or SemVer. This field is optional. When left
+	// unspecified, the field is implicitly treated as if its value were
+	// "Commits".
+	//
+	//+kubebuilder:default=Commits
+	Require GitSignatureRequirement `json:"require,omitempty"`
+}
+
 // ImageSubscription defines a subscription to an image repository.
 type ImageSubscription struct {
 	// RepoURL specifies the URL of the image repository to subscribe to. The
@@ -208,14 +407,133 @@ type ChartSubscription struct {
 	SemverConstraint string `json:"semverConstraint,omitempty"`
 }
 
+// OCIArtifactSubscription defines a subscription to a repository of arbitrary
+// OCI artifacts within an OCI registry.
+type OCIArtifactSubscription struct {
+	// RepoURL specifies the URL of the artifact repository to subscribe to. The
+	// value in this field MUST NOT include a tag. This field is required.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+	RepoURL string `json:"repoURL"`
+	// SelectionStrategy specifies the rules for how to identify the newest
+	// version of the artifact specified by the RepoURL field. This field is
+	// optional. When left unspecified, the field is implicitly treated as if its
+	// value were "SemVer".
+	//
+	// +kubebuilder:default=SemVer
+	SelectionStrategy OCIArtifactSelectionStrategy `json:"selectionStrategy,omitempty"`
+	// SemverConstraint specifies constraints on what new artifact versions are
+	// permissible. The value in this field only has any effect when the
+	// SelectionStrategy is SemVer or left unspecified (which is implicitly the
+	// same as SemVer). When the SelectionStrategy is Digest, this field is
+	// required and MUST exactly match the name of the (presumably mutable) tag
+	// whose digest should be tracked. This field is otherwise optional.
+	//
+	//+kubebuilder:validation:Optional
+	SemverConstraint string `json:"semverConstraint,omitempty"`
+	// AllowTags is a regular expression that can optionally be used to limit the
+	// tags that are considered in determining the newest version of an artifact.
+	// This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	AllowTags string `json:"allowTags,omitempty"`
+	// IgnoreTags is a list of tags that must be ignored when determining the
+	// newest version of an artifact. No regular expressions or glob patterns are
+	// supported yet. This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	IgnoreTags []string `json:"ignoreTags,omitempty"`
+	// ArtifactType optionally limits the artifacts that are considered in
+	// determining the newest version of an artifact to those of the specified
+	// type. An artifact's type is the artifactType of its manifest or, if that
+	// is not set, the media type of its config. e.g.
+	// "application/vnd.cncf.flux.config.v1+json" matches Flux OCI artifacts.
+	// Because the type of each candidate must be retrieved from the registry,
+	// only the 20 most preferred of the eligible tags are examined, so care
+	// should be taken to also constrain the eligible tags as much as possible.
+	// This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	ArtifactType string `json:"artifactType,omitempty"`
+}
+
 // WarehouseStatus describes a Warehouse's most recently observed state.
 type WarehouseStatus struct {
+	// Conditions contains the latest available observations of the Warehouse's
+	// state.
+	//
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 	// Error describes any errors that are preventing the Warehouse controller
 	// from polling repositories to discover new Freight.
 	Error string `json:"error,omitempty"`
 	// ObservedGeneration represents the .metadata.generation that this Warehouse
 	// was reconciled against.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// UnverifiedRevisions describes candidate commits and tags that were most
+	// recently disregarded because their signatures could not be verified. Only
+	// candidates that would otherwise have been preferred over those selected
+	// are included.
+	UnverifiedRevisions []UnverifiedGitRevision `json:"unverifiedRevisions,omitempty"`
+	// Subscriptions describes the most recently observed state of each of the
+	// Warehouse's subscriptions. Entries appear in the same order as the
+	// subscriptions in the Warehouse's spec.
+	Subscriptions []SubscriptionStatus `json:"subscriptions,omitempty"`
+	// PendingFreight describes Freight that was assembled from the artifacts
+	// most recently selected from the Warehouse's subscriptions, but that has
+	// not been created because the Warehouse's Freight assembly rules are not
+	// (yet) satisfied.
+	PendingFreight *PendingFreight `json:"pendingFreight,omitempty"`
+}
+
+// PendingFreight describes Freight that has been assembled, but not created,
+// because a Warehouse's Freight assembly rules are not (yet) satisfied.
+type PendingFreight struct {
+	// ID is the ID the Freight will have if it is created.
+	ID string `json:"id"`
+	// Since is when the artifacts the Freight was assembled from were first
+	// selected.
+	Since metav1.Time `json:"since"`
+	// Reason describes why the Freight has not been created.
+	Reason string `json:"reason,omitempty"`
+}
+
+// SubscriptionStatus describes the most recently observed state of one of a
+// Warehouse's subscriptions.
+type SubscriptionStatus struct {
+	// RepoURL is the URL of the repository the subscription is to.
+	RepoURL string `json:"repoURL"`
+	// Chart is the name of the chart the subscription is to. This is only set
+	// for subscriptions to classic chart repositories.
+	Chart string `json:"chart,omitempty"`
+	// LastPolled is when the repository was last polled successfully.
+	LastPolled *metav1.Time `json:"lastPolled,omitempty"`
+	// LatestVersion is the version most recently selected from the repository.
+	// This is a tag or commit ID for a Git repository, a tag for an image
+	// repository, or a version for a chart repository.
+	LatestVersion string `json:"latestVersion,omitempty"`
+	// RecentVersions lists the most preferred of the versions found in the
+	// repository that satisfied the subscription's criteria the last time it was
+	// polled successfully, ordered from most to least preferred.
+	RecentVersions []string `json:"recentVersions,omitempty"`
+	// Error describes the error, if any, that was encountered the last time the
+	// repository was polled.
+	Error string `json:"error,omitempty"`
+}
+
+// UnverifiedGitRevision describes a commit or tag from a Git repository that
+// was disregarded because its signature could not be verified.
+type UnverifiedGitRevision struct {
+	// RepoURL is the URL of the Git repository.
+	RepoURL string `json:"repoURL"`
+	// ID is the ID of the commit.
+	ID string `json:"id,omitempty"`
+	// Tag is the tag that resolved to the commit, if any.
+	Tag string `json:"tag,omitempty"`
+	// Reason explains why the signature could not be verified.
+	Reason string `json:"reason"`
 }
 
 //+kubebuilder:object:root=true
@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -82,9 +83,60 @@ func (in *AnalysisTemplateReference) DeepCopy() *AnalysisTemplateReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Approval) DeepCopyInto(out *Approval) {
+	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approval.
+func (in *Approval) DeepCopy() *Approval {
+	if in == nil {
+		return nil
+	}
+	out := new(Approval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalPolicy) DeepCopyInto(out *ApprovalPolicy) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalPolicy.
+func (in *ApprovalPolicy) DeepCopy() *ApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApprovedStage) DeepCopyInto(out *ApprovedStage) {
 	*out = *in
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]Approval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovedStage.
@@ -266,6 +318,21 @@ func (in *ArgoCDSourceUpdate) DeepCopy() *ArgoCDSourceUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactVersionReference) DeepCopyInto(out *ArtifactVersionReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactVersionReference.
+func (in *ArtifactVersionReference) DeepCopy() *ArtifactVersionReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactVersionReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chart) DeepCopyInto(out *Chart) {
 	*out = *in
@@ -296,6 +363,21 @@ func (in *ChartSubscription) DeepCopy() *ChartSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedStage) DeepCopyInto(out *FailedStage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedStage.
+func (in *FailedStage) DeepCopy() *FailedStage {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Freight) DeepCopyInto(out *Freight) {
 	*out = *in
@@ -304,7 +386,9 @@ func (in *Freight) DeepCopyInto(out *Freight) {
 	if in.Commits != nil {
 		in, out := &in.Commits, &out.Commits
 		*out = make([]GitCommit, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
@@ -316,6 +400,11 @@ func (in *Freight) DeepCopyInto(out *Freight) {
 		*out = make([]Chart, len(*in))
 		copy(*out, *in)
 	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifact, len(*in))
+		copy(*out, *in)
+	}
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -337,6 +426,48 @@ func (in *Freight) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightAssemblyConstraint) DeepCopyInto(out *FreightAssemblyConstraint) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make([]ArtifactVersionReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightAssemblyConstraint.
+func (in *FreightAssemblyConstraint) DeepCopy() *FreightAssemblyConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightAssemblyConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightAssemblyRules) DeepCopyInto(out *FreightAssemblyRules) {
+	*out = *in
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make([]FreightAssemblyConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightAssemblyRules.
+func (in *FreightAssemblyRules) DeepCopy() *FreightAssemblyRules {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightAssemblyRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreightList) DeepCopyInto(out *FreightList) {
 	*out = *in
@@ -375,7 +506,9 @@ func (in *FreightReference) DeepCopyInto(out *FreightReference) {
 	if in.Commits != nil {
 		in, out := &in.Commits, &out.Commits
 		*out = make([]GitCommit, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
@@ -387,11 +520,20 @@ func (in *FreightReference) DeepCopyInto(out *FreightReference) {
 		*out = make([]Chart, len(*in))
 		copy(*out, *in)
 	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifact, len(*in))
+		copy(*out, *in)
+	}
 	if in.VerificationInfo != nil {
 		in, out := &in.VerificationInfo, &out.VerificationInfo
 		*out = new(VerificationInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PromotedAt != nil {
+		in, out := &in.PromotedAt, &out.PromotedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightReference.
@@ -432,12 +574,19 @@ func (in *FreightStatus) DeepCopyInto(out *FreightStatus) {
 		in, out := &in.VerifiedIn, &out.VerifiedIn
 		*out = make(map[string]VerifiedStage, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 	if in.ApprovedFor != nil {
 		in, out := &in.ApprovedFor, &out.ApprovedFor
 		*out = make(map[string]ApprovedStage, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.FailedIn != nil {
+		in, out := &in.FailedIn, &out.FailedIn
+		*out = make(map[string]FailedStage, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
@@ -457,6 +606,10 @@ func (in *FreightStatus) DeepCopy() *FreightStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitCommit) DeepCopyInto(out *GitCommit) {
 	*out = *in
+	if in.CommittedAt != nil {
+		in, out := &in.CommittedAt, &out.CommittedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitCommit.
@@ -507,6 +660,11 @@ func (in *GitRepoUpdate) DeepCopyInto(out *GitRepoUpdate) {
 		*out = new(HelmPromotionMechanism)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifactUpdate, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepoUpdate.
@@ -519,6 +677,21 @@ func (in *GitRepoUpdate) DeepCopy() *GitRepoUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSignatureVerification) DeepCopyInto(out *GitSignatureVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSignatureVerification.
+func (in *GitSignatureVerification) DeepCopy() *GitSignatureVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSignatureVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitSubscription) DeepCopyInto(out *GitSubscription) {
 	*out = *in
@@ -527,6 +700,31 @@ func (in *GitSubscription) DeepCopyInto(out *GitSubscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IncludePaths != nil {
+		in, out := &in.IncludePaths, &out.IncludePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludePaths != nil {
+		in, out := &in.ExcludePaths, &out.ExcludePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludeAuthors != nil {
+		in, out := &in.IncludeAuthors, &out.IncludeAuthors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeAuthors != nil {
+		in, out := &in.ExcludeAuthors, &out.ExcludeAuthors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(GitSignatureVerification)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSubscription.
@@ -539,6 +737,41 @@ func (in *GitSubscription) DeepCopy() *GitSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeader.
+func (in *HTTPHeader) DeepCopy() *HTTPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPromotionHook) DeepCopyInto(out *HTTPPromotionHook) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeader, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPromotionHook.
+func (in *HTTPPromotionHook) DeepCopy() *HTTPPromotionHook {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPromotionHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Health) DeepCopyInto(out *Health) {
 	*out = *in
@@ -726,6 +959,72 @@ func (in *KustomizePromotionMechanism) DeepCopy() *KustomizePromotionMechanism {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifact) DeepCopyInto(out *OCIArtifact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifact.
+func (in *OCIArtifact) DeepCopy() *OCIArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactSubscription) DeepCopyInto(out *OCIArtifactSubscription) {
+	*out = *in
+	if in.IgnoreTags != nil {
+		in, out := &in.IgnoreTags, &out.IgnoreTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactSubscription.
+func (in *OCIArtifactSubscription) DeepCopy() *OCIArtifactSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactUpdate) DeepCopyInto(out *OCIArtifactUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactUpdate.
+func (in *OCIArtifactUpdate) DeepCopy() *OCIArtifactUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingFreight) DeepCopyInto(out *PendingFreight) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingFreight.
+func (in *PendingFreight) DeepCopy() *PendingFreight {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingFreight)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -795,7 +1094,9 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	if in.PromotionPolicies != nil {
 		in, out := &in.PromotionPolicies, &out.PromotionPolicies
 		*out = make([]PromotionPolicy, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -832,7 +1133,7 @@ func (in *Promotion) DeepCopyInto(out *Promotion) {
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = new(PromotionSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	in.Status.DeepCopyInto(&out.Status)
 }
@@ -855,6 +1156,54 @@ func (in *Promotion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionHook) DeepCopyInto(out *PromotionHook) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPPromotionHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(PromotionJob)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionHook.
+func (in *PromotionHook) DeepCopy() *PromotionHook {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionHookResult) DeepCopyInto(out *PromotionHookResult) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionHookResult.
+func (in *PromotionHookResult) DeepCopy() *PromotionHookResult {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionHookResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionInfo) DeepCopyInto(out *PromotionInfo) {
 	*out = *in
@@ -871,6 +1220,92 @@ func (in *PromotionInfo) DeepCopy() *PromotionInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionJob) DeepCopyInto(out *PromotionJob) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]PromotionJobEnvVar, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionJob.
+func (in *PromotionJob) DeepCopy() *PromotionJob {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionJobEnvVar) DeepCopyInto(out *PromotionJobEnvVar) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionJobEnvVar.
+func (in *PromotionJobEnvVar) DeepCopy() *PromotionJobEnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionJobEnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionJobGitCheckout) DeepCopyInto(out *PromotionJobGitCheckout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionJobGitCheckout.
+func (in *PromotionJobGitCheckout) DeepCopy() *PromotionJobGitCheckout {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionJobGitCheckout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionJobStep) DeepCopyInto(out *PromotionJobStep) {
+	*out = *in
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(PromotionJobGitCheckout)
+		**out = **in
+	}
+	in.Job.DeepCopyInto(&out.Job)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionJobStep.
+func (in *PromotionJobStep) DeepCopy() *PromotionJobStep {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionJobStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionList) DeepCopyInto(out *PromotionList) {
 	*out = *in
@@ -920,6 +1355,27 @@ func (in *PromotionMechanisms) DeepCopyInto(out *PromotionMechanisms) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.JobSteps != nil {
+		in, out := &in.JobSteps, &out.JobSteps
+		*out = make([]PromotionJobStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreHooks != nil {
+		in, out := &in.PreHooks, &out.PreHooks
+		*out = make([]PromotionHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostHooks != nil {
+		in, out := &in.PostHooks, &out.PostHooks
+		*out = make([]PromotionHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionMechanisms.
@@ -935,6 +1391,16 @@ func (in *PromotionMechanisms) DeepCopy() *PromotionMechanisms {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionPolicy) DeepCopyInto(out *PromotionPolicy) {
 	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]PromotionWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApprovalPolicy != nil {
+		in, out := &in.ApprovalPolicy, &out.ApprovalPolicy
+		*out = new(ApprovalPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPolicy.
@@ -950,6 +1416,10 @@ func (in *PromotionPolicy) DeepCopy() *PromotionPolicy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
 	*out = *in
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionSpec.
@@ -972,6 +1442,13 @@ func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]PromotionHookResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
@@ -984,6 +1461,21 @@ func (in *PromotionStatus) DeepCopy() *PromotionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWindow) DeepCopyInto(out *PromotionWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWindow.
+func (in *PromotionWindow) DeepCopy() *PromotionWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestPromotionMechanism) DeepCopyInto(out *PullRequestPromotionMechanism) {
 	*out = *in
@@ -1022,6 +1514,11 @@ func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 		*out = new(ChartSubscription)
 		**out = **in
 	}
+	if in.OCIArtifact != nil {
+		in, out := &in.OCIArtifact, &out.OCIArtifact
+		*out = new(OCIArtifactSubscription)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoSubscription.
@@ -1097,6 +1594,25 @@ func (in *StageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageLock) DeepCopyInto(out *StageLock) {
+	*out = *in
+	if in.LockedAt != nil {
+		in, out := &in.LockedAt, &out.LockedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageLock.
+func (in *StageLock) DeepCopy() *StageLock {
+	if in == nil {
+		return nil
+	}
+	out := new(StageLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 	*out = *in
@@ -1152,6 +1668,11 @@ func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 		*out = new(PromotionInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Lock != nil {
+		in, out := &in.Lock, &out.Lock
+		*out = new(StageLock)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageStatus.
@@ -1179,9 +1700,38 @@ func (in *StageSubscription) DeepCopy() *StageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionStatus) DeepCopyInto(out *SubscriptionStatus) {
+	*out = *in
+	if in.LastPolled != nil {
+		in, out := &in.LastPolled, &out.LastPolled
+		*out = (*in).DeepCopy()
+	}
+	if in.RecentVersions != nil {
+		in, out := &in.RecentVersions, &out.RecentVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionStatus.
+func (in *SubscriptionStatus) DeepCopy() *SubscriptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subscriptions) DeepCopyInto(out *Subscriptions) {
 	*out = *in
+	if in.Warehouses != nil {
+		in, out := &in.Warehouses, &out.Warehouses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.UpstreamStages != nil {
 		in, out := &in.UpstreamStages, &out.UpstreamStages
 		*out = make([]StageSubscription, len(*in))
@@ -1199,6 +1749,21 @@ func (in *Subscriptions) DeepCopy() *Subscriptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnverifiedGitRevision) DeepCopyInto(out *UnverifiedGitRevision) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnverifiedGitRevision.
+func (in *UnverifiedGitRevision) DeepCopy() *UnverifiedGitRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(UnverifiedGitRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Verification) DeepCopyInto(out *Verification) {
 	*out = *in
@@ -1252,6 +1817,10 @@ func (in *VerificationInfo) DeepCopy() *VerificationInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerifiedStage) DeepCopyInto(out *VerifiedStage) {
 	*out = *in
+	if in.VerifiedAt != nil {
+		in, out := &in.VerifiedAt, &out.VerifiedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerifiedStage.
@@ -1274,7 +1843,7 @@ func (in *Warehouse) DeepCopyInto(out *Warehouse) {
 		*out = new(WarehouseSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Warehouse.
@@ -1337,6 +1906,11 @@ func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FreightAssembly != nil {
+		in, out := &in.FreightAssembly, &out.FreightAssembly
+		*out = new(FreightAssemblyRules)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseSpec.
@@ -1352,6 +1926,30 @@ func (in *WarehouseSpec) DeepCopy() *WarehouseSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WarehouseStatus) DeepCopyInto(out *WarehouseStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnverifiedRevisions != nil {
+		in, out := &in.UnverifiedRevisions, &out.UnverifiedRevisions
+		*out = make([]UnverifiedGitRevision, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subscriptions != nil {
+		in, out := &in.Subscriptions, &out.Subscriptions
+		*out = make([]SubscriptionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PendingFreight != nil {
+		in, out := &in.PendingFreight, &out.PendingFreight
+		*out = new(PendingFreight)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseStatus.
@@ -27,6 +27,7 @@ import (
 	"github.com/akuity/kargo/internal/cli/cmd/refresh"
 	"github.com/akuity/kargo/internal/cli/cmd/stage"
 	"github.com/akuity/kargo/internal/cli/cmd/update"
+	"github.com/akuity/kargo/internal/cli/cmd/warehouse"
 	clicfg "github.com/akuity/kargo/internal/cli/config"
 	"github.com/akuity/kargo/internal/cli/option"
 )
@@ -108,6 +109,7 @@ func NewRootCommand(
 	cmd.AddCommand(stage.NewCommand(cfg, opt))
 	cmd.AddCommand(refresh.NewCommand(cfg, opt))
 	cmd.AddCommand(update.NewCommand(cfg, opt))
+	cmd.AddCommand(warehouse.NewCommand(cfg, opt))
 	cmd.AddCommand(dashboard.NewCommand(cfg))
 	cmd.AddCommand(newVersionCommand(cfg, opt))
 	cmd.AddCommand(
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubescheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -123,6 +124,15 @@ func newClientForAPI(ctx context.Context, r *rest.Config, scheme *runtime.Scheme
 		Metrics: server.Options{
 			BindAddress: "0",
 		},
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// Secrets are only read occasionally (to obtain repository
+				// credentials) and the API server is only permitted to read them in
+				// Project namespaces and global credentials namespaces, so they
+				// cannot be watched cluster-wide.
+				DisableFor: []client.Object{&corev1.Secret{}},
+			},
+		},
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "new manager")
@@ -6,10 +6,13 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
@@ -77,6 +80,20 @@ func newControllerCommand() *cobra.Command {
 							"scheme",
 					)
 				}
+				if err = batchv1.AddToScheme(scheme); err != nil {
+					return errors.Wrap(
+						err,
+						"error adding Kubernetes batch API to Kargo controller manager "+
+							"scheme",
+					)
+				}
+				if err = coordinationv1.AddToScheme(scheme); err != nil {
+					return errors.Wrap(
+						err,
+						"error adding Kubernetes coordination API to Kargo controller "+
+							"manager scheme",
+					)
+				}
 				if err = rollouts.AddToScheme(scheme); err != nil {
 					return errors.Wrap(
 						err,
@@ -96,6 +113,14 @@ func newControllerCommand() *cobra.Command {
 						Metrics: server.Options{
 							BindAddress: "0",
 						},
+						Client: client.Options{
+							Cache: &client.CacheOptions{
+								// Pods are only read occasionally (to obtain the results of
+								// Jobs executed on behalf of Promotions) and caching them
+								// would mean watching every pod in the cluster.
+								DisableFor: []client.Object{&corev1.Pod{}},
+							},
+						},
 					},
 				); err != nil {
 					return errors.Wrap(err, "error initializing Kargo controller manager")
@@ -257,7 +282,7 @@ func newControllerCommand() *cobra.Command {
 				kargoMgr,
 				argocdMgr,
 				credentialsDB,
-				shardName,
+				promotions.ReconcilerConfigFromEnv(),
 			); err != nil {
 				return errors.Wrap(err, "error setting up Promotions reconciler")
 			}
@@ -90,7 +90,10 @@ func newWebhooksServerCommand() *cobra.Command {
 			); err != nil {
 				return errors.Wrap(err, "setup Project webhook")
 			}
-			if err = promotion.SetupWebhookWithManager(mgr); err != nil {
+			if err = promotion.SetupWebhookWithManager(
+				mgr,
+				promotion.WebhookConfigFromEnv(),
+			); err != nil {
 				return errors.Wrap(err, "setup Promotion webhook")
 			}
 			if err = stage.SetupWebhookWithManager(mgr); err != nil {
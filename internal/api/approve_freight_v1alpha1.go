@@ -6,11 +6,13 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
-	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
-	"github.com/akuity/kargo/internal/kubeclient"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/kargo"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -24,53 +26,67 @@ func (s *server) ApproveFreight(
 	if err := validateProjectAndStageNonEmpty(projectName, stageName); err != nil {
 		return nil, err
 	}
-	if err := s.validateProject(ctx, projectName); err != nil {
+	if err := s.validateProjectFn(ctx, projectName); err != nil {
 		return nil, err
 	}
 
-	var freight kargoapi.Freight
-	freightKey := client.ObjectKey{
+	freightKey := types.NamespacedName{
 		Namespace: projectName,
 		Name:      req.Msg.GetId(),
 	}
-	if err := s.client.Get(ctx, freightKey, &freight); err != nil {
-		if kubeerr.IsNotFound(err) {
-			return nil, connect.NewError(connect.CodeNotFound,
-				fmt.Errorf("freight %q not found", freightKey.String()))
-		}
+	freight, err := s.getFreightFn(ctx, s.client, freightKey)
+	if err != nil {
 		return nil, errors.Wrap(err, "get freight")
 	}
+	if freight == nil {
+		return nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("freight %q not found", freightKey.String()))
+	}
 
-	var stage kargoapi.Stage
-	key := client.ObjectKey{
+	stageKey := types.NamespacedName{
 		Namespace: projectName,
 		Name:      stageName,
 	}
-	if err := s.client.Get(ctx, key, &stage); err != nil {
-		if kubeerr.IsNotFound(err) {
-			return nil, connect.NewError(connect.CodeNotFound,
-				fmt.Errorf("stage %q not found", key.String()))
-		}
+	stage, err := s.getStageFn(ctx, s.client, stageKey)
+	if err != nil {
 		return nil, errors.Wrap(err, "get stage")
 	}
-
-	newStatus := *freight.Status.DeepCopy()
-	if newStatus.ApprovedFor == nil {
-		newStatus.ApprovedFor = map[string]kargoapi.ApprovedStage{}
+	if stage == nil {
+		return nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("stage %q not found", stageKey.String()))
 	}
 
-	if _, ok := newStatus.ApprovedFor[stageName]; ok {
-		return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
+	project, err := s.getProjectFn(ctx, s.client, projectName)
+	if err != nil {
+		return nil, errors.Wrap(err, "get project")
 	}
+	policy := kargo.GetApprovalPolicy(project, stageName)
 
-	newStatus.ApprovedFor[stageName] = kargoapi.ApprovedStage{}
+	userInfo, _ := user.InfoFromContext(ctx)
+	subject, email := userInfo.Subject, userInfo.Email
+	if userInfo.IsAdmin {
+		subject = "admin"
+	}
+	if eligible, msg := kargo.IsEligibleApprover(
+		policy,
+		freight,
+		subject,
+		email,
+		userInfo.Groups,
+	); !eligible {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New(msg))
+	}
 
-	if err := kubeclient.PatchStatus(
+	now := metav1.Now()
+	if err := s.approveFreightFn(
 		ctx,
-		s.client,
-		&freight,
-		func(status *kargoapi.FreightStatus) {
-			*status = newStatus
+		freight,
+		stageName,
+		kargoapi.Approval{
+			Subject:    subject,
+			Email:      email,
+			ApprovedAt: &now,
+			Comment:    req.Msg.GetComment(),
 		},
 	); err != nil {
 		return nil, errors.Wrap(err, "patch status")
@@ -78,3 +94,33 @@ func (s *server) ApproveFreight(
 
 	return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 }
+
+// approveFreight records the specified Approval of the specified Freight for
+// the specified Stage. Any previous approval by the same user is replaced, so
+// that approving again refreshes it. An optimistic lock is used so that
+// concurrent approvals by different users are never lost.
+func (s *server) approveFreight(
+	ctx context.Context,
+	freight *kargoapi.Freight,
+	stage string,
+	approval kargoapi.Approval,
+) error {
+	newFreight := freight.DeepCopy()
+	if newFreight.Status.ApprovedFor == nil {
+		newFreight.Status.ApprovedFor = map[string]kargoapi.ApprovedStage{}
+	}
+	approved := newFreight.Status.ApprovedFor[stage]
+	approvals := make([]kargoapi.Approval, 0, len(approved.Approvals)+1)
+	for _, a := range approved.Approvals {
+		if approval.Subject == "" || a.Subject != approval.Subject {
+			approvals = append(approvals, a)
+		}
+	}
+	approved.Approvals = append(approvals, approval)
+	newFreight.Status.ApprovedFor[stage] = approved
+	return s.client.Status().Patch(
+		ctx,
+		newFreight,
+		client.MergeFromWithOptions(freight, client.MergeFromWithOptimisticLock{}),
+	)
+}
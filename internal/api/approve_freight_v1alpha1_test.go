@@ -0,0 +1,233 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestApproveFreight(t *testing.T) {
+	getFreightFn := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Freight, error) {
+		return &kargoapi.Freight{}, nil
+	}
+	getStageFn := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error) {
+		return &kargoapi.Stage{}, nil
+	}
+	getProjectFn := func(
+		context.Context,
+		client.Client,
+		string,
+	) (*kargoapi.Project, error) {
+		return &kargoapi.Project{
+			Spec: &kargoapi.ProjectSpec{
+				PromotionPolicies: []kargoapi.PromotionPolicy{
+					{
+						Stage: "fake-stage",
+						ApprovalPolicy: &kargoapi.ApprovalPolicy{
+							RequiredApprovals: 2,
+							Groups:            []string{"release-managers"},
+						},
+					},
+				},
+			},
+		}, nil
+	}
+	testCases := []struct {
+		name       string
+		userInfo   user.Info
+		server     *server
+		assertions func(error)
+	}{
+		{
+			name: "error validating project",
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "Freight not found",
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+			},
+		},
+		{
+			name: "Stage not found",
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightFn: getFreightFn,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+			},
+		},
+		{
+			name: "error getting project",
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightFn: getFreightFn,
+				getStageFn:   getStageFn,
+				getProjectFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (*kargoapi.Project, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "approver not eligible",
+			userInfo: user.Info{
+				Subject: "fake-user",
+				Groups:  []string{"developers"},
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightFn: getFreightFn,
+				getStageFn:   getStageFn,
+				getProjectFn: getProjectFn,
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+			},
+		},
+		{
+			name: "error recording approval",
+			userInfo: user.Info{
+				Subject: "fake-user",
+				Groups:  []string{"release-managers"},
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightFn: getFreightFn,
+				getStageFn:   getStageFn,
+				getProjectFn: getProjectFn,
+				approveFreightFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					string,
+					kargoapi.Approval,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			userInfo: user.Info{
+				Subject: "fake-user",
+				Email:   "fake-user@example.com",
+				Groups:  []string{"release-managers"},
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightFn: getFreightFn,
+				getStageFn:   getStageFn,
+				getProjectFn: getProjectFn,
+				approveFreightFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					stage string,
+					approval kargoapi.Approval,
+				) error {
+					if stage != "fake-stage" ||
+						approval.Subject != "fake-user" ||
+						approval.Email != "fake-user@example.com" ||
+						approval.Comment != "fake-comment" ||
+						approval.ApprovedAt == nil {
+						return errors.New("unexpected approval")
+					}
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := testCase.server.ApproveFreight(
+				user.ContextWithInfo(context.Background(), testCase.userInfo),
+				connect.NewRequest(
+					&svcv1alpha1.ApproveFreightRequest{
+						Project: "fake-project",
+						Id:      "fake-freight",
+						Stage:   "fake-stage",
+						Comment: "fake-comment",
+					},
+				),
+			)
+			testCase.assertions(err)
+		})
+	}
+}
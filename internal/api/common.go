@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"io"
 
 	"github.com/pkg/errors"
@@ -10,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/yaml"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
 )
 
 // splitYAML splits YAML bytes into unstructured objects. It separates Project
@@ -45,3 +47,30 @@ func splitYAML(
 	}
 	return projects, otherResources, nil
 }
+
+// subjectFromContext returns an identifier for the user bound to the provided
+// context.Context, suitable for recording who performed an action. The admin
+// user is identified as "admin". An empty string is returned if the user has no
+// known identity.
+func subjectFromContext(ctx context.Context) string {
+	userInfo, _ := user.InfoFromContext(ctx)
+	if userInfo.IsAdmin {
+		return "admin"
+	}
+	return userInfo.Subject
+}
+
+// setPromotionCreator records the user bound to the provided context.Context
+// as the creator of the provided Promotion. Because Promotions are created
+// using the API server's own identity, this is the only way the user on whose
+// behalf a Promotion was created can be recorded.
+func setPromotionCreator(ctx context.Context, promo *kargoapi.Promotion) {
+	subject := subjectFromContext(ctx)
+	if subject == "" {
+		return
+	}
+	if promo.Annotations == nil {
+		promo.Annotations = map[string]string{}
+	}
+	promo.Annotations[kargoapi.AnnotationKeyCreatedBy] = subject
+}
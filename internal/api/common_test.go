@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+)
+
+func TestSetPromotionCreator(t *testing.T) {
+	testCases := []struct {
+		name       string
+		userInfo   *user.Info
+		assertions func(*kargoapi.Promotion)
+	}{
+		{
+			name: "no user info",
+			assertions: func(promo *kargoapi.Promotion) {
+				require.NotContains(t, promo.Annotations, kargoapi.AnnotationKeyCreatedBy)
+			},
+		},
+		{
+			name:     "admin user",
+			userInfo: &user.Info{IsAdmin: true},
+			assertions: func(promo *kargoapi.Promotion) {
+				require.Equal(t, "admin", promo.Annotations[kargoapi.AnnotationKeyCreatedBy])
+			},
+		},
+		{
+			name:     "non-admin user",
+			userInfo: &user.Info{Subject: "fake-user"},
+			assertions: func(promo *kargoapi.Promotion) {
+				require.Equal(t, "fake-user", promo.Annotations[kargoapi.AnnotationKeyCreatedBy])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.userInfo != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.userInfo)
+			}
+			promo := &kargoapi.Promotion{}
+			setPromotionCreator(ctx, promo)
+			testCase.assertions(promo)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/akuity/kargo/internal/api/dex"
 	"github.com/akuity/kargo/internal/api/oidc"
+	"github.com/akuity/kargo/internal/api/receiver"
 	"github.com/akuity/kargo/internal/os"
 	"github.com/akuity/kargo/internal/types"
 )
@@ -27,6 +28,7 @@ type ServerConfig struct {
 	DexProxyConfig              *dex.ProxyConfig
 	ArgoCDConfig                ArgoCDConfig
 	PermissiveCORSPolicyEnabled bool
+	WebhookReceiverConfig       *receiver.Config
 }
 
 func ServerConfigFromEnv() ServerConfig {
@@ -51,6 +53,10 @@ func ServerConfigFromEnv() ServerConfig {
 	envconfig.MustProcess("", &cfg.ArgoCDConfig)
 	cfg.PermissiveCORSPolicyEnabled =
 		types.MustParseBool(os.GetEnv("PERMISSIVE_CORS_POLICY_ENABLED", "false"))
+	if types.MustParseBool(os.GetEnv("WEBHOOK_RECEIVER_ENABLED", "false")) {
+		receiverCfg := receiver.ConfigFromEnv()
+		cfg.WebhookReceiverConfig = &receiverCfg
+	}
 	return cfg
 }
 
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/warehouses"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// CreateFreight creates Freight for a Warehouse from explicitly specified
+// versions of the artifacts the Warehouse subscribes to. Each version is
+// verified to exist before the Freight is created.
+func (s *server) CreateFreight(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.CreateFreightRequest],
+) (*connect.Response[svcv1alpha1.CreateFreightResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateProjectAndWarehouseName(project, req.Msg.GetWarehouse()); err != nil {
+		return nil, err
+	}
+	versions := make([]warehouses.ArtifactVersion, len(req.Msg.GetVersions()))
+	for i, v := range req.Msg.GetVersions() {
+		if v.GetRepoUrl() == "" || v.GetVersion() == "" {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("versions should specify both a repo URL and a version"),
+			)
+		}
+		versions[i] = warehouses.ArtifactVersion{
+			RepoURL: v.GetRepoUrl(),
+			Chart:   v.GetChart(),
+			Version: v.GetVersion(),
+		}
+	}
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	warehouseKey := types.NamespacedName{
+		Namespace: project,
+		Name:      req.Msg.GetWarehouse(),
+	}
+	// Creating Freight manually requires additional permission. Verifying
+	// versions can be expensive, so make sure the user has it before doing so.
+	if err := s.authorizeFn(
+		ctx,
+		"createfreight",
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: "warehouses",
+		},
+		"", // No subresource
+		warehouseKey,
+	); err != nil {
+		return nil, err
+	}
+
+	warehouse, err := s.getWarehouseFn(ctx, s.client, warehouseKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "get warehouse")
+	}
+	if warehouse == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Warehouse %q not found in namespace %q",
+				req.Msg.GetWarehouse(),
+				project,
+			),
+		)
+	}
+
+	freight, err := s.assembleFreightFn(ctx, warehouse, versions)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Wrap(err, "assemble freight"),
+		)
+	}
+	if err = s.createFreightFn(ctx, freight); err != nil {
+		if kubeerr.IsAlreadyExists(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		return nil, errors.Wrap(err, "create freight")
+	}
+	return connect.NewResponse(&svcv1alpha1.CreateFreightResponse{
+		Freight: typesv1alpha1.ToFreightProto(*freight),
+	}), nil
+}
+
+// assembleFreight assembles Freight for the provided Warehouse from the
+// specified artifact versions using the credentials available to the
+// Warehouse's Project.
+func (s *server) assembleFreight(
+	ctx context.Context,
+	warehouse *kargoapi.Warehouse,
+	versions []warehouses.ArtifactVersion,
+) (*kargoapi.Freight, error) {
+	return warehouses.AssembleFreight(
+		ctx,
+		s.internalClient,
+		s.credentialsDB,
+		warehouse,
+		versions,
+	)
+}
+
+// createFreight creates the provided Freight. The internal client is used
+// because permission to create Freight for a Warehouse was already verified
+// and users are not otherwise permitted to create Freight, which is normally
+// created only by Warehouses.
+func (s *server) createFreight(
+	ctx context.Context,
+	obj client.Object,
+	opts ...client.CreateOption,
+) error {
+	return s.internalClient.Create(ctx, obj, opts...)
+}
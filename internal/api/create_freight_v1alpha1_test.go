@@ -0,0 +1,247 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/warehouses"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestCreateFreight(t *testing.T) {
+	testReq := &svcv1alpha1.CreateFreightRequest{
+		Project:   "fake-project",
+		Warehouse: "fake-warehouse",
+		Versions: []*svcv1alpha1.ArtifactVersion{
+			{
+				RepoUrl: "fake-image-repo",
+				Version: "1.0.0",
+			},
+		},
+	}
+	validateProjectFn := func(context.Context, string) error {
+		return nil
+	}
+	authorizeFn := func(
+		context.Context,
+		string,
+		schema.GroupVersionResource,
+		string,
+		client.ObjectKey,
+	) error {
+		return nil
+	}
+	getWarehouseFn := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Warehouse, error) {
+		return &kargoapi.Warehouse{}, nil
+	}
+	assembleFreightFn := func(
+		context.Context,
+		*kargoapi.Warehouse,
+		[]warehouses.ArtifactVersion,
+	) (*kargoapi.Freight, error) {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-id",
+			},
+			ID: "fake-id",
+		}, nil
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.CreateFreightRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.CreateFreightResponse], error)
+	}{
+		{
+			name:   "project not specified",
+			req:    &svcv1alpha1.CreateFreightRequest{},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "version not specified",
+			req: &svcv1alpha1.CreateFreightRequest{
+				Project:   "fake-project",
+				Warehouse: "fake-warehouse",
+				Versions: []*svcv1alpha1.ArtifactVersion{
+					{
+						RepoUrl: "fake-image-repo",
+					},
+				},
+			},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "error validating project",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "user not authorized",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				authorizeFn: func(
+					_ context.Context,
+					verb string,
+					gvr schema.GroupVersionResource,
+					_ string,
+					key client.ObjectKey,
+				) error {
+					require.Equal(t, "createfreight", verb)
+					require.Equal(t, "warehouses", gvr.Resource)
+					require.Equal(t, "fake-project", key.Namespace)
+					require.Equal(t, "fake-warehouse", key.Name)
+					return errors.New("not authorized")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, "not authorized", err.Error())
+			},
+		},
+		{
+			name: "Warehouse not found",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				authorizeFn:       authorizeFn,
+				getWarehouseFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Warehouse, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "error assembling Freight",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				authorizeFn:       authorizeFn,
+				getWarehouseFn:    getWarehouseFn,
+				assembleFreightFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					[]warehouses.ArtifactVersion,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "Freight already exists",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				authorizeFn:       authorizeFn,
+				getWarehouseFn:    getWarehouseFn,
+				assembleFreightFn: assembleFreightFn,
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return kubeerr.NewAlreadyExists(
+						schema.GroupResource{
+							Group:    kargoapi.GroupVersion.Group,
+							Resource: "freights",
+						},
+						"fake-id",
+					)
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "success",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				authorizeFn:       authorizeFn,
+				getWarehouseFn:    getWarehouseFn,
+				assembleFreightFn: func(
+					ctx context.Context,
+					warehouse *kargoapi.Warehouse,
+					versions []warehouses.ArtifactVersion,
+				) (*kargoapi.Freight, error) {
+					require.Equal(
+						t,
+						[]warehouses.ArtifactVersion{
+							{
+								RepoURL: "fake-image-repo",
+								Version: "1.0.0",
+							},
+						},
+						versions,
+					)
+					return assembleFreightFn(ctx, warehouse, versions)
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.CreateFreightResponse], err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-id", res.Msg.GetFreight().GetId())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.server.CreateFreight(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(res, err)
+		})
+	}
+}
@@ -97,6 +97,17 @@ type Client interface {
 		namespace string,
 		opts metav1.ListOptions,
 	) (watch.Interface, error)
+	// Authorize returns an error if the user bound to the provided context is
+	// not permitted to perform the specified verb on the specified resource.
+	// This is useful for enforcing custom verbs that do not correspond to any
+	// operation the client itself performs.
+	Authorize(
+		ctx context.Context,
+		verb string,
+		gvr schema.GroupVersionResource,
+		subresource string,
+		key libClient.ObjectKey,
+	) error
 }
 
 // client implements Client.
@@ -537,6 +548,24 @@ func (c *client) Watch(
 	return ri.Watch(ctx, opts)
 }
 
+func (c *client) Authorize(
+	ctx context.Context,
+	verb string,
+	gvr schema.GroupVersionResource,
+	subresource string,
+	key libClient.ObjectKey,
+) error {
+	_, err := c.getAuthorizedClientFn(
+		ctx,
+		c.internalClient,
+		verb,
+		gvr,
+		subresource,
+		key,
+	)
+	return err
+}
+
 func GetRestConfig(ctx context.Context, path string) (*rest.Config, error) {
 	logger := logging.LoggerFromContext(ctx)
 
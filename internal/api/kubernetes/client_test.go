@@ -167,6 +167,23 @@ func TestAllClientOperations(t *testing.T) {
 		return err
 	}
 
+	authorizeOp := func(client *client) error {
+		return client.Authorize(
+			context.Background(),
+			"promote",
+			schema.GroupVersionResource{
+				Group:    "kargo.akuity.io",
+				Version:  "v1alpha1",
+				Resource: "stages",
+			},
+			"", // No subresource
+			types.NamespacedName{
+				Namespace: "test-namespace",
+				Name:      "test-name",
+			},
+		)
+	}
+
 	testCases := []struct {
 		name       string
 		op         func(client *client) error
@@ -358,6 +375,24 @@ func TestAllClientOperations(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+
+		{
+			name: "authorize unauthorized",
+			op:   authorizeOp,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "not allowed", err.Error())
+			},
+		},
+
+		{
+			name:    "authorize authorized",
+			op:      authorizeOp,
+			allowed: true,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+// ListStageHistory returns a page of the specified Stage's Freight history,
+// most recent first.
+func (s *server) ListStageHistory(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ListStageHistoryRequest],
+) (*connect.Response[svcv1alpha1.ListStageHistoryResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetName()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	if req.Msg.GetLimit() < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("limit should not be negative"))
+	}
+	if req.Msg.GetOffset() < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("offset should not be negative"))
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetName(),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "get stage")
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Stage %q not found in namespace %q",
+				req.Msg.GetName(),
+				req.Msg.GetProject(),
+			),
+		)
+	}
+
+	total := len(stage.Status.History)
+	start := min(int(req.Msg.GetOffset()), total)
+	end := total
+	if limit := int(req.Msg.GetLimit()); limit > 0 {
+		end = min(start+limit, total)
+	}
+	history := make([]*v1alpha1.FreightReference, 0, end-start)
+	for _, ref := range stage.Status.History[start:end] {
+		history = append(history, typesv1alpha1.ToFreightReferenceProto(ref, nil))
+	}
+	return connect.NewResponse(&svcv1alpha1.ListStageHistoryResponse{
+		History: history,
+		Total:   int32(total),
+	}), nil
+}
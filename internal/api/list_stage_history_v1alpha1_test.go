@@ -0,0 +1,203 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestListStageHistory(t *testing.T) {
+	validateProjectFn := func(context.Context, string) error {
+		return nil
+	}
+	getStageFn := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error) {
+		return &kargoapi.Stage{
+			Status: kargoapi.StageStatus{
+				History: kargoapi.FreightReferenceStack{
+					{ID: "fake-freight-3", Promotion: "fake-promo-3"},
+					{ID: "fake-freight-2", Promotion: "fake-promo-2"},
+					{ID: "fake-freight-1", Promotion: "fake-promo-1"},
+				},
+			},
+		}, nil
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.ListStageHistoryRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.ListStageHistoryResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.ListStageHistoryRequest{},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.ListStageHistoryResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "negative limit",
+			req: &svcv1alpha1.ListStageHistoryRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Limit:   -1,
+			},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.ListStageHistoryResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "limit should not be negative")
+			},
+		},
+		{
+			name: "negative offset",
+			req: &svcv1alpha1.ListStageHistoryRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Offset:  -1,
+			},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.ListStageHistoryResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "offset should not be negative")
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.ListStageHistoryRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.ListStageHistoryResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error getting Stage",
+			req: &svcv1alpha1.ListStageHistoryRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.ListStageHistoryResponse], err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "get stage")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "Stage not found",
+			req: &svcv1alpha1.ListStageHistoryRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.ListStageHistoryResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "all history",
+			req: &svcv1alpha1.ListStageHistoryRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				getStageFn:        getStageFn,
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.ListStageHistoryResponse], err error) {
+				require.NoError(t, err)
+				require.Equal(t, int32(3), res.Msg.GetTotal())
+				require.Len(t, res.Msg.GetHistory(), 3)
+				require.Equal(t, "fake-freight-3", res.Msg.GetHistory()[0].GetId())
+				require.Equal(t, "fake-promo-3", res.Msg.GetHistory()[0].GetPromotion())
+			},
+		},
+		{
+			name: "page of history",
+			req: &svcv1alpha1.ListStageHistoryRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Limit:   1,
+				Offset:  1,
+			},
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				getStageFn:        getStageFn,
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.ListStageHistoryResponse], err error) {
+				require.NoError(t, err)
+				require.Equal(t, int32(3), res.Msg.GetTotal())
+				require.Len(t, res.Msg.GetHistory(), 1)
+				require.Equal(t, "fake-freight-2", res.Msg.GetHistory()[0].GetId())
+			},
+		},
+		{
+			name: "offset beyond end of history",
+			req: &svcv1alpha1.ListStageHistoryRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Limit:   5,
+				Offset:  5,
+			},
+			server: &server{
+				validateProjectFn: validateProjectFn,
+				getStageFn:        getStageFn,
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.ListStageHistoryResponse], err error) {
+				require.NoError(t, err)
+				require.Equal(t, int32(3), res.Msg.GetTotal())
+				require.Empty(t, res.Msg.GetHistory())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.server.ListStageHistory(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(res, err)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// LockStage pins a Stage to its current Freight. While a Stage is locked, no
+// Promotions to it are permitted unless explicitly overridden.
+func (s *server) LockStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.LockStageRequest],
+) (*connect.Response[svcv1alpha1.LockStageResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetName()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	if req.Msg.GetReason() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("reason should not be empty"))
+	}
+	stage, err := s.getStageForLock(ctx, req.Msg.GetProject(), req.Msg.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	now := metav1.Now()
+	if err = s.patchStageLockFn(
+		ctx,
+		stage,
+		&kargoapi.StageLock{
+			Reason:   req.Msg.GetReason(),
+			LockedBy: subjectFromContext(ctx),
+			LockedAt: &now,
+		},
+	); err != nil {
+		return nil, errors.Wrap(err, "patch status")
+	}
+	return connect.NewResponse(&svcv1alpha1.LockStageResponse{
+		Stage: typesv1alpha1.ToStageProto(*stage),
+	}), nil
+}
+
+// getStageForLock validates the specified Project, retrieves the specified
+// Stage, and verifies the user is permitted to lock or unlock it.
+func (s *server) getStageForLock(
+	ctx context.Context,
+	project string,
+	name string,
+) (*kargoapi.Stage, error) {
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	stageKey := types.NamespacedName{
+		Namespace: project,
+		Name:      name,
+	}
+	stage, err := s.getStageFn(ctx, s.client, stageKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "get stage")
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf("Stage %q not found in namespace %q", name, project),
+		)
+	}
+	// Locking and unlocking a Stage requires additional permission
+	if err = s.authorizeFn(
+		ctx,
+		"lock",
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: "stages",
+		},
+		"", // No subresource
+		stageKey,
+	); err != nil {
+		return nil, err
+	}
+	return stage, nil
+}
+
+// patchStageLock sets (or, if the specified lock is nil, clears) the lock on
+// the specified Stage. The internal client is used because permission to lock
+// a Stage was already verified and does not imply permission to update the
+// Stage's status in general. The Stage passed in is updated in place.
+func (s *server) patchStageLock(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	lock *kargoapi.StageLock,
+) error {
+	base := stage.DeepCopy()
+	stage.Status.Lock = lock
+	return s.internalClient.Status().Patch(ctx, stage, client.MergeFrom(base))
+}
@@ -0,0 +1,319 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestLockStage(t *testing.T) {
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.LockStageRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.LockStageResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.LockStageRequest{},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.LockStageResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "reason not specified",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.LockStageResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "reason should not be empty")
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Reason:  "fake-reason",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.LockStageResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error getting Stage",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Reason:  "fake-reason",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.LockStageResponse], err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "get stage")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "Stage not found",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Reason:  "fake-reason",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.LockStageResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "user not authorized",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Reason:  "fake-reason",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					_ context.Context,
+					verb string,
+					_ schema.GroupVersionResource,
+					_ string,
+					_ client.ObjectKey,
+				) error {
+					require.Equal(t, "lock", verb)
+					return errors.New("not authorized")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.LockStageResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, "not authorized", err.Error())
+			},
+		},
+		{
+			name: "error patching Stage",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Reason:  "fake-reason",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchStageLockFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.StageLock,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.LockStageResponse], err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "patch status")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.LockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Reason:  "fake-reason",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				patchStageLockFn: func(
+					_ context.Context,
+					stage *kargoapi.Stage,
+					lock *kargoapi.StageLock,
+				) error {
+					require.NotNil(t, lock)
+					require.Equal(t, "fake-reason", lock.Reason)
+					require.Equal(t, "fake-user", lock.LockedBy)
+					require.NotNil(t, lock.LockedAt)
+					stage.Status.Lock = lock
+					return nil
+				},
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.LockStageResponse], err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				lock := res.Msg.GetStage().GetStatus().GetLock()
+				require.NotNil(t, lock)
+				require.Equal(t, "fake-reason", lock.GetReason())
+				require.Equal(t, "fake-user", lock.GetLockedBy())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					Subject: "fake-user",
+				},
+			)
+			res, err := testCase.server.LockStage(
+				ctx,
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(res, err)
+		})
+	}
+}
+
+func TestPatchStageLock(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	initialStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-stage",
+		},
+		Status: kargoapi.StageStatus{
+			Phase: kargoapi.StagePhaseSteady,
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(initialStage).
+		WithStatusSubresource(initialStage).
+		Build()
+	s := &server{internalClient: c}
+
+	stage := &kargoapi.Stage{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(initialStage), stage))
+
+	// Lock the Stage
+	now := metav1.Now()
+	err := s.patchStageLock(
+		context.Background(),
+		stage,
+		&kargoapi.StageLock{
+			Reason:   "fake-reason",
+			LockedBy: "fake-user",
+			LockedAt: &now,
+		},
+	)
+	require.NoError(t, err)
+	patched := &kargoapi.Stage{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(stage), patched))
+	require.NotNil(t, patched.Status.Lock)
+	require.Equal(t, "fake-reason", patched.Status.Lock.Reason)
+	require.Equal(t, "fake-user", patched.Status.Lock.LockedBy)
+	// Other status fields should be left alone
+	require.Equal(t, kargoapi.StagePhaseSteady, patched.Status.Phase)
+
+	// Unlock the Stage
+	require.NoError(t, s.patchStageLock(context.Background(), patched, nil))
+	unlocked := &kargoapi.Stage{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(stage), unlocked))
+	require.Nil(t, unlocked.Status.Lock)
+	require.Equal(t, kargoapi.StagePhaseSteady, unlocked.Status.Phase)
+}
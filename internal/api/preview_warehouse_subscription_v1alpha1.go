@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/warehouses"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+// PreviewWarehouseSubscription polls the repository specified by a
+// subscription exactly as a Warehouse in the specified Project would and
+// returns the candidate versions and the version that would be selected. No
+// Freight is created.
+func (s *server) PreviewWarehouseSubscription(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.PreviewWarehouseSubscriptionRequest],
+) (*connect.Response[svcv1alpha1.PreviewWarehouseSubscriptionResponse], error) {
+	project := req.Msg.GetProject()
+	if project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("project should not be empty"))
+	}
+	sub := req.Msg.GetSubscription()
+	if sub.GetGit() == nil && sub.GetImage() == nil && sub.GetChart() == nil &&
+		sub.GetOciArtifact() == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New(
+				"subscription should specify a git, image, chart, or OCI artifact repository",
+			),
+		)
+	}
+	if err := s.validateProjectFn(ctx, project); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	// The preview is conducted using the Project's credentials, so it is only
+	// permitted to users who could create a Warehouse that uses them anyway.
+	if err := s.authorizeFn(
+		ctx,
+		"create",
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: "warehouses",
+		},
+		"", // No subresource
+		types.NamespacedName{Namespace: project},
+	); err != nil {
+		return nil, err
+	}
+
+	preview, err := s.previewSubscriptionFn(
+		ctx,
+		project,
+		*typesv1alpha1.FromRepoSubscriptionProto(sub),
+	)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Wrap(err, "preview subscription"),
+		)
+	}
+	unverifiedRevisions := make(
+		[]*v1alpha1.UnverifiedGitRevision,
+		len(preview.UnverifiedRevisions),
+	)
+	for i, r := range preview.UnverifiedRevisions {
+		unverifiedRevisions[i] = typesv1alpha1.ToUnverifiedGitRevisionProto(r)
+	}
+	return connect.NewResponse(&svcv1alpha1.PreviewWarehouseSubscriptionResponse{
+		Candidates:          preview.Candidates,
+		Selected:            preview.Selected,
+		UnverifiedRevisions: unverifiedRevisions,
+	}), nil
+}
+
+// previewSubscription polls the repository specified by the provided
+// subscription using the credentials available to the specified Project.
+func (s *server) previewSubscription(
+	ctx context.Context,
+	project string,
+	sub kargoapi.RepoSubscription,
+) (*warehouses.SubscriptionPreview, error) {
+	return warehouses.PreviewSubscription(
+		ctx,
+		s.internalClient,
+		s.credentialsDB,
+		project,
+		sub,
+	)
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/warehouses"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/v1alpha1"
+)
+
+func TestPreviewWarehouseSubscription(t *testing.T) {
+	testSub := &v1alpha1.RepoSubscription{
+		Image: &v1alpha1.ImageSubscription{
+			RepoUrl: "fake-url",
+		},
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.PreviewWarehouseSubscriptionRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.PreviewWarehouseSubscriptionResponse], error)
+	}{
+		{
+			name:   "project not specified",
+			req:    &svcv1alpha1.PreviewWarehouseSubscriptionRequest{},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.PreviewWarehouseSubscriptionResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "subscription not specified",
+			req: &svcv1alpha1.PreviewWarehouseSubscriptionRequest{
+				Project: "fake-project",
+			},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.PreviewWarehouseSubscriptionResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.PreviewWarehouseSubscriptionRequest{
+				Project:      "fake-project",
+				Subscription: testSub,
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.PreviewWarehouseSubscriptionResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "user not authorized",
+			req: &svcv1alpha1.PreviewWarehouseSubscriptionRequest{
+				Project:      "fake-project",
+				Subscription: testSub,
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn: func(
+					_ context.Context,
+					verb string,
+					gvr schema.GroupVersionResource,
+					_ string,
+					key client.ObjectKey,
+				) error {
+					require.Equal(t, "create", verb)
+					require.Equal(t, "warehouses", gvr.Resource)
+					require.Equal(t, "fake-project", key.Namespace)
+					return errors.New("not authorized")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.PreviewWarehouseSubscriptionResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, "not authorized", err.Error())
+			},
+		},
+		{
+			name: "error previewing subscription",
+			req: &svcv1alpha1.PreviewWarehouseSubscriptionRequest{
+				Project:      "fake-project",
+				Subscription: testSub,
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				previewSubscriptionFn: func(
+					context.Context,
+					string,
+					kargoapi.RepoSubscription,
+				) (*warehouses.SubscriptionPreview, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.PreviewWarehouseSubscriptionResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.PreviewWarehouseSubscriptionRequest{
+				Project:      "fake-project",
+				Subscription: testSub,
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				previewSubscriptionFn: func(
+					_ context.Context,
+					project string,
+					sub kargoapi.RepoSubscription,
+				) (*warehouses.SubscriptionPreview, error) {
+					require.Equal(t, "fake-project", project)
+					require.NotNil(t, sub.Image)
+					require.Equal(t, "fake-url", sub.Image.RepoURL)
+					return &warehouses.SubscriptionPreview{
+						Candidates: []string{"v2.0.0", "v1.0.0"},
+						Selected:   "v2.0.0",
+					}, nil
+				},
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.PreviewWarehouseSubscriptionResponse], err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v2.0.0", "v1.0.0"}, res.Msg.GetCandidates())
+				require.Equal(t, "v2.0.0", res.Msg.GetSelected())
+				require.Empty(t, res.Msg.GetUnverifiedRevisions())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.server.PreviewWarehouseSubscription(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(res, err)
+		})
+	}
+}
@@ -5,8 +5,11 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
 	"github.com/akuity/kargo/internal/kargo"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
@@ -67,11 +70,12 @@ func (s *server) PromoteStage(
 			),
 		)
 	}
-	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
-	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
-		upstreamStages[i] = upstreamStage.Name
-	}
-	if !s.isFreightAvailableFn(freight, stage.Name, upstreamStages) {
+	if !s.isFreightAvailableFn(
+		freight,
+		stage.Name,
+		stage.Spec.Subscriptions.UpstreamStages,
+		stage.Spec.Subscriptions.UpstreamStagesMode,
+	) {
 		return nil, connect.NewError(
 			connect.CodeInvalidArgument,
 			errors.Errorf(
@@ -82,7 +86,33 @@ func (s *server) PromoteStage(
 		)
 	}
 
+	if req.Msg.GetPriority() > 0 {
+		// Jumping the queue requires additional permission
+		if err := s.authorizeFn(
+			ctx,
+			"prioritize",
+			schema.GroupVersionResource{
+				Group:    kargoapi.GroupVersion.Group,
+				Version:  kargoapi.GroupVersion.Version,
+				Resource: "stages",
+			},
+			"", // No subresource
+			types.NamespacedName{
+				Namespace: req.Msg.GetProject(),
+				Name:      req.Msg.GetName(),
+			},
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	promotion := kargo.NewPromotion(*stage, req.Msg.GetFreight())
+	promotion.Spec.Priority = req.Msg.GetPriority()
+	setPromotionCreator(ctx, &promotion)
+	if req.Msg.GetNotBefore() != nil {
+		notBefore := metav1.NewTime(req.Msg.GetNotBefore().AsTime())
+		promotion.Spec.NotBefore = &notBefore
+	}
 	if err := s.createPromotionFn(ctx, &promotion); err != nil {
 		return nil, errors.Wrap(err, "create promotion")
 	}
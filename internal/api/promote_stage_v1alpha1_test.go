@@ -3,10 +3,13 @@ package api
 import (
 	"context"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -240,7 +243,12 @@ func TestPromoteStage(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return false
 				},
 			},
@@ -291,7 +299,12 @@ func TestPromoteStage(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				createPromotionFn: func(
@@ -345,7 +358,12 @@ func TestPromoteStage(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				createPromotionFn: func(
@@ -365,6 +383,213 @@ func TestPromoteStage(t *testing.T) {
 				require.NotNil(t, res.Msg.GetPromotion())
 			},
 		},
+		{
+			name: "success with schedule",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Freight: "fake-freight",
+				NotBefore: timestamppb.New(
+					time.Date(2024, time.January, 1, 2, 0, 0, 0, time.UTC),
+				),
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								UpstreamStages: []kargoapi.StageSubscription{
+									{
+										Name: "fake-upstream-stage",
+									},
+								},
+							},
+						},
+					}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
+					return true
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(
+					t,
+					time.Date(2024, time.January, 1, 2, 0, 0, 0, time.UTC),
+					res.Msg.GetPromotion().GetSpec().GetNotBefore().AsTime(),
+				)
+			},
+		},
+		{
+			name: "prioritization not permitted",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project:  "fake-project",
+				Name:     "fake-stage",
+				Freight:  "fake-freight",
+				Priority: 100,
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								UpstreamStages: []kargoapi.StageSubscription{
+									{
+										Name: "fake-upstream-stage",
+									},
+								},
+							},
+						},
+					}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
+					return true
+				},
+				authorizeFn: func(
+					_ context.Context,
+					verb string,
+					_ schema.GroupVersionResource,
+					_ string,
+					_ client.ObjectKey,
+				) error {
+					return errors.Errorf("%s not allowed", verb)
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "prioritize not allowed", err.Error())
+				require.Nil(t, res)
+			},
+		},
+		{
+			name: "success with priority",
+			req: &svcv1alpha1.PromoteStageRequest{
+				Project:  "fake-project",
+				Name:     "fake-stage",
+				Freight:  "fake-freight",
+				Priority: 100,
+			},
+			server: &server{
+				validateProjectFn: func(ctx context.Context, project string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{
+								UpstreamStages: []kargoapi.StageSubscription{
+									{
+										Name: "fake-upstream-stage",
+									},
+								},
+							},
+						},
+					}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
+					return true
+				},
+				authorizeFn: func(
+					_ context.Context,
+					verb string,
+					_ schema.GroupVersionResource,
+					_ string,
+					_ client.ObjectKey,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.PromoteStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, int32(100), res.Msg.GetPromotion().GetSpec().GetPriority())
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
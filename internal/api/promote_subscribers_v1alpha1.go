@@ -85,8 +85,9 @@ func (s *server) PromoteSubscribers(
 	}
 	if !s.isFreightAvailableFn(
 		freight,
-		"",                           // approved for not considered
-		[]string{req.Msg.GetStage()}, // verified in
+		"", // approved for not considered
+		[]kargoapi.StageSubscription{{Name: req.Msg.GetStage()}}, // verified in
+		kargoapi.UpstreamStagesModeAny,
 	) {
 		return nil, connect.NewError(
 			connect.CodeInvalidArgument,
@@ -110,6 +111,7 @@ func (s *server) PromoteSubscribers(
 	createdPromos := make([]*v1alpha1.Promotion, 0, len(subscribers))
 	for _, subscriber := range subscribers {
 		newPromo := kargo.NewPromotion(subscriber, req.Msg.GetFreight())
+		setPromotionCreator(ctx, &newPromo)
 		if err := s.createPromotionFn(ctx, &newPromo); err != nil {
 			promoteErrs = append(promoteErrs, err)
 			continue
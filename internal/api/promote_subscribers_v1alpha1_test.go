@@ -242,7 +242,12 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return false
 				},
 			},
@@ -293,7 +298,12 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				findStageSubscribersFn: func(
@@ -346,7 +356,12 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				findStageSubscribersFn: func(
@@ -403,7 +418,12 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				findStageSubscribersFn: func(
@@ -466,7 +486,12 @@ func TestPromoteSubscribers(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				isFreightAvailableFn: func(*kargoapi.Freight, string, []string) bool {
+				isFreightAvailableFn: func(
+					*kargoapi.Freight,
+					string,
+					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
+				) bool {
 					return true
 				},
 				findStageSubscribersFn: func(
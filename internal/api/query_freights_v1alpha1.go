@@ -4,10 +4,12 @@ import (
 	"context"
 	"path"
 	"sort"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/Masterminds/semver"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,6 +49,7 @@ func (s *server) QueryFreight(
 	}
 
 	var freight []kargoapi.Freight
+	var soakTimeRemaining map[string]*durationpb.Duration
 	if req.Msg.GetStage() != "" {
 		stage, err := s.getStageFn(
 			ctx,
@@ -77,6 +80,21 @@ func (s *server) QueryFreight(
 		if err != nil {
 			return nil, errors.Wrap(err, "get available freight for stage")
 		}
+		soakingFreight, err := s.getSoakingFreightFn(
+			ctx,
+			req.Msg.GetProject(),
+			req.Msg.GetStage(),
+			*stage.Spec.Subscriptions,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "get soaking freight for stage")
+		}
+		if len(soakingFreight) > 0 {
+			soakTimeRemaining = make(map[string]*durationpb.Duration, len(soakingFreight))
+			for name, remaining := range soakingFreight {
+				soakTimeRemaining[name] = durationpb.New(remaining)
+			}
+		}
 	} else {
 		freightList := &kargoapi.FreightList{}
 		// Get ALL Freight in the project/namespace
@@ -106,29 +124,40 @@ func (s *server) QueryFreight(
 	sortFreightGroups(req.Msg.GetOrderBy(), req.Msg.GetReverse(), freightGroups)
 
 	return connect.NewResponse(&svcv1alpha1.QueryFreightResponse{
-		Groups: freightGroups,
+		Groups:            freightGroups,
+		SoakTimeRemaining: soakTimeRemaining,
 	}), nil
 }
 
 // getAvailableFreightForStage gets all Freight available to the specified Stage
 // for any reason. This includes:
 //
-// 1. Any Freight from a Warehouse that the Stage subscribes to directly
-// 2. Any Freight that is verified in any upstream Stages
-// 3. Any Freight that is approved for the Stage
+//  1. Any Freight from any Warehouse that the Stage subscribes to directly
+//  2. Any Freight that is verified in any upstream Stages (or in all of them, if
+//     the Stage's subscriptions require it)
+//  3. Any Freight that is approved for the Stage
 func (s *server) getAvailableFreightForStage(
 	ctx context.Context,
 	project string,
 	stage string,
 	subs kargoapi.Subscriptions,
 ) ([]kargoapi.Freight, error) {
-	if subs.Warehouse != "" {
-		return s.getFreightFromWarehouseFn(ctx, project, subs.Warehouse)
+	if warehouses := subs.GetWarehouses(); len(warehouses) > 0 {
+		var availableFreight []kargoapi.Freight
+		for _, warehouse := range warehouses {
+			freight, err := s.getFreightFromWarehouseFn(ctx, project, warehouse)
+			if err != nil {
+				return nil, err
+			}
+			availableFreight = append(availableFreight, freight...)
+		}
+		return availableFreight, nil
 	}
 	verifiedFreight, err := s.getVerifiedFreightFn(
 		ctx,
 		project,
 		subs.UpstreamStages,
+		subs.UpstreamStagesMode,
 	)
 	if err != nil {
 		return nil, errors.Wrapf(
@@ -212,6 +241,7 @@ func (s *server) getVerifiedFreight(
 	ctx context.Context,
 	project string,
 	stageSubs []kargoapi.StageSubscription,
+	mode kargoapi.UpstreamStagesMode,
 ) ([]kargoapi.Freight, error) {
 	// Start by building a de-duped map of Freight verified in any upstream
 	// Stage(s)
@@ -237,6 +267,12 @@ func (s *server) getVerifiedFreight(
 			)
 		}
 		for _, freight := range freight.Items {
+			// Disregard Freight that is still soaking upstream or, if verification
+			// in all upstream Stages is required, that has been verified in only
+			// some of them.
+			if !kargoapi.IsFreightVerifiedUpstream(&freight, stageSubs, mode) {
+				continue
+			}
 			verifiedFreight[freight.Name] = freight
 		}
 	}
@@ -253,6 +289,62 @@ func (s *server) getVerifiedFreight(
 	return verifiedFreightList, nil
 }
 
+// getSoakingFreight returns a map of the names of all Freight that has been
+// verified in Stages upstream from the specified Stage, but that has not yet
+// soaked there for long enough to be available to the specified Stage, to how
+// much longer each must soak. Freight that is approved for the specified Stage
+// is available to it regardless, so it is never included.
+func (s *server) getSoakingFreight(
+	ctx context.Context,
+	project string,
+	stage string,
+	subs kargoapi.Subscriptions,
+) (map[string]time.Duration, error) {
+	if len(subs.GetWarehouses()) > 0 {
+		return nil, nil
+	}
+	now := time.Now()
+	soakingFreight := map[string]time.Duration{}
+	for _, stageSub := range subs.UpstreamStages {
+		if stageSub.RequiredSoakTime == "" {
+			continue
+		}
+		var freight kargoapi.FreightList
+		if err := s.listFreightFn(
+			ctx,
+			&freight,
+			&client.ListOptions{
+				Namespace: project,
+				FieldSelector: fields.OneTermEqualSelector(
+					kubeclient.FreightByVerifiedStagesIndexField,
+					stageSub.Name,
+				),
+			},
+		); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error listing Freight verified in Stage %q in namespace %q",
+				stageSub.Name,
+				project,
+			)
+		}
+		for _, f := range freight.Items {
+			if _, approved := f.Status.ApprovedFor[stage]; approved {
+				continue
+			}
+			if remaining := kargoapi.GetSoakTimeRemaining(
+				&f,
+				subs.UpstreamStages,
+				subs.UpstreamStagesMode,
+				now,
+			); remaining > 0 {
+				soakingFreight[f.Name] = remaining
+			}
+		}
+	}
+	return soakingFreight, nil
+}
+
 func groupByImageRepo(
 	freight []kargoapi.Freight,
 	group string,
@@ -182,6 +182,115 @@ func TestQueryFreight(t *testing.T) {
 			},
 		},
 
+		{
+			name: "error getting soaking Freight for Stage",
+			req: &svcv1alpha1.QueryFreightRequest{
+				Project: "fake-project",
+				GroupBy: GroupByImageRepository,
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+						},
+					}, nil
+				},
+				getAvailableFreightForStageFn: func(
+					context.Context,
+					string,
+					string,
+					kargoapi.Subscriptions,
+				) ([]kargoapi.Freight, error) {
+					return nil, nil
+				},
+				getSoakingFreightFn: func(
+					context.Context,
+					string,
+					string,
+					kargoapi.Subscriptions,
+				) (map[string]time.Duration, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.QueryFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+
+		{
+			name: "soak time remaining for Stage",
+			req: &svcv1alpha1.QueryFreightRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+						},
+					}, nil
+				},
+				getAvailableFreightForStageFn: func(
+					context.Context,
+					string,
+					string,
+					kargoapi.Subscriptions,
+				) ([]kargoapi.Freight, error) {
+					return []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-freight",
+							},
+						},
+					}, nil
+				},
+				getSoakingFreightFn: func(
+					context.Context,
+					string,
+					string,
+					kargoapi.Subscriptions,
+				) (map[string]time.Duration, error) {
+					return map[string]time.Duration{
+						"another-fake-freight": time.Hour,
+					}, nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.QueryFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetGroups()[""].Freight, 1)
+				require.Len(t, res.Msg.GetSoakTimeRemaining(), 1)
+				require.Equal(
+					t,
+					time.Hour,
+					res.Msg.GetSoakTimeRemaining()["another-fake-freight"].AsDuration(),
+				)
+			},
+		},
+
 		{
 			name: "error listing all Freight",
 			req: &svcv1alpha1.QueryFreightRequest{
@@ -416,6 +525,33 @@ func TestGetAvailableFreightForStage(t *testing.T) {
 				require.Len(t, freight, 2)
 			},
 		},
+		{
+			name: "success getting Freight from multiple Warehouses",
+			subs: kargoapi.Subscriptions{
+				Warehouses: []string{"fake-warehouse", "another-fake-warehouse"},
+			},
+			server: &server{
+				getFreightFromWarehouseFn: func(
+					_ context.Context,
+					_ string,
+					warehouse string,
+				) ([]kargoapi.Freight, error) {
+					return []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: warehouse + "-freight",
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(freight []kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, freight, 2)
+				require.Equal(t, "fake-warehouse-freight", freight[0].Name)
+				require.Equal(t, "another-fake-warehouse-freight", freight[1].Name)
+			},
+		},
 		{
 			name: "error getting Freight verified in upstream Stages",
 			subs: kargoapi.Subscriptions{
@@ -430,6 +566,7 @@ func TestGetAvailableFreightForStage(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -458,6 +595,7 @@ func TestGetAvailableFreightForStage(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -493,6 +631,7 @@ func TestGetAvailableFreightForStage(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return []kargoapi.Freight{
 						{
@@ -609,6 +748,7 @@ func TestGetFreightFromWarehouse(t *testing.T) {
 func TestGetVerifiedFreight(t *testing.T) {
 	testCases := []struct {
 		name       string
+		mode       kargoapi.UpstreamStagesMode
 		server     *server
 		assertions func([]kargoapi.Freight, error)
 	}{
@@ -648,11 +788,21 @@ func TestGetVerifiedFreight(t *testing.T) {
 							ObjectMeta: metav1.ObjectMeta{
 								Name: "fake-freight",
 							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {},
+								},
+							},
 						},
 						{
 							ObjectMeta: metav1.ObjectMeta{
 								Name: "another fake-freight",
 							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"another-fake-stage": {},
+								},
+							},
 						},
 					}
 					return nil
@@ -664,6 +814,49 @@ func TestGetVerifiedFreight(t *testing.T) {
 				require.Len(t, freight, 2)
 			},
 		},
+		{
+			name: "only Freight verified in all upstream Stages",
+			mode: kargoapi.UpstreamStagesModeAll,
+			server: &server{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage":         {},
+									"another-fake-stage": {},
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "another-fake-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {},
+								},
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(freight []kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, freight, 1)
+				require.Equal(t, "fake-freight", freight[0].Name)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -679,6 +872,139 @@ func TestGetVerifiedFreight(t *testing.T) {
 							Name: "another-fake-stage",
 						},
 					},
+					testCase.mode,
+				),
+			)
+		})
+	}
+}
+
+func TestGetSoakingFreight(t *testing.T) {
+	recently := metav1.NewTime(time.Now().Add(-time.Hour))
+	testCases := []struct {
+		name       string
+		subs       kargoapi.Subscriptions
+		server     *server
+		assertions func(map[string]time.Duration, error)
+	}{
+		{
+			name: "Stage subscribes to a Warehouse",
+			subs: kargoapi.Subscriptions{
+				Warehouse: "fake-warehouse",
+			},
+			server: &server{},
+			assertions: func(soakingFreight map[string]time.Duration, err error) {
+				require.NoError(t, err)
+				require.Empty(t, soakingFreight)
+			},
+		},
+		{
+			name: "error listing Freight",
+			subs: kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{
+					{
+						Name:             "fake-stage",
+						RequiredSoakTime: "24h",
+					},
+				},
+			},
+			server: &server{
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ map[string]time.Duration, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(
+					t,
+					err.Error(),
+					"error listing Freight verified in Stage",
+				)
+			},
+		},
+		{
+			name: "success",
+			subs: kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{
+					{
+						Name:             "fake-stage",
+						RequiredSoakTime: "24h",
+					},
+				},
+			},
+			server: &server{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "soaking-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {
+										VerifiedAt: &recently,
+									},
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "approved-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {
+										VerifiedAt: &recently,
+									},
+								},
+								ApprovedFor: map[string]kargoapi.ApprovedStage{
+									"downstream-stage": {},
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "soaked-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {},
+								},
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(soakingFreight map[string]time.Duration, err error) {
+				require.NoError(t, err)
+				require.Len(t, soakingFreight, 1)
+				remaining, ok := soakingFreight["soaking-freight"]
+				require.True(t, ok)
+				require.Greater(t, remaining, 22*time.Hour)
+				require.LessOrEqual(t, remaining, 23*time.Hour)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.getSoakingFreight(
+					context.Background(),
+					"fake-project",
+					"downstream-stage",
+					testCase.subs,
 				),
 			)
 		})
@@ -0,0 +1,195 @@
+package receiver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// secretQueryParam is the name of the query parameter by which providers that
+// do not support signing or authenticating their requests are expected to
+// present a secret.
+const secretQueryParam = "secret"
+
+// github handles push events sent by GitHub.
+type github struct {
+	secret string
+}
+
+func (g *github) authenticate(req *http.Request, payload []byte) error {
+	sig := strings.TrimPrefix(req.Header.Get("X-Hub-Signature-256"), "sha256=")
+	if sig == "" {
+		return errors.New("request has no X-Hub-Signature-256 header")
+	}
+	sigBytes, err := hex.DecodeString(sig)
+	if err != nil {
+		return errors.Wrap(err, "error decoding signature")
+	}
+	mac := hmac.New(sha256.New, []byte(g.secret))
+	_, _ = mac.Write(payload)
+	if !hmac.Equal(sigBytes, mac.Sum(nil)) {
+		return errors.New("signature does not match payload")
+	}
+	return nil
+}
+
+func (g *github) parse(req *http.Request, payload []byte) (*event, error) {
+	if req.Header.Get("X-GitHub-Event") != "push" {
+		return nil, nil // e.g. A ping
+	}
+	p := struct {
+		Repository struct {
+			CloneURL string `json:"clone_url"`
+			HTMLURL  string `json:"html_url"`
+			SSHURL   string `json:"ssh_url"`
+		} `json:"repository"`
+	}{}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling GitHub push event")
+	}
+	if p.Repository.CloneURL == "" {
+		return nil, errors.New("push event from GitHub does not identify a repository")
+	}
+	return &event{
+		gitRepoURLs: []string{
+			p.Repository.CloneURL,
+			p.Repository.HTMLURL,
+			p.Repository.SSHURL,
+		},
+	}, nil
+}
+
+// gitlab handles push and tag push events sent by GitLab.
+type gitlab struct {
+	secret string
+}
+
+func (g *gitlab) authenticate(req *http.Request, _ []byte) error {
+	if !secretsEqual(req.Header.Get("X-Gitlab-Token"), g.secret) {
+		return errors.New("request has missing or incorrect X-Gitlab-Token header")
+	}
+	return nil
+}
+
+func (g *gitlab) parse(req *http.Request, payload []byte) (*event, error) {
+	switch req.Header.Get("X-Gitlab-Event") {
+	case "Push Hook", "Tag Push Hook":
+	default:
+		return nil, nil
+	}
+	p := struct {
+		Project struct {
+			GitHTTPURL string `json:"git_http_url"`
+			GitSSHURL  string `json:"git_ssh_url"`
+			WebURL     string `json:"web_url"`
+		} `json:"project"`
+	}{}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling GitLab push event")
+	}
+	if p.Project.GitHTTPURL == "" {
+		return nil, errors.New("push event from GitLab does not identify a project")
+	}
+	return &event{
+		gitRepoURLs: []string{
+			p.Project.GitHTTPURL,
+			p.Project.GitSSHURL,
+			p.Project.WebURL,
+		},
+	}, nil
+}
+
+// dockerHub handles push events sent by Docker Hub.
+type dockerHub struct {
+	secret string
+}
+
+func (d *dockerHub) authenticate(req *http.Request, _ []byte) error {
+	if !secretsEqual(req.URL.Query().Get(secretQueryParam), d.secret) {
+		return errors.New("request has missing or incorrect secret")
+	}
+	return nil
+}
+
+func (d *dockerHub) parse(_ *http.Request, payload []byte) (*event, error) {
+	p := struct {
+		Repository struct {
+			RepoName string `json:"repo_name"`
+		} `json:"repository"`
+	}{}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling Docker Hub push event")
+	}
+	if p.Repository.RepoName == "" {
+		return nil,
+			errors.New("push event from Docker Hub does not identify a repository")
+	}
+	return &event{imageRepoURL: "docker.io/" + p.Repository.RepoName}, nil
+}
+
+// harbor handles artifact push events sent by Harbor.
+type harbor struct {
+	secret string
+}
+
+func (h *harbor) authenticate(req *http.Request, _ []byte) error {
+	if !secretsEqual(req.Header.Get("Authorization"), h.secret) {
+		return errors.New("request has missing or incorrect Authorization header")
+	}
+	return nil
+}
+
+func (h *harbor) parse(_ *http.Request, payload []byte) (*event, error) {
+	p := struct {
+		Type      string `json:"type"`
+		EventData struct {
+			Resources []struct {
+				ResourceURL string `json:"resource_url"`
+			} `json:"resources"`
+		} `json:"event_data"`
+	}{}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling Harbor event")
+	}
+	if p.Type != "PUSH_ARTIFACT" {
+		return nil, nil
+	}
+	if len(p.EventData.Resources) == 0 ||
+		p.EventData.Resources[0].ResourceURL == "" {
+		return nil, errors.New("push event from Harbor does not identify an artifact")
+	}
+	// The resource URL includes a tag or digest, which will be discarded when
+	// the URL is normalized.
+	return &event{imageRepoURL: p.EventData.Resources[0].ResourceURL}, nil
+}
+
+// quay handles repository push notifications sent by Quay.
+type quay struct {
+	secret string
+}
+
+func (q *quay) authenticate(req *http.Request, _ []byte) error {
+	if !secretsEqual(req.URL.Query().Get(secretQueryParam), q.secret) {
+		return errors.New("request has missing or incorrect secret")
+	}
+	return nil
+}
+
+func (q *quay) parse(_ *http.Request, payload []byte) (*event, error) {
+	p := struct {
+		DockerURL string `json:"docker_url"`
+	}{}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling Quay push notification")
+	}
+	if p.DockerURL == "" {
+		return nil,
+			errors.New("push notification from Quay does not identify a repository")
+	}
+	return &event{imageRepoURL: p.DockerURL}, nil
+}
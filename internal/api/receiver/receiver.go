@@ -0,0 +1,267 @@
+package receiver
+
+import (
+	"context"
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/distribution/distribution/v3/reference"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// PathPrefix is the path prefix under which all of the receiver's endpoints
+// are served.
+const PathPrefix = "/webhook/"
+
+// maxPayloadBytes is the maximum size of a webhook payload the receiver is
+// willing to read. Payloads sent by all supported providers are comfortably
+// smaller than this.
+const maxPayloadBytes = 10 << 20 // 10 MiB
+
+// Config represents configuration for the inbound webhook receiver. Each
+// provider's endpoint is only enabled if a secret has been configured for it.
+type Config struct {
+	// GitHubSecret is the secret used to validate the HMAC signatures of
+	// payloads sent by GitHub.
+	GitHubSecret string `envconfig:"GITHUB_WEBHOOK_SECRET"`
+	// GitLabSecret is the secret token GitLab is expected to send with every
+	// request.
+	GitLabSecret string `envconfig:"GITLAB_WEBHOOK_SECRET"`
+	// DockerHubSecret is the secret Docker Hub is expected to send as the value
+	// of the "secret" query parameter. Docker Hub does not sign its payloads, so
+	// this secret must be included in the webhook URL configured in Docker Hub.
+	DockerHubSecret string `envconfig:"DOCKERHUB_WEBHOOK_SECRET"`
+	// HarborSecret is the value Harbor is expected to send in the Authorization
+	// header of every request. This corresponds to the "Auth Header" setting of a
+	// Harbor webhook policy.
+	HarborSecret string `envconfig:"HARBOR_WEBHOOK_SECRET"`
+	// QuaySecret is the secret Quay is expected to send as the value of the
+	// "secret" query parameter. Quay does not sign its payloads, so this secret
+	// must be included in the webhook URL configured in Quay.
+	QuaySecret string `envconfig:"QUAY_WEBHOOK_SECRET"`
+}
+
+// ConfigFromEnv returns a Config populated from environment variables.
+func ConfigFromEnv() Config {
+	cfg := Config{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// event is a provider-agnostic representation of a push to a repository.
+type event struct {
+	// gitRepoURLs contains every URL by which the Git repository that was pushed
+	// to may be known. This is empty if the push was not to a Git repository.
+	gitRepoURLs []string
+	// imageRepoURL is the URL of the image repository that was pushed to. This
+	// is empty if the push was not to an image repository.
+	imageRepoURL string
+}
+
+// provider knows how to authenticate a request from a specific webhook sender
+// and extract an event from the payload. A nil event with a nil error
+// indicates a request that was authenticated, but does not warrant refreshing
+// any Warehouses. e.g. A ping.
+type provider interface {
+	authenticate(req *http.Request, payload []byte) error
+	parse(req *http.Request, payload []byte) (*event, error)
+}
+
+type handler struct {
+	client client.Client
+
+	// The following behaviors are overridable for testing purposes:
+
+	refreshWarehouseFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Warehouse, error)
+}
+
+// NewHandler returns an http.Handler that serves one endpoint under PathPrefix
+// for each provider that a secret has been configured for. Upon receipt of a
+// valid push event, every Warehouse subscribed to the repository that was
+// pushed to is refreshed.
+func NewHandler(cfg Config, c client.Client) http.Handler {
+	h := &handler{
+		client:             c,
+		refreshWarehouseFn: kargoapi.RefreshWarehouse,
+	}
+	mux := http.NewServeMux()
+	if cfg.GitHubSecret != "" {
+		mux.Handle(PathPrefix+"github", h.handle(&github{secret: cfg.GitHubSecret}))
+	}
+	if cfg.GitLabSecret != "" {
+		mux.Handle(PathPrefix+"gitlab", h.handle(&gitlab{secret: cfg.GitLabSecret}))
+	}
+	if cfg.DockerHubSecret != "" {
+		mux.Handle(
+			PathPrefix+"dockerhub",
+			h.handle(&dockerHub{secret: cfg.DockerHubSecret}),
+		)
+	}
+	if cfg.HarborSecret != "" {
+		mux.Handle(PathPrefix+"harbor", h.handle(&harbor{secret: cfg.HarborSecret}))
+	}
+	if cfg.QuaySecret != "" {
+		mux.Handle(PathPrefix+"quay", h.handle(&quay{secret: cfg.QuaySecret}))
+	}
+	return mux
+}
+
+func (h *handler) handle(p provider) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		logger := logging.LoggerFromContext(ctx).WithField("path", req.URL.Path)
+
+		if req.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		payload, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxPayloadBytes))
+		if err != nil {
+			http.Error(w, "error reading payload", http.StatusBadRequest)
+			return
+		}
+		if err = p.authenticate(req, payload); err != nil {
+			logger.Debugf("rejected webhook request: %s", err)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		evt, err := p.parse(req, payload)
+		if err != nil {
+			logger.Debugf("error parsing webhook payload: %s", err)
+			http.Error(w, "error parsing payload", http.StatusBadRequest)
+			return
+		}
+		if evt == nil {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		refreshed, err := h.refreshWarehouses(ctx, evt)
+		if err != nil {
+			logger.Errorf("error refreshing Warehouses: %s", err)
+			http.Error(w, "error refreshing Warehouses", http.StatusInternalServerError)
+			return
+		}
+		logger.WithFields(logrus.Fields{
+			"gitRepoURLs":  evt.gitRepoURLs,
+			"imageRepoURL": evt.imageRepoURL,
+			"refreshed":    refreshed,
+		}).Debug("handled webhook request")
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// refreshWarehouses refreshes every Warehouse, in any namespace, that
+// subscribes to the repository described by the provided event. It returns
+// the number of Warehouses that were refreshed.
+func (h *handler) refreshWarehouses(
+	ctx context.Context,
+	evt *event,
+) (int, error) {
+	warehouses := kargoapi.WarehouseList{}
+	if err := h.client.List(ctx, &warehouses); err != nil {
+		return 0, errors.Wrap(err, "error listing Warehouses")
+	}
+	var refreshed int
+	for _, warehouse := range warehouses.Items {
+		if !subscribesTo(warehouse, evt) {
+			continue
+		}
+		if _, err := h.refreshWarehouseFn(
+			ctx,
+			h.client,
+			types.NamespacedName{
+				Namespace: warehouse.Namespace,
+				Name:      warehouse.Name,
+			},
+		); err != nil {
+			return refreshed, errors.Wrapf(
+				err,
+				"error refreshing Warehouse %q in namespace %q",
+				warehouse.Name,
+				warehouse.Namespace,
+			)
+		}
+		refreshed++
+	}
+	return refreshed, nil
+}
+
+// subscribesTo returns true if the provided Warehouse has any subscription to
+// the repository described by the provided event. It returns false otherwise.
+func subscribesTo(warehouse kargoapi.Warehouse, evt *event) bool {
+	if warehouse.Spec == nil {
+		return false
+	}
+	gitRepoURLs := make(map[string]struct{}, len(evt.gitRepoURLs))
+	for _, u := range evt.gitRepoURLs {
+		if u != "" {
+			gitRepoURLs[git.NormalizeGitURL(u)] = struct{}{}
+		}
+	}
+	imageRepoURL := normalizeImageRepoURL(evt.imageRepoURL)
+	for _, sub := range warehouse.Spec.Subscriptions {
+		switch {
+		case sub.Git != nil:
+			if _, ok := gitRepoURLs[git.NormalizeGitURL(sub.Git.RepoURL)]; ok {
+				return true
+			}
+		case sub.Image != nil:
+			if imageRepoURL != "" &&
+				normalizeImageRepoURL(sub.Image.RepoURL) == imageRepoURL {
+				return true
+			}
+		case sub.Chart != nil:
+			// Charts stored in OCI registries are pushed just like images are
+			if imageRepoURL != "" &&
+				strings.HasPrefix(sub.Chart.RepoURL, "oci://") &&
+				normalizeImageRepoURL(sub.Chart.RepoURL) == imageRepoURL {
+				return true
+			}
+		case sub.OCIArtifact != nil:
+			if imageRepoURL != "" &&
+				normalizeImageRepoURL(sub.OCIArtifact.RepoURL) == imageRepoURL {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// normalizeImageRepoURL normalizes an image repository URL for purposes of
+// comparison. e.g. "nginx", "docker.io/nginx", and
+// "docker.io/library/nginx" are all normalized to "docker.io/library/nginx".
+// Any tag or digest is discarded. If the URL cannot be parsed, an empty string
+// is returned.
+func normalizeImageRepoURL(repoURL string) string {
+	repoURL = strings.TrimPrefix(
+		strings.ToLower(strings.TrimSpace(repoURL)),
+		"oci://",
+	)
+	if repoURL == "" {
+		return ""
+	}
+	ref, err := reference.ParseNormalizedNamed(repoURL)
+	if err != nil {
+		return ""
+	}
+	return ref.Name()
+}
+
+// secretsEqual compares the provided secrets in constant time.
+func secretsEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -0,0 +1,382 @@
+package receiver
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewHandler(t *testing.T) {
+	const githubPayload = `{"repository":{"clone_url":"https://github.com/example/repo.git"}}`
+	sign := func(secret, payload string) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		_, _ = mac.Write([]byte(payload))
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	newClient := func() client.Client {
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "subscribed",
+				},
+				Spec: &kargoapi.WarehouseSpec{
+					Subscriptions: []kargoapi.RepoSubscription{{
+						Git: &kargoapi.GitSubscription{
+							RepoURL: "https://github.com/example/repo",
+						},
+					}},
+				},
+			},
+			&kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "not-subscribed",
+				},
+				Spec: &kargoapi.WarehouseSpec{
+					Subscriptions: []kargoapi.RepoSubscription{{
+						Git: &kargoapi.GitSubscription{
+							RepoURL: "https://github.com/example/other-repo",
+						},
+					}},
+				},
+			},
+		).Build()
+	}
+
+	testCases := []struct {
+		name       string
+		cfg        Config
+		req        func() *http.Request
+		assertions func(*httptest.ResponseRecorder, client.Client)
+	}{
+		{
+			name: "provider not configured",
+			cfg:  Config{},
+			req: func() *http.Request {
+				return httptest.NewRequest(
+					http.MethodPost,
+					"/webhook/github",
+					bytes.NewBufferString(githubPayload),
+				)
+			},
+			assertions: func(rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusNotFound, rr.Code)
+			},
+		},
+		{
+			name: "method not allowed",
+			cfg:  Config{GitHubSecret: "fake-secret"},
+			req: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/webhook/github", nil)
+			},
+			assertions: func(rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+			},
+		},
+		{
+			name: "invalid signature",
+			cfg:  Config{GitHubSecret: "fake-secret"},
+			req: func() *http.Request {
+				req := httptest.NewRequest(
+					http.MethodPost,
+					"/webhook/github",
+					bytes.NewBufferString(githubPayload),
+				)
+				req.Header.Set("X-GitHub-Event", "push")
+				req.Header.Set(
+					"X-Hub-Signature-256",
+					sign("wrong-secret", githubPayload),
+				)
+				return req
+			},
+			assertions: func(rr *httptest.ResponseRecorder, c client.Client) {
+				require.Equal(t, http.StatusUnauthorized, rr.Code)
+				requireRefreshed(t, c, "subscribed", false)
+			},
+		},
+		{
+			name: "success",
+			cfg:  Config{GitHubSecret: "fake-secret"},
+			req: func() *http.Request {
+				req := httptest.NewRequest(
+					http.MethodPost,
+					"/webhook/github",
+					bytes.NewBufferString(githubPayload),
+				)
+				req.Header.Set("X-GitHub-Event", "push")
+				req.Header.Set(
+					"X-Hub-Signature-256",
+					sign("fake-secret", githubPayload),
+				)
+				return req
+			},
+			assertions: func(rr *httptest.ResponseRecorder, c client.Client) {
+				require.Equal(t, http.StatusOK, rr.Code)
+				requireRefreshed(t, c, "subscribed", true)
+				requireRefreshed(t, c, "not-subscribed", false)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := newClient()
+			rr := httptest.NewRecorder()
+			NewHandler(testCase.cfg, c).ServeHTTP(rr, testCase.req())
+			testCase.assertions(rr, c)
+		})
+	}
+}
+
+func requireRefreshed(t *testing.T, c client.Client, name string, refreshed bool) {
+	warehouse := &kargoapi.Warehouse{}
+	require.NoError(
+		t,
+		c.Get(
+			context.Background(),
+			client.ObjectKey{Namespace: "fake-namespace", Name: name},
+			warehouse,
+		),
+	)
+	_, ok := warehouse.Annotations[kargoapi.AnnotationKeyRefresh]
+	require.Equal(t, refreshed, ok)
+}
+
+func TestProviders(t *testing.T) {
+	testCases := []struct {
+		name       string
+		provider   provider
+		req        func() *http.Request
+		payload    string
+		assertions func(authErr error, evt *event, parseErr error)
+	}{
+		{
+			name:     "GitLab with incorrect token",
+			provider: &gitlab{secret: "fake-secret"},
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/", nil)
+				req.Header.Set("X-Gitlab-Token", "wrong-secret")
+				return req
+			},
+			assertions: func(authErr error, _ *event, _ error) {
+				require.Error(t, authErr)
+			},
+		},
+		{
+			name:     "GitLab push",
+			provider: &gitlab{secret: "fake-secret"},
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/", nil)
+				req.Header.Set("X-Gitlab-Token", "fake-secret")
+				req.Header.Set("X-Gitlab-Event", "Push Hook")
+				return req
+			},
+			payload: `{"project":{"git_http_url":"https://gitlab.com/example/repo.git","git_ssh_url":"git@gitlab.com:example/repo.git"}}`, // nolint: lll
+			assertions: func(authErr error, evt *event, parseErr error) {
+				require.NoError(t, authErr)
+				require.NoError(t, parseErr)
+				require.Contains(
+					t,
+					evt.gitRepoURLs,
+					"https://gitlab.com/example/repo.git",
+				)
+			},
+		},
+		{
+			name:     "GitLab event other than push",
+			provider: &gitlab{secret: "fake-secret"},
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/", nil)
+				req.Header.Set("X-Gitlab-Token", "fake-secret")
+				req.Header.Set("X-Gitlab-Event", "Issue Hook")
+				return req
+			},
+			payload: `{}`,
+			assertions: func(authErr error, evt *event, parseErr error) {
+				require.NoError(t, authErr)
+				require.NoError(t, parseErr)
+				require.Nil(t, evt)
+			},
+		},
+		{
+			name:     "Docker Hub with missing secret",
+			provider: &dockerHub{secret: "fake-secret"},
+			req: func() *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/", nil)
+			},
+			assertions: func(authErr error, _ *event, _ error) {
+				require.Error(t, authErr)
+			},
+		},
+		{
+			name:     "Docker Hub push",
+			provider: &dockerHub{secret: "fake-secret"},
+			req: func() *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/?secret=fake-secret", nil)
+			},
+			payload: `{"repository":{"repo_name":"example/image"}}`,
+			assertions: func(authErr error, evt *event, parseErr error) {
+				require.NoError(t, authErr)
+				require.NoError(t, parseErr)
+				require.Equal(t, "docker.io/example/image", evt.imageRepoURL)
+			},
+		},
+		{
+			name:     "Harbor push",
+			provider: &harbor{secret: "fake-secret"},
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/", nil)
+				req.Header.Set("Authorization", "fake-secret")
+				return req
+			},
+			payload: `{"type":"PUSH_ARTIFACT","event_data":{"resources":[{"resource_url":"harbor.example.com/example/image:v1.0.0"}]}}`, // nolint: lll
+			assertions: func(authErr error, evt *event, parseErr error) {
+				require.NoError(t, authErr)
+				require.NoError(t, parseErr)
+				require.Equal(
+					t,
+					"harbor.example.com/example/image:v1.0.0",
+					evt.imageRepoURL,
+				)
+			},
+		},
+		{
+			name:     "Harbor event other than push",
+			provider: &harbor{secret: "fake-secret"},
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/", nil)
+				req.Header.Set("Authorization", "fake-secret")
+				return req
+			},
+			payload: `{"type":"DELETE_ARTIFACT"}`,
+			assertions: func(authErr error, evt *event, parseErr error) {
+				require.NoError(t, authErr)
+				require.NoError(t, parseErr)
+				require.Nil(t, evt)
+			},
+		},
+		{
+			name:     "Quay push",
+			provider: &quay{secret: "fake-secret"},
+			req: func() *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/?secret=fake-secret", nil)
+			},
+			payload: `{"docker_url":"quay.io/example/image"}`,
+			assertions: func(authErr error, evt *event, parseErr error) {
+				require.NoError(t, authErr)
+				require.NoError(t, parseErr)
+				require.Equal(t, "quay.io/example/image", evt.imageRepoURL)
+			},
+		},
+		{
+			name:     "Quay with malformed payload",
+			provider: &quay{secret: "fake-secret"},
+			req: func() *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/?secret=fake-secret", nil)
+			},
+			payload: `{`,
+			assertions: func(authErr error, _ *event, parseErr error) {
+				require.NoError(t, authErr)
+				require.Error(t, parseErr)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := testCase.req()
+			authErr := testCase.provider.authenticate(req, []byte(testCase.payload))
+			var evt *event
+			var parseErr error
+			if authErr == nil {
+				evt, parseErr = testCase.provider.parse(req, []byte(testCase.payload))
+			}
+			testCase.assertions(authErr, evt, parseErr)
+		})
+	}
+}
+
+func TestSubscribesTo(t *testing.T) {
+	warehouse := kargoapi.Warehouse{
+		Spec: &kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{
+				{
+					Git: &kargoapi.GitSubscription{
+						RepoURL: "https://github.com/example/repo.git",
+					},
+				},
+				{
+					Image: &kargoapi.ImageSubscription{
+						RepoURL: "nginx",
+					},
+				},
+				{
+					Chart: &kargoapi.ChartSubscription{
+						RepoURL: "oci://registry.example.com/charts/app",
+					},
+				},
+				{
+					OCIArtifact: &kargoapi.OCIArtifactSubscription{
+						RepoURL: "ghcr.io/example/manifests",
+					},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name     string
+		evt      *event
+		expected bool
+	}{
+		{
+			name:     "git repo URL differs only in form",
+			evt:      &event{gitRepoURLs: []string{"https://GitHub.com/example/repo"}},
+			expected: true,
+		},
+		{
+			name:     "git repo URL does not match",
+			evt:      &event{gitRepoURLs: []string{"https://github.com/example/other"}},
+			expected: false,
+		},
+		{
+			name:     "image repo URL differs only in form",
+			evt:      &event{imageRepoURL: "docker.io/library/nginx"},
+			expected: true,
+		},
+		{
+			name:     "OCI chart repo URL matches",
+			evt:      &event{imageRepoURL: "registry.example.com/charts/app:1.0.0"},
+			expected: true,
+		},
+		{
+			name:     "OCI artifact repo URL matches",
+			evt:      &event{imageRepoURL: "ghcr.io/example/manifests:1.0.0"},
+			expected: true,
+		},
+		{
+			name:     "image repo URL does not match",
+			evt:      &event{imageRepoURL: "quay.io/example/image"},
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, subscribesTo(warehouse, testCase.evt))
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -21,7 +22,10 @@ import (
 	"github.com/akuity/kargo/internal/api/dex"
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/api/option"
+	"github.com/akuity/kargo/internal/api/receiver"
 	"github.com/akuity/kargo/internal/api/validation"
+	"github.com/akuity/kargo/internal/controller/warehouses"
+	"github.com/akuity/kargo/internal/credentials"
 	httputil "github.com/akuity/kargo/internal/http"
 	"github.com/akuity/kargo/internal/logging"
 	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
@@ -35,6 +39,7 @@ type server struct {
 	cfg            config.ServerConfig
 	client         kubernetes.Client
 	internalClient client.Client
+	credentialsDB  credentials.Database
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -61,12 +66,27 @@ type server struct {
 		c client.Client,
 		namespacedName types.NamespacedName,
 	) (*kargoapi.Freight, error)
+	getProjectFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (*kargoapi.Project, error)
 	isFreightAvailableFn func(
 		freight *kargoapi.Freight,
 		stage string,
-		upstreamStages []string,
+		upstreamStages []kargoapi.StageSubscription,
+		mode kargoapi.UpstreamStagesMode,
 	) bool
 
+	// Common authorization of custom verbs:
+	authorizeFn func(
+		ctx context.Context,
+		verb string,
+		gvr schema.GroupVersionResource,
+		subresource string,
+		key client.ObjectKey,
+	) error
+
 	// Common Promotions:
 	createPromotionFn func(
 		context.Context,
@@ -98,7 +118,22 @@ type server struct {
 		ctx context.Context,
 		project string,
 		stageSubs []kargoapi.StageSubscription,
+		mode kargoapi.UpstreamStagesMode,
 	) ([]kargoapi.Freight, error)
+	getSoakingFreightFn func(
+		ctx context.Context,
+		project string,
+		stage string,
+		subs kargoapi.Subscriptions,
+	) (map[string]time.Duration, error)
+
+	// Freight approval:
+	approveFreightFn func(
+		ctx context.Context,
+		freight *kargoapi.Freight,
+		stage string,
+		approval kargoapi.Approval,
+	) error
 
 	// Freight aliasing:
 	patchFreightAliasFn func(
@@ -106,6 +141,37 @@ type server struct {
 		freight *kargoapi.Freight,
 		alias string,
 	) error
+
+	// Stage locking:
+	patchStageLockFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		lock *kargoapi.StageLock,
+	) error
+
+	// Warehouse subscription previews:
+	previewSubscriptionFn func(
+		ctx context.Context,
+		project string,
+		sub kargoapi.RepoSubscription,
+	) (*warehouses.SubscriptionPreview, error)
+
+	// Freight creation:
+	getWarehouseFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Warehouse, error)
+	assembleFreightFn func(
+		ctx context.Context,
+		warehouse *kargoapi.Warehouse,
+		versions []warehouses.ArtifactVersion,
+	) (*kargoapi.Freight, error)
+	createFreightFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
 }
 
 type Server interface {
@@ -121,12 +187,18 @@ func NewServer(
 		cfg:            cfg,
 		client:         kubeClient,
 		internalClient: internalClient,
+		credentialsDB: credentials.NewKubernetesDatabase(
+			internalClient,
+			credentials.KubernetesDatabaseConfigFromEnv(),
+		),
 	}
 	s.validateProjectFn = s.validateProject
 	s.externalValidateProjectFn = validation.ValidateProject
 	s.getStageFn = kargoapi.GetStage
 	s.getFreightFn = kargoapi.GetFreight
+	s.getProjectFn = kargoapi.GetProject
 	s.isFreightAvailableFn = kargoapi.IsFreightAvailable
+	s.authorizeFn = kubeClient.Authorize
 	s.createPromotionFn = kubeClient.Create
 	s.findStageSubscribersFn = s.findStageSubscribers
 	s.listFreightFn = kubeClient.List
@@ -134,7 +206,14 @@ func NewServer(
 	s.getFreightFromWarehouseFn = s.getFreightFromWarehouse
 	s.getVerifiedFreightFn =
 		s.getVerifiedFreight
+	s.getSoakingFreightFn = s.getSoakingFreight
+	s.approveFreightFn = s.approveFreight
 	s.patchFreightAliasFn = s.patchFreightAlias
+	s.patchStageLockFn = s.patchStageLock
+	s.previewSubscriptionFn = s.previewSubscription
+	s.getWarehouseFn = kargoapi.GetWarehouse
+	s.assembleFreightFn = s.assembleFreight
+	s.createFreightFn = s.createFreight
 	return s
 }
 
@@ -158,6 +237,12 @@ func (s *server) Serve(ctx context.Context, l net.Listener) error {
 		}
 		mux.Handle("/dex/", dexProxy)
 	}
+	if s.cfg.WebhookReceiverConfig != nil {
+		mux.Handle(
+			receiver.PathPrefix,
+			receiver.NewHandler(*s.cfg.WebhookReceiverConfig, s.internalClient),
+		)
+	}
 
 	handler := h2c.NewHandler(mux, &http2.Server{})
 
@@ -39,6 +39,7 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.externalValidateProjectFn)
 	require.NotNil(t, s.getStageFn)
 	require.NotNil(t, s.getFreightFn)
+	require.NotNil(t, s.getProjectFn)
 	require.NotNil(t, s.isFreightAvailableFn)
 	require.NotNil(t, s.createPromotionFn)
 	require.NotNil(t, s.findStageSubscribersFn)
@@ -46,4 +47,11 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.getAvailableFreightForStageFn)
 	require.NotNil(t, s.getFreightFromWarehouseFn)
 	require.NotNil(t, s.getVerifiedFreightFn)
+	require.NotNil(t, s.getSoakingFreightFn)
+	require.NotNil(t, s.approveFreightFn)
+	require.NotNil(t, s.patchStageLockFn)
+	require.NotNil(t, s.previewSubscriptionFn)
+	require.NotNil(t, s.getWarehouseFn)
+	require.NotNil(t, s.assembleFreightFn)
+	require.NotNil(t, s.createFreightFn)
 }
@@ -10,6 +10,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesmetav1 "github.com/akuity/kargo/internal/api/types/metav1"
 	"github.com/akuity/kargo/internal/version"
+	"github.com/akuity/kargo/pkg/api/metav1"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 	"github.com/akuity/kargo/pkg/api/v1alpha1"
 )
@@ -74,6 +75,7 @@ func FromStageSpecProto(s *v1alpha1.StageSpec) *kargoapi.StageSpec {
 		Subscriptions:       FromSubscriptionsProto(s.GetSubscriptions()),
 		PromotionMechanisms: FromPromotionMechanismsProto(s.GetPromotionMechanisms()),
 		Verification:        FromVerificationProto(s.GetVerification()),
+		HistoryDepth:        s.GetHistoryDepth(),
 	}
 }
 
@@ -91,6 +93,24 @@ func FromStageStatusProto(s *v1alpha1.StageStatus) *kargoapi.StageStatus {
 		History:        history,
 		Health:         FromHealthProto(s.GetHealth()),
 		Error:          s.GetError(),
+		Lock:           FromStageLockProto(s.GetLock()),
+		Message:        s.GetMessage(),
+	}
+}
+
+func FromStageLockProto(l *v1alpha1.StageLock) *kargoapi.StageLock {
+	if l == nil {
+		return nil
+	}
+	var lockedAt *kubemetav1.Time
+	if l.GetLockedAt() != nil {
+		t := kubemetav1.NewTime(l.GetLockedAt().AsTime())
+		lockedAt = &t
+	}
+	return &kargoapi.StageLock{
+		Reason:   l.GetReason(),
+		LockedBy: l.GetLockedBy(),
+		LockedAt: lockedAt,
 	}
 }
 
@@ -114,29 +134,40 @@ func FromFreightProto(f *v1alpha1.Freight) *kargoapi.Freight {
 	for idx, chart := range f.GetCharts() {
 		charts[idx] = *FromChartProto(chart)
 	}
+	ociArtifacts := make([]kargoapi.OCIArtifact, len(f.GetOciArtifacts()))
+	for idx, artifact := range f.GetOciArtifacts() {
+		ociArtifacts[idx] = *FromOCIArtifactProto(artifact)
+	}
 	verifiedIn :=
 		make(map[string]kargoapi.VerifiedStage, len(f.Status.VerifiedIn))
-	for stage := range f.Status.VerifiedIn {
-		verifiedIn[stage] = kargoapi.VerifiedStage{}
+	for stage, verified := range f.Status.VerifiedIn {
+		verifiedIn[stage] = *FromVerifiedStageProto(verified)
 	}
 	approvedFor :=
 		make(map[string]kargoapi.ApprovedStage, len(f.Status.ApprovedFor))
-	for stage := range f.Status.ApprovedFor {
-		approvedFor[stage] = kargoapi.ApprovedStage{}
+	for stage, approved := range f.Status.ApprovedFor {
+		approvedFor[stage] = *FromApprovedStageProto(approved)
+	}
+	failedIn :=
+		make(map[string]kargoapi.FailedStage, len(f.Status.FailedIn))
+	for stage := range f.Status.FailedIn {
+		failedIn[stage] = kargoapi.FailedStage{}
 	}
 	return &kargoapi.Freight{
 		TypeMeta: kubemetav1.TypeMeta{
 			APIVersion: kargoapi.GroupVersion.String(),
 			Kind:       "Freight",
 		},
-		ObjectMeta: objectMeta,
-		ID:         f.GetId(),
-		Commits:    commits,
-		Images:     images,
-		Charts:     charts,
+		ObjectMeta:   objectMeta,
+		ID:           f.GetId(),
+		Commits:      commits,
+		Images:       images,
+		Charts:       charts,
+		OCIArtifacts: ociArtifacts,
 		Status: kargoapi.FreightStatus{
 			VerifiedIn:  verifiedIn,
 			ApprovedFor: approvedFor,
+			FailedIn:    failedIn,
 		},
 	}
 }
@@ -157,12 +188,25 @@ func FromFreightReferenceProto(s *v1alpha1.FreightReference) *kargoapi.FreightRe
 	for idx, chart := range s.GetCharts() {
 		charts[idx] = *FromChartProto(chart)
 	}
+	ociArtifacts := make([]kargoapi.OCIArtifact, len(s.GetOciArtifacts()))
+	for idx, artifact := range s.GetOciArtifacts() {
+		ociArtifacts[idx] = *FromOCIArtifactProto(artifact)
+	}
+	var promotedAt *kubemetav1.Time
+	if s.GetPromotedAt() != nil {
+		t := kubemetav1.NewTime(s.GetPromotedAt().AsTime())
+		promotedAt = &t
+	}
 	return &kargoapi.FreightReference{
 		ID:               s.GetId(),
 		Commits:          commits,
 		Images:           images,
 		Charts:           charts,
+		OCIArtifacts:     ociArtifacts,
 		VerificationInfo: FromVerificationInfoProto(s.VerificationInfo),
+		Promotion:        s.GetPromotion(),
+		PromotedBy:       s.GetPromotedBy(),
+		PromotedAt:       promotedAt,
 	}
 }
 
@@ -201,7 +245,40 @@ func FromWarehouseSpecProto(s *v1alpha1.WarehouseSpec) *kargoapi.WarehouseSpec {
 		subscriptions = append(subscriptions, *FromRepoSubscriptionProto(subscription))
 	}
 	return &kargoapi.WarehouseSpec{
-		Subscriptions: subscriptions,
+		Subscriptions:   subscriptions,
+		Interval:        s.GetInterval(),
+		FreightAssembly: FromFreightAssemblyRulesProto(s.GetFreightAssembly()),
+	}
+}
+
+func FromFreightAssemblyRulesProto(
+	r *v1alpha1.FreightAssemblyRules,
+) *kargoapi.FreightAssemblyRules {
+	if r == nil {
+		return nil
+	}
+	var constraints []kargoapi.FreightAssemblyConstraint
+	if len(r.GetConstraints()) > 0 {
+		constraints =
+			make([]kargoapi.FreightAssemblyConstraint, len(r.GetConstraints()))
+		for i, c := range r.GetConstraints() {
+			match := make([]kargoapi.ArtifactVersionReference, len(c.GetMatch()))
+			for j, ref := range c.GetMatch() {
+				match[j] = kargoapi.ArtifactVersionReference{
+					RepoURL:    ref.GetRepoUrl(),
+					Chart:      ref.GetChart(),
+					Field:      kargoapi.ArtifactVersionField(ref.GetField()),
+					TrimPrefix: ref.GetTrimPrefix(),
+				}
+			}
+			constraints[i] = kargoapi.FreightAssemblyConstraint{
+				Match: match,
+			}
+		}
+	}
+	return &kargoapi.FreightAssemblyRules{
+		SettleTime:  r.GetSettleTime(),
+		Constraints: constraints,
 	}
 }
 
@@ -209,19 +286,99 @@ func FromWarehouseStatusProto(s *v1alpha1.WarehouseStatus) *kargoapi.WarehouseSt
 	if s == nil {
 		return nil
 	}
-	return &kargoapi.WarehouseStatus{}
+	var unverifiedRevisions []kargoapi.UnverifiedGitRevision
+	if len(s.GetUnverifiedRevisions()) > 0 {
+		unverifiedRevisions =
+			make([]kargoapi.UnverifiedGitRevision, len(s.GetUnverifiedRevisions()))
+		for i, r := range s.GetUnverifiedRevisions() {
+			unverifiedRevisions[i] = *FromUnverifiedGitRevisionProto(r)
+		}
+	}
+	var conditions []kubemetav1.Condition
+	if len(s.GetConditions()) > 0 {
+		conditions = make([]kubemetav1.Condition, len(s.GetConditions()))
+		for i, c := range s.GetConditions() {
+			conditions[i] = *typesmetav1.FromConditionProto(c)
+		}
+	}
+	var subscriptions []kargoapi.SubscriptionStatus
+	if len(s.GetSubscriptions()) > 0 {
+		subscriptions = make([]kargoapi.SubscriptionStatus, len(s.GetSubscriptions()))
+		for i, sub := range s.GetSubscriptions() {
+			subscriptions[i] = *FromSubscriptionStatusProto(sub)
+		}
+	}
+	var pendingFreight *kargoapi.PendingFreight
+	if s.GetPendingFreight() != nil {
+		pendingFreight = &kargoapi.PendingFreight{
+			ID:     s.GetPendingFreight().GetId(),
+			Since:  kubemetav1.NewTime(s.GetPendingFreight().GetSince().AsTime()),
+			Reason: s.GetPendingFreight().GetReason(),
+		}
+	}
+	return &kargoapi.WarehouseStatus{
+		Conditions:          conditions,
+		Error:               s.GetError(),
+		ObservedGeneration:  s.GetObservedGeneration(),
+		UnverifiedRevisions: unverifiedRevisions,
+		Subscriptions:       subscriptions,
+		PendingFreight:      pendingFreight,
+	}
+}
+
+func FromUnverifiedGitRevisionProto(
+	r *v1alpha1.UnverifiedGitRevision,
+) *kargoapi.UnverifiedGitRevision {
+	if r == nil {
+		return nil
+	}
+	return &kargoapi.UnverifiedGitRevision{
+		RepoURL: r.GetRepoUrl(),
+		ID:      r.GetId(),
+		Tag:     r.GetTag(),
+		Reason:  r.GetReason(),
+	}
+}
+
+func FromSubscriptionStatusProto(
+	s *v1alpha1.SubscriptionStatus,
+) *kargoapi.SubscriptionStatus {
+	if s == nil {
+		return nil
+	}
+	var lastPolled *kubemetav1.Time
+	if s.GetLastPolled() != nil {
+		t := kubemetav1.NewTime(s.GetLastPolled().AsTime())
+		lastPolled = &t
+	}
+	return &kargoapi.SubscriptionStatus{
+		RepoURL:        s.GetRepoUrl(),
+		Chart:          s.GetChart(),
+		LastPolled:     lastPolled,
+		LatestVersion:  s.GetLatestVersion(),
+		RecentVersions: s.GetRecentVersions(),
+		Error:          s.GetError(),
+	}
 }
 
 func FromGitCommitProto(g *v1alpha1.GitCommit) *kargoapi.GitCommit {
 	if g == nil {
 		return nil
 	}
+	var committedAt *kubemetav1.Time
+	if g.GetCommittedAt() != nil {
+		t := kubemetav1.NewTime(g.GetCommittedAt().AsTime())
+		committedAt = &t
+	}
 	return &kargoapi.GitCommit{
 		RepoURL:           g.GetRepoUrl(),
 		ID:                g.GetId(),
 		Branch:            g.GetBranch(),
 		Tag:               g.GetTag(),
 		HealthCheckCommit: g.GetHealthCheckCommit(),
+		Message:           g.GetMessage(),
+		Author:            g.GetAuthor(),
+		CommittedAt:       committedAt,
 	}
 }
 
@@ -247,6 +404,18 @@ func FromChartProto(c *v1alpha1.Chart) *kargoapi.Chart {
 	}
 }
 
+func FromOCIArtifactProto(a *v1alpha1.OCIArtifact) *kargoapi.OCIArtifact {
+	if a == nil {
+		return nil
+	}
+	return &kargoapi.OCIArtifact{
+		RepoURL:      a.GetRepoUrl(),
+		Tag:          a.GetTag(),
+		Digest:       a.GetDigest(),
+		ArtifactType: a.GetArtifactType(),
+	}
+}
+
 func FromHealthProto(h *v1alpha1.Health) *kargoapi.Health {
 	if h == nil {
 		return nil
@@ -301,8 +470,10 @@ func FromSubscriptionsProto(s *v1alpha1.Subscriptions) *kargoapi.Subscriptions {
 		upstreamStages[idx] = *FromStageSubscriptionProto(stage)
 	}
 	return &kargoapi.Subscriptions{
-		Warehouse:      s.GetWarehouse(),
-		UpstreamStages: upstreamStages,
+		Warehouse:          s.GetWarehouse(),
+		Warehouses:         s.GetWarehouses(),
+		UpstreamStages:     upstreamStages,
+		UpstreamStagesMode: kargoapi.UpstreamStagesMode(s.GetUpstreamStagesMode()),
 	}
 }
 
@@ -311,9 +482,10 @@ func FromRepoSubscriptionProto(s *v1alpha1.RepoSubscription) *kargoapi.RepoSubsc
 		return nil
 	}
 	return &kargoapi.RepoSubscription{
-		Git:   FromGitSubscriptionProto(s.Git),
-		Image: FromImageSubscriptionProto(s.Image),
-		Chart: FromChartSubscriptionProto(s.Chart),
+		Git:         FromGitSubscriptionProto(s.Git),
+		Image:       FromImageSubscriptionProto(s.Image),
+		Chart:       FromChartSubscriptionProto(s.Chart),
+		OCIArtifact: FromOCIArtifactSubscriptionProto(s.OciArtifact),
 	}
 }
 
@@ -328,6 +500,25 @@ func FromGitSubscriptionProto(s *v1alpha1.GitSubscription) *kargoapi.GitSubscrip
 		SemverConstraint:        s.GetSemverConstraint(),
 		AllowTags:               s.GetAllowTags(),
 		IgnoreTags:              s.GetIgnoreTags(),
+		IncludePaths:            s.GetIncludePaths(),
+		ExcludePaths:            s.GetExcludePaths(),
+		IncludeMessages:         s.GetIncludeMessages(),
+		ExcludeMessages:         s.GetExcludeMessages(),
+		IncludeAuthors:          s.GetIncludeAuthors(),
+		ExcludeAuthors:          s.GetExcludeAuthors(),
+		SignatureVerification:   FromGitSignatureVerificationProto(s.GetSignatureVerification()),
+	}
+}
+
+func FromGitSignatureVerificationProto(
+	v *v1alpha1.GitSignatureVerification,
+) *kargoapi.GitSignatureVerification {
+	if v == nil {
+		return nil
+	}
+	return &kargoapi.GitSignatureVerification{
+		SecretName: v.GetSecretName(),
+		Require:    kargoapi.GitSignatureRequirement(v.GetRequire()),
 	}
 }
 
@@ -356,6 +547,22 @@ func FromChartSubscriptionProto(s *v1alpha1.ChartSubscription) *kargoapi.ChartSu
 	}
 }
 
+func FromOCIArtifactSubscriptionProto(
+	s *v1alpha1.OCIArtifactSubscription,
+) *kargoapi.OCIArtifactSubscription {
+	if s == nil {
+		return nil
+	}
+	return &kargoapi.OCIArtifactSubscription{
+		RepoURL:           s.GetRepoUrl(),
+		SelectionStrategy: kargoapi.OCIArtifactSelectionStrategy(s.GetSelectionStrategy()),
+		SemverConstraint:  s.GetSemverConstraint(),
+		AllowTags:         s.GetAllowTags(),
+		IgnoreTags:        s.GetIgnoreTags(),
+		ArtifactType:      s.GetArtifactType(),
+	}
+}
+
 func FromPromotionMechanismsProto(m *v1alpha1.PromotionMechanisms) *kargoapi.PromotionMechanisms {
 	if m == nil {
 		return nil
@@ -368,9 +575,99 @@ func FromPromotionMechanismsProto(m *v1alpha1.PromotionMechanisms) *kargoapi.Pro
 	for idx, argo := range m.GetArgocdAppUpdates() {
 		argoUpdates[idx] = *FromArgoCDAppUpdatesProto(argo)
 	}
+	preHooks := make([]kargoapi.PromotionHook, len(m.GetPreHooks()))
+	for idx, hook := range m.GetPreHooks() {
+		preHooks[idx] = *FromPromotionHookProto(hook)
+	}
+	postHooks := make([]kargoapi.PromotionHook, len(m.GetPostHooks()))
+	for idx, hook := range m.GetPostHooks() {
+		postHooks[idx] = *FromPromotionHookProto(hook)
+	}
+	jobSteps := make([]kargoapi.PromotionJobStep, len(m.GetJobSteps()))
+	for idx, step := range m.GetJobSteps() {
+		jobSteps[idx] = *FromPromotionJobStepProto(step)
+	}
 	return &kargoapi.PromotionMechanisms{
 		GitRepoUpdates:   gitUpdates,
 		ArgoCDAppUpdates: argoUpdates,
+		JobSteps:         jobSteps,
+		PreHooks:         preHooks,
+		PostHooks:        postHooks,
+	}
+}
+
+func FromPromotionJobStepProto(s *v1alpha1.PromotionJobStep) *kargoapi.PromotionJobStep {
+	if s == nil {
+		return nil
+	}
+	var gitCheckout *kargoapi.PromotionJobGitCheckout
+	if s.GetGit() != nil {
+		gitCheckout = &kargoapi.PromotionJobGitCheckout{
+			RepoURL: s.GetGit().GetRepoUrl(),
+			Branch:  s.GetGit().GetBranch(),
+		}
+	}
+	var job kargoapi.PromotionJob
+	if s.GetJob() != nil {
+		job = *FromPromotionJobProto(s.GetJob())
+	}
+	return &kargoapi.PromotionJobStep{
+		Name: s.GetName(),
+		Git:  gitCheckout,
+		Job:  job,
+	}
+}
+
+func FromPromotionHookProto(h *v1alpha1.PromotionHook) *kargoapi.PromotionHook {
+	if h == nil {
+		return nil
+	}
+	return &kargoapi.PromotionHook{
+		Name: h.GetName(),
+		HTTP: FromHTTPPromotionHookProto(h.GetHttp()),
+		Job:  FromPromotionJobProto(h.GetJob()),
+	}
+}
+
+func FromHTTPPromotionHookProto(h *v1alpha1.HTTPPromotionHook) *kargoapi.HTTPPromotionHook {
+	if h == nil {
+		return nil
+	}
+	headers := make([]kargoapi.HTTPHeader, len(h.GetHeaders()))
+	for idx, header := range h.GetHeaders() {
+		headers[idx] = kargoapi.HTTPHeader{
+			Name:  header.GetName(),
+			Value: header.GetValue(),
+		}
+	}
+	return &kargoapi.HTTPPromotionHook{
+		URL:                   h.GetUrl(),
+		Method:                h.GetMethod(),
+		Headers:               headers,
+		HeadersSecret:         h.GetHeadersSecret(),
+		Body:                  h.GetBody(),
+		InsecureSkipTLSVerify: h.GetInsecureSkipTlsVerify(),
+	}
+}
+
+func FromPromotionJobProto(j *v1alpha1.PromotionJob) *kargoapi.PromotionJob {
+	if j == nil {
+		return nil
+	}
+	env := make([]kargoapi.PromotionJobEnvVar, len(j.GetEnv()))
+	for idx, e := range j.GetEnv() {
+		env[idx] = kargoapi.PromotionJobEnvVar{
+			Name:  e.GetName(),
+			Value: e.GetValue(),
+		}
+	}
+	return &kargoapi.PromotionJob{
+		Image:                 j.GetImage(),
+		Command:               j.GetCommand(),
+		Args:                  j.GetArgs(),
+		Env:                   env,
+		ServiceAccountName:    j.GetServiceAccountName(),
+		ActiveDeadlineSeconds: j.ActiveDeadlineSeconds,
 	}
 }
 
@@ -378,14 +675,34 @@ func FromGitRepoUpdateProto(u *v1alpha1.GitRepoUpdate) *kargoapi.GitRepoUpdate {
 	if u == nil {
 		return nil
 	}
+	var ociArtifacts []kargoapi.OCIArtifactUpdate
+	if len(u.GetOciArtifacts()) > 0 {
+		ociArtifacts = make([]kargoapi.OCIArtifactUpdate, len(u.GetOciArtifacts()))
+		for idx, artifact := range u.GetOciArtifacts() {
+			ociArtifacts[idx] = *FromOCIArtifactUpdateProto(artifact)
+		}
+	}
 	return &kargoapi.GitRepoUpdate{
-		RepoURL:     u.GetRepoUrl(),
-		ReadBranch:  u.GetReadBranch(),
-		WriteBranch: u.GetWriteBranch(),
-		Render:      FromKargoRenderPromotionMechanismProto(u.GetRender()),
-		Kustomize:   FromKustomizePromotionMechanismProto(u.GetKustomize()),
-		Helm:        FromHelmPromotionMechanismProto(u.GetHelm()),
-		PullRequest: FromPullRequestPromotionMechanismProto(u.GetPullRequest()),
+		RepoURL:      u.GetRepoUrl(),
+		ReadBranch:   u.GetReadBranch(),
+		WriteBranch:  u.GetWriteBranch(),
+		Render:       FromKargoRenderPromotionMechanismProto(u.GetRender()),
+		Kustomize:    FromKustomizePromotionMechanismProto(u.GetKustomize()),
+		Helm:         FromHelmPromotionMechanismProto(u.GetHelm()),
+		PullRequest:  FromPullRequestPromotionMechanismProto(u.GetPullRequest()),
+		OCIArtifacts: ociArtifacts,
+	}
+}
+
+func FromOCIArtifactUpdateProto(u *v1alpha1.OCIArtifactUpdate) *kargoapi.OCIArtifactUpdate {
+	if u == nil {
+		return nil
+	}
+	return &kargoapi.OCIArtifactUpdate{
+		RepoURL: u.GetRepoUrl(),
+		Path:    u.GetPath(),
+		Key:     u.GetKey(),
+		Value:   kargoapi.OCIArtifactUpdateValueType(u.GetValue()),
 	}
 }
 
@@ -562,7 +879,8 @@ func FromStageSubscriptionProto(s *v1alpha1.StageSubscription) *kargoapi.StageSu
 		return nil
 	}
 	return &kargoapi.StageSubscription{
-		Name: s.GetName(),
+		Name:             s.GetName(),
+		RequiredSoakTime: s.GetRequiredSoakTime(),
 	}
 }
 
@@ -593,9 +911,16 @@ func FromPromotionSpecProto(s *v1alpha1.PromotionSpec) *kargoapi.PromotionSpec {
 	if s == nil {
 		return nil
 	}
+	var notBefore *kubemetav1.Time
+	if s.GetNotBefore() != nil {
+		t := kubemetav1.NewTime(s.GetNotBefore().AsTime())
+		notBefore = &t
+	}
 	return &kargoapi.PromotionSpec{
-		Stage:   s.GetStage(),
-		Freight: s.GetFreight(),
+		Stage:     s.GetStage(),
+		Freight:   s.GetFreight(),
+		NotBefore: notBefore,
+		Priority:  s.GetPriority(),
 	}
 }
 
@@ -603,10 +928,40 @@ func FromPromotionStatusProto(s *v1alpha1.PromotionStatus) *kargoapi.PromotionSt
 	if s == nil {
 		return nil
 	}
+	hooks := make([]kargoapi.PromotionHookResult, len(s.GetHooks()))
+	for idx, hook := range s.GetHooks() {
+		hooks[idx] = *FromPromotionHookResultProto(hook)
+	}
 	return &kargoapi.PromotionStatus{
-		Phase:    kargoapi.PromotionPhase(s.GetPhase()),
-		Message:  s.GetMessage(),
-		Metadata: s.GetMetadata(),
+		Phase:              kargoapi.PromotionPhase(s.GetPhase()),
+		Message:            s.GetMessage(),
+		Metadata:           s.GetMetadata(),
+		Hooks:              hooks,
+		ControllerInstance: s.GetControllerInstance(),
+	}
+}
+
+func FromPromotionHookResultProto(r *v1alpha1.PromotionHookResult) *kargoapi.PromotionHookResult {
+	if r == nil {
+		return nil
+	}
+	var startedAt, finishedAt *kubemetav1.Time
+	if r.GetStartedAt() != nil {
+		t := kubemetav1.NewTime(r.GetStartedAt().AsTime())
+		startedAt = &t
+	}
+	if r.GetFinishedAt() != nil {
+		t := kubemetav1.NewTime(r.GetFinishedAt().AsTime())
+		finishedAt = &t
+	}
+	return &kargoapi.PromotionHookResult{
+		Name:       r.GetName(),
+		Type:       kargoapi.PromotionHookType(r.GetType()),
+		Phase:      kargoapi.PromotionHookPhase(r.GetPhase()),
+		Message:    r.GetMessage(),
+		Job:        r.GetJob(),
+		StartedAt:  startedAt,
+		FinishedAt: finishedAt,
 	}
 }
 
@@ -614,9 +969,85 @@ func FromPromotionPolicyProto(p *v1alpha1.PromotionPolicy) *kargoapi.PromotionPo
 	if p == nil {
 		return nil
 	}
+	windows := make([]kargoapi.PromotionWindow, len(p.GetWindows()))
+	for idx, window := range p.GetWindows() {
+		windows[idx] = *FromPromotionWindowProto(window)
+	}
 	return &kargoapi.PromotionPolicy{
 		Stage:                p.GetStage(),
 		AutoPromotionEnabled: p.GetAutoPromotionEnabled(),
+		QueuePolicy:          kargoapi.PromotionQueuePolicy(p.GetQueuePolicy()),
+		Windows:              windows,
+		ApprovalPolicy:       FromApprovalPolicyProto(p.GetApprovalPolicy()),
+	}
+}
+
+func FromApprovalPolicyProto(p *v1alpha1.ApprovalPolicy) *kargoapi.ApprovalPolicy {
+	if p == nil {
+		return nil
+	}
+	return &kargoapi.ApprovalPolicy{
+		RequiredApprovals:     p.GetRequiredApprovals(),
+		Groups:                p.GetGroups(),
+		Subjects:              p.GetSubjects(),
+		Expiry:                p.GetExpiry(),
+		ExcludeFreightAuthors: p.GetExcludeFreightAuthors(),
+	}
+}
+
+func FromVerifiedStageProto(s *v1alpha1.VerifiedStage) *kargoapi.VerifiedStage {
+	if s == nil || s.GetVerifiedAt() == nil {
+		return &kargoapi.VerifiedStage{}
+	}
+	verifiedAt := kubemetav1.NewTime(s.GetVerifiedAt().AsTime())
+	return &kargoapi.VerifiedStage{
+		VerifiedAt: &verifiedAt,
+	}
+}
+
+func FromApprovedStageProto(s *v1alpha1.ApprovedStage) *kargoapi.ApprovedStage {
+	if s == nil {
+		return &kargoapi.ApprovedStage{}
+	}
+	var approvals []kargoapi.Approval
+	if len(s.GetApprovals()) > 0 {
+		approvals = make([]kargoapi.Approval, len(s.GetApprovals()))
+		for idx, approval := range s.GetApprovals() {
+			approvals[idx] = *FromApprovalProto(approval)
+		}
+	}
+	return &kargoapi.ApprovedStage{
+		Approvals: approvals,
+	}
+}
+
+func FromApprovalProto(a *v1alpha1.Approval) *kargoapi.Approval {
+	if a == nil {
+		return nil
+	}
+	var approvedAt *kubemetav1.Time
+	if a.GetApprovedAt() != nil {
+		t := kubemetav1.NewTime(a.GetApprovedAt().AsTime())
+		approvedAt = &t
+	}
+	return &kargoapi.Approval{
+		Subject:    a.GetSubject(),
+		Email:      a.GetEmail(),
+		ApprovedAt: approvedAt,
+		Comment:    a.GetComment(),
+	}
+}
+
+func FromPromotionWindowProto(w *v1alpha1.PromotionWindow) *kargoapi.PromotionWindow {
+	if w == nil {
+		return nil
+	}
+	return &kargoapi.PromotionWindow{
+		Kind:     kargoapi.PromotionWindowKind(w.GetKind()),
+		Schedule: w.GetSchedule(),
+		Duration: w.GetDuration(),
+		TimeZone: w.GetTimeZone(),
+		Reason:   w.GetReason(),
 	}
 }
 
@@ -637,6 +1068,7 @@ func FromVerificationProto(v *v1alpha1.Verification) *kargoapi.Verification {
 		AnalysisTemplates:   templates,
 		AnalysisRunMetadata: FromAnalysisRunMetadataProto(v.AnalysisRunMetadata),
 		Args:                args,
+		AutoRollback:        v.GetAutoRollback(),
 	}
 }
 
@@ -732,13 +1164,18 @@ func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 	if e.Spec.PromotionMechanisms != nil {
 		promotionMechanisms = ToPromotionMechanismsProto(*e.Spec.PromotionMechanisms)
 	}
+	var lock *v1alpha1.StageLock
+	if e.Status.Lock != nil {
+		lock = ToStageLockProto(*e.Status.Lock)
+	}
 	var currentPromotion *v1alpha1.PromotionInfo
 	if e.Status.CurrentPromotion != nil {
 		sf := kargoapi.FreightReference{
-			ID:      e.Status.CurrentPromotion.Freight.ID,
-			Commits: e.Status.CurrentPromotion.Freight.Commits,
-			Images:  e.Status.CurrentPromotion.Freight.Images,
-			Charts:  e.Status.CurrentPromotion.Freight.Charts,
+			ID:           e.Status.CurrentPromotion.Freight.ID,
+			Commits:      e.Status.CurrentPromotion.Freight.Commits,
+			Images:       e.Status.CurrentPromotion.Freight.Images,
+			Charts:       e.Status.CurrentPromotion.Freight.Charts,
+			OCIArtifacts: e.Status.CurrentPromotion.Freight.OCIArtifacts,
 		}
 		currentPromotion = &v1alpha1.PromotionInfo{
 			Name:    e.Status.CurrentPromotion.Name,
@@ -753,6 +1190,7 @@ func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 			Subscriptions:       ToSubscriptionsProto(*e.Spec.Subscriptions),
 			PromotionMechanisms: promotionMechanisms,
 			Verification:        ToVerificationProto(e.Spec.Verification),
+			HistoryDepth:        e.Spec.HistoryDepth,
 		},
 		Status: &v1alpha1.StageStatus{
 			Phase:            string(e.Status.Phase),
@@ -761,10 +1199,24 @@ func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 			History:          history,
 			Health:           health,
 			Error:            e.Status.Error,
+			Lock:             lock,
+			Message:          e.Status.Message,
 		},
 	}
 }
 
+func ToStageLockProto(l kargoapi.StageLock) *v1alpha1.StageLock {
+	var lockedAt *timestamppb.Timestamp
+	if l.LockedAt != nil {
+		lockedAt = timestamppb.New(l.LockedAt.Time)
+	}
+	return &v1alpha1.StageLock{
+		Reason:   l.Reason,
+		LockedBy: l.LockedBy,
+		LockedAt: lockedAt,
+	}
+}
+
 func ToRepoSubscriptionProto(s kargoapi.RepoSubscription) *v1alpha1.RepoSubscription {
 	var git *v1alpha1.GitSubscription
 	if s.Git != nil {
@@ -778,10 +1230,15 @@ func ToRepoSubscriptionProto(s kargoapi.RepoSubscription) *v1alpha1.RepoSubscrip
 	if s.Chart != nil {
 		chart = ToChartSubscriptionProto(*s.Chart)
 	}
+	var ociArtifact *v1alpha1.OCIArtifactSubscription
+	if s.OCIArtifact != nil {
+		ociArtifact = ToOCIArtifactSubscriptionProto(*s.OCIArtifact)
+	}
 	return &v1alpha1.RepoSubscription{
-		Git:   git,
-		Image: image,
-		Chart: chart,
+		Git:         git,
+		Image:       image,
+		Chart:       chart,
+		OciArtifact: ociArtifact,
 	}
 }
 
@@ -791,8 +1248,10 @@ func ToSubscriptionsProto(s kargoapi.Subscriptions) *v1alpha1.Subscriptions {
 		upstreamStages[idx] = ToStageSubscriptionProto(s.UpstreamStages[idx])
 	}
 	return &v1alpha1.Subscriptions{
-		Warehouse:      s.Warehouse,
-		UpstreamStages: upstreamStages,
+		Warehouse:          s.Warehouse,
+		Warehouses:         s.Warehouses,
+		UpstreamStages:     upstreamStages,
+		UpstreamStagesMode: proto.String(string(s.UpstreamStagesMode)),
 	}
 }
 
@@ -804,6 +1263,25 @@ func ToGitSubscriptionProto(g kargoapi.GitSubscription) *v1alpha1.GitSubscriptio
 		SemverConstraint:        proto.String(g.SemverConstraint),
 		AllowTags:               proto.String(g.AllowTags),
 		IgnoreTags:              g.IgnoreTags,
+		IncludePaths:            g.IncludePaths,
+		ExcludePaths:            g.ExcludePaths,
+		IncludeMessages:         proto.String(g.IncludeMessages),
+		ExcludeMessages:         proto.String(g.ExcludeMessages),
+		IncludeAuthors:          g.IncludeAuthors,
+		ExcludeAuthors:          g.ExcludeAuthors,
+		SignatureVerification:   ToGitSignatureVerificationProto(g.SignatureVerification),
+	}
+}
+
+func ToGitSignatureVerificationProto(
+	v *kargoapi.GitSignatureVerification,
+) *v1alpha1.GitSignatureVerification {
+	if v == nil {
+		return nil
+	}
+	return &v1alpha1.GitSignatureVerification{
+		SecretName: v.SecretName,
+		Require:    string(v.Require),
 	}
 }
 
@@ -826,9 +1304,23 @@ func ToChartSubscriptionProto(c kargoapi.ChartSubscription) *v1alpha1.ChartSubsc
 	}
 }
 
+func ToOCIArtifactSubscriptionProto(
+	o kargoapi.OCIArtifactSubscription,
+) *v1alpha1.OCIArtifactSubscription {
+	return &v1alpha1.OCIArtifactSubscription{
+		RepoUrl:           o.RepoURL,
+		SelectionStrategy: string(o.SelectionStrategy),
+		SemverConstraint:  proto.String(o.SemverConstraint),
+		AllowTags:         proto.String(o.AllowTags),
+		IgnoreTags:        o.IgnoreTags,
+		ArtifactType:      proto.String(o.ArtifactType),
+	}
+}
+
 func ToStageSubscriptionProto(e kargoapi.StageSubscription) *v1alpha1.StageSubscription {
 	return &v1alpha1.StageSubscription{
-		Name: e.Name,
+		Name:             e.Name,
+		RequiredSoakTime: proto.String(e.RequiredSoakTime),
 	}
 }
 
@@ -841,9 +1333,91 @@ func ToPromotionMechanismsProto(p kargoapi.PromotionMechanisms) *v1alpha1.Promot
 	for idx := range p.ArgoCDAppUpdates {
 		argoCDAppUpdates[idx] = ToArgoCDAppUpdateProto(p.ArgoCDAppUpdates[idx])
 	}
+	preHooks := make([]*v1alpha1.PromotionHook, len(p.PreHooks))
+	for idx := range p.PreHooks {
+		preHooks[idx] = ToPromotionHookProto(p.PreHooks[idx])
+	}
+	postHooks := make([]*v1alpha1.PromotionHook, len(p.PostHooks))
+	for idx := range p.PostHooks {
+		postHooks[idx] = ToPromotionHookProto(p.PostHooks[idx])
+	}
+	jobSteps := make([]*v1alpha1.PromotionJobStep, len(p.JobSteps))
+	for idx := range p.JobSteps {
+		jobSteps[idx] = ToPromotionJobStepProto(p.JobSteps[idx])
+	}
 	return &v1alpha1.PromotionMechanisms{
 		GitRepoUpdates:   gitRepoUpdates,
 		ArgocdAppUpdates: argoCDAppUpdates,
+		PreHooks:         preHooks,
+		PostHooks:        postHooks,
+		JobSteps:         jobSteps,
+	}
+}
+
+func ToPromotionJobStepProto(s kargoapi.PromotionJobStep) *v1alpha1.PromotionJobStep {
+	var gitCheckout *v1alpha1.PromotionJobGitCheckout
+	if s.Git != nil {
+		gitCheckout = &v1alpha1.PromotionJobGitCheckout{
+			RepoUrl: s.Git.RepoURL,
+			Branch:  proto.String(s.Git.Branch),
+		}
+	}
+	return &v1alpha1.PromotionJobStep{
+		Name: s.Name,
+		Git:  gitCheckout,
+		Job:  ToPromotionJobProto(s.Job),
+	}
+}
+
+func ToPromotionHookProto(h kargoapi.PromotionHook) *v1alpha1.PromotionHook {
+	var http *v1alpha1.HTTPPromotionHook
+	if h.HTTP != nil {
+		http = ToHTTPPromotionHookProto(*h.HTTP)
+	}
+	var job *v1alpha1.PromotionJob
+	if h.Job != nil {
+		job = ToPromotionJobProto(*h.Job)
+	}
+	return &v1alpha1.PromotionHook{
+		Name: h.Name,
+		Http: http,
+		Job:  job,
+	}
+}
+
+func ToHTTPPromotionHookProto(h kargoapi.HTTPPromotionHook) *v1alpha1.HTTPPromotionHook {
+	headers := make([]*v1alpha1.HTTPHeader, len(h.Headers))
+	for idx := range h.Headers {
+		headers[idx] = &v1alpha1.HTTPHeader{
+			Name:  h.Headers[idx].Name,
+			Value: h.Headers[idx].Value,
+		}
+	}
+	return &v1alpha1.HTTPPromotionHook{
+		Url:                   h.URL,
+		Method:                h.Method,
+		Headers:               headers,
+		HeadersSecret:         h.HeadersSecret,
+		Body:                  h.Body,
+		InsecureSkipTlsVerify: h.InsecureSkipTLSVerify,
+	}
+}
+
+func ToPromotionJobProto(j kargoapi.PromotionJob) *v1alpha1.PromotionJob {
+	env := make([]*v1alpha1.PromotionJobEnvVar, len(j.Env))
+	for idx := range j.Env {
+		env[idx] = &v1alpha1.PromotionJobEnvVar{
+			Name:  j.Env[idx].Name,
+			Value: j.Env[idx].Value,
+		}
+	}
+	return &v1alpha1.PromotionJob{
+		Image:                 j.Image,
+		Command:               j.Command,
+		Args:                  j.Args,
+		Env:                   env,
+		ServiceAccountName:    j.ServiceAccountName,
+		ActiveDeadlineSeconds: j.ActiveDeadlineSeconds,
 	}
 }
 
@@ -860,14 +1434,28 @@ func ToGitRepoUpdateProto(g kargoapi.GitRepoUpdate) *v1alpha1.GitRepoUpdate {
 	if g.Helm != nil {
 		helm = ToHelmPromotionMechanismProto(*g.Helm)
 	}
+	ociArtifacts := make([]*v1alpha1.OCIArtifactUpdate, len(g.OCIArtifacts))
+	for idx := range g.OCIArtifacts {
+		ociArtifacts[idx] = ToOCIArtifactUpdateProto(g.OCIArtifacts[idx])
+	}
 	return &v1alpha1.GitRepoUpdate{
-		RepoUrl:     g.RepoURL,
-		ReadBranch:  proto.String(g.ReadBranch),
-		WriteBranch: g.WriteBranch,
-		Render:      render,
-		Kustomize:   kustomize,
-		Helm:        helm,
-		PullRequest: ToPullRequestPromotionMechanismProto(g.PullRequest),
+		RepoUrl:      g.RepoURL,
+		ReadBranch:   proto.String(g.ReadBranch),
+		WriteBranch:  g.WriteBranch,
+		Render:       render,
+		Kustomize:    kustomize,
+		Helm:         helm,
+		PullRequest:  ToPullRequestPromotionMechanismProto(g.PullRequest),
+		OciArtifacts: ociArtifacts,
+	}
+}
+
+func ToOCIArtifactUpdateProto(o kargoapi.OCIArtifactUpdate) *v1alpha1.OCIArtifactUpdate {
+	return &v1alpha1.OCIArtifactUpdate{
+		RepoUrl: o.RepoURL,
+		Path:    o.Path,
+		Key:     o.Key,
+		Value:   string(o.Value),
 	}
 }
 
@@ -1022,27 +1610,38 @@ func ToFreightProto(f kargoapi.Freight) *v1alpha1.Freight {
 	for idx := range f.Charts {
 		charts[idx] = ToChartProto(f.Charts[idx])
 	}
+	ociArtifacts := make([]*v1alpha1.OCIArtifact, len(f.OCIArtifacts))
+	for idx := range f.OCIArtifacts {
+		ociArtifacts[idx] = ToOCIArtifactProto(f.OCIArtifacts[idx])
+	}
 	verifiedIn :=
 		make(map[string]*v1alpha1.VerifiedStage, len(f.Status.VerifiedIn))
-	for stage := range f.Status.VerifiedIn {
-		verifiedIn[stage] = &v1alpha1.VerifiedStage{}
+	for stage, verified := range f.Status.VerifiedIn {
+		verifiedIn[stage] = ToVerifiedStageProto(verified)
 	}
 	approvedFor :=
 		make(map[string]*v1alpha1.ApprovedStage, len(f.Status.ApprovedFor))
-	for stage := range f.Status.ApprovedFor {
-		approvedFor[stage] = &v1alpha1.ApprovedStage{}
+	for stage, approved := range f.Status.ApprovedFor {
+		approvedFor[stage] = ToApprovedStageProto(approved)
+	}
+	failedIn :=
+		make(map[string]*v1alpha1.FailedStage, len(f.Status.FailedIn))
+	for stage := range f.Status.FailedIn {
+		failedIn[stage] = &v1alpha1.FailedStage{}
 	}
 	return &v1alpha1.Freight{
-		ApiVersion: f.APIVersion,
-		Kind:       f.Kind,
-		Id:         f.ID,
-		Images:     images,
-		Charts:     charts,
-		Commits:    commits,
-		Metadata:   typesmetav1.ToObjectMetaProto(*metadata),
+		ApiVersion:   f.APIVersion,
+		Kind:         f.Kind,
+		Id:           f.ID,
+		Images:       images,
+		Charts:       charts,
+		OciArtifacts: ociArtifacts,
+		Commits:      commits,
+		Metadata:     typesmetav1.ToObjectMetaProto(*metadata),
 		Status: &v1alpha1.FreightStatus{
 			VerifiedIn:  verifiedIn,
 			ApprovedFor: approvedFor,
+			FailedIn:    failedIn,
 		},
 	}
 }
@@ -1064,13 +1663,25 @@ func ToFreightReferenceProto(s kargoapi.FreightReference, firstSeen *time.Time)
 	for idx := range s.Charts {
 		charts[idx] = ToChartProto(s.Charts[idx])
 	}
+	ociArtifacts := make([]*v1alpha1.OCIArtifact, len(s.OCIArtifacts))
+	for idx := range s.OCIArtifacts {
+		ociArtifacts[idx] = ToOCIArtifactProto(s.OCIArtifacts[idx])
+	}
+	var promotedAt *timestamppb.Timestamp
+	if s.PromotedAt != nil {
+		promotedAt = timestamppb.New(s.PromotedAt.Time)
+	}
 	return &v1alpha1.FreightReference{
 		Id:               s.ID,
 		FirstSeen:        firstSeenProto,
 		Commits:          commits,
 		Images:           images,
 		Charts:           charts,
+		OciArtifacts:     ociArtifacts,
 		VerificationInfo: ToVerificationInfoProto(s.VerificationInfo),
+		Promotion:        s.Promotion,
+		PromotedBy:       s.PromotedBy,
+		PromotedAt:       promotedAt,
 	}
 }
 
@@ -1081,9 +1692,39 @@ func ToWarehouseProto(w kargoapi.Warehouse) *v1alpha1.Warehouse {
 	}
 	var status *v1alpha1.WarehouseStatus
 	if w.GetStatus() != nil {
+		unverifiedRevisions := make(
+			[]*v1alpha1.UnverifiedGitRevision,
+			len(w.GetStatus().UnverifiedRevisions),
+		)
+		for i, r := range w.GetStatus().UnverifiedRevisions {
+			unverifiedRevisions[i] = ToUnverifiedGitRevisionProto(r)
+		}
+		conditions := make([]*metav1.Condition, len(w.GetStatus().Conditions))
+		for i, c := range w.GetStatus().Conditions {
+			conditions[i] = typesmetav1.ToConditionProto(c)
+		}
+		subscriptions := make(
+			[]*v1alpha1.SubscriptionStatus,
+			len(w.GetStatus().Subscriptions),
+		)
+		for i, sub := range w.GetStatus().Subscriptions {
+			subscriptions[i] = ToSubscriptionStatusProto(sub)
+		}
+		var pendingFreight *v1alpha1.PendingFreight
+		if p := w.GetStatus().PendingFreight; p != nil {
+			pendingFreight = &v1alpha1.PendingFreight{
+				Id:     p.ID,
+				Since:  timestamppb.New(p.Since.Time),
+				Reason: proto.String(p.Reason),
+			}
+		}
 		status = &v1alpha1.WarehouseStatus{
-			Error:              w.GetStatus().Error,
-			ObservedGeneration: w.GetStatus().ObservedGeneration,
+			Error:               w.GetStatus().Error,
+			ObservedGeneration:  w.GetStatus().ObservedGeneration,
+			UnverifiedRevisions: unverifiedRevisions,
+			Conditions:          conditions,
+			Subscriptions:       subscriptions,
+			PendingFreight:      pendingFreight,
 		}
 	}
 	return &v1alpha1.Warehouse{
@@ -1091,13 +1732,74 @@ func ToWarehouseProto(w kargoapi.Warehouse) *v1alpha1.Warehouse {
 		Kind:       w.Kind,
 		Metadata:   typesmetav1.ToObjectMetaProto(w.ObjectMeta),
 		Spec: &v1alpha1.WarehouseSpec{
-			Subscriptions: subscriptions,
+			Subscriptions:   subscriptions,
+			Interval:        proto.String(w.Spec.Interval),
+			FreightAssembly: ToFreightAssemblyRulesProto(w.Spec.FreightAssembly),
 		},
 		Status: status,
 	}
 }
 
+func ToFreightAssemblyRulesProto(
+	r *kargoapi.FreightAssemblyRules,
+) *v1alpha1.FreightAssemblyRules {
+	if r == nil {
+		return nil
+	}
+	constraints := make([]*v1alpha1.FreightAssemblyConstraint, len(r.Constraints))
+	for i, c := range r.Constraints {
+		match := make([]*v1alpha1.ArtifactVersionReference, len(c.Match))
+		for j, ref := range c.Match {
+			match[j] = &v1alpha1.ArtifactVersionReference{
+				RepoUrl:    ref.RepoURL,
+				Chart:      proto.String(ref.Chart),
+				Field:      string(ref.Field),
+				TrimPrefix: proto.String(ref.TrimPrefix),
+			}
+		}
+		constraints[i] = &v1alpha1.FreightAssemblyConstraint{
+			Match: match,
+		}
+	}
+	return &v1alpha1.FreightAssemblyRules{
+		SettleTime:  proto.String(r.SettleTime),
+		Constraints: constraints,
+	}
+}
+
+func ToUnverifiedGitRevisionProto(
+	r kargoapi.UnverifiedGitRevision,
+) *v1alpha1.UnverifiedGitRevision {
+	return &v1alpha1.UnverifiedGitRevision{
+		RepoUrl: r.RepoURL,
+		Id:      r.ID,
+		Tag:     r.Tag,
+		Reason:  r.Reason,
+	}
+}
+
+func ToSubscriptionStatusProto(
+	s kargoapi.SubscriptionStatus,
+) *v1alpha1.SubscriptionStatus {
+	var lastPolled *timestamppb.Timestamp
+	if s.LastPolled != nil {
+		lastPolled = timestamppb.New(s.LastPolled.Time)
+	}
+	return &v1alpha1.SubscriptionStatus{
+		RepoUrl:        s.RepoURL,
+		Chart:          proto.String(s.Chart),
+		LastPolled:     lastPolled,
+		LatestVersion:  proto.String(s.LatestVersion),
+		RecentVersions: s.RecentVersions,
+		Error:          proto.String(s.Error),
+	}
+}
+
 func ToGitCommitProto(g kargoapi.GitCommit) *v1alpha1.GitCommit {
+	var committedAt *timestamppb.Timestamp
+	if g.CommittedAt != nil {
+		committedAt = timestamppb.New(g.CommittedAt.Time)
+	}
 	return &v1alpha1.GitCommit{
 		RepoUrl:           g.RepoURL,
 		Id:                g.ID,
@@ -1106,6 +1808,7 @@ func ToGitCommitProto(g kargoapi.GitCommit) *v1alpha1.GitCommit {
 		HealthCheckCommit: proto.String(g.HealthCheckCommit),
 		Message:           g.Message,
 		Author:            g.Author,
+		CommittedAt:       committedAt,
 	}
 }
 
@@ -1125,6 +1828,15 @@ func ToChartProto(c kargoapi.Chart) *v1alpha1.Chart {
 	}
 }
 
+func ToOCIArtifactProto(a kargoapi.OCIArtifact) *v1alpha1.OCIArtifact {
+	return &v1alpha1.OCIArtifact{
+		RepoUrl:      a.RepoURL,
+		Tag:          a.Tag,
+		Digest:       a.Digest,
+		ArtifactType: a.ArtifactType,
+	}
+}
+
 func ToHealthProto(h kargoapi.Health) *v1alpha1.Health {
 	argocdAppStates := make([]*v1alpha1.ArgoCDAppState, len(h.ArgoCDApps))
 	for i, argocdAppState := range h.ArgoCDApps {
@@ -1171,26 +1883,124 @@ func ToPromotionProto(p kargoapi.Promotion) *v1alpha1.Promotion {
 	metadata := p.ObjectMeta.DeepCopy()
 	metadata.SetManagedFields(nil)
 
+	var notBefore *timestamppb.Timestamp
+	if p.Spec.NotBefore != nil {
+		notBefore = timestamppb.New(p.Spec.NotBefore.Time)
+	}
 	return &v1alpha1.Promotion{
 		ApiVersion: p.APIVersion,
 		Kind:       p.Kind,
 		Metadata:   typesmetav1.ToObjectMetaProto(*metadata),
 		Spec: &v1alpha1.PromotionSpec{
-			Stage:   p.Spec.Stage,
-			Freight: p.Spec.Freight,
-		},
-		Status: &v1alpha1.PromotionStatus{
-			Phase:    string(p.Status.Phase),
-			Message:  p.Status.Message,
-			Metadata: p.Status.Metadata,
+			Stage:     p.Spec.Stage,
+			Freight:   p.Spec.Freight,
+			NotBefore: notBefore,
+			Priority:  p.Spec.Priority,
 		},
+		Status: ToPromotionStatusProto(p.Status),
+	}
+}
+
+func ToPromotionStatusProto(s kargoapi.PromotionStatus) *v1alpha1.PromotionStatus {
+	hooks := make([]*v1alpha1.PromotionHookResult, len(s.Hooks))
+	for idx := range s.Hooks {
+		hooks[idx] = ToPromotionHookResultProto(s.Hooks[idx])
+	}
+	return &v1alpha1.PromotionStatus{
+		Phase:              string(s.Phase),
+		Message:            s.Message,
+		Metadata:           s.Metadata,
+		Hooks:              hooks,
+		ControllerInstance: s.ControllerInstance,
+	}
+}
+
+func ToPromotionHookResultProto(r kargoapi.PromotionHookResult) *v1alpha1.PromotionHookResult {
+	var startedAt, finishedAt *timestamppb.Timestamp
+	if r.StartedAt != nil {
+		startedAt = timestamppb.New(r.StartedAt.Time)
+	}
+	if r.FinishedAt != nil {
+		finishedAt = timestamppb.New(r.FinishedAt.Time)
+	}
+	return &v1alpha1.PromotionHookResult{
+		Name:       r.Name,
+		Type:       string(r.Type),
+		Phase:      string(r.Phase),
+		Message:    r.Message,
+		Job:        r.Job,
+		StartedAt:  startedAt,
+		FinishedAt: finishedAt,
 	}
 }
 
 func ToPromotionPolicyProto(p kargoapi.PromotionPolicy) *v1alpha1.PromotionPolicy {
+	windows := make([]*v1alpha1.PromotionWindow, len(p.Windows))
+	for idx := range p.Windows {
+		windows[idx] = ToPromotionWindowProto(p.Windows[idx])
+	}
 	return &v1alpha1.PromotionPolicy{
 		Stage:                p.Stage,
 		AutoPromotionEnabled: p.AutoPromotionEnabled,
+		QueuePolicy:          proto.String(string(p.QueuePolicy)),
+		Windows:              windows,
+		ApprovalPolicy:       ToApprovalPolicyProto(p.ApprovalPolicy),
+	}
+}
+
+func ToApprovalPolicyProto(p *kargoapi.ApprovalPolicy) *v1alpha1.ApprovalPolicy {
+	if p == nil {
+		return nil
+	}
+	return &v1alpha1.ApprovalPolicy{
+		RequiredApprovals:     p.RequiredApprovals,
+		Groups:                p.Groups,
+		Subjects:              p.Subjects,
+		Expiry:                proto.String(p.Expiry),
+		ExcludeFreightAuthors: p.ExcludeFreightAuthors,
+	}
+}
+
+func ToVerifiedStageProto(s kargoapi.VerifiedStage) *v1alpha1.VerifiedStage {
+	var verifiedAt *timestamppb.Timestamp
+	if s.VerifiedAt != nil {
+		verifiedAt = timestamppb.New(s.VerifiedAt.Time)
+	}
+	return &v1alpha1.VerifiedStage{
+		VerifiedAt: verifiedAt,
+	}
+}
+
+func ToApprovedStageProto(s kargoapi.ApprovedStage) *v1alpha1.ApprovedStage {
+	approvals := make([]*v1alpha1.Approval, len(s.Approvals))
+	for idx := range s.Approvals {
+		approvals[idx] = ToApprovalProto(s.Approvals[idx])
+	}
+	return &v1alpha1.ApprovedStage{
+		Approvals: approvals,
+	}
+}
+
+func ToApprovalProto(a kargoapi.Approval) *v1alpha1.Approval {
+	var approvedAt *timestamppb.Timestamp
+	if a.ApprovedAt != nil {
+		approvedAt = timestamppb.New(a.ApprovedAt.Time)
+	}
+	return &v1alpha1.Approval{
+		Subject:    a.Subject,
+		Email:      proto.String(a.Email),
+		ApprovedAt: approvedAt,
+		Comment:    proto.String(a.Comment),
+	}
+}
+
+func ToPromotionWindowProto(w kargoapi.PromotionWindow) *v1alpha1.PromotionWindow {
+	return &v1alpha1.PromotionWindow{
+		Kind:     string(w.Kind),
+		Schedule: w.Schedule,
+		Duration: w.Duration,
+		TimeZone: proto.String(w.TimeZone),
+		Reason:   proto.String(w.Reason),
 	}
 }
 
@@ -1223,6 +2033,7 @@ func ToVerificationProto(v *kargoapi.Verification) *v1alpha1.Verification {
 		AnalysisTemplates:   templates,
 		AnalysisRunMetadata: ToAnalysisRunMetadataProto(v.AnalysisRunMetadata),
 		Args:                args,
+		AutoRollback:        v.AutoRollback,
 	}
 }
 
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// UnlockStage removes any lock from a Stage, permitting Promotions to it
+// again.
+func (s *server) UnlockStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.UnlockStageRequest],
+) (*connect.Response[svcv1alpha1.UnlockStageResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetName()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	stage, err := s.getStageForLock(ctx, req.Msg.GetProject(), req.Msg.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if stage.Status.Lock != nil {
+		if err = s.patchStageLockFn(ctx, stage, nil); err != nil {
+			return nil, errors.Wrap(err, "patch status")
+		}
+	}
+	return connect.NewResponse(&svcv1alpha1.UnlockStageResponse{
+		Stage: typesv1alpha1.ToStageProto(*stage),
+	}), nil
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestUnlockStage(t *testing.T) {
+	authorizeFn := func(
+		context.Context,
+		string,
+		schema.GroupVersionResource,
+		string,
+		client.ObjectKey,
+	) error {
+		return nil
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.UnlockStageRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.UnlockStageResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.UnlockStageRequest{},
+			server: &server{},
+			assertions: func(_ *connect.Response[svcv1alpha1.UnlockStageResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "user not authorized",
+			req: &svcv1alpha1.UnlockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return errors.New("not authorized")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.UnlockStageResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, "not authorized", err.Error())
+			},
+		},
+		{
+			name: "Stage not locked",
+			req: &svcv1alpha1.UnlockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+						},
+					}, nil
+				},
+				authorizeFn: authorizeFn,
+				patchStageLockFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.StageLock,
+				) error {
+					require.FailNow(t, "Stage should not have been patched")
+					return nil
+				},
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.UnlockStageResponse], err error) {
+				require.NoError(t, err)
+				require.Nil(t, res.Msg.GetStage().GetStatus().GetLock())
+			},
+		},
+		{
+			name: "error patching Stage",
+			req: &svcv1alpha1.UnlockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+						},
+						Status: kargoapi.StageStatus{
+							Lock: &kargoapi.StageLock{},
+						},
+					}, nil
+				},
+				authorizeFn: authorizeFn,
+				patchStageLockFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.StageLock,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *connect.Response[svcv1alpha1.UnlockStageResponse], err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "patch status")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.UnlockStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{
+							Subscriptions: &kargoapi.Subscriptions{},
+						},
+						Status: kargoapi.StageStatus{
+							Lock: &kargoapi.StageLock{},
+						},
+					}, nil
+				},
+				authorizeFn: authorizeFn,
+				patchStageLockFn: func(
+					_ context.Context,
+					stage *kargoapi.Stage,
+					lock *kargoapi.StageLock,
+				) error {
+					require.Nil(t, lock)
+					stage.Status.Lock = lock
+					return nil
+				},
+			},
+			assertions: func(res *connect.Response[svcv1alpha1.UnlockStageResponse], err error) {
+				require.NoError(t, err)
+				require.Nil(t, res.Msg.GetStage().GetStatus().GetLock())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.server.UnlockStage(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(res, err)
+		})
+	}
+}
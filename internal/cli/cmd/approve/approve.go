@@ -12,11 +12,18 @@ import (
 )
 
 func NewCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
-	var freight, stage string
+	var freight, stage, comment string
 	cmd := &cobra.Command{
-		Use:     "approve --project=project --freight=freight --stage=stage",
-		Short:   "Manually approve freight for promotion to a stage",
-		Example: "kargo approve --project=project --freight=abc1234 --stage=qa",
+		Use:   "approve --project=project --freight=freight --stage=stage [--comment=comment]",
+		Short: "Manually approve freight for promotion to a stage",
+		Example: `
+# Approve a freight for promotion to a stage
+kargo approve --project=project --freight=abc1234 --stage=qa
+
+# Approve a freight for promotion to a stage, explaining why
+kargo approve --project=project --freight=abc1234 --stage=prod \
+  --comment="change ticket CHG-1234"
+`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			ctx := cmd.Context()
 
@@ -43,6 +50,7 @@ func NewCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
 						Project: project,
 						Id:      freight,
 						Stage:   stage,
+						Comment: comment,
 					},
 				),
 			); err != nil {
@@ -54,5 +62,11 @@ func NewCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
 	option.Project(cmd.Flags(), opt, opt.Project)
 	option.Freight(cmd.Flags(), &freight)
 	option.Stage(cmd.Flags(), &stage)
+	cmd.Flags().StringVar(
+		&comment,
+		"comment",
+		"",
+		"Comment recorded alongside the approval",
+	)
 	return cmd
 }
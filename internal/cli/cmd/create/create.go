@@ -30,6 +30,10 @@ kargo create -f stage.yaml
 
 # Create project
 kargo create project my-project
+
+# Create freight from a specific image tag
+kargo create freight --project=my-project --warehouse=my-warehouse \
+  --version=nginx=1.24.0
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -98,6 +102,7 @@ kargo create project my-project
 	option.LocalServer(cmd.PersistentFlags(), opt)
 
 	// Subcommands
+	cmd.AddCommand(newFreightCommand(cfg, opt))
 	cmd.AddCommand(newProjectCommand(cfg, opt))
 	return cmd
 }
@@ -0,0 +1,115 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	kargosvcapi "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newFreightCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
+	var warehouse string
+	var versions []string
+	cmd := &cobra.Command{
+		Use: "freight --project=project --warehouse=warehouse " +
+			"[--version=REPO_URL=VERSION...]",
+		Short: "Create freight from chosen versions of a warehouse's artifacts",
+		Args:  cobra.NoArgs,
+		Example: `
+# Create freight from an older image tag, taking the versions of the
+# warehouse's other artifacts that it would currently select
+kargo create freight --project=my-project --warehouse=my-warehouse \
+  --version=nginx=1.24.0
+
+# Create freight from a specific commit and a specific chart version. Charts in
+# classic chart repositories are identified by appending the chart name to the
+# repository URL.
+kargo create freight --project=my-project --warehouse=my-warehouse \
+  --version=https://github.com/example/repo.git=a1b2c3d \
+  --version=https://charts.example.com/my-chart=1.2.3
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+			if warehouse == "" {
+				return errors.New("warehouse is required")
+			}
+
+			artifactVersions := make([]*kargosvcapi.ArtifactVersion, len(versions))
+			for i, v := range versions {
+				repoURL, version, ok := strings.Cut(v, "=")
+				if !ok || repoURL == "" || version == "" {
+					return errors.Errorf(
+						"invalid version %q; expected REPO_URL=VERSION",
+						v,
+					)
+				}
+				artifactVersions[i] = &kargosvcapi.ArtifactVersion{
+					RepoUrl: repoURL,
+					Version: version,
+				}
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return errors.Wrap(err, "get client from config")
+			}
+			resp, err := kargoSvcCli.CreateFreight(ctx,
+				connect.NewRequest(&kargosvcapi.CreateFreightRequest{
+					Project:   project,
+					Warehouse: warehouse,
+					Versions:  artifactVersions,
+				}))
+			if err != nil {
+				return errors.Wrap(err, "create freight")
+			}
+
+			freight := typesv1alpha1.FromFreightProto(resp.Msg.GetFreight())
+
+			if ptr.Deref(opt.PrintFlags.OutputFormat, "") == "" {
+				name := types.NamespacedName{
+					Namespace: freight.Namespace,
+					Name:      freight.Name,
+				}.String()
+				_, _ = fmt.Fprintf(
+					opt.IOStreams.Out,
+					"Freight Created: %q (alias %q)\n",
+					name,
+					freight.Labels[kargoapi.AliasLabelKey],
+				)
+				return nil
+			}
+			printer, err := opt.PrintFlags.ToPrinter()
+			if err != nil {
+				return errors.Wrap(err, "new printer")
+			}
+			return printer.PrintObj(freight, opt.IOStreams.Out)
+		},
+	}
+	opt.PrintFlags.AddFlags(cmd)
+	option.Project(cmd.Flags(), opt, opt.Project)
+	cmd.Flags().StringVar(&warehouse, "warehouse", "", "Warehouse")
+	cmd.Flags().StringArrayVar(
+		&versions,
+		"version",
+		nil,
+		"Version of the artifact from the specified repository, "+
+			"as REPO_URL=VERSION; may be repeated",
+	)
+	return cmd
+}
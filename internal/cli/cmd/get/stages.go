@@ -99,12 +99,20 @@ func newStageTable(list *metav1.List) *metav1.Table {
 		if stage.Status.Health != nil {
 			health = string(stage.Status.Health.Status)
 		}
+		var lockedBy string
+		if stage.Status.Lock != nil {
+			lockedBy = stage.Status.Lock.LockedBy
+			if lockedBy == "" {
+				lockedBy = "<unknown>"
+			}
+		}
 		rows[i] = metav1.TableRow{
 			Cells: []any{
 				stage.Name,
 				currentFreightID,
 				health,
 				stage.Status.Phase,
+				lockedBy,
 				duration.HumanDuration(time.Since(stage.CreationTimestamp.Time)),
 			},
 			Object: list.Items[i],
@@ -116,6 +124,7 @@ func newStageTable(list *metav1.List) *metav1.Table {
 			{Name: "Current Freight", Type: "string"},
 			{Name: "Health", Type: "string"},
 			{Name: "Phase", Type: "string"},
+			{Name: "Locked By", Type: "string"},
 			{Name: "Age", Type: "string"},
 		},
 		Rows: rows,
@@ -0,0 +1,141 @@
+package stage
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newLockCommand(
+	cfg config.CLIConfig,
+	opt *option.Option,
+) *cobra.Command {
+	var reason string
+	cmd := &cobra.Command{
+		Use:  "lock --project=project (STAGE) --reason=reason",
+		Args: option.ExactArgs(1),
+		Example: `
+# Lock a stage on its current freight for a specific project
+kargo stage lock --project=my-project prod --reason="incident in progress"
+
+# Lock a stage on its current freight for the default project
+kargo config set project my-project
+kargo stage lock prod --reason="incident in progress"
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+
+			stage := strings.TrimSpace(args[0])
+			if stage == "" {
+				return errors.New("stage is required")
+			}
+
+			if reason == "" {
+				return errors.New("reason is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return err
+			}
+			res, err := kargoSvcCli.LockStage(ctx, connect.NewRequest(&v1alpha1.LockStageRequest{
+				Project: project,
+				Name:    stage,
+				Reason:  reason,
+			}))
+			if err != nil {
+				return errors.Wrapf(err, "lock stage: %q", stage)
+			}
+			return printStageLockResult(opt, typesv1alpha1.FromStageProto(res.Msg.GetStage()), "Locked")
+		},
+	}
+	opt.PrintFlags.AddFlags(cmd)
+	cmd.Flags().StringVar(
+		&reason,
+		"reason",
+		"",
+		"Reason for locking the stage",
+	)
+	option.Project(cmd.Flags(), opt, opt.Project)
+	return cmd
+}
+
+func newUnlockCommand(
+	cfg config.CLIConfig,
+	opt *option.Option,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "unlock --project=project (STAGE)",
+		Args: option.ExactArgs(1),
+		Example: `
+# Unlock a stage for a specific project
+kargo stage unlock --project=my-project prod
+
+# Unlock a stage for the default project
+kargo config set project my-project
+kargo stage unlock prod
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+
+			stage := strings.TrimSpace(args[0])
+			if stage == "" {
+				return errors.New("stage is required")
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return err
+			}
+			res, err := kargoSvcCli.UnlockStage(ctx, connect.NewRequest(&v1alpha1.UnlockStageRequest{
+				Project: project,
+				Name:    stage,
+			}))
+			if err != nil {
+				return errors.Wrapf(err, "unlock stage: %q", stage)
+			}
+			return printStageLockResult(opt, typesv1alpha1.FromStageProto(res.Msg.GetStage()), "Unlocked")
+		},
+	}
+	opt.PrintFlags.AddFlags(cmd)
+	option.Project(cmd.Flags(), opt, opt.Project)
+	return cmd
+}
+
+func printStageLockResult(
+	opt *option.Option,
+	stage *kargoapi.Stage,
+	action string,
+) error {
+	if ptr.Deref(opt.PrintFlags.OutputFormat, "") == "" {
+		fmt.Fprintf(opt.IOStreams.Out, "%s Stage %q\n", action, stage.Name)
+		return nil
+	}
+	printer, err := opt.PrintFlags.ToPrinter()
+	if err != nil {
+		return errors.Wrap(err, "new printer")
+	}
+	_ = printer.PrintObj(stage, opt.IOStreams.Out)
+	return nil
+}
@@ -3,10 +3,12 @@ package stage
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/utils/ptr"
 
 	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
@@ -20,9 +22,10 @@ func newPromoteCommand(
 	cfg config.CLIConfig,
 	opt *option.Option,
 ) *cobra.Command {
-	var freight string
+	var freight, at string
+	var priority int32
 	cmd := &cobra.Command{
-		Use:  "promote --project=project (STAGE) [(--freight=)freight-id]",
+		Use:  "promote --project=project (STAGE) [(--freight=)freight-id] [--at=time] [--priority=priority]",
 		Args: option.ExactArgs(1),
 		Example: `
 # Promote a freight to a stage for a specific project
@@ -31,6 +34,13 @@ kargo stage promote dev --project=my-project --freight=abc123
 # Promote a freight to a stage for the default project
 kargo config set project my-project
 kargo stage promote dev --freight=abc123
+
+# Schedule a promotion to begin no earlier than 02:00 UTC
+kargo stage promote prod --project=my-project --freight=abc123 \
+  --at=2024-01-01T02:00:00Z
+
+# Promote a hotfix ahead of other pending promotions to the same stage
+kargo stage promote prod --project=my-project --freight=abc123 --priority=100
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -55,10 +65,21 @@ kargo stage promote dev --freight=abc123
 				return errors.New("freight is required")
 			}
 
+			var notBefore *timestamppb.Timestamp
+			if at != "" {
+				t, err := time.Parse(time.RFC3339, at)
+				if err != nil {
+					return errors.Wrap(err, "parse --at; expected RFC 3339 format")
+				}
+				notBefore = timestamppb.New(t)
+			}
+
 			res, err := kargoSvcCli.PromoteStage(ctx, connect.NewRequest(&v1alpha1.PromoteStageRequest{
-				Project: project,
-				Name:    stage,
-				Freight: freight,
+				Project:   project,
+				Name:      stage,
+				Freight:   freight,
+				NotBefore: notBefore,
+				Priority:  priority,
 			}))
 			if err != nil {
 				return errors.Wrap(err, "promote stage")
@@ -79,6 +100,18 @@ kargo stage promote dev --freight=abc123
 	}
 	opt.PrintFlags.AddFlags(cmd)
 	option.Freight(cmd.Flags(), &freight)
+	cmd.Flags().StringVar(
+		&at,
+		"at",
+		"",
+		"Time (RFC 3339) before which the promotion must not begin",
+	)
+	cmd.Flags().Int32Var(
+		&priority,
+		"priority",
+		0,
+		"Priority of the promotion relative to other promotions to the same stage",
+	)
 	option.Project(cmd.Flags(), opt, opt.Project)
 	return cmd
 }
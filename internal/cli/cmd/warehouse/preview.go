@@ -0,0 +1,133 @@
+package warehouse
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newPreviewCommand(
+	cfg config.CLIConfig,
+	opt *option.Option,
+) *cobra.Command {
+	var filename string
+	cmd := &cobra.Command{
+		Use:  "preview --project=project -f (FILENAME)",
+		Args: cobra.NoArgs,
+		Short: "Preview what a warehouse subscription would select, " +
+			"without creating freight",
+		Example: `
+# Preview the subscription described in subscription.yaml, e.g.:
+#
+#   image:
+#     repoURL: nginx
+#     semverConstraint: ^1.24.0
+#
+kargo warehouse preview --project=my-project -f subscription.yaml
+
+# Preview a subscription read from stdin for the default project
+kargo config set project my-project
+kargo warehouse preview -f - < subscription.yaml
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			project := opt.Project
+			if project == "" {
+				return errors.New("project is required")
+			}
+			if filename == "" {
+				return errors.New("filename is required")
+			}
+
+			sub, err := readSubscription(filename, opt.IOStreams.In)
+			if err != nil {
+				return err
+			}
+
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, cfg, opt)
+			if err != nil {
+				return err
+			}
+			res, err := kargoSvcCli.PreviewWarehouseSubscription(
+				ctx,
+				connect.NewRequest(&v1alpha1.PreviewWarehouseSubscriptionRequest{
+					Project:      project,
+					Subscription: typesv1alpha1.ToRepoSubscriptionProto(*sub),
+				}),
+			)
+			if err != nil {
+				return errors.Wrap(err, "preview warehouse subscription")
+			}
+			printPreview(opt.IOStreams.Out, res.Msg)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(
+		&filename,
+		"filename",
+		"f",
+		"",
+		"File containing the subscription to preview, or - to read from stdin",
+	)
+	option.Project(cmd.Flags(), opt, opt.Project)
+	return cmd
+}
+
+// readSubscription reads a single RepoSubscription, formatted exactly as an
+// entry in a Warehouse's spec.subscriptions, from the specified file or, if
+// the filename is "-", from the provided reader.
+func readSubscription(filename string, in io.Reader) (*kargoapi.RepoSubscription, error) {
+	var data []byte
+	var err error
+	if filename == "-" {
+		data, err = io.ReadAll(in)
+	} else {
+		data, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "read subscription")
+	}
+	sub := &kargoapi.RepoSubscription{}
+	if err = sigyaml.UnmarshalStrict(data, sub); err != nil {
+		return nil, errors.Wrap(err, "unmarshal subscription")
+	}
+	if sub.Git == nil && sub.Image == nil && sub.Chart == nil &&
+		sub.OCIArtifact == nil {
+		return nil, errors.New(
+			"subscription must specify a git, image, chart, or OCI artifact repository",
+		)
+	}
+	return sub, nil
+}
+
+func printPreview(out io.Writer, res *v1alpha1.PreviewWarehouseSubscriptionResponse) {
+	fmt.Fprintf(out, "Selected: %s\n", res.GetSelected())
+	fmt.Fprintf(out, "Candidates (%d):\n", len(res.GetCandidates()))
+	for _, candidate := range res.GetCandidates() {
+		fmt.Fprintf(out, "  %s\n", candidate)
+	}
+	if len(res.GetUnverifiedRevisions()) == 0 {
+		return
+	}
+	fmt.Fprintln(out, "Disregarded because of unverified signatures:")
+	for _, r := range res.GetUnverifiedRevisions() {
+		revision := r.GetId()
+		if r.GetTag() != "" {
+			revision = r.GetTag()
+		}
+		fmt.Fprintf(out, "  %s: %s\n", revision, r.GetReason())
+	}
+}
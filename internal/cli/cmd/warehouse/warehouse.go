@@ -0,0 +1,20 @@
+package warehouse
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewCommand(cfg config.CLIConfig, opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "warehouse",
+		Short: "Manage warehouses",
+	}
+	option.InsecureTLS(cmd.PersistentFlags(), opt)
+	option.LocalServer(cmd.PersistentFlags(), opt)
+
+	cmd.AddCommand(newPreviewCommand(cfg, opt))
+	return cmd
+}
@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -72,6 +73,26 @@ type Repo interface {
 	LastCommitID() (string, error)
 	// ListTags returns a slice of tags in the repository.
 	ListTags() ([]string, error)
+	// ListCommits returns metadata for commits reachable from the head of the
+	// current branch by following first parents only, ordered newest to oldest.
+	// At most limit commits are returned, unless limit is zero, in which case
+	// there is no limit. For a merge commit, the changed paths are those that
+	// differ from its first parent.
+	ListCommits(limit uint) ([]CommitMetadata, error)
+	// CommitMetadata returns metadata for the commit having the specified ID.
+	// The changed paths are not included.
+	CommitMetadata(id string) (CommitMetadata, error)
+	// TrustSigners configures the repository to trust the signers whose key
+	// material is provided when verifying signatures of commits and tags.
+	TrustSigners(signers TrustedSigners) error
+	// VerifyCommit returns an error explaining why the signature of the commit
+	// having the specified ID could not be verified. It returns nil if the commit
+	// bears a valid signature by a trusted signer.
+	VerifyCommit(id string) error
+	// VerifyTag returns an error explaining why the signature of the specified
+	// tag could not be verified. It returns nil if the tag is an annotated tag
+	// bearing a valid signature by a trusted signer.
+	VerifyTag(tag string) error
 	// CommitMessage returns the text of the most recent commit message associated
 	// with the specified commit ID.
 	CommitMessage(id string) (string, error)
@@ -96,6 +117,66 @@ type Repo interface {
 	HomeDir() string
 }
 
+// CommitMetadata describes a single commit.
+type CommitMetadata struct {
+	// ID is the ID (sha) of the commit.
+	ID string
+	// AuthorName is the name of the commit's author.
+	AuthorName string
+	// AuthorEmail is the email address of the commit's author.
+	AuthorEmail string
+	// CommitDate is the time at which the commit was committed.
+	CommitDate time.Time
+	// Message is the full commit message.
+	Message string
+	// Paths are the paths, relative to the root of the repository, of any files
+	// added, modified, or deleted by the commit.
+	Paths []string
+	// ShallowBoundary indicates whether the commit's parents were omitted from
+	// a shallow clone. Such a commit would appear to add every file in its
+	// tree, so the files it really changed cannot be determined and its Paths
+	// are left empty.
+	ShallowBoundary bool
+}
+
+// Author returns the commit's author in the conventional "name <email>" form.
+func (c CommitMetadata) Author() string {
+	if c.AuthorEmail == "" {
+		return c.AuthorName
+	}
+	return fmt.Sprintf("%s <%s>", c.AuthorName, c.AuthorEmail)
+}
+
+// TrustedSigners represents the key material of signers whose signatures on
+// commits and tags are to be trusted.
+type TrustedSigners struct {
+	// GPGKeys is one or more ASCII-armored GPG public keys.
+	GPGKeys string
+	// SSHAllowedSigners is the content of an SSH allowed signers file, as
+	// described by ssh-keygen(1).
+	SSHAllowedSigners string
+}
+
+// signatureStatusReasons maps the signature status codes reported by git's
+// %G? pretty format placeholder to explanations of why a signature could not be
+// verified.
+var signatureStatusReasons = map[string]string{
+	"B": "commit has a bad signature",
+	"E": "commit signature cannot be checked; the signer is not trusted",
+	"N": "commit is not signed",
+	"R": "commit is signed with a revoked key",
+	"U": "commit is not signed by a trusted signer",
+	"X": "commit signature has expired",
+	"Y": "commit is signed with an expired key",
+}
+
+// commitMetadataFormat is the pretty format used for obtaining commit metadata
+// from git log. Each commit is introduced by a record separator. Fields are
+// delimited by unit separators, and the last field is terminated by one so
+// that any names of changed files that follow can be told apart from the
+// commit message.
+const commitMetadataFormat = "--pretty=format:%x1e%H%x1f%an%x1f%ae%x1f%cI%x1f%B%x1f"
+
 // repo is an implementation of the Repo interface for interacting with a git
 // repository.
 type repo struct {
@@ -117,6 +198,9 @@ type CloneOptions struct {
 	// useful for speeding up the cloning process when all we care about is the
 	// latest commit from a single branch.
 	Shallow bool
+	// Depth, when greater than zero, limits the history that is cloned to the
+	// specified number of commits. It is ignored if Shallow is true.
+	Depth uint
 	// InsecureSkipTLSVerify specifies whether certificate verification errors
 	// should be ignored when cloning the repository. The setting will be
 	// remembered for subsequent interactions with the remote repository.
@@ -184,6 +268,8 @@ func (r *repo) clone(opts *CloneOptions) error {
 	}
 	if opts.Shallow {
 		args = append(args, "--depth=1")
+	} else if opts.Depth > 0 {
+		args = append(args, fmt.Sprintf("--depth=%d", opts.Depth))
 	}
 	args = append(args, r.url, r.dir)
 	cmd := r.buildCommand(args...)
@@ -373,6 +459,203 @@ func (r *repo) ListTags() ([]string, error) {
 	return tags, nil
 }
 
+func (r *repo) ListCommits(limit uint) ([]CommitMetadata, error) {
+	args := []string{"log", "--first-parent", "-m", "--name-only"}
+	if limit > 0 {
+		args = append(args, fmt.Sprintf("--max-count=%d", limit))
+	}
+	logBytes, err := libExec.Exec(
+		r.buildCommand(append(args, commitMetadataFormat)...),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing commits for repo %q", r.url)
+	}
+	commits, err := parseCommitMetadata(logBytes)
+	if err != nil {
+		return nil, err
+	}
+	boundary, err := r.shallowBoundary()
+	if err != nil {
+		return nil, err
+	}
+	for i, commit := range commits {
+		if _, ok := boundary[commit.ID]; ok {
+			commits[i].ShallowBoundary = true
+			commits[i].Paths = nil
+		}
+	}
+	return commits, nil
+}
+
+// shallowBoundary returns the IDs of any commits whose parents were omitted
+// from a shallow clone of the repository. If the repository is not a shallow
+// clone, an empty set is returned.
+func (r *repo) shallowBoundary() (map[string]struct{}, error) {
+	resBytes, err := libExec.Exec(r.buildCommand("rev-parse", "--git-path", "shallow"))
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error locating shallow file for repo %q",
+			r.url,
+		)
+	}
+	shallowPath := strings.TrimSpace(string(resBytes))
+	if !filepath.IsAbs(shallowPath) {
+		shallowPath = filepath.Join(r.dir, shallowPath)
+	}
+	shallowBytes, err := os.ReadFile(shallowPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(
+			err,
+			"error reading shallow file for repo %q",
+			r.url,
+		)
+	}
+	boundary := map[string]struct{}{}
+	for _, id := range strings.Fields(string(shallowBytes)) {
+		boundary[id] = struct{}{}
+	}
+	return boundary, nil
+}
+
+func (r *repo) CommitMetadata(id string) (CommitMetadata, error) {
+	logBytes, err := libExec.Exec(
+		r.buildCommand("log", "-n", "1", commitMetadataFormat, id),
+	)
+	if err != nil {
+		return CommitMetadata{}, errors.Wrapf(
+			err,
+			"error obtaining metadata for commit %q",
+			id,
+		)
+	}
+	commits, err := parseCommitMetadata(logBytes)
+	if err != nil {
+		return CommitMetadata{}, err
+	}
+	if len(commits) != 1 {
+		return CommitMetadata{}, errors.Errorf("found no metadata for commit %q", id)
+	}
+	return commits[0], nil
+}
+
+// parseCommitMetadata parses output of git log produced using
+// commitMetadataFormat and, optionally, the --name-only flag.
+func parseCommitMetadata(logBytes []byte) ([]CommitMetadata, error) {
+	records := strings.Split(string(logBytes), "\x1e")
+	commits := make([]CommitMetadata, 0, len(records))
+	for _, record := range records {
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+		fields := strings.SplitN(record, "\x1f", 6)
+		if len(fields) != 6 {
+			return nil, errors.Errorf("error parsing commit metadata %q", record)
+		}
+		commitDate, err := time.Parse(time.RFC3339, fields[3])
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error parsing date of commit %q",
+				fields[0],
+			)
+		}
+		commit := CommitMetadata{
+			ID:          fields[0],
+			AuthorName:  fields[1],
+			AuthorEmail: fields[2],
+			CommitDate:  commitDate,
+			Message:     strings.TrimSpace(fields[4]),
+		}
+		for _, path := range strings.Split(fields[5], "\n") {
+			if path = strings.TrimSpace(path); path != "" {
+				commit.Paths = append(commit.Paths, path)
+			}
+		}
+		commits = append(commits, commit)
+	}
+	return commits, nil
+}
+
+func (r *repo) TrustSigners(signers TrustedSigners) error {
+	if signers.GPGKeys != "" {
+		gnupgHome := filepath.Join(r.homeDir, ".gnupg")
+		if err := os.MkdirAll(gnupgHome, 0700); err != nil {
+			return errors.Wrapf(err, "error creating GPG home directory %q", gnupgHome)
+		}
+		cmd := exec.Command("gpg", "--batch", "--import")
+		cmd.Env = []string{fmt.Sprintf("HOME=%s", r.homeDir)}
+		cmd.Dir = r.homeDir
+		cmd.Stdin = strings.NewReader(signers.GPGKeys)
+		if _, err := libExec.Exec(cmd); err != nil {
+			return errors.Wrap(err, "error importing trusted GPG keys")
+		}
+	}
+	if signers.SSHAllowedSigners != "" {
+		allowedSignersPath := filepath.Join(r.homeDir, "allowed_signers")
+		if err := os.WriteFile(
+			allowedSignersPath,
+			[]byte(signers.SSHAllowedSigners),
+			0600,
+		); err != nil {
+			return errors.Wrapf(
+				err,
+				"error writing SSH allowed signers to %q",
+				allowedSignersPath,
+			)
+		}
+		cmd := r.buildCommand(
+			"config",
+			"--global",
+			"gpg.ssh.allowedSignersFile",
+			allowedSignersPath,
+		)
+		cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
+		if _, err := libExec.Exec(cmd); err != nil {
+			return errors.Wrap(err, "error configuring SSH allowed signers")
+		}
+	}
+	return nil
+}
+
+func (r *repo) VerifyCommit(id string) error {
+	if _, err := libExec.Exec(r.buildCommand("verify-commit", id)); err == nil {
+		return nil
+	}
+	// Verification failed. Find out why.
+	statusBytes, err := libExec.Exec(
+		r.buildCommand("log", "-n", "1", "--pretty=format:%G?", id),
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error obtaining signature status of commit %q",
+			id,
+		)
+	}
+	if reason, ok :=
+		signatureStatusReasons[strings.TrimSpace(string(statusBytes))]; ok {
+		return errors.New(reason)
+	}
+	return errors.New("commit signature could not be verified")
+}
+
+func (r *repo) VerifyTag(tag string) error {
+	res, err := libExec.Exec(r.buildCommand("verify-tag", tag))
+	if err == nil {
+		return nil
+	}
+	// Lightweight tags are not tag objects and so cannot be signed
+	if strings.Contains(string(res), "no signature found") ||
+		strings.Contains(string(res), "cannot verify a non-tag object") {
+		return errors.New("tag is not signed")
+	}
+	return errors.New("tag is not signed by a trusted signer")
+}
+
 func (r *repo) CommitMessage(id string) (string, error) {
 	msgBytes, err := libExec.Exec(
 		r.buildCommand("log", "-n", "1", "--pretty=format:%s", id),
@@ -0,0 +1,299 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	libExec "github.com/akuity/kargo/internal/exec"
+)
+
+func TestParseCommitMetadata(t *testing.T) {
+	// record builds a single record of git log output in the format produced
+	// using commitMetadataFormat and the --name-only flag
+	record := func(id, date, message string, paths ...string) string {
+		out := fmt.Sprintf(
+			"\x1e%s\x1fAlice\x1falice@example.com\x1f%s\x1f%s\x1f",
+			id,
+			date,
+			message,
+		)
+		if len(paths) > 0 {
+			out += "\n\n" + strings.Join(paths, "\n") + "\n"
+		}
+		return out
+	}
+	testCases := []struct {
+		name       string
+		log        string
+		assertions func([]CommitMetadata, error)
+	}{
+		{
+			name: "no commits",
+			log:  "",
+			assertions: func(commits []CommitMetadata, err error) {
+				require.NoError(t, err)
+				require.Empty(t, commits)
+			},
+		},
+		{
+			name: "malformed record",
+			log:  "\x1efake-id\x1fAlice",
+			assertions: func(_ []CommitMetadata, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing commit metadata")
+			},
+		},
+		{
+			name: "malformed date",
+			log:  record("fake-id", "yesterday", "fake-message"),
+			assertions: func(_ []CommitMetadata, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing date of commit")
+			},
+		},
+		{
+			name: "commit with no paths",
+			log:  record("fake-id", "2024-01-02T03:04:05Z", "fake-message\n"),
+			assertions: func(commits []CommitMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]CommitMetadata{{
+						ID:          "fake-id",
+						AuthorName:  "Alice",
+						AuthorEmail: "alice@example.com",
+						CommitDate:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+						Message:     "fake-message",
+					}},
+					commits,
+				)
+			},
+		},
+		{
+			name: "multi-line messages, merge commits, and paths",
+			log: record(
+				"merge-id",
+				"2024-01-02T03:04:05Z",
+				"Merge branch 'feature'\n\n* feature:\n  add b.txt\n",
+				"b.txt",
+				"docs/c.md",
+			) + "\n" + record(
+				"parent-id",
+				"2024-01-01T03:04:05+02:00",
+				"add a.txt\n\nThis line\nhas a body.\n",
+				"a.txt",
+			),
+			assertions: func(commits []CommitMetadata, err error) {
+				require.NoError(t, err)
+				require.Len(t, commits, 2)
+				require.Equal(t, "merge-id", commits[0].ID)
+				require.Equal(
+					t,
+					"Merge branch 'feature'\n\n* feature:\n  add b.txt",
+					commits[0].Message,
+				)
+				require.Equal(t, []string{"b.txt", "docs/c.md"}, commits[0].Paths)
+				require.Equal(t, "parent-id", commits[1].ID)
+				require.Equal(
+					t,
+					"add a.txt\n\nThis line\nhas a body.",
+					commits[1].Message,
+				)
+				require.Equal(t, []string{"a.txt"}, commits[1].Paths)
+				require.True(
+					t,
+					time.Date(2024, 1, 1, 1, 4, 5, 0, time.UTC).
+						Equal(commits[1].CommitDate),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(parseCommitMetadata([]byte(testCase.log)))
+		})
+	}
+}
+
+// newLocalRepo initializes an empty repository, not cloned from anywhere, in a
+// temporary directory.
+func newLocalRepo(t *testing.T) *repo {
+	homeDir := t.TempDir()
+	r := &repo{
+		homeDir:       homeDir,
+		dir:           filepath.Join(homeDir, "repo"),
+		currentBranch: "main",
+	}
+	require.NoError(t, os.Mkdir(r.dir, 0700))
+	runGit(t, r, "init", "--initial-branch=main")
+	runGit(t, r, "config", "user.name", "Alice")
+	runGit(t, r, "config", "user.email", "alice@example.com")
+	runGit(t, r, "config", "gpg.format", "ssh")
+	return r
+}
+
+// runGit runs a git command in the provided repository and returns its
+// trimmed output.
+func runGit(t *testing.T, r *repo, args ...string) string {
+	res, err := libExec.Exec(r.buildCommand(args...))
+	require.NoError(t, err)
+	return strings.TrimSpace(string(res))
+}
+
+// commitFile writes the specified file to the provided repository and commits
+// it. If a signing key is specified, the commit is signed with it. The ID of
+// the new commit is returned.
+func commitFile(t *testing.T, r *repo, path, message, signingKey string) string {
+	absPath := filepath.Join(r.dir, path)
+	require.NoError(t, os.MkdirAll(filepath.Dir(absPath), 0700))
+	require.NoError(t, os.WriteFile(absPath, []byte(message), 0600))
+	runGit(t, r, "add", path)
+	args := []string{"commit", "-m", message}
+	if signingKey != "" {
+		args = append([]string{"-c", "user.signingKey=" + signingKey}, args...)
+		args = append(args, "-S")
+	}
+	runGit(t, r, args...)
+	return runGit(t, r, "rev-parse", "HEAD")
+}
+
+// newSSHKey generates an SSH key pair in the specified directory and returns
+// the path to the private key and the content of the public key.
+func newSSHKey(t *testing.T, dir, name string) (string, string) {
+	keyPath := filepath.Join(dir, name)
+	_, err := libExec.Exec(
+		exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath),
+	)
+	require.NoError(t, err)
+	pubKey, err := os.ReadFile(keyPath + ".pub")
+	require.NoError(t, err)
+	return keyPath, strings.TrimSpace(string(pubKey))
+}
+
+func TestListCommits(t *testing.T) {
+	r := newLocalRepo(t)
+	firstID := commitFile(t, r, "a.txt", "first", "")
+	runGit(t, r, "checkout", "-b", "feature")
+	commitFile(t, r, "docs/b.md", "feature", "")
+	runGit(t, r, "checkout", "main")
+	secondID := commitFile(t, r, "a.txt", "second", "")
+	runGit(t, r, "merge", "--no-ff", "-m", "Merge feature\n\nwith a body", "feature")
+	mergeID := runGit(t, r, "rev-parse", "HEAD")
+	runGit(t, r, "commit", "--allow-empty", "-m", "empty")
+	emptyID := runGit(t, r, "rev-parse", "HEAD")
+
+	commits, err := r.ListCommits(0)
+	require.NoError(t, err)
+	// The commit on the feature branch is not a first parent of anything on
+	// main, so it is not listed
+	require.Len(t, commits, 4)
+
+	require.Equal(t, emptyID, commits[0].ID)
+	require.Equal(t, "empty", commits[0].Message)
+	require.Empty(t, commits[0].Paths)
+
+	require.Equal(t, mergeID, commits[1].ID)
+	require.Equal(t, "Merge feature\n\nwith a body", commits[1].Message)
+	require.Equal(t, []string{"docs/b.md"}, commits[1].Paths)
+
+	require.Equal(t, secondID, commits[2].ID)
+	require.Equal(t, []string{"a.txt"}, commits[2].Paths)
+
+	require.Equal(t, firstID, commits[3].ID)
+	require.Equal(t, "Alice", commits[3].AuthorName)
+	require.Equal(t, "alice@example.com", commits[3].AuthorEmail)
+	require.Equal(t, []string{"a.txt"}, commits[3].Paths)
+
+	commits, err = r.ListCommits(2)
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, emptyID, commits[0].ID)
+	require.Equal(t, mergeID, commits[1].ID)
+}
+
+func TestListCommitsShallow(t *testing.T) {
+	origin := newLocalRepo(t)
+	commitFile(t, origin, "a.txt", "first", "")
+	commitFile(t, origin, "docs/b.md", "second", "")
+	thirdID := commitFile(t, origin, "c.txt", "third", "")
+
+	homeDir := t.TempDir()
+	r := &repo{
+		homeDir: homeDir,
+		dir:     filepath.Join(homeDir, "repo"),
+	}
+	_, err := libExec.Exec(exec.Command(
+		"git",
+		"clone",
+		"--depth=2",
+		"file://"+origin.dir,
+		r.dir,
+	))
+	require.NoError(t, err)
+
+	commits, err := r.ListCommits(0)
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+
+	require.Equal(t, thirdID, commits[0].ID)
+	require.False(t, commits[0].ShallowBoundary)
+	require.Equal(t, []string{"c.txt"}, commits[0].Paths)
+
+	// The second commit would otherwise appear to have added every file
+	require.True(t, commits[1].ShallowBoundary)
+	require.Empty(t, commits[1].Paths)
+}
+
+func TestVerifyCommitAndTag(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen is required to sign commits and tags")
+	}
+	r := newLocalRepo(t)
+	keysDir := t.TempDir()
+	trustedKey, trustedPubKey := newSSHKey(t, keysDir, "trusted")
+	untrustedKey, _ := newSSHKey(t, keysDir, "untrusted")
+	require.NoError(
+		t,
+		r.TrustSigners(TrustedSigners{
+			SSHAllowedSigners: "alice@example.com " + trustedPubKey,
+		}),
+	)
+
+	unsignedID := commitFile(t, r, "a.txt", "unsigned", "")
+	runGit(t, r, "tag", "lightweight")
+	runGit(t, r, "tag", "-a", "-m", "unsigned", "unsigned")
+	trustedID := commitFile(t, r, "a.txt", "trusted", trustedKey)
+	runGit(t, r, "-c", "user.signingKey="+trustedKey, "tag", "-s", "-m", "trusted", "trusted")
+	untrustedID := commitFile(t, r, "a.txt", "untrusted", untrustedKey)
+	runGit(t, r, "-c", "user.signingKey="+untrustedKey, "tag", "-s", "-m", "untrusted", "untrusted")
+
+	t.Run("commits", func(t *testing.T) {
+		require.NoError(t, r.VerifyCommit(trustedID))
+		err := r.VerifyCommit(unsignedID)
+		require.Error(t, err)
+		require.Equal(t, "commit is not signed", err.Error())
+		err = r.VerifyCommit(untrustedID)
+		require.Error(t, err)
+		require.Equal(t, "commit is not signed by a trusted signer", err.Error())
+	})
+
+	t.Run("tags", func(t *testing.T) {
+		require.NoError(t, r.VerifyTag("trusted"))
+		err := r.VerifyTag("lightweight")
+		require.Error(t, err)
+		require.Equal(t, "tag is not signed", err.Error())
+		err = r.VerifyTag("unsigned")
+		require.Error(t, err)
+		require.Equal(t, "tag is not signed", err.Error())
+		err = r.VerifyTag("untrusted")
+		require.Error(t, err)
+		require.Equal(t, "tag is not signed by a trusted signer", err.Error())
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/akuity/kargo/internal/credentials"
 	libGit "github.com/akuity/kargo/internal/git"
 	"github.com/akuity/kargo/internal/logging"
+	libYAML "github.com/akuity/kargo/internal/yaml"
 )
 
 // gitMechanism is an implementation of the Mechanism interface that uses Git to
@@ -50,6 +51,11 @@ type gitMechanism struct {
 		homeDir string,
 		workingDir string,
 	) ([]string, error)
+	applyOCIArtifactUpdatesFn func(
+		update kargoapi.GitRepoUpdate,
+		newFreight kargoapi.FreightReference,
+		workingDir string,
+	) ([]string, error)
 }
 
 // newGitMechanism returns an implementation of the Mechanism interface that
@@ -76,6 +82,9 @@ func newGitMechanism(
 	g.getCredentialsFn = getRepoCredentialsFn(credentialsDB)
 	g.gitCommitFn = g.gitCommit
 	g.applyConfigManagementFn = applyConfigManagementFn
+	g.applyOCIArtifactUpdatesFn = (&ociArtifactUpdater{
+		setStringsInYAMLFileFn: libYAML.SetStringsInFile,
+	}).apply
 	return g
 }
 
@@ -315,6 +324,17 @@ func (g *gitMechanism) gitCommit(
 			return "", err
 		}
 	}
+	if len(update.OCIArtifacts) > 0 {
+		artifactChanges, err := g.applyOCIArtifactUpdatesFn(
+			update,
+			newFreight,
+			repo.WorkingDir(),
+		)
+		if err != nil {
+			return "", err
+		}
+		changes = append(changes, artifactChanges...)
+	}
 	commitMsg := buildCommitMessage(changes)
 
 	// Sometimes we don't write to the same branch we read from...
@@ -42,6 +42,7 @@ func TestNewGitMechanism(t *testing.T) {
 	require.NotNil(t, gpm.getCredentialsFn)
 	require.NotNil(t, gpm.gitCommitFn)
 	require.NotNil(t, gpm.applyConfigManagementFn)
+	require.NotNil(t, gpm.applyOCIArtifactUpdatesFn)
 }
 
 func TestGitGetName(t *testing.T) {
@@ -0,0 +1,448 @@
+package promotion
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	hookHTTPTimeout = 30 * time.Second
+	// maxHookResponseBytes limits how much of an HTTP hook's response body is
+	// recorded in the hook's result.
+	maxHookResponseBytes = 256
+)
+
+// HookRunner executes pre- and post-promotion hooks.
+type HookRunner interface {
+	// RunHooks executes, in order, those of the specified hooks that have not
+	// already completed according to the results recorded in the status of the
+	// provided Promotion. It returns updated results for all of the Promotion's
+	// hooks, along with the aggregated phase of the specified hooks. Execution
+	// stops at the first hook that is still running. Execution of pre-hooks
+	// also stops at the first hook that fails. Updated results are returned even
+	// if an error is encountered.
+	RunHooks(
+		ctx context.Context,
+		hookType kargoapi.PromotionHookType,
+		hooks []kargoapi.PromotionHook,
+		stage *kargoapi.Stage,
+		promo *kargoapi.Promotion,
+		freight kargoapi.FreightReference,
+	) ([]kargoapi.PromotionHookResult, kargoapi.PromotionHookPhase, error)
+}
+
+// hookRunner is the default implementation of the HookRunner interface.
+type hookRunner struct {
+	// These behaviors are overridable for testing purposes:
+	getSecretFn func(
+		ctx context.Context,
+		namespace string,
+		name string,
+	) (*corev1.Secret, error)
+	getJobFn func(
+		ctx context.Context,
+		namespace string,
+		name string,
+	) (*batchv1.Job, error)
+	createJobFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+	doHTTPRequestFn func(
+		req *http.Request,
+		insecureSkipTLSVerify bool,
+	) (*http.Response, error)
+}
+
+// NewHookRunner returns the default implementation of the HookRunner
+// interface.
+func NewHookRunner(kargoClient client.Client) HookRunner {
+	h := &hookRunner{}
+	h.getSecretFn = func(
+		ctx context.Context,
+		namespace string,
+		name string,
+	) (*corev1.Secret, error) {
+		secret := &corev1.Secret{}
+		if err := kargoClient.Get(
+			ctx,
+			types.NamespacedName{Namespace: namespace, Name: name},
+			secret,
+		); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		return secret, nil
+	}
+	h.getJobFn = getJobFn(kargoClient)
+	h.createJobFn = kargoClient.Create
+	h.doHTTPRequestFn = doHTTPRequest
+	return h
+}
+
+// RunHooks implements the HookRunner interface.
+func (h *hookRunner) RunHooks(
+	ctx context.Context,
+	hookType kargoapi.PromotionHookType,
+	hooks []kargoapi.PromotionHook,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	freight kargoapi.FreightReference,
+) ([]kargoapi.PromotionHookResult, kargoapi.PromotionHookPhase, error) {
+	results := make([]kargoapi.PromotionHookResult, len(promo.Status.Hooks))
+	for i := range promo.Status.Hooks {
+		results[i] = *promo.Status.Hooks[i].DeepCopy()
+	}
+	if len(hooks) == 0 {
+		return results, kargoapi.PromotionHookPhaseSucceeded, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	data := templateData{
+		Promotion: promo,
+		Stage:     stage,
+		Freight:   freight,
+	}
+
+	phase := kargoapi.PromotionHookPhaseSucceeded
+	for _, hook := range hooks {
+		idx := -1
+		for i := range results {
+			if results[i].Type == hookType && results[i].Name == hook.Name {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			results = append(
+				results,
+				kargoapi.PromotionHookResult{
+					Name: hook.Name,
+					Type: hookType,
+				},
+			)
+			idx = len(results) - 1
+		}
+		result := &results[idx]
+		if !result.Phase.IsTerminal() {
+			hookLogger := logger.WithField("hook", hook.Name).
+				WithField("hookType", hookType)
+			if err := h.runHook(
+				logging.ContextWithLogger(ctx, hookLogger),
+				hookType,
+				hook,
+				data,
+				result,
+			); err != nil {
+				return results, "", errors.Wrapf(
+					err,
+					"error executing %s-promotion hook %q",
+					strings.ToLower(string(hookType)),
+					hook.Name,
+				)
+			}
+			hookLogger.Debugf("hook %s", result.Phase)
+		}
+		switch result.Phase {
+		case kargoapi.PromotionHookPhaseRunning:
+			return results, kargoapi.PromotionHookPhaseRunning, nil
+		case kargoapi.PromotionHookPhaseFailed:
+			if hookType == kargoapi.PromotionHookTypePre {
+				return results, kargoapi.PromotionHookPhaseFailed, nil
+			}
+			phase = kargoapi.PromotionHookPhaseFailed
+		}
+	}
+	return results, phase, nil
+}
+
+// runHook executes a single hook, or follows up on a hook that is already
+// running, and updates the provided result accordingly. Errors returned from
+// this function are indicative of internal problems. Problems attributable to
+// the hook itself are recorded in the result instead.
+func (h *hookRunner) runHook(
+	ctx context.Context,
+	hookType kargoapi.PromotionHookType,
+	hook kargoapi.PromotionHook,
+	data templateData,
+	result *kargoapi.PromotionHookResult,
+) error {
+	if result.StartedAt == nil {
+		now := metav1.Now()
+		result.StartedAt = &now
+	}
+	var err error
+	switch {
+	case hook.HTTP != nil:
+		h.runHTTPHook(ctx, *hook.HTTP, data, result)
+	case hook.Job != nil:
+		err = h.runJobHook(ctx, hookType, hook.Name, *hook.Job, data, result)
+	default:
+		result.Phase = kargoapi.PromotionHookPhaseFailed
+		result.Message = "hook defines no action"
+	}
+	if err == nil && result.Phase.IsTerminal() {
+		now := metav1.Now()
+		result.FinishedAt = &now
+	}
+	return err
+}
+
+func (h *hookRunner) runHTTPHook(
+	ctx context.Context,
+	hook kargoapi.HTTPPromotionHook,
+	data templateData,
+	result *kargoapi.PromotionHookResult,
+) {
+	fail := func(format string, args ...any) {
+		result.Phase = kargoapi.PromotionHookPhaseFailed
+		result.Message = fmt.Sprintf(format, args...)
+	}
+
+	var body io.Reader
+	if hook.Body != "" {
+		rendered, err := renderTemplate("body", hook.Body, data)
+		if err != nil {
+			fail("error rendering request body: %s", err)
+			return
+		}
+		if !json.Valid([]byte(rendered)) {
+			fail("rendered request body is not valid JSON")
+			return
+		}
+		body = strings.NewReader(rendered)
+	}
+
+	method := hook.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	req, err := http.NewRequestWithContext(ctx, method, hook.URL, body)
+	if err != nil {
+		fail("error building request: %s", err)
+		return
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, header := range hook.Headers {
+		req.Header.Set(header.Name, header.Value)
+	}
+	if hook.HeadersSecret != "" {
+		secret, err := h.getSecretFn(
+			ctx,
+			data.Promotion.Namespace,
+			hook.HeadersSecret,
+		)
+		if err != nil {
+			fail(
+				"error getting Secret %q in namespace %q: %s",
+				hook.HeadersSecret,
+				data.Promotion.Namespace,
+				err,
+			)
+			return
+		}
+		if secret == nil {
+			fail(
+				"Secret %q not found in namespace %q",
+				hook.HeadersSecret,
+				data.Promotion.Namespace,
+			)
+			return
+		}
+		// Only Secrets explicitly intended for use by hooks may be sent to the
+		// hook's URL. Repository credentials never are, even if labeled as such.
+		if credentials.IsCredentialsSecret(secret) {
+			fail(
+				"Secret %q holds repository credentials and cannot be used by hooks",
+				hook.HeadersSecret,
+			)
+			return
+		}
+		if secret.Labels[kargoapi.PromotionHookSecretLabelKey] !=
+			kargoapi.LabelTrueValue {
+			fail(
+				"Secret %q is not labeled %s=%s",
+				hook.HeadersSecret,
+				kargoapi.PromotionHookSecretLabelKey,
+				kargoapi.LabelTrueValue,
+			)
+			return
+		}
+		for name, value := range secret.Data {
+			req.Header.Set(name, string(value))
+		}
+	}
+
+	res, err := h.doHTTPRequestFn(req, hook.InsecureSkipTLSVerify)
+	if err != nil {
+		fail("error sending %s request to %s: %s", method, hook.URL, err)
+		return
+	}
+	defer res.Body.Close()
+	resBody, _ := io.ReadAll(io.LimitReader(res.Body, maxHookResponseBytes))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		fail(
+			"received unexpected HTTP %d response: %s",
+			res.StatusCode,
+			strings.TrimSpace(string(resBody)),
+		)
+		return
+	}
+	result.Phase = kargoapi.PromotionHookPhaseSucceeded
+	result.Message = fmt.Sprintf("received HTTP %d response", res.StatusCode)
+}
+
+func (h *hookRunner) runJobHook(
+	ctx context.Context,
+	hookType kargoapi.PromotionHookType,
+	hookName string,
+	promoJob kargoapi.PromotionJob,
+	data templateData,
+	result *kargoapi.PromotionHookResult,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+	if result.Job == "" {
+		result.Job = jobName(
+			data.Promotion,
+			hookName,
+			"hook",
+			string(hookType),
+		)
+	}
+	result.Phase = kargoapi.PromotionHookPhaseRunning
+	result.Message = fmt.Sprintf("waiting for Job %q to complete", result.Job)
+
+	job, err := h.getJobFn(ctx, data.Promotion.Namespace, result.Job)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error getting Job %q in namespace %q",
+			result.Job,
+			data.Promotion.Namespace,
+		)
+	}
+	if job == nil {
+		if job, err = buildJob(result.Job, promoJob, data); err != nil {
+			result.Phase = kargoapi.PromotionHookPhaseFailed
+			result.Message = fmt.Sprintf("error building Job: %s", err)
+			return nil
+		}
+		if err = h.createJobFn(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+			return errors.Wrapf(
+				err,
+				"error creating Job %q in namespace %q",
+				result.Job,
+				data.Promotion.Namespace,
+			)
+		}
+		logger.WithField("job", result.Job).Debug("created hook Job")
+		return nil
+	}
+
+	finished, jobErr := getJobResult(job)
+	if !finished {
+		return nil
+	}
+	if jobErr != nil {
+		result.Phase = kargoapi.PromotionHookPhaseFailed
+		result.Message = jobErr.Error()
+		return nil
+	}
+	result.Phase = kargoapi.PromotionHookPhaseSucceeded
+	result.Message = fmt.Sprintf("Job %q completed", result.Job)
+	return nil
+}
+
+// FailedHooksMessage returns a message summarizing the failures of any hooks
+// of the specified type.
+func FailedHooksMessage(
+	results []kargoapi.PromotionHookResult,
+	hookType kargoapi.PromotionHookType,
+) string {
+	var msgs []string
+	for _, result := range results {
+		if result.Type == hookType &&
+			result.Phase == kargoapi.PromotionHookPhaseFailed {
+			msgs = append(msgs, fmt.Sprintf("%q: %s", result.Name, result.Message))
+		}
+	}
+	if len(msgs) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(
+		"%s-promotion hook(s) failed: %s",
+		strings.ToLower(string(hookType)),
+		strings.Join(msgs, "; "),
+	)
+}
+
+// HasUnfinishedHooks returns true if any of the specified results for hooks of
+// the specified type have not yet reached a terminal phase.
+func HasUnfinishedHooks(
+	results []kargoapi.PromotionHookResult,
+	hookType kargoapi.PromotionHookType,
+) bool {
+	for _, result := range results {
+		if result.Type == hookType && !result.Phase.IsTerminal() {
+			return true
+		}
+	}
+	return false
+}
+
+func getJobFn(
+	c client.Client,
+) func(context.Context, string, string) (*batchv1.Job, error) {
+	return func(
+		ctx context.Context,
+		namespace string,
+		name string,
+	) (*batchv1.Job, error) {
+		job := &batchv1.Job{}
+		if err := c.Get(
+			ctx,
+			types.NamespacedName{Namespace: namespace, Name: name},
+			job,
+		); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		return job, nil
+	}
+}
+
+func doHTTPRequest(
+	req *http.Request,
+	insecureSkipTLSVerify bool,
+) (*http.Response, error) {
+	httpClient := &http.Client{
+		Timeout: hookHTTPTimeout,
+	}
+	if insecureSkipTLSVerify {
+		transport := http.DefaultTransport.(*http.Transport).Clone() // nolint: forcetypeassert
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+		httpClient.Transport = transport
+	}
+	return httpClient.Do(req)
+}
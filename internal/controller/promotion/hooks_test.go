@@ -0,0 +1,525 @@
+package promotion
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewHookRunner(t *testing.T) {
+	hr := NewHookRunner(fake.NewClientBuilder().Build())
+	h, ok := hr.(*hookRunner)
+	require.True(t, ok)
+	require.NotNil(t, h.getSecretFn)
+	require.NotNil(t, h.getJobFn)
+	require.NotNil(t, h.createJobFn)
+	require.NotNil(t, h.doHTTPRequestFn)
+}
+
+func TestRunHooks(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	testFreight := kargoapi.FreightReference{ID: "fake-freight"}
+
+	srv := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/ok":
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != `{"stage":"fake-stage","freight":"fake-freight"}` ||
+					r.Header.Get("X-Token") != "secret" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte("boom"))
+			}
+		}),
+	)
+	defer srv.Close()
+
+	okHook := kargoapi.PromotionHook{
+		Name: "ok",
+		HTTP: &kargoapi.HTTPPromotionHook{
+			URL: srv.URL + "/ok",
+			Body: `{"stage":{{ toJson .Stage.Name }},` +
+				`"freight":{{ toJson .Freight.ID }}}`,
+			HeadersSecret: "fake-secret",
+		},
+	}
+	failHook := kargoapi.PromotionHook{
+		Name: "fail",
+		HTTP: &kargoapi.HTTPPromotionHook{
+			URL: srv.URL + "/fail",
+		},
+	}
+	jobHook := kargoapi.PromotionHook{
+		Name: "job",
+		Job: &kargoapi.PromotionJob{
+			Image: "fake-image",
+			Args:  []string{"{{ .Freight.ID }}"},
+		},
+	}
+	getSecretFn := func(
+		context.Context,
+		string,
+		string,
+	) (*corev1.Secret, error) {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					kargoapi.PromotionHookSecretLabelKey: kargoapi.LabelTrueValue,
+				},
+			},
+			Data: map[string][]byte{
+				"X-Token": []byte("secret"),
+			},
+		}, nil
+	}
+
+	testCases := []struct {
+		name       string
+		runner     *hookRunner
+		hookType   kargoapi.PromotionHookType
+		hooks      []kargoapi.PromotionHook
+		promo      *kargoapi.Promotion
+		assertions func(
+			[]kargoapi.PromotionHookResult,
+			kargoapi.PromotionHookPhase,
+			error,
+		)
+	}{
+		{
+			name:     "no hooks",
+			runner:   &hookRunner{},
+			hookType: kargoapi.PromotionHookTypePre,
+			promo: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					Hooks: []kargoapi.PromotionHookResult{{Name: "existing"}},
+				},
+			},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				phase kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionHookPhaseSucceeded, phase)
+				require.Equal(
+					t,
+					[]kargoapi.PromotionHookResult{{Name: "existing"}},
+					results,
+				)
+			},
+		},
+		{
+			name: "HTTP hooks succeed",
+			runner: &hookRunner{
+				getSecretFn:     getSecretFn,
+				doHTTPRequestFn: doHTTPRequest,
+			},
+			hookType: kargoapi.PromotionHookTypePre,
+			hooks:    []kargoapi.PromotionHook{okHook},
+			promo:    &kargoapi.Promotion{},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				phase kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionHookPhaseSucceeded, phase)
+				require.Len(t, results, 1)
+				require.Equal(t, "ok", results[0].Name)
+				require.Equal(t, kargoapi.PromotionHookTypePre, results[0].Type)
+				require.Equal(t, kargoapi.PromotionHookPhaseSucceeded, results[0].Phase)
+				require.NotNil(t, results[0].StartedAt)
+				require.NotNil(t, results[0].FinishedAt)
+			},
+		},
+		{
+			name: "failed pre-hook stops execution",
+			runner: &hookRunner{
+				getSecretFn:     getSecretFn,
+				doHTTPRequestFn: doHTTPRequest,
+			},
+			hookType: kargoapi.PromotionHookTypePre,
+			hooks:    []kargoapi.PromotionHook{failHook, okHook},
+			promo:    &kargoapi.Promotion{},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				phase kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionHookPhaseFailed, phase)
+				require.Len(t, results, 1)
+				require.Equal(t, kargoapi.PromotionHookPhaseFailed, results[0].Phase)
+				require.Contains(t, results[0].Message, "HTTP 500")
+				require.Contains(t, results[0].Message, "boom")
+			},
+		},
+		{
+			name: "failed post-hook does not stop execution",
+			runner: &hookRunner{
+				getSecretFn:     getSecretFn,
+				doHTTPRequestFn: doHTTPRequest,
+			},
+			hookType: kargoapi.PromotionHookTypePost,
+			hooks:    []kargoapi.PromotionHook{failHook, okHook},
+			promo:    &kargoapi.Promotion{},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				phase kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionHookPhaseFailed, phase)
+				require.Len(t, results, 2)
+				require.Equal(t, kargoapi.PromotionHookPhaseFailed, results[0].Phase)
+				require.Equal(t, kargoapi.PromotionHookPhaseSucceeded, results[1].Phase)
+			},
+		},
+		{
+			name:     "completed hooks are not executed again",
+			runner:   &hookRunner{},
+			hookType: kargoapi.PromotionHookTypePre,
+			hooks:    []kargoapi.PromotionHook{failHook},
+			promo: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					Hooks: []kargoapi.PromotionHookResult{{
+						Name:  "fail",
+						Type:  kargoapi.PromotionHookTypePre,
+						Phase: kargoapi.PromotionHookPhaseSucceeded,
+					}},
+				},
+			},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				phase kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionHookPhaseSucceeded, phase)
+				require.Len(t, results, 1)
+			},
+		},
+		{
+			name:     "invalid JSON body",
+			runner:   &hookRunner{},
+			hookType: kargoapi.PromotionHookTypePre,
+			hooks: []kargoapi.PromotionHook{{
+				Name: "bad-body",
+				HTTP: &kargoapi.HTTPPromotionHook{
+					URL:  srv.URL,
+					Body: "{{ .Stage.Name }}",
+				},
+			}},
+			promo: &kargoapi.Promotion{},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				phase kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionHookPhaseFailed, phase)
+				require.Contains(t, results[0].Message, "not valid JSON")
+			},
+		},
+		{
+			name: "headers Secret not labeled for use by hooks",
+			runner: &hookRunner{
+				getSecretFn: func(
+					context.Context,
+					string,
+					string,
+				) (*corev1.Secret, error) {
+					return &corev1.Secret{
+						Data: map[string][]byte{"X-Token": []byte("secret")},
+					}, nil
+				},
+				doHTTPRequestFn: func(*http.Request, bool) (*http.Response, error) {
+					require.Fail(t, "request should not have been sent")
+					return nil, nil
+				},
+			},
+			hookType: kargoapi.PromotionHookTypePre,
+			hooks:    []kargoapi.PromotionHook{okHook},
+			promo:    &kargoapi.Promotion{},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				phase kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionHookPhaseFailed, phase)
+				require.Contains(
+					t,
+					results[0].Message,
+					"is not labeled "+kargoapi.PromotionHookSecretLabelKey,
+				)
+			},
+		},
+		{
+			name: "headers Secret holds repository credentials",
+			runner: &hookRunner{
+				getSecretFn: func(
+					context.Context,
+					string,
+					string,
+				) (*corev1.Secret, error) {
+					return &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"kargo.akuity.io/secret-type":        "repository",
+								kargoapi.PromotionHookSecretLabelKey: kargoapi.LabelTrueValue,
+							},
+						},
+						Data: map[string][]byte{"password": []byte("secret")},
+					}, nil
+				},
+				doHTTPRequestFn: func(*http.Request, bool) (*http.Response, error) {
+					require.Fail(t, "request should not have been sent")
+					return nil, nil
+				},
+			},
+			hookType: kargoapi.PromotionHookTypePre,
+			hooks:    []kargoapi.PromotionHook{okHook},
+			promo:    &kargoapi.Promotion{},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				phase kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionHookPhaseFailed, phase)
+				require.Contains(t, results[0].Message, "holds repository credentials")
+			},
+		},
+		{
+			name: "error getting Job",
+			runner: &hookRunner{
+				getJobFn: func(
+					context.Context,
+					string,
+					string,
+				) (*batchv1.Job, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			hookType: kargoapi.PromotionHookTypePre,
+			hooks:    []kargoapi.PromotionHook{jobHook},
+			promo:    &kargoapi.Promotion{},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				_ kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				// The unfinished result should still be returned
+				require.Len(t, results, 1)
+				require.False(t, results[0].Phase.IsTerminal())
+			},
+		},
+		{
+			name: "Job created",
+			runner: &hookRunner{
+				getJobFn: func(
+					context.Context,
+					string,
+					string,
+				) (*batchv1.Job, error) {
+					return nil, nil
+				},
+				createJobFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					job, ok := obj.(*batchv1.Job)
+					require.True(t, ok)
+					require.Equal(t, "fake-namespace", job.Namespace)
+					require.Equal(
+						t,
+						[]string{"fake-freight"},
+						job.Spec.Template.Spec.Containers[0].Args,
+					)
+					return nil
+				},
+			},
+			hookType: kargoapi.PromotionHookTypePost,
+			hooks:    []kargoapi.PromotionHook{jobHook},
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promo",
+				},
+			},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				phase kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionHookPhaseRunning, phase)
+				require.Equal(t, kargoapi.PromotionHookPhaseRunning, results[0].Phase)
+				require.NotEmpty(t, results[0].Job)
+				require.Nil(t, results[0].FinishedAt)
+			},
+		},
+		{
+			name: "Job failed",
+			runner: &hookRunner{
+				getJobFn: func(
+					context.Context,
+					string,
+					string,
+				) (*batchv1.Job, error) {
+					return &batchv1.Job{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-job",
+						},
+						Status: batchv1.JobStatus{
+							Conditions: []batchv1.JobCondition{{
+								Type:    batchv1.JobFailed,
+								Status:  corev1.ConditionTrue,
+								Message: "exit code 1",
+							}},
+						},
+					}, nil
+				},
+			},
+			hookType: kargoapi.PromotionHookTypePre,
+			hooks:    []kargoapi.PromotionHook{jobHook},
+			promo: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					Hooks: []kargoapi.PromotionHookResult{{
+						Name:  "job",
+						Type:  kargoapi.PromotionHookTypePre,
+						Phase: kargoapi.PromotionHookPhaseRunning,
+						Job:   "fake-job",
+					}},
+				},
+			},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				phase kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionHookPhaseFailed, phase)
+				require.Contains(t, results[0].Message, "exit code 1")
+				require.NotNil(t, results[0].FinishedAt)
+			},
+		},
+		{
+			name: "Job completed",
+			runner: &hookRunner{
+				getJobFn: func(
+					context.Context,
+					string,
+					string,
+				) (*batchv1.Job, error) {
+					return &batchv1.Job{
+						Status: batchv1.JobStatus{
+							Conditions: []batchv1.JobCondition{{
+								Type:   batchv1.JobComplete,
+								Status: corev1.ConditionTrue,
+							}},
+						},
+					}, nil
+				},
+			},
+			hookType: kargoapi.PromotionHookTypePre,
+			hooks:    []kargoapi.PromotionHook{jobHook},
+			promo: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					Hooks: []kargoapi.PromotionHookResult{{
+						Name:  "job",
+						Type:  kargoapi.PromotionHookTypePre,
+						Phase: kargoapi.PromotionHookPhaseRunning,
+						Job:   "fake-job",
+					}},
+				},
+			},
+			assertions: func(
+				results []kargoapi.PromotionHookResult,
+				phase kargoapi.PromotionHookPhase,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionHookPhaseSucceeded, phase)
+				require.Equal(t, kargoapi.PromotionHookPhaseSucceeded, results[0].Phase)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.runner.RunHooks(
+					context.Background(),
+					testCase.hookType,
+					testCase.hooks,
+					testStage,
+					testCase.promo,
+					testFreight,
+				),
+			)
+		})
+	}
+}
+
+func TestFailedHooksMessage(t *testing.T) {
+	results := []kargoapi.PromotionHookResult{
+		{
+			Name:    "foo",
+			Type:    kargoapi.PromotionHookTypePre,
+			Phase:   kargoapi.PromotionHookPhaseFailed,
+			Message: "something went wrong",
+		},
+		{
+			Name:  "bar",
+			Type:  kargoapi.PromotionHookTypePost,
+			Phase: kargoapi.PromotionHookPhaseFailed,
+		},
+	}
+	require.Equal(
+		t,
+		`pre-promotion hook(s) failed: "foo": something went wrong`,
+		FailedHooksMessage(results, kargoapi.PromotionHookTypePre),
+	)
+	require.Empty(t, FailedHooksMessage(results[:1], kargoapi.PromotionHookTypePost))
+}
+
+func TestHasUnfinishedHooks(t *testing.T) {
+	results := []kargoapi.PromotionHookResult{
+		{
+			Type:  kargoapi.PromotionHookTypePre,
+			Phase: kargoapi.PromotionHookPhaseSucceeded,
+		},
+		{
+			Type:  kargoapi.PromotionHookTypePost,
+			Phase: kargoapi.PromotionHookPhaseRunning,
+		},
+	}
+	require.False(t, HasUnfinishedHooks(results, kargoapi.PromotionHookTypePre))
+	require.True(t, HasUnfinishedHooks(results, kargoapi.PromotionHookTypePost))
+}
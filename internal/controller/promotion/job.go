@@ -0,0 +1,165 @@
+package promotion
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	// maxJobNamePrefixLength is the maximum length of the human-readable prefix
+	// of a Job name. A hyphen and a ten character hash are appended to this
+	// prefix and the result must remain a valid label value (63 characters).
+	maxJobNamePrefixLength = 40
+
+	jobContainerName = "main"
+)
+
+// jobName returns a deterministic name for a Job executed on behalf of the
+// specified Promotion. The name begins with the specified prefix and ends with
+// a hash of the Promotion's namespace and name, the prefix, and any additional
+// discriminators.
+func jobName(
+	promo *kargoapi.Promotion,
+	prefix string,
+	discriminators ...string,
+) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s/%s/%s", promo.Namespace, promo.Name, prefix)
+	for _, d := range discriminators {
+		_, _ = fmt.Fprintf(h, "/%s", d)
+	}
+	if len(prefix) > maxJobNamePrefixLength {
+		prefix = prefix[:maxJobNamePrefixLength]
+	}
+	return fmt.Sprintf("%s-%x", prefix, h.Sum(nil))[:len(prefix)+11]
+}
+
+// buildJob returns a Job, owned by the specified Promotion, that executes the
+// container described by the specified PromotionJob. Templated arguments and
+// environment variables are rendered using the provided data.
+func buildJob(
+	name string,
+	promoJob kargoapi.PromotionJob,
+	data templateData,
+) (*batchv1.Job, error) {
+	promo := data.Promotion
+	args := make([]string, len(promoJob.Args))
+	for i, arg := range promoJob.Args {
+		var err error
+		if args[i], err = renderTemplate(
+			fmt.Sprintf("args[%d]", i),
+			arg,
+			data,
+		); err != nil {
+			return nil, err
+		}
+	}
+	jobCtx, err := marshalJobContext(data)
+	if err != nil {
+		return nil, err
+	}
+	env := []corev1.EnvVar{
+		{Name: "KARGO_PROMOTION", Value: promo.Name},
+		{Name: "KARGO_STAGE", Value: data.Stage.Name},
+		{Name: "KARGO_FREIGHT", Value: data.Freight.ID},
+		{Name: "KARGO_CONTEXT", Value: jobCtx},
+	}
+	for _, e := range promoJob.Env {
+		val, err := renderTemplate(fmt.Sprintf("env[%s]", e.Name), e.Value, data)
+		if err != nil {
+			return nil, err
+		}
+		env = append(env, corev1.EnvVar{Name: e.Name, Value: val})
+	}
+	backoffLimit := int32(0)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       promo.Namespace,
+			OwnerReferences: promotionOwnerReferences(promo),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          &backoffLimit,
+			ActiveDeadlineSeconds: promoJob.ActiveDeadlineSeconds,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: promoJob.ServiceAccountName,
+					Containers: []corev1.Container{{
+						Name:    jobContainerName,
+						Image:   promoJob.Image,
+						Command: promoJob.Command,
+						Args:    args,
+						Env:     env,
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
+// promotionOwnerReferences returns owner references that make the specified
+// Promotion the controller of any object created on its behalf.
+func promotionOwnerReferences(promo *kargoapi.Promotion) []metav1.OwnerReference {
+	return []metav1.OwnerReference{{
+		APIVersion: kargoapi.GroupVersion.String(),
+		Kind:       "Promotion",
+		Name:       promo.Name,
+		UID:        promo.UID,
+		Controller: ptr.To(true),
+	}}
+}
+
+// marshalJobContext returns a JSON representation of the Promotion, Stage, and
+// Freight described by the provided data. Managed fields are omitted to keep
+// the result reasonably small.
+func marshalJobContext(data templateData) (string, error) {
+	promo := data.Promotion.DeepCopy()
+	promo.ManagedFields = nil
+	stage := data.Stage.DeepCopy()
+	stage.ManagedFields = nil
+	jobCtx, err := json.Marshal(struct {
+		Promotion *kargoapi.Promotion       `json:"promotion"`
+		Stage     *kargoapi.Stage           `json:"stage"`
+		Freight   kargoapi.FreightReference `json:"freight"`
+	}{
+		Promotion: promo,
+		Stage:     stage,
+		Freight:   data.Freight,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "error marshaling Job context")
+	}
+	return string(jobCtx), nil
+}
+
+// getJobResult inspects the conditions of the specified Job and returns
+// whether it has finished and, if so, an error describing its failure, if it
+// did not succeed.
+func getJobResult(job *batchv1.Job) (bool, error) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return true, nil
+		case batchv1.JobFailed:
+			msg := cond.Message
+			if msg == "" {
+				msg = cond.Reason
+			}
+			return true, errors.Errorf("Job %q failed: %s", job.Name, msg)
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,121 @@
+package promotion
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestJobName(t *testing.T) {
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+		},
+	}
+	name := jobName(promo, "foo", "bar")
+	require.True(t, strings.HasPrefix(name, "foo-"))
+	require.Len(t, name, len("foo")+11)
+	// Names are deterministic...
+	require.Equal(t, name, jobName(promo, "foo", "bar"))
+	// ...but vary with discriminators
+	require.NotEqual(t, name, jobName(promo, "foo", "baz"))
+	// Long prefixes are truncated
+	require.LessOrEqual(t, len(jobName(promo, strings.Repeat("a", 100))), 63)
+}
+
+func TestBuildJob(t *testing.T) {
+	deadline := int64(60)
+	job, err := buildJob(
+		"fake-job",
+		kargoapi.PromotionJob{
+			Image:                 "fake-image",
+			Command:               []string{"/bin/sh"},
+			Args:                  []string{"-c", "echo {{ .Stage.Name }}"},
+			Env:                   []kargoapi.PromotionJobEnvVar{{Name: "FOO", Value: "{{ .Freight.ID }}"}},
+			ServiceAccountName:    "fake-sa",
+			ActiveDeadlineSeconds: &deadline,
+		},
+		templateData{
+			Promotion: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promo",
+					UID:       "fake-uid",
+				},
+			},
+			Stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-stage",
+				},
+			},
+			Freight: kargoapi.FreightReference{ID: "fake-freight"},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fake-job", job.Name)
+	require.Equal(t, "fake-namespace", job.Namespace)
+	require.Len(t, job.OwnerReferences, 1)
+	require.Equal(t, "fake-promo", job.OwnerReferences[0].Name)
+	require.Equal(t, &deadline, job.Spec.ActiveDeadlineSeconds)
+	podSpec := job.Spec.Template.Spec
+	require.Equal(t, corev1.RestartPolicyNever, podSpec.RestartPolicy)
+	require.Equal(t, "fake-sa", podSpec.ServiceAccountName)
+	require.Len(t, podSpec.Containers, 1)
+	require.Equal(t, []string{"-c", "echo fake-stage"}, podSpec.Containers[0].Args)
+	require.Contains(
+		t,
+		podSpec.Containers[0].Env,
+		corev1.EnvVar{Name: "KARGO_FREIGHT", Value: "fake-freight"},
+	)
+	require.Contains(
+		t,
+		podSpec.Containers[0].Env,
+		corev1.EnvVar{Name: "FOO", Value: "fake-freight"},
+	)
+
+	_, err = buildJob(
+		"fake-job",
+		kargoapi.PromotionJob{Args: []string{"{{ .Bogus }}"}},
+		templateData{
+			Promotion: &kargoapi.Promotion{},
+			Stage:     &kargoapi.Stage{},
+		},
+	)
+	require.Error(t, err)
+}
+
+func TestGetJobResult(t *testing.T) {
+	finished, err := getJobResult(&batchv1.Job{})
+	require.False(t, finished)
+	require.NoError(t, err)
+
+	finished, err = getJobResult(&batchv1.Job{
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	})
+	require.True(t, finished)
+	require.NoError(t, err)
+
+	finished, err = getJobResult(&batchv1.Job{
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{
+				Type:   batchv1.JobFailed,
+				Status: corev1.ConditionTrue,
+				Reason: "BackoffLimitExceeded",
+			}},
+		},
+	})
+	require.True(t, finished)
+	require.ErrorContains(t, err, "BackoffLimitExceeded")
+}
@@ -0,0 +1,614 @@
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	libGit "github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	jobWorkspaceVolumeName = "workspace"
+	jobWorkspaceDir        = "/workspace"
+	jobRepoDir             = jobWorkspaceDir + "/repo"
+	jobResultFile          = corev1.TerminationMessagePathDefault
+	jobGitContainerName    = "git-checkout"
+
+	// jobGitCheckoutScript clones the repository identified by the
+	// KARGO_GIT_REPO_URL environment variable into the directory identified by
+	// the KARGO_REPO_DIR environment variable and, if specified, checks out the
+	// branch or commit identified by the KARGO_GIT_REF environment variable.
+	// Credentials, if any, are read from the GIT_USERNAME and GIT_PASSWORD
+	// environment variables.
+	jobGitCheckoutScript = `set -e
+if [ -n "$GIT_USERNAME" ]; then
+  git config --global credential.helper '!f() { echo "username=${GIT_USERNAME}"; echo "password=${GIT_PASSWORD}"; }; f'
+fi
+git clone --no-tags "$KARGO_GIT_REPO_URL" "$KARGO_REPO_DIR"
+if [ -n "$KARGO_GIT_REF" ]; then
+  git -C "$KARGO_REPO_DIR" checkout "$KARGO_GIT_REF"
+fi
+`
+)
+
+// jobStepResult represents the optional result document written by the
+// container of a PromotionJobStep.
+type jobStepResult struct {
+	Phase             kargoapi.PromotionPhase `json:"phase,omitempty"`
+	Message           string                  `json:"message,omitempty"`
+	HealthCheckCommit string                  `json:"healthCheckCommit,omitempty"`
+}
+
+// jobStepMechanism is an implementation of the Mechanism interface that
+// executes user-defined promotion steps as Kubernetes Jobs.
+type jobStepMechanism struct {
+	// gitImage is the container image used to check out Git repositories into
+	// the workspace of a Job.
+	gitImage string
+	// workspaceSize is the size of the PersistentVolumeClaim that is requested
+	// for the workspace of any step that checks out a Git repository.
+	workspaceSize string
+	// These behaviors are overridable for testing purposes:
+	doSingleStepFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		promo *kargoapi.Promotion,
+		step kargoapi.PromotionJobStep,
+		newFreight kargoapi.FreightReference,
+	) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error)
+	getJobFn func(
+		ctx context.Context,
+		namespace string,
+		name string,
+	) (*batchv1.Job, error)
+	createFn func(
+		ctx context.Context,
+		obj client.Object,
+		opts ...client.CreateOption,
+	) error
+	deleteFn func(
+		ctx context.Context,
+		obj client.Object,
+		opts ...client.DeleteOption,
+	) error
+	getCredentialsSecretFn func(
+		ctx context.Context,
+		namespace string,
+		repoURL string,
+	) (*corev1.Secret, error)
+	getJobStepResultFn func(
+		ctx context.Context,
+		job *batchv1.Job,
+	) (*jobStepResult, error)
+}
+
+// newJobStepMechanism returns an implementation of the Mechanism interface
+// that executes user-defined promotion steps as Kubernetes Jobs.
+func newJobStepMechanism(
+	kargoClient client.Client,
+	gitImage string,
+	workspaceSize string,
+) Mechanism {
+	j := &jobStepMechanism{
+		gitImage:      gitImage,
+		workspaceSize: workspaceSize,
+	}
+	j.doSingleStepFn = j.doSingleStep
+	j.getJobFn = getJobFn(kargoClient)
+	j.createFn = kargoClient.Create
+	j.deleteFn = kargoClient.Delete
+	j.getCredentialsSecretFn = getCredentialsSecretFn(kargoClient)
+	j.getJobStepResultFn = getJobStepResultFn(kargoClient)
+	return j
+}
+
+// GetName implements the Mechanism interface.
+func (*jobStepMechanism) GetName() string {
+	return "Job-based promotion mechanisms"
+}
+
+// Promote implements the Mechanism interface.
+func (j *jobStepMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+	steps := stage.Spec.PromotionMechanisms.JobSteps
+
+	if len(steps) == 0 {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+	}
+
+	newFreight = *newFreight.DeepCopy()
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("executing Job-based promotion mechanisms")
+
+	newStatus := promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded)
+	for _, step := range steps {
+		var err error
+		if newStatus, newFreight, err = j.doSingleStepFn(
+			ctx,
+			stage,
+			promo,
+			step,
+			newFreight,
+		); err != nil {
+			return nil, newFreight, err
+		}
+		if newStatus.Phase != kargoapi.PromotionPhaseSucceeded {
+			// Steps are executed strictly in sequence
+			return newStatus, newFreight, nil
+		}
+	}
+
+	logger.Debug("done executing Job-based promotion mechanisms")
+
+	return newStatus, newFreight, nil
+}
+
+// doSingleStep creates the Job for a single PromotionJobStep if it does not
+// already exist and otherwise reports on its outcome. If the step checks out a
+// Git repository, the checkout is performed first, by a separate Job, and the
+// step's Job is created only once that Job has succeeded.
+func (j *jobStepMechanism) doSingleStep(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	step kargoapi.PromotionJobStep,
+	newFreight kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("step", step.Name)
+	name := jobName(promo, step.Name, "step")
+
+	job, err := j.getJobFn(ctx, promo.Namespace, name)
+	if err != nil {
+		return nil, newFreight, errors.Wrapf(
+			err,
+			"error getting Job %q in namespace %q",
+			name,
+			promo.Namespace,
+		)
+	}
+
+	if job == nil && step.Git != nil {
+		checkoutStatus, err := j.checkOut(ctx, promo, step, newFreight)
+		if err != nil || checkoutStatus != nil {
+			return checkoutStatus, newFreight, err
+		}
+	}
+
+	newStatus := promo.Status.WithPhase(kargoapi.PromotionPhaseRunning)
+	newStatus.Message = fmt.Sprintf(
+		"waiting for Job %q of step %q to complete",
+		name,
+		step.Name,
+	)
+
+	if job == nil {
+		if job, err = j.buildStepJob(name, stage, promo, step, newFreight); err != nil {
+			return nil, newFreight, err
+		}
+		if err = j.createFn(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+			return nil, newFreight, errors.Wrapf(
+				err,
+				"error creating Job %q in namespace %q",
+				name,
+				promo.Namespace,
+			)
+		}
+		logger.WithField("job", name).Debug("created step Job")
+		return newStatus, newFreight, nil
+	}
+
+	finished, jobErr := getJobResult(job)
+	if !finished {
+		return newStatus, newFreight, nil
+	}
+
+	if step.Git != nil {
+		// The workspace is of no further use once the step's Job has finished
+		claim := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: promo.Namespace,
+				Name:      workspaceClaimName(promo, step),
+			},
+		}
+		if err = j.deleteFn(ctx, claim); err != nil && !apierrors.IsNotFound(err) {
+			return nil, newFreight, errors.Wrapf(
+				err,
+				"error deleting PersistentVolumeClaim %q in namespace %q",
+				claim.Name,
+				claim.Namespace,
+			)
+		}
+	}
+
+	result, err := j.getJobStepResultFn(ctx, job)
+	if err != nil {
+		return nil, newFreight, err
+	}
+	if jobErr != nil || result.Phase == kargoapi.PromotionPhaseFailed {
+		msg := result.Message
+		if msg == "" && jobErr != nil {
+			msg = jobErr.Error()
+		}
+		newStatus.Phase = kargoapi.PromotionPhaseFailed
+		newStatus.Message = fmt.Sprintf("step %q failed: %s", step.Name, msg)
+		return newStatus, newFreight, nil
+	}
+
+	if step.Git != nil && result.HealthCheckCommit != "" {
+		repoURL := libGit.NormalizeGitURL(step.Git.RepoURL)
+		for i, commit := range newFreight.Commits {
+			if libGit.NormalizeGitURL(commit.RepoURL) == repoURL {
+				newFreight.Commits[i].HealthCheckCommit = result.HealthCheckCommit
+				break
+			}
+		}
+	}
+
+	newStatus.Phase = kargoapi.PromotionPhaseSucceeded
+	newStatus.Message = result.Message
+	return newStatus, newFreight, nil
+}
+
+// checkOut ensures the Git repository of the specified PromotionJobStep has
+// been checked out into the step's workspace. It creates the workspace's
+// PersistentVolumeClaim and the Job that performs the checkout if they do not
+// already exist. A nil status is returned once the checkout has succeeded.
+// Otherwise, the returned status describes the checkout's progress or failure.
+func (j *jobStepMechanism) checkOut(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	step kargoapi.PromotionJobStep,
+	newFreight kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("step", step.Name)
+	name := jobName(promo, step.Name, "step", "checkout")
+
+	job, err := j.getJobFn(ctx, promo.Namespace, name)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error getting Job %q in namespace %q",
+			name,
+			promo.Namespace,
+		)
+	}
+
+	newStatus := promo.Status.WithPhase(kargoapi.PromotionPhaseRunning)
+	newStatus.Message = fmt.Sprintf(
+		"waiting for Job %q to check out the repository of step %q",
+		name,
+		step.Name,
+	)
+
+	if job == nil {
+		claim, err := j.buildWorkspaceClaim(promo, step)
+		if err != nil {
+			return nil, err
+		}
+		if err = j.createFn(ctx, claim); err != nil && !apierrors.IsAlreadyExists(err) {
+			return nil, errors.Wrapf(
+				err,
+				"error creating PersistentVolumeClaim %q in namespace %q",
+				claim.Name,
+				claim.Namespace,
+			)
+		}
+		if job, err = j.buildCheckoutJob(ctx, name, promo, step, newFreight); err != nil {
+			return nil, err
+		}
+		if err = j.createFn(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+			return nil, errors.Wrapf(
+				err,
+				"error creating Job %q in namespace %q",
+				name,
+				promo.Namespace,
+			)
+		}
+		logger.WithField("job", name).Debug("created checkout Job")
+		return newStatus, nil
+	}
+
+	finished, jobErr := getJobResult(job)
+	if !finished {
+		return newStatus, nil
+	}
+	if jobErr != nil {
+		newStatus.Phase = kargoapi.PromotionPhaseFailed
+		newStatus.Message = fmt.Sprintf(
+			"step %q failed: error checking out repository %q: %s",
+			step.Name,
+			step.Git.RepoURL,
+			jobErr,
+		)
+		return newStatus, nil
+	}
+	return nil, nil
+}
+
+// workspaceClaimName returns the name of the PersistentVolumeClaim that backs
+// the workspace of the specified PromotionJobStep.
+func workspaceClaimName(
+	promo *kargoapi.Promotion,
+	step kargoapi.PromotionJobStep,
+) string {
+	return jobName(promo, step.Name, "step", "workspace")
+}
+
+// buildWorkspaceClaim returns the PersistentVolumeClaim, owned by the specified
+// Promotion, that backs the workspace of the specified PromotionJobStep.
+func (j *jobStepMechanism) buildWorkspaceClaim(
+	promo *kargoapi.Promotion,
+	step kargoapi.PromotionJobStep,
+) (*corev1.PersistentVolumeClaim, error) {
+	size, err := resource.ParseQuantity(j.workspaceSize)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error parsing workspace size %q",
+			j.workspaceSize,
+		)
+	}
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            workspaceClaimName(promo, step),
+			Namespace:       promo.Namespace,
+			OwnerReferences: promotionOwnerReferences(promo),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: size,
+				},
+			},
+		},
+	}, nil
+}
+
+// buildCheckoutJob returns the Job that checks out the Git repository of the
+// specified PromotionJobStep into the step's workspace. If the Promotion's
+// namespace contains a Secret with credentials for the repository, the Job
+// references it. The Job runs only Kargo's own Git image and never mounts a
+// ServiceAccount token. Credentials from any other namespace, including global
+// credentials namespaces, are never exposed to the Job.
+func (j *jobStepMechanism) buildCheckoutJob(
+	ctx context.Context,
+	name string,
+	promo *kargoapi.Promotion,
+	step kargoapi.PromotionJobStep,
+	newFreight kargoapi.FreightReference,
+) (*batchv1.Job, error) {
+	ref := step.Git.Branch
+	repoURL := libGit.NormalizeGitURL(step.Git.RepoURL)
+	for _, commit := range newFreight.Commits {
+		if libGit.NormalizeGitURL(commit.RepoURL) == repoURL {
+			ref = commit.ID
+			break
+		}
+	}
+	env := []corev1.EnvVar{
+		{Name: "HOME", Value: "/tmp"},
+		{Name: "KARGO_GIT_REPO_URL", Value: step.Git.RepoURL},
+		{Name: "KARGO_GIT_REF", Value: ref},
+		{Name: "KARGO_REPO_DIR", Value: jobRepoDir},
+	}
+
+	secret, err :=
+		j.getCredentialsSecretFn(ctx, promo.Namespace, step.Git.RepoURL)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error obtaining credentials for git repo %q",
+			step.Git.RepoURL,
+		)
+	}
+	if secret != nil {
+		env = append(
+			env,
+			secretKeyEnvVar("GIT_USERNAME", secret.Name, "username"),
+			secretKeyEnvVar("GIT_PASSWORD", secret.Name, "password"),
+		)
+	}
+
+	backoffLimit := int32(0)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       promo.Namespace,
+			OwnerReferences: promotionOwnerReferences(promo),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:                corev1.RestartPolicyNever,
+					AutomountServiceAccountToken: ptr.To(false),
+					Volumes:                      []corev1.Volume{workspaceClaimVolume(promo, step)},
+					Containers: []corev1.Container{{
+						Name:    jobGitContainerName,
+						Image:   j.gitImage,
+						Command: []string{"/bin/sh", "-c", jobGitCheckoutScript},
+						Env:     env,
+						VolumeMounts: []corev1.VolumeMount{{
+							Name:      jobWorkspaceVolumeName,
+							MountPath: jobWorkspaceDir,
+						}},
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
+// workspaceClaimVolume returns a volume backed by the PersistentVolumeClaim
+// of the specified PromotionJobStep's workspace.
+func workspaceClaimVolume(
+	promo *kargoapi.Promotion,
+	step kargoapi.PromotionJobStep,
+) corev1.Volume {
+	return corev1.Volume{
+		Name: jobWorkspaceVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: workspaceClaimName(promo, step),
+			},
+		},
+	}
+}
+
+// buildStepJob returns the Job for the specified PromotionJobStep. The Job's
+// pod mounts the step's workspace. If the step checks out a Git repository,
+// the workspace is the PersistentVolumeClaim into which the repository was
+// already checked out by a separate Job. Otherwise, it is an empty volume. The
+// step's Job never references any credentials.
+func (j *jobStepMechanism) buildStepJob(
+	name string,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	step kargoapi.PromotionJobStep,
+	newFreight kargoapi.FreightReference,
+) (*batchv1.Job, error) {
+	job, err := buildJob(
+		name,
+		step.Job,
+		templateData{
+			Promotion: promo,
+			Stage:     stage,
+			Freight:   newFreight,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building Job for step %q", step.Name)
+	}
+
+	podSpec := &job.Spec.Template.Spec
+	podSpec.Volumes = []corev1.Volume{{
+		Name: jobWorkspaceVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}}
+	container := &podSpec.Containers[0]
+	container.WorkingDir = jobWorkspaceDir
+	container.VolumeMounts = []corev1.VolumeMount{{
+		Name:      jobWorkspaceVolumeName,
+		MountPath: jobWorkspaceDir,
+	}}
+	container.Env = append(
+		container.Env,
+		corev1.EnvVar{Name: "KARGO_WORKSPACE", Value: jobWorkspaceDir},
+		corev1.EnvVar{Name: "KARGO_RESULT_FILE", Value: jobResultFile},
+	)
+
+	if step.Git != nil {
+		podSpec.Volumes = []corev1.Volume{workspaceClaimVolume(promo, step)}
+		container.WorkingDir = jobRepoDir
+		container.Env = append(
+			container.Env,
+			corev1.EnvVar{Name: "KARGO_REPO_DIR", Value: jobRepoDir},
+		)
+	}
+
+	return job, nil
+}
+
+func secretKeyEnvVar(name, secretName, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secretName,
+				},
+				Key: key,
+				// Credentials Secrets need not define every key
+				Optional: ptr.To(true),
+			},
+		},
+	}
+}
+
+// getCredentialsSecretFn returns a function that closes over the provided
+// client and, when invoked, uses that client to find the Secret, if any, in the
+// specified namespace that holds credentials for the specified Git repository.
+func getCredentialsSecretFn(
+	kargoClient client.Client,
+) func(context.Context, string, string) (*corev1.Secret, error) {
+	return func(
+		ctx context.Context,
+		namespace string,
+		repoURL string,
+	) (*corev1.Secret, error) {
+		return credentials.GetCredentialsSecret(
+			ctx,
+			kargoClient,
+			namespace,
+			credentials.TypeGit,
+			repoURL,
+		)
+	}
+}
+
+// getJobStepResultFn returns a function that closes over the provided client
+// and, when invoked, uses that client to find the pod of a finished Job and
+// parse the result document written by its container. If no such document was
+// written, an empty result is returned.
+func getJobStepResultFn(
+	c client.Reader,
+) func(context.Context, *batchv1.Job) (*jobStepResult, error) {
+	return func(ctx context.Context, job *batchv1.Job) (*jobStepResult, error) {
+		pods := &corev1.PodList{}
+		if err := c.List(
+			ctx,
+			pods,
+			client.InNamespace(job.Namespace),
+			client.MatchingLabels{batchv1.JobNameLabel: job.Name},
+		); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error listing pods for Job %q in namespace %q",
+				job.Name,
+				job.Namespace,
+			)
+		}
+		result := &jobStepResult{}
+		for _, pod := range pods.Items {
+			for _, status := range pod.Status.ContainerStatuses {
+				if status.Name != jobContainerName || status.State.Terminated == nil {
+					continue
+				}
+				msg := strings.TrimSpace(status.State.Terminated.Message)
+				if msg == "" {
+					return result, nil
+				}
+				if err := json.Unmarshal([]byte(msg), result); err != nil {
+					// Report unstructured results as-is
+					return &jobStepResult{Message: msg}, nil
+				}
+				return result, nil
+			}
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,850 @@
+package promotion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewJobStepMechanism(t *testing.T) {
+	pm := newJobStepMechanism(
+		fake.NewClientBuilder().Build(),
+		"fake-image",
+		"1Gi",
+	)
+	jpm, ok := pm.(*jobStepMechanism)
+	require.True(t, ok)
+	require.Equal(t, "fake-image", jpm.gitImage)
+	require.Equal(t, "1Gi", jpm.workspaceSize)
+	require.NotNil(t, jpm.doSingleStepFn)
+	require.NotNil(t, jpm.getJobFn)
+	require.NotNil(t, jpm.createFn)
+	require.NotNil(t, jpm.deleteFn)
+	require.NotNil(t, jpm.getCredentialsSecretFn)
+	require.NotNil(t, jpm.getJobStepResultFn)
+}
+
+func TestJobStepMechanismPromote(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMech  *jobStepMechanism
+		stage      *kargoapi.Stage
+		assertions func(*kargoapi.PromotionStatus, error)
+	}{
+		{
+			name:      "no steps",
+			promoMech: &jobStepMechanism{},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "error executing step",
+			promoMech: &jobStepMechanism{
+				doSingleStepFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					_ *kargoapi.Promotion,
+					_ kargoapi.PromotionJobStep,
+					freight kargoapi.FreightReference,
+				) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+					return nil, freight, errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						JobSteps: []kargoapi.PromotionJobStep{{Name: "foo"}},
+					},
+				},
+			},
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "running step blocks subsequent steps",
+			promoMech: &jobStepMechanism{
+				doSingleStepFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					_ *kargoapi.Promotion,
+					step kargoapi.PromotionJobStep,
+					freight kargoapi.FreightReference,
+				) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+					require.Equal(t, "foo", step.Name)
+					return &kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhaseRunning,
+					}, freight, nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						JobSteps: []kargoapi.PromotionJobStep{
+							{Name: "foo"},
+							{Name: "bar"},
+						},
+					},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+			},
+		},
+		{
+			name: "all steps succeed",
+			promoMech: &jobStepMechanism{
+				doSingleStepFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					_ *kargoapi.Promotion,
+					_ kargoapi.PromotionJobStep,
+					freight kargoapi.FreightReference,
+				) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+					return &kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhaseSucceeded,
+					}, freight, nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						JobSteps: []kargoapi.PromotionJobStep{
+							{Name: "foo"},
+							{Name: "bar"},
+						},
+					},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, _, err := testCase.promoMech.Promote(
+				context.Background(),
+				testCase.stage,
+				&kargoapi.Promotion{},
+				kargoapi.FreightReference{},
+			)
+			testCase.assertions(status, err)
+		})
+	}
+}
+
+func TestJobStepMechanismDoSingleStep(t *testing.T) {
+	const testRepoURL = "https://github.com/akuity/kargo"
+	testPromo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+		},
+	}
+	testStep := kargoapi.PromotionJobStep{
+		Name: "foo",
+		Git: &kargoapi.PromotionJobGitCheckout{
+			RepoURL: testRepoURL,
+		},
+		Job: kargoapi.PromotionJob{
+			Image: "fake-image",
+		},
+	}
+	testFreight := kargoapi.FreightReference{
+		Commits: []kargoapi.GitCommit{{
+			RepoURL: testRepoURL,
+			ID:      "fake-commit",
+		}},
+	}
+	checkoutJobName := jobName(testPromo, testStep.Name, "step", "checkout")
+	completedJob := &batchv1.Job{
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	}
+	failedJob := &batchv1.Job{
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{
+				Type:    batchv1.JobFailed,
+				Status:  corev1.ConditionTrue,
+				Message: "exit code 1",
+			}},
+		},
+	}
+	// getCheckedOutJobFn returns a function that reports the checkout Job as
+	// complete and the step's Job as the specified Job.
+	getCheckedOutJobFn := func(
+		stepJob *batchv1.Job,
+	) func(context.Context, string, string) (*batchv1.Job, error) {
+		return func(_ context.Context, _ string, name string) (*batchv1.Job, error) {
+			if name == checkoutJobName {
+				return completedJob, nil
+			}
+			return stepJob, nil
+		}
+	}
+	noCredentialsFn := func(
+		context.Context,
+		string,
+		string,
+	) (*corev1.Secret, error) {
+		return nil, nil
+	}
+	testCases := []struct {
+		name       string
+		promoMech  *jobStepMechanism
+		assertions func(
+			*kargoapi.PromotionStatus,
+			kargoapi.FreightReference,
+			error,
+		)
+	}{
+		{
+			name: "error getting Job",
+			promoMech: &jobStepMechanism{
+				getJobFn: func(
+					context.Context,
+					string,
+					string,
+				) (*batchv1.Job, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.ErrorContains(t, err, "error getting Job")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error creating workspace",
+			promoMech: &jobStepMechanism{
+				workspaceSize: "1Gi",
+				getJobFn: func(
+					context.Context,
+					string,
+					string,
+				) (*batchv1.Job, error) {
+					return nil, nil
+				},
+				createFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.ErrorContains(t, err, "error creating PersistentVolumeClaim")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error creating checkout Job",
+			promoMech: &jobStepMechanism{
+				workspaceSize: "1Gi",
+				getJobFn: func(
+					context.Context,
+					string,
+					string,
+				) (*batchv1.Job, error) {
+					return nil, nil
+				},
+				getCredentialsSecretFn: noCredentialsFn,
+				createFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					if _, ok := obj.(*batchv1.Job); ok {
+						return errors.New("something went wrong")
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.ErrorContains(t, err, "error creating Job")
+				require.ErrorContains(t, err, checkoutJobName)
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "checkout Job created",
+			promoMech: &jobStepMechanism{
+				workspaceSize: "1Gi",
+				getJobFn: func(
+					context.Context,
+					string,
+					string,
+				) (*batchv1.Job, error) {
+					return nil, nil
+				},
+				getCredentialsSecretFn: noCredentialsFn,
+				createFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Contains(t, status.Message, "to check out the repository")
+			},
+		},
+		{
+			name: "checkout Job still running",
+			promoMech: &jobStepMechanism{
+				getJobFn: func(
+					_ context.Context,
+					_ string,
+					name string,
+				) (*batchv1.Job, error) {
+					if name == checkoutJobName {
+						return &batchv1.Job{}, nil
+					}
+					return nil, nil
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Contains(t, status.Message, "to check out the repository")
+			},
+		},
+		{
+			name: "checkout Job failed",
+			promoMech: &jobStepMechanism{
+				getJobFn: func(
+					_ context.Context,
+					_ string,
+					name string,
+				) (*batchv1.Job, error) {
+					if name == checkoutJobName {
+						return failedJob, nil
+					}
+					return nil, nil
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Contains(t, status.Message, "error checking out repository")
+				require.Contains(t, status.Message, "exit code 1")
+			},
+		},
+		{
+			name: "error creating Job",
+			promoMech: &jobStepMechanism{
+				getJobFn: getCheckedOutJobFn(nil),
+				createFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.ErrorContains(t, err, "error creating Job")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "Job created",
+			promoMech: &jobStepMechanism{
+				getJobFn: getCheckedOutJobFn(nil),
+				createFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					job, ok := obj.(*batchv1.Job)
+					require.True(t, ok)
+					require.Equal(t, "fake-image", job.Spec.Template.Spec.Containers[0].Image)
+					return nil
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Contains(t, status.Message, "waiting for Job")
+				require.Contains(t, status.Message, "to complete")
+			},
+		},
+		{
+			name: "Job still running",
+			promoMech: &jobStepMechanism{
+				getJobFn: getCheckedOutJobFn(&batchv1.Job{}),
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+			},
+		},
+		{
+			name: "error deleting workspace",
+			promoMech: &jobStepMechanism{
+				getJobFn: getCheckedOutJobFn(completedJob),
+				deleteFn: func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.ErrorContains(t, err, "error deleting PersistentVolumeClaim")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "Job failed",
+			promoMech: &jobStepMechanism{
+				getJobFn: getCheckedOutJobFn(failedJob),
+				deleteFn: func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					return nil
+				},
+				getJobStepResultFn: func(
+					context.Context,
+					*batchv1.Job,
+				) (*jobStepResult, error) {
+					return &jobStepResult{}, nil
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Contains(t, status.Message, "exit code 1")
+			},
+		},
+		{
+			name: "result reports failure",
+			promoMech: &jobStepMechanism{
+				getJobFn: getCheckedOutJobFn(completedJob),
+				deleteFn: func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					return nil
+				},
+				getJobStepResultFn: func(
+					context.Context,
+					*batchv1.Job,
+				) (*jobStepResult, error) {
+					return &jobStepResult{
+						Phase:   kargoapi.PromotionPhaseFailed,
+						Message: "nope",
+					}, nil
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Equal(t, `step "foo" failed: nope`, status.Message)
+			},
+		},
+		{
+			name: "success",
+			promoMech: &jobStepMechanism{
+				getJobFn: getCheckedOutJobFn(completedJob),
+				deleteFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.DeleteOption,
+				) error {
+					require.IsType(t, &corev1.PersistentVolumeClaim{}, obj)
+					require.Equal(
+						t,
+						workspaceClaimName(testPromo, testStep),
+						obj.GetName(),
+					)
+					// The workspace having already been deleted is not an error
+					return apierrors.NewNotFound(schema.GroupResource{}, obj.GetName())
+				},
+				getJobStepResultFn: func(
+					context.Context,
+					*batchv1.Job,
+				) (*jobStepResult, error) {
+					return &jobStepResult{
+						Message:           "done",
+						HealthCheckCommit: "new-commit",
+					}, nil
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				freight kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(t, "done", status.Message)
+				require.Equal(t, "new-commit", freight.Commits[0].HealthCheckCommit)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.promoMech.doSingleStep(
+					context.Background(),
+					&kargoapi.Stage{},
+					testPromo,
+					testStep,
+					*testFreight.DeepCopy(),
+				),
+			)
+		})
+	}
+}
+
+func TestJobStepMechanismDoSingleStepWithoutGit(t *testing.T) {
+	completedJob := &batchv1.Job{
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	}
+	j := &jobStepMechanism{
+		getJobFn: func(
+			context.Context,
+			string,
+			string,
+		) (*batchv1.Job, error) {
+			return completedJob, nil
+		},
+		getJobStepResultFn: func(
+			context.Context,
+			*batchv1.Job,
+		) (*jobStepResult, error) {
+			// Ignored, as the step checked out no repository
+			return &jobStepResult{HealthCheckCommit: "new-commit"}, nil
+		},
+	}
+	status, freight, err := j.doSingleStep(
+		context.Background(),
+		&kargoapi.Stage{},
+		&kargoapi.Promotion{},
+		kargoapi.PromotionJobStep{Name: "foo"},
+		kargoapi.FreightReference{
+			Commits: []kargoapi.GitCommit{{ID: "fake-commit"}},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+	require.Empty(t, freight.Commits[0].HealthCheckCommit)
+}
+
+func TestBuildWorkspaceClaim(t *testing.T) {
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+		},
+	}
+	step := kargoapi.PromotionJobStep{Name: "foo"}
+
+	j := &jobStepMechanism{workspaceSize: "2Gi"}
+	claim, err := j.buildWorkspaceClaim(promo, step)
+	require.NoError(t, err)
+	require.Equal(t, "fake-namespace", claim.Namespace)
+	require.Equal(t, workspaceClaimName(promo, step), claim.Name)
+	require.Len(t, claim.OwnerReferences, 1)
+	require.Equal(t, "fake-promo", claim.OwnerReferences[0].Name)
+	require.Equal(
+		t,
+		resource.MustParse("2Gi"),
+		claim.Spec.Resources.Requests[corev1.ResourceStorage],
+	)
+
+	j.workspaceSize = "bogus"
+	_, err = j.buildWorkspaceClaim(promo, step)
+	require.ErrorContains(t, err, "error parsing workspace size")
+}
+
+func TestBuildCheckoutJob(t *testing.T) {
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+		},
+	}
+	step := kargoapi.PromotionJobStep{
+		Name: "foo",
+		Git: &kargoapi.PromotionJobGitCheckout{
+			RepoURL: "https://github.com/akuity/kargo",
+			Branch:  "main",
+		},
+		Job: kargoapi.PromotionJob{
+			Image:              "fake-image",
+			ServiceAccountName: "fake-sa",
+		},
+	}
+	j := &jobStepMechanism{
+		gitImage: "fake-git-image",
+		getCredentialsSecretFn: func(
+			_ context.Context,
+			namespace string,
+			repoURL string,
+		) (*corev1.Secret, error) {
+			require.Equal(t, "fake-namespace", namespace)
+			require.Equal(t, "https://github.com/akuity/kargo", repoURL)
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "fake-secret",
+				},
+			}, nil
+		},
+	}
+	job, err := j.buildCheckoutJob(
+		context.Background(),
+		"fake-job",
+		promo,
+		step,
+		kargoapi.FreightReference{
+			Commits: []kargoapi.GitCommit{{
+				RepoURL: "https://github.com/akuity/kargo.git",
+				ID:      "fake-commit",
+			}},
+		},
+	)
+	require.NoError(t, err)
+
+	podSpec := job.Spec.Template.Spec
+	// The checkout never runs as the step's ServiceAccount
+	require.Empty(t, podSpec.ServiceAccountName)
+	require.Equal(t, ptr.To(false), podSpec.AutomountServiceAccountToken)
+	require.Equal(t, []corev1.Volume{workspaceClaimVolume(promo, step)}, podSpec.Volumes)
+	require.Len(t, podSpec.Containers, 1)
+	container := podSpec.Containers[0]
+	require.Equal(t, "fake-git-image", container.Image)
+	// The commit referenced by the Freight wins out over the branch
+	require.Contains(
+		t,
+		container.Env,
+		corev1.EnvVar{Name: "KARGO_GIT_REF", Value: "fake-commit"},
+	)
+	require.Contains(
+		t,
+		container.Env,
+		secretKeyEnvVar("GIT_PASSWORD", "fake-secret", "password"),
+	)
+}
+
+func TestBuildStepJob(t *testing.T) {
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+		},
+	}
+	step := kargoapi.PromotionJobStep{
+		Name: "foo",
+		Git: &kargoapi.PromotionJobGitCheckout{
+			RepoURL: "https://github.com/akuity/kargo",
+			Branch:  "main",
+		},
+		Job: kargoapi.PromotionJob{
+			Image: "fake-image",
+		},
+	}
+	j := &jobStepMechanism{}
+	job, err := j.buildStepJob(
+		"fake-job",
+		&kargoapi.Stage{},
+		promo,
+		step,
+		kargoapi.FreightReference{},
+	)
+	require.NoError(t, err)
+
+	podSpec := job.Spec.Template.Spec
+	// Credentials never share a pod with the step's own container
+	require.Empty(t, podSpec.InitContainers)
+	require.Equal(t, []corev1.Volume{workspaceClaimVolume(promo, step)}, podSpec.Volumes)
+	container := podSpec.Containers[0]
+	for _, env := range container.Env {
+		require.Nil(t, env.ValueFrom)
+	}
+	require.Equal(t, jobRepoDir, container.WorkingDir)
+	require.Contains(
+		t,
+		container.Env,
+		corev1.EnvVar{Name: "KARGO_REPO_DIR", Value: jobRepoDir},
+	)
+	require.Contains(
+		t,
+		container.Env,
+		corev1.EnvVar{Name: "KARGO_RESULT_FILE", Value: jobResultFile},
+	)
+
+	// Without a Git repository, the workspace is an empty volume
+	step.Git = nil
+	job, err = j.buildStepJob(
+		"fake-job",
+		&kargoapi.Stage{},
+		promo,
+		step,
+		kargoapi.FreightReference{},
+	)
+	require.NoError(t, err)
+	podSpec = job.Spec.Template.Spec
+	require.Len(t, podSpec.Volumes, 1)
+	require.NotNil(t, podSpec.Volumes[0].EmptyDir)
+	require.Equal(t, jobWorkspaceDir, podSpec.Containers[0].WorkingDir)
+}
+
+func TestGetJobStepResultFn(t *testing.T) {
+	newPod := func(name, msg string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      name,
+				Labels: map[string]string{
+					batchv1.JobNameLabel: name,
+				},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name: jobContainerName,
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							Message: msg,
+						},
+					},
+				}},
+			},
+		}
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newPod("structured", `{"message":"done","healthCheckCommit":"abc"}`),
+		newPod("unstructured", "all good\n"),
+	).Build()
+	getResult := getJobStepResultFn(c)
+
+	testCases := []struct {
+		job      string
+		expected *jobStepResult
+	}{
+		{
+			job: "structured",
+			expected: &jobStepResult{
+				Message:           "done",
+				HealthCheckCommit: "abc",
+			},
+		},
+		{
+			job:      "unstructured",
+			expected: &jobStepResult{Message: "all good"},
+		},
+		{
+			job:      "missing",
+			expected: &jobStepResult{},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.job, func(t *testing.T) {
+			result, err := getResult(
+				context.Background(),
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      testCase.job,
+					},
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, result)
+		})
+	}
+}
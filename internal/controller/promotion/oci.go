@@ -0,0 +1,90 @@
+package promotion
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// ociArtifactUpdater is a helper struct whose sole purpose is to close over
+// several other functions that are used in the implementation of the apply()
+// function.
+type ociArtifactUpdater struct {
+	setStringsInYAMLFileFn func(file string, changes map[string]string) error
+}
+
+// apply writes references to the OCI artifacts in the provided Freight to the
+// YAML files specified by the provided update, in the specified working
+// directory. Updates referencing artifacts that are not in the Freight are
+// ignored.
+func (o *ociArtifactUpdater) apply(
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.FreightReference,
+	workingDir string,
+) ([]string, error) {
+	changesByFile, changeSummary :=
+		buildOCIArtifactChanges(newFreight.OCIArtifacts, update.OCIArtifacts)
+	for file, changes := range changesByFile {
+		if err := o.setStringsInYAMLFileFn(
+			filepath.Join(workingDir, file),
+			changes,
+		); err != nil {
+			return nil, errors.Wrapf(err, "error updating values in file %q", file)
+		}
+	}
+	return changeSummary, nil
+}
+
+// buildOCIArtifactChanges takes a list of OCI artifacts and a list of
+// instructions about changes that should be made to various YAML files and
+// distills them into a map of maps that indexes new values for each YAML file
+// by file name and key.
+func buildOCIArtifactChanges(
+	artifacts []kargoapi.OCIArtifact,
+	updates []kargoapi.OCIArtifactUpdate,
+) (map[string]map[string]string, []string) {
+	artifactsByRepo := make(map[string]kargoapi.OCIArtifact, len(artifacts))
+	for _, artifact := range artifacts {
+		artifactsByRepo[artifact.RepoURL] = artifact
+	}
+	changesByFile := make(map[string]map[string]string, len(updates))
+	changeSummary := make([]string, 0, len(updates))
+	for _, update := range updates {
+		artifact, found := artifactsByRepo[update.RepoURL]
+		if !found {
+			// There's no change to make in this case.
+			continue
+		}
+		var value string
+		var fqRef string // Fully qualified artifact reference
+		switch update.Value {
+		case kargoapi.OCIArtifactUpdateValueTypeRepoAndTag:
+			fqRef = fmt.Sprintf("%s:%s", artifact.RepoURL, artifact.Tag)
+			value = fqRef
+		case kargoapi.OCIArtifactUpdateValueTypeTag:
+			fqRef = fmt.Sprintf("%s:%s", artifact.RepoURL, artifact.Tag)
+			value = "'" + artifact.Tag + "'"
+		case kargoapi.OCIArtifactUpdateValueTypeRepoAndDigest:
+			fqRef = fmt.Sprintf("%s@%s", artifact.RepoURL, artifact.Digest)
+			value = fqRef
+		case kargoapi.OCIArtifactUpdateValueTypeDigest:
+			fqRef = fmt.Sprintf("%s@%s", artifact.RepoURL, artifact.Digest)
+			value = artifact.Digest
+		default:
+			// This really shouldn't happen, so we'll ignore it.
+			continue
+		}
+		if _, found = changesByFile[update.Path]; !found {
+			changesByFile[update.Path] = map[string]string{}
+		}
+		changesByFile[update.Path][update.Key] = value
+		changeSummary = append(
+			changeSummary,
+			fmt.Sprintf("updated %s to use artifact %s", update.Path, fqRef),
+		)
+	}
+	return changesByFile, changeSummary
+}
@@ -0,0 +1,172 @@
+package promotion
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestOCIArtifactUpdaterApply(t *testing.T) {
+	const testWorkingDir = "fake-working-dir"
+	testUpdate := kargoapi.GitRepoUpdate{
+		OCIArtifacts: []kargoapi.OCIArtifactUpdate{
+			{
+				RepoURL: "fake-url",
+				Path:    "fake-file.yaml",
+				Key:     "fake-key",
+				Value:   kargoapi.OCIArtifactUpdateValueTypeRepoAndTag,
+			},
+		},
+	}
+	testFreight := kargoapi.FreightReference{
+		OCIArtifacts: []kargoapi.OCIArtifact{
+			{
+				RepoURL: "fake-url",
+				Tag:     "fake-tag",
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		updater    *ociArtifactUpdater
+		assertions func(changes []string, err error)
+	}{
+		{
+			name: "error updating file",
+			updater: &ociArtifactUpdater{
+				setStringsInYAMLFileFn: func(string, map[string]string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error updating values in file")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			updater: &ociArtifactUpdater{
+				setStringsInYAMLFileFn: func(
+					file string,
+					changes map[string]string,
+				) error {
+					require.Equal(
+						t,
+						filepath.Join(testWorkingDir, "fake-file.yaml"),
+						file,
+					)
+					require.Equal(
+						t,
+						map[string]string{"fake-key": "fake-url:fake-tag"},
+						changes,
+					)
+					return nil
+				},
+			},
+			assertions: func(changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"updated fake-file.yaml to use artifact fake-url:fake-tag",
+					},
+					changes,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.updater.apply(testUpdate, testFreight, testWorkingDir),
+			)
+		})
+	}
+}
+
+func TestBuildOCIArtifactChanges(t *testing.T) {
+	artifacts := []kargoapi.OCIArtifact{
+		{
+			RepoURL: "fake-url",
+			Tag:     "fake-tag",
+			Digest:  "fake-digest",
+		},
+		{
+			RepoURL: "second-fake-url",
+			Tag:     "second-fake-tag",
+			Digest:  "second-fake-digest",
+		},
+		{
+			RepoURL: "third-fake-url",
+			Tag:     "third-fake-tag",
+			Digest:  "third-fake-digest",
+		},
+		{
+			RepoURL: "fourth-fake-url",
+			Tag:     "fourth-fake-tag",
+			Digest:  "fourth-fake-digest",
+		},
+	}
+	updates := []kargoapi.OCIArtifactUpdate{
+		{
+			RepoURL: "fake-url",
+			Path:    "fake-file.yaml",
+			Key:     "fake-key",
+			Value:   kargoapi.OCIArtifactUpdateValueTypeRepoAndTag,
+		},
+		{
+			RepoURL: "second-fake-url",
+			Path:    "fake-file.yaml",
+			Key:     "second-fake-key",
+			Value:   kargoapi.OCIArtifactUpdateValueTypeTag,
+		},
+		{
+			RepoURL: "third-fake-url",
+			Path:    "another-fake-file.yaml",
+			Key:     "third-fake-key",
+			Value:   kargoapi.OCIArtifactUpdateValueTypeRepoAndDigest,
+		},
+		{
+			RepoURL: "fourth-fake-url",
+			Path:    "another-fake-file.yaml",
+			Key:     "fourth-fake-key",
+			Value:   kargoapi.OCIArtifactUpdateValueTypeDigest,
+		},
+		{
+			RepoURL: "artifact-that-is-not-in-list",
+			Path:    "yet-another-fake-file.yaml",
+			Key:     "fake-key",
+			Value:   kargoapi.OCIArtifactUpdateValueTypeTag,
+		},
+	}
+	result, changeSummary := buildOCIArtifactChanges(artifacts, updates)
+	require.Equal(
+		t,
+		map[string]map[string]string{
+			"fake-file.yaml": {
+				"fake-key":        "fake-url:fake-tag",
+				"second-fake-key": "'second-fake-tag'",
+			},
+			"another-fake-file.yaml": {
+				"third-fake-key":  "third-fake-url@third-fake-digest",
+				"fourth-fake-key": "fourth-fake-digest",
+			},
+		},
+		result,
+	)
+	require.Equal(
+		t,
+		[]string{
+			"updated fake-file.yaml to use artifact fake-url:fake-tag",
+			"updated fake-file.yaml to use artifact second-fake-url:second-fake-tag",
+			"updated another-fake-file.yaml to use artifact third-fake-url@third-fake-digest",
+			"updated another-fake-file.yaml to use artifact fourth-fake-url@fourth-fake-digest",
+		},
+		changeSummary,
+	)
+}
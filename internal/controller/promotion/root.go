@@ -27,8 +27,11 @@ type Mechanism interface {
 // NewMechanisms returns the entrypoint to a hierarchical tree of promotion
 // mechanisms.
 func NewMechanisms(
+	kargoClient client.Client,
 	argocdClient client.Client,
 	credentialsDB credentials.Database,
+	jobStepGitImage string,
+	jobStepWorkspaceSize string,
 ) Mechanism {
 	return newCompositeMechanism(
 		"promotion mechanisms",
@@ -39,6 +42,11 @@ func NewMechanisms(
 			newKustomizeMechanism(credentialsDB),
 			newHelmMechanism(credentialsDB),
 		),
+		newJobStepMechanism(
+			kargoClient,
+			jobStepGitImage,
+			jobStepWorkspaceSize,
+		),
 		newArgoCDMechanism(argocdClient),
 	)
 }
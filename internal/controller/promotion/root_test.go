@@ -13,8 +13,11 @@ import (
 
 func TestNewMechanisms(t *testing.T) {
 	promoMechs := NewMechanisms(
+		fake.NewClientBuilder().Build(),
 		fake.NewClientBuilder().Build(),
 		credentials.NewKubernetesDatabase(nil, credentials.KubernetesDatabaseConfig{}),
+		"fake-image",
+		"1Gi",
 	)
 	require.IsType(t, &compositeMechanism{}, promoMechs)
 }
@@ -0,0 +1,40 @@
+package promotion
+
+import (
+	"bytes"
+	"encoding/json"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// templateData is the data made available to user-defined templates, such as
+// the body of an HTTP hook or the arguments of a Job.
+type templateData struct {
+	Promotion *kargoapi.Promotion
+	Stage     *kargoapi.Stage
+	Freight   kargoapi.FreightReference
+}
+
+var templateFuncs = template.FuncMap{
+	"toJson": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// renderTemplate parses the provided text as a Go template and executes it
+// using the provided data.
+func renderTemplate(name, text string, data templateData) (string, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", errors.Wrapf(err, "error parsing template %q", name)
+	}
+	buf := &bytes.Buffer{}
+	if err = tmpl.Execute(buf, data); err != nil {
+		return "", errors.Wrapf(err, "error executing template %q", name)
+	}
+	return buf.String(), nil
+}
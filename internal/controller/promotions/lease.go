@@ -0,0 +1,187 @@
+package promotions
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// instanceLeaseDuration is how long a controller instance's Lease remains
+	// valid after it was last renewed. Once it has expired, the instance is
+	// considered gone and the Running Promotions attributed to it may be
+	// resumed by another instance.
+	instanceLeaseDuration = 30 * time.Second
+	// instanceLeaseRenewInterval is how often a controller instance renews its
+	// Lease. It must be comfortably shorter than instanceLeaseDuration.
+	instanceLeaseRenewInterval = 10 * time.Second
+)
+
+// instanceLease maintains a Lease that attests to a controller instance being
+// alive and can determine whether the Lease of any other instance has expired.
+// It implements the manager.Runnable interface.
+type instanceLease struct {
+	kargoClient client.Client
+	// apiReader is used for reading Leases so that doing so does not require
+	// a cache of all Leases.
+	apiReader  client.Reader
+	namespace  string
+	instanceID string
+
+	mu        sync.RWMutex
+	renewedAt time.Time
+
+	nowFn func() time.Time
+}
+
+func newInstanceLease(
+	kargoClient client.Client,
+	apiReader client.Reader,
+	namespace string,
+	instanceID string,
+) *instanceLease {
+	return &instanceLease{
+		kargoClient: kargoClient,
+		apiReader:   apiReader,
+		namespace:   namespace,
+		instanceID:  instanceID,
+		nowFn:       time.Now,
+	}
+}
+
+// instanceLeaseName returns the name of the Lease of the controller instance
+// with the specified ID.
+func instanceLeaseName(instanceID string) string {
+	return "kargo-controller-" + instanceID
+}
+
+// Start renews the Lease at regular intervals until the provided context is
+// canceled. Failures to renew are logged; if they persist, the Lease expires
+// and isHeld begins to return false.
+func (l *instanceLease) Start(ctx context.Context) error {
+	logger := logging.LoggerFromContext(ctx).WithField("instance", l.instanceID)
+	ticker := time.NewTicker(instanceLeaseRenewInterval)
+	defer ticker.Stop()
+	for {
+		if err := l.renew(ctx); err != nil {
+			logger.Errorf("error renewing controller instance Lease: %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements the manager.LeaderElectionRunnable interface.
+// Every controller instance maintains its own Lease.
+func (*instanceLease) NeedLeaderElection() bool {
+	return false
+}
+
+// renew creates or renews the Lease.
+func (l *instanceLease) renew(ctx context.Context) error {
+	now := l.nowFn()
+	lease := &coordinationv1.Lease{}
+	err := l.apiReader.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: l.namespace,
+			Name:      instanceLeaseName(l.instanceID),
+		},
+		lease,
+	)
+	switch {
+	case apierrors.IsNotFound(err):
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: l.namespace,
+				Name:      instanceLeaseName(l.instanceID),
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(l.instanceID),
+				LeaseDurationSeconds: ptr.To(int32(instanceLeaseDuration.Seconds())),
+				AcquireTime:          ptr.To(metav1.NewMicroTime(now)),
+				RenewTime:            ptr.To(metav1.NewMicroTime(now)),
+			},
+		}
+		err = l.kargoClient.Create(ctx, lease)
+	case err == nil:
+		lease.Spec.HolderIdentity = ptr.To(l.instanceID)
+		lease.Spec.LeaseDurationSeconds =
+			ptr.To(int32(instanceLeaseDuration.Seconds()))
+		lease.Spec.RenewTime = ptr.To(metav1.NewMicroTime(now))
+		err = l.kargoClient.Update(ctx, lease)
+	}
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error renewing Lease %q in namespace %q",
+			instanceLeaseName(l.instanceID),
+			l.namespace,
+		)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.renewedAt = now
+	return nil
+}
+
+// isHeld returns true if this controller instance's Lease was renewed recently
+// enough that no other instance will consider it expired. It returns false
+// otherwise.
+func (l *instanceLease) isHeld() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	// Leave a margin so this instance stops work before others may take over.
+	return l.nowFn().Before(
+		l.renewedAt.Add(instanceLeaseDuration - instanceLeaseRenewInterval),
+	)
+}
+
+// isExpired returns true if the Lease of the controller instance with the
+// specified ID does not exist or has not been renewed within its duration. It
+// returns false otherwise.
+func (l *instanceLease) isExpired(
+	ctx context.Context,
+	instanceID string,
+) (bool, error) {
+	if instanceID == "" {
+		return true, nil
+	}
+	lease := &coordinationv1.Lease{}
+	if err := l.apiReader.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: l.namespace,
+			Name:      instanceLeaseName(instanceID),
+		},
+		lease,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, errors.Wrapf(
+			err,
+			"error getting Lease %q in namespace %q",
+			instanceLeaseName(instanceID),
+			l.namespace,
+		)
+	}
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true, nil
+	}
+	duration := time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	return l.nowFn().After(lease.Spec.RenewTime.Add(duration)), nil
+}
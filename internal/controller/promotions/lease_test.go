@@ -0,0 +1,129 @@
+package promotions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newFakeInstanceLease(
+	t *testing.T,
+	now time.Time,
+	objects ...client.Object,
+) *instanceLease {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, coordinationv1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	l := newInstanceLease(c, c, "fake-namespace", "fake-instance")
+	l.nowFn = func() time.Time { return now }
+	return l
+}
+
+func TestInstanceLeaseRenew(t *testing.T) {
+	now := time.Now().Truncate(time.Microsecond)
+	l := newFakeInstanceLease(t, now)
+	require.False(t, l.isHeld())
+
+	// The first renewal creates the Lease
+	require.NoError(t, l.renew(context.Background()))
+	require.True(t, l.isHeld())
+	lease := &coordinationv1.Lease{}
+	require.NoError(
+		t,
+		l.kargoClient.Get(
+			context.Background(),
+			types.NamespacedName{
+				Namespace: "fake-namespace",
+				Name:      "kargo-controller-fake-instance",
+			},
+			lease,
+		),
+	)
+	require.Equal(t, "fake-instance", *lease.Spec.HolderIdentity)
+	require.True(t, lease.Spec.RenewTime.Time.Equal(now))
+
+	// Subsequent renewals update it
+	later := now.Add(instanceLeaseRenewInterval)
+	l.nowFn = func() time.Time { return later }
+	require.NoError(t, l.renew(context.Background()))
+	require.NoError(
+		t,
+		l.kargoClient.Get(
+			context.Background(),
+			client.ObjectKeyFromObject(lease),
+			lease,
+		),
+	)
+	require.True(t, lease.Spec.RenewTime.Time.Equal(later))
+
+	// The Lease is no longer considered held once renewals have stopped for
+	// long enough that another instance might soon consider it expired
+	l.nowFn = func() time.Time {
+		return later.Add(instanceLeaseDuration - instanceLeaseRenewInterval)
+	}
+	require.False(t, l.isHeld())
+}
+
+func TestInstanceLeaseIsExpired(t *testing.T) {
+	now := time.Now()
+	newLease := func(name string, renewedAt time.Time) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      instanceLeaseName(name),
+			},
+			Spec: coordinationv1.LeaseSpec{
+				LeaseDurationSeconds: ptr.To(int32(30)),
+				RenewTime:            ptr.To(metav1.NewMicroTime(renewedAt)),
+			},
+		}
+	}
+	l := newFakeInstanceLease(
+		t,
+		now,
+		newLease("live-instance", now.Add(-10*time.Second)),
+		newLease("dead-instance", now.Add(-time.Minute)),
+	)
+	testCases := []struct {
+		name       string
+		instanceID string
+		expired    bool
+	}{
+		{
+			name:       "no instance",
+			instanceID: "",
+			expired:    true,
+		},
+		{
+			name:       "no Lease",
+			instanceID: "unknown-instance",
+			expired:    true,
+		},
+		{
+			name:       "Lease expired",
+			instanceID: "dead-instance",
+			expired:    true,
+		},
+		{
+			name:       "Lease current",
+			instanceID: "live-instance",
+			expired:    false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			expired, err := l.isExpired(context.Background(), testCase.instanceID)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expired, expired)
+		})
+	}
+}
@@ -2,7 +2,9 @@ package promotions
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/types"
@@ -19,10 +21,17 @@ type promoQueues struct {
 	// activePromoByStage holds the active promotion for a given stage (if any)
 	activePromoByStage map[types.NamespacedName]string
 	// pendingPromoQueuesByStage holds a priority queue of promotions, per Stage. We allow one
-	// promotion to run at a time, ordered by creationTimestamp.
+	// promotion to run at a time, ordered by explicit priority and then by the earliest time
+	// each may begin.
 	pendingPromoQueuesByStage map[types.NamespacedName]runtime.PriorityQueue
 	// promoQueuesByStageMu protects access to the above maps
 	promoQueuesByStageMu sync.RWMutex
+	// maxActive is the maximum number of promotions that may be active at once,
+	// across all Stages. Zero means unlimited.
+	maxActive int
+	// maxActivePerProject is the maximum number of promotions that may be active
+	// at once within a single Project (namespace). Zero means unlimited.
+	maxActivePerProject int
 }
 
 func newPriorityQueue() runtime.PriorityQueue {
@@ -30,17 +39,47 @@ func newPriorityQueue() runtime.PriorityQueue {
 	// involves initializing the queue with a nil priority function, which we
 	// know we aren't doing.
 	pq, _ := runtime.NewPriorityQueue(func(left, right client.Object) bool {
-		if left.GetCreationTimestamp().Time.Equal(
-			right.GetCreationTimestamp().Time,
-		) {
+		leftPriority, rightPriority := promotionPriority(left), promotionPriority(right)
+		if leftPriority != rightPriority {
+			return leftPriority > rightPriority
+		}
+		leftStart, rightStart := earliestStartTime(left), earliestStartTime(right)
+		if leftStart.Equal(rightStart) {
 			return left.GetName() < right.GetName()
 		}
-		return left.GetCreationTimestamp().Time.
-			Before(right.GetCreationTimestamp().Time)
+		return leftStart.Before(rightStart)
 	})
 	return pq
 }
 
+// promotionPriority returns the explicit priority of the specified Promotion.
+// Higher values indicate higher priority.
+func promotionPriority(obj client.Object) int32 {
+	if promo, ok := obj.(*kargoapi.Promotion); ok && promo.Spec != nil {
+		return promo.Spec.Priority
+	}
+	return 0
+}
+
+// earliestStartTime returns the earliest time at which the specified
+// Promotion may begin. This is the Promotion's creation time unless it was
+// scheduled to begin later than that.
+func earliestStartTime(obj client.Object) time.Time {
+	start := obj.GetCreationTimestamp().Time
+	if promo, ok := obj.(*kargoapi.Promotion); ok && promo.Spec != nil &&
+		promo.Spec.NotBefore != nil && promo.Spec.NotBefore.After(start) {
+		return promo.Spec.NotBefore.Time
+	}
+	return start
+}
+
+// isScheduledForLater returns true if the specified Promotion must not begin
+// before a time that has not yet arrived.
+func isScheduledForLater(promo *kargoapi.Promotion) bool {
+	return promo.Spec != nil && promo.Spec.NotBefore != nil &&
+		time.Now().Before(promo.Spec.NotBefore.Time)
+}
+
 // initializeQueues adds the promotion list to relevant priority queues.
 // This is intended to be invoked ONCE and the caller MUST ensure that.
 func (pqs *promoQueues) initializeQueues(ctx context.Context, promos kargoapi.PromotionList) {
@@ -67,6 +106,11 @@ func (pqs *promoQueues) initializeQueues(ctx context.Context, promos kargoapi.Pr
 			}
 			continue
 		}
+		if isScheduledForLater(&promo) {
+			// Scheduled Promotions are not queued until they are due. They will be
+			// pushed onto the queue when they are next reconciled.
+			continue
+		}
 		pq.Push(&promo)
 		logger.WithFields(log.Fields{
 			"promotion": promo.Name,
@@ -115,6 +159,12 @@ func (pqs *promoQueues) tryBegin(ctx context.Context, promo *kargoapi.Promotion)
 		return true
 	}
 
+	if isScheduledForLater(promo) {
+		// This promo is not due yet. It is not queued until it is so that it does
+		// not block other promos to the same Stage in the meantime.
+		return false
+	}
+
 	// Push this promo to the queue in case it doesn't exist in the queue. Note that we
 	// deduplicate pushes on the same object, so this is safe to call repeatedly
 	if pq.Push(promo) {
@@ -126,6 +176,12 @@ func (pqs *promoQueues) tryBegin(ctx context.Context, promo *kargoapi.Promotion)
 		// NOTE: first will never be empty because of the push call above
 		first := pq.Peek()
 		if first.GetNamespace() == promo.Namespace && first.GetName() == promo.Name {
+			if pqs.atCapacity(promo.Namespace) {
+				// This promo is next in line for its Stage, but too many promos are
+				// already active. It will be enqueued again when a slot frees up.
+				logger.Debug("promo throttled by concurrency limit")
+				return false
+			}
 			// This promo is the first in the queue. Mark it as active and pop it off the pending queue.
 			popped := pq.Pop()
 			pqs.activePromoByStage[stageKey] = popped.GetName()
@@ -136,11 +192,102 @@ func (pqs *promoQueues) tryBegin(ctx context.Context, promo *kargoapi.Promotion)
 	return false
 }
 
+// atCapacity returns true if no further promotions may become active, either
+// overall or within the specified namespace, because of concurrency limits. The
+// caller MUST hold the mutex.
+func (pqs *promoQueues) atCapacity(namespace string) bool {
+	active, activeInNamespace := pqs.countActive(namespace)
+	return (pqs.maxActive > 0 && active >= pqs.maxActive) ||
+		(pqs.maxActivePerProject > 0 && activeInNamespace >= pqs.maxActivePerProject)
+}
+
+// countActive returns the total number of active promotions as well as the
+// number of active promotions in the specified namespace. The caller MUST hold
+// the mutex.
+func (pqs *promoQueues) countActive(namespace string) (int, int) {
+	var active, activeInNamespace int
+	for stageKey, promoName := range pqs.activePromoByStage {
+		if promoName == "" {
+			continue
+		}
+		active++
+		if stageKey.Namespace == namespace {
+			activeInNamespace++
+		}
+	}
+	return active, activeInNamespace
+}
+
+// throttledMessage returns a message explaining why the given promotion cannot
+// begin if that is because of a concurrency limit. Otherwise, it returns an
+// empty string.
+func (pqs *promoQueues) throttledMessage(promo *kargoapi.Promotion) string {
+	pqs.promoQueuesByStageMu.RLock()
+	defer pqs.promoQueuesByStageMu.RUnlock()
+	stageKey := types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      promo.Spec.Stage,
+	}
+	if pqs.activePromoByStage[stageKey] != "" {
+		// Waiting on another promo to the same Stage; not throttled
+		return ""
+	}
+	active, activeInNamespace := pqs.countActive(promo.Namespace)
+	if pqs.maxActivePerProject > 0 && activeInNamespace >= pqs.maxActivePerProject {
+		return fmt.Sprintf(
+			"waiting for one of %d Running Promotions in Project %q to conclude "+
+				"(concurrency limit per Project reached)",
+			activeInNamespace,
+			promo.Namespace,
+		)
+	}
+	if pqs.maxActive > 0 && active >= pqs.maxActive {
+		return fmt.Sprintf(
+			"waiting for one of %d Running Promotions to conclude (concurrency "+
+				"limit for this controller reached)",
+			active,
+		)
+	}
+	return ""
+}
+
+// idleStages returns the keys of all Stages that have pending promotions but no
+// active promotion. If namespace is non-empty, only Stages in that namespace
+// are returned.
+func (pqs *promoQueues) idleStages(namespace string) []types.NamespacedName {
+	pqs.promoQueuesByStageMu.RLock()
+	defer pqs.promoQueuesByStageMu.RUnlock()
+	var stageKeys []types.NamespacedName
+	for stageKey, pq := range pqs.pendingPromoQueuesByStage {
+		if namespace != "" && stageKey.Namespace != namespace {
+			continue
+		}
+		if pqs.activePromoByStage[stageKey] == "" && pq.Depth() > 0 {
+			stageKeys = append(stageKeys, stageKey)
+		}
+	}
+	return stageKeys
+}
+
+// isActive returns true if the given promotion is the active one for its Stage.
+func (pqs *promoQueues) isActive(promo *kargoapi.Promotion) bool {
+	if promo == nil || promo.Spec == nil {
+		return false
+	}
+	stageKey := types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      promo.Spec.Stage,
+	}
+	pqs.promoQueuesByStageMu.RLock()
+	defer pqs.promoQueuesByStageMu.RUnlock()
+	return pqs.activePromoByStage[stageKey] == promo.Name
+}
+
 // conclude removes the given active promotion entry for the given stage key.
 // This should only be called after the active promotion has become terminal.
 func (pqs *promoQueues) conclude(ctx context.Context, stageKey types.NamespacedName, promoName string) {
-	pqs.promoQueuesByStageMu.RLock()
-	defer pqs.promoQueuesByStageMu.RUnlock()
+	pqs.promoQueuesByStageMu.Lock()
+	defer pqs.promoQueuesByStageMu.Unlock()
 	if pqs.activePromoByStage[stageKey] == promoName {
 		logger := logging.LoggerFromContext(ctx).WithFields(log.Fields{
 			"namespace": stageKey.Namespace,
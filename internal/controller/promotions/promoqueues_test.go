@@ -188,3 +188,121 @@ func TestConclude(t *testing.T) {
 	pqs.conclude(ctx, fooStageKey, "a")
 	require.Equal(t, "", pqs.activePromoByStage[fooStageKey])
 }
+
+func TestNewPromotionsQueueScheduled(t *testing.T) {
+	pq := newPriorityQueue()
+	now := time.Now()
+	scheduled := newPromo(testNamespace, "scheduled", "foo", "", metav1.NewTime(now.Add(-time.Hour)))
+	notBefore := metav1.NewTime(now.Add(-time.Minute))
+	scheduled.Spec.NotBefore = &notBefore
+	require.True(t, pq.Push(scheduled))
+	require.True(t, pq.Push(newPromo(testNamespace, "immediate", "foo", "", metav1.NewTime(now.Add(-30*time.Minute)))))
+
+	// The scheduled promo was created first, but may not begin until after the
+	// other promo was created
+	require.Equal(t, "immediate", pq.Pop().GetName())
+	require.Equal(t, "scheduled", pq.Pop().GetName())
+}
+
+func TestNewPromotionsQueuePriority(t *testing.T) {
+	pq := newPriorityQueue()
+	now := time.Now()
+	require.True(t, pq.Push(newPromo(testNamespace, "routine", "foo", "", metav1.NewTime(now.Add(-time.Hour)))))
+	hotfix := newPromo(testNamespace, "hotfix", "foo", "", metav1.NewTime(now))
+	hotfix.Spec.Priority = 100
+	require.True(t, pq.Push(hotfix))
+	older := newPromo(testNamespace, "older-hotfix", "foo", "", metav1.NewTime(now.Add(-time.Minute)))
+	older.Spec.Priority = 100
+	require.True(t, pq.Push(older))
+	low := newPromo(testNamespace, "low", "foo", "", metav1.NewTime(now.Add(-2*time.Hour)))
+	low.Spec.Priority = -1
+	require.True(t, pq.Push(low))
+
+	// Higher priority promos come first. Ties are broken by creation time.
+	require.Equal(t, "older-hotfix", pq.Pop().GetName())
+	require.Equal(t, "hotfix", pq.Pop().GetName())
+	require.Equal(t, "routine", pq.Pop().GetName())
+	require.Equal(t, "low", pq.Pop().GetName())
+}
+
+func TestTryBeginScheduled(t *testing.T) {
+	pqs := promoQueues{
+		activePromoByStage:        map[types.NamespacedName]string{},
+		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+	}
+	ctx := context.TODO()
+
+	future := metav1.NewTime(time.Now().Add(time.Hour))
+	scheduled := newPromo(testNamespace, "a", "foo", "", before)
+	scheduled.Spec.NotBefore = &future
+	pqs.initializeQueues(
+		ctx,
+		kargoapi.PromotionList{Items: []kargoapi.Promotion{*scheduled}},
+	)
+	// Promos that are not due yet are not queued
+	require.Equal(t, 0, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
+
+	// A promo that is not due yet cannot begin and does not block others
+	require.False(t, pqs.tryBegin(ctx, scheduled))
+	require.Equal(t, 0, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
+	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "b", "foo", "", now)))
+	pqs.conclude(ctx, fooStageKey, "b")
+
+	// Once due, the promo begins normally
+	past := metav1.NewTime(time.Now().Add(-time.Minute))
+	scheduled.Spec.NotBefore = &past
+	require.True(t, pqs.tryBegin(ctx, scheduled))
+	require.Equal(t, "a", pqs.activePromoByStage[fooStageKey])
+}
+
+func TestTryBeginConcurrencyLimits(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("per Project", func(t *testing.T) {
+		pqs := promoQueues{
+			activePromoByStage:        map[types.NamespacedName]string{},
+			pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+			maxActivePerProject:       1,
+		}
+		require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "a", "foo", "", now)))
+
+		// Another Stage in the same Project is held back
+		promo := newPromo(testNamespace, "b", "bar", "", now)
+		require.False(t, pqs.tryBegin(ctx, promo))
+		require.Contains(t, pqs.throttledMessage(promo), "concurrency limit per Project")
+		require.Equal(t, []types.NamespacedName{barStageKey}, pqs.idleStages(testNamespace))
+		require.Empty(t, pqs.idleStages("other-namespace"))
+
+		// A Stage in another Project is not
+		require.True(t, pqs.tryBegin(ctx, newPromo("other-namespace", "c", "foo", "", now)))
+
+		// Once a slot frees up, the held back promo may begin
+		pqs.conclude(ctx, fooStageKey, "a")
+		require.Empty(t, pqs.throttledMessage(promo))
+		require.True(t, pqs.tryBegin(ctx, promo))
+		require.Empty(t, pqs.idleStages(""))
+	})
+
+	t.Run("per controller", func(t *testing.T) {
+		pqs := promoQueues{
+			activePromoByStage:        map[types.NamespacedName]string{},
+			pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+			maxActive:                 1,
+		}
+		require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "a", "foo", "", now)))
+
+		// Stages in any Project are held back
+		promo := newPromo("other-namespace", "b", "foo", "", now)
+		require.False(t, pqs.tryBegin(ctx, promo))
+		require.Contains(t, pqs.throttledMessage(promo), "concurrency limit for this controller")
+
+		// Promos to a Stage with an active promo are waiting on that instead
+		require.Empty(
+			t,
+			pqs.throttledMessage(newPromo(testNamespace, "c", "foo", "", now)),
+		)
+
+		pqs.conclude(ctx, fooStageKey, "a")
+		require.True(t, pqs.tryBegin(ctx, promo))
+	})
+}
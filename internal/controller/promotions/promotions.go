@@ -3,14 +3,20 @@ package promotions
 import (
 	"context"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
+	"github.com/kelseyhightower/envconfig"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -25,17 +31,88 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// ReconcilerConfig represents configuration for the promotion reconciler.
+type ReconcilerConfig struct {
+	ShardName string `envconfig:"SHARD_NAME"`
+	// JobStepGitImage is the container image used to check out Git repositories
+	// into the workspace of Job-based promotion steps. It must provide both a
+	// shell and the git CLI.
+	JobStepGitImage string `envconfig:"PROMOTION_JOB_GIT_IMAGE" default:"ghcr.io/akuity/kargo"`
+	// JobStepWorkspaceSize is the size of the PersistentVolumeClaim requested
+	// for the workspace of any Job-based promotion step that checks out a Git
+	// repository.
+	JobStepWorkspaceSize string `envconfig:"PROMOTION_JOB_WORKSPACE_SIZE" default:"1Gi"`
+	// MaxRunningPromotions is the maximum number of Promotions this controller
+	// will run concurrently. Zero means unlimited.
+	MaxRunningPromotions int `envconfig:"MAX_RUNNING_PROMOTIONS" default:"0"`
+	// MaxRunningPromotionsPerProject is the maximum number of Promotions this
+	// controller will run concurrently within any one Project. Zero means
+	// unlimited.
+	MaxRunningPromotionsPerProject int `envconfig:"MAX_RUNNING_PROMOTIONS_PER_PROJECT" default:"0"`
+	// InstanceID uniquely identifies this controller instance. If unspecified,
+	// the hostname (i.e. the Pod name, when running in Kubernetes) is used.
+	InstanceID string `envconfig:"POD_NAME"`
+	// LeaseNamespace is the namespace in which each controller instance
+	// maintains a Lease attesting that it is alive. A Running Promotion is only
+	// ever resumed by a different instance once the Lease of the instance that
+	// was executing it has expired.
+	LeaseNamespace string `envconfig:"CONTROLLER_LEASE_NAMESPACE" default:"kargo"`
+}
+
+func ReconcilerConfigFromEnv() ReconcilerConfig {
+	cfg := ReconcilerConfig{}
+	envconfig.MustProcess("", &cfg)
+	if cfg.InstanceID == "" {
+		cfg.InstanceID, _ = os.Hostname()
+	}
+	return cfg
+}
+
 // reconciler reconciles Promotion resources.
 type reconciler struct {
 	kargoClient     client.Client
 	promoMechanisms promotion.Mechanism
+	hookRunner      promotion.HookRunner
 
 	pqs            *promoQueues
 	initializeOnce sync.Once
 
+	// instanceID uniquely identifies this controller instance. It is recorded
+	// on the Promotions it executes.
+	instanceID string
+
+	// lease attests that this controller instance is alive.
+	lease *instanceLease
+
 	// The following behaviors are overridable for testing purposes:
 
 	promoteFn func(context.Context, kargoapi.Promotion) (*kargoapi.PromotionStatus, error)
+
+	getProjectFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (*kargoapi.Project, error)
+
+	listPromosFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
+	supersedePendingPromotionsFn func(
+		context.Context,
+		*kargoapi.Promotion,
+	) (bool, error)
+
+	claimPromotionFn func(context.Context, *kargoapi.Promotion) error
+
+	holdsInstanceLeaseFn func() bool
+
+	isInstanceLeaseExpiredFn func(
+		ctx context.Context,
+		instanceID string,
+	) (bool, error)
 }
 
 // SetupReconcilerWithManager initializes a reconciler for Promotion resources
@@ -45,10 +122,10 @@ func SetupReconcilerWithManager(
 	kargoMgr manager.Manager,
 	argocdMgr manager.Manager,
 	credentialsDB credentials.Database,
-	shardName string,
+	cfg ReconcilerConfig,
 ) error {
 
-	shardPredicate, err := controller.GetShardPredicate(shardName)
+	shardPredicate, err := controller.GetShardPredicate(cfg.ShardName)
 	if err != nil {
 		return errors.Wrap(err, "error creating shard selector predicate")
 	}
@@ -62,7 +139,14 @@ func SetupReconcilerWithManager(
 		kargoMgr.GetClient(),
 		argocdClient,
 		credentialsDB,
+		cfg,
 	)
+	// Leases are read directly from the API server so that doing so does not
+	// require a cache of every Lease in the cluster.
+	reconciler.lease.apiReader = kargoMgr.GetAPIReader()
+	if err = kargoMgr.Add(reconciler.lease); err != nil {
+		return errors.Wrap(err, "error adding controller instance Lease to manager")
+	}
 
 	changePredicate := predicate.Or(
 		predicate.GenerationChangedPredicate{},
@@ -99,6 +183,24 @@ func SetupReconcilerWithManager(
 		return errors.Wrap(err, "unable to watch Promotions")
 	}
 
+	// Watch Jobs created on behalf of Promotions (e.g. by hooks) and enqueue the
+	// owning Promotion when they finish
+	if err := c.Watch(
+		source.Kind(
+			kargoMgr.GetCache(),
+			&batchv1.Job{},
+		),
+		handler.EnqueueRequestForOwner(
+			kargoMgr.GetScheme(),
+			kargoMgr.GetRESTMapper(),
+			&kargoapi.Promotion{},
+			handler.OnlyControllerOwner(),
+		),
+		jobFinished{},
+	); err != nil {
+		return errors.Wrap(err, "unable to watch Jobs")
+	}
+
 	return nil
 }
 
@@ -106,20 +208,40 @@ func newReconciler(
 	kargoClient client.Client,
 	argocdClient client.Client,
 	credentialsDB credentials.Database,
+	cfg ReconcilerConfig,
 ) *reconciler {
 	pqs := promoQueues{
 		activePromoByStage:        map[types.NamespacedName]string{},
 		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+		maxActive:                 cfg.MaxRunningPromotions,
+		maxActivePerProject:       cfg.MaxRunningPromotionsPerProject,
 	}
 	r := &reconciler{
 		kargoClient: kargoClient,
 		pqs:         &pqs,
+		instanceID:  cfg.InstanceID,
 		promoMechanisms: promotion.NewMechanisms(
+			kargoClient,
 			argocdClient,
 			credentialsDB,
+			cfg.JobStepGitImage,
+			cfg.JobStepWorkspaceSize,
+		),
+		hookRunner: promotion.NewHookRunner(kargoClient),
+		lease: newInstanceLease(
+			kargoClient,
+			kargoClient,
+			cfg.LeaseNamespace,
+			cfg.InstanceID,
 		),
 	}
 	r.promoteFn = r.promote
+	r.getProjectFn = kargoapi.GetProject
+	r.listPromosFn = r.kargoClient.List
+	r.supersedePendingPromotionsFn = r.supersedePendingPromotions
+	r.claimPromotionFn = r.claimPromotion
+	r.holdsInstanceLeaseFn = r.lease.isHeld
+	r.isInstanceLeaseExpiredFn = r.lease.isExpired
 	return r
 }
 
@@ -179,16 +301,81 @@ func (r *reconciler) Reconcile(
 		"freight":   promo.Spec.Freight,
 	})
 
+	// No Promotion is executed unless this controller instance's Lease is
+	// current. Otherwise, another instance could conclude this one is gone and
+	// resume the same Promotion.
+	if !r.holdsInstanceLeaseFn() {
+		logger.Debug("controller instance Lease is not current; will retry")
+		result.RequeueAfter = instanceLeaseRenewInterval
+		return result, nil
+	}
+
 	if promo.Status.Phase == kargoapi.PromotionPhaseRunning {
 		// anything we've already marked Running, we allow it to continue to reconcile
-		logger.Debug("continuing Promotion")
+		if promo.Status.ControllerInstance != r.instanceID {
+			// This promo was begun by another controller instance. It may only be
+			// resumed once that instance's Lease has expired, since until then the
+			// instance may still be executing it.
+			expired, err :=
+				r.isInstanceLeaseExpiredFn(ctx, promo.Status.ControllerInstance)
+			if err != nil {
+				return result, err
+			}
+			instanceLogger := logger.WithField(
+				"previousControllerInstance",
+				promo.Status.ControllerInstance,
+			)
+			if !expired {
+				instanceLogger.Debug("Promotion is being executed by another controller instance")
+				result.RequeueAfter = instanceLeaseDuration
+				return result, nil
+			}
+			instanceLogger.Info(
+				"resuming Promotion begun by a controller instance whose Lease has expired",
+			)
+		} else {
+			logger.Debug("continuing Promotion")
+		}
 	} else {
-		// promo is Pending. Try to begin it.
+		// promo is Pending. Before trying to begin it, give the applicable
+		// PromotionPolicy a chance to supersede it (or older Pending promos).
+		if !r.pqs.isActive(promo) {
+			superseded, err := r.supersedePendingPromotionsFn(ctx, promo)
+			if err != nil {
+				return result, err
+			}
+			if superseded {
+				logger.Info("promotion superseded")
+				return result, nil
+			}
+		}
+		// Try to begin it.
 		if !r.pqs.tryBegin(ctx, promo) {
+			if isScheduledForLater(promo) {
+				// This promo is scheduled to begin later. Mark it as Pending (if it
+				// wasn't already) and check back when it is due.
+				notBefore := promo.Spec.NotBefore
+				msg := fmt.Sprintf(
+					"scheduled to begin no earlier than %s",
+					notBefore.UTC().Format(time.RFC3339),
+				)
+				if promo.Status.Phase != kargoapi.PromotionPhasePending || promo.Status.Message != msg {
+					err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+						status.Phase = kargoapi.PromotionPhasePending
+						status.Message = msg
+					})
+				}
+				result.RequeueAfter = time.Until(notBefore.Time)
+				return result, err
+			}
 			// It wasn't our turn. Mark this promo as Pending (if it wasn't already)
-			if promo.Status.Phase != kargoapi.PromotionPhasePending {
+			// and explain if it is being held back by a concurrency limit. When a
+			// slot frees up, it will be enqueued again.
+			msg := r.pqs.throttledMessage(promo)
+			if promo.Status.Phase != kargoapi.PromotionPhasePending || promo.Status.Message != msg {
 				err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 					status.Phase = kargoapi.PromotionPhasePending
+					status.Message = msg
 				})
 				return result, err
 			}
@@ -197,12 +384,14 @@ func (r *reconciler) Reconcile(
 		logger.Infof("began promotion")
 	}
 
-	// Update promo status as Running to give visibility in UI. Also, a promo which
-	// has already entered Running status will be allowed to continue to reconcile.
-	if promo.Status.Phase != kargoapi.PromotionPhaseRunning {
-		if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
-			status.Phase = kargoapi.PromotionPhaseRunning
-		}); err != nil {
+	// Update promo status as Running to give visibility in UI and record that
+	// this controller instance is executing it. Also, a promo which has already
+	// entered Running status will be allowed to continue to reconcile.
+	if promo.Status.Phase != kargoapi.PromotionPhaseRunning ||
+		promo.Status.ControllerInstance != r.instanceID {
+		if err = r.claimPromotionFn(ctx, promo); err != nil {
+			// If we lost a race to claim the promo, we will try again with a fresh
+			// copy of it. Until then, it must not be executed.
 			return result, err
 		}
 	}
@@ -235,6 +424,8 @@ func (r *reconciler) Reconcile(
 		}
 	}()
 
+	newStatus.ControllerInstance = r.instanceID
+
 	if newStatus.Phase.IsTerminal() {
 		logger.Infof("promotion %s", newStatus.Phase)
 	}
@@ -260,6 +451,154 @@ func (r *reconciler) Reconcile(
 	return result, err
 }
 
+// supersedePendingPromotions applies the SupersedePending queue policy, if the
+// PromotionPolicy for the specified Pending Promotion's Stage calls for it. If
+// a newer, due Promotion to the same Stage exists, the specified Promotion is
+// itself marked Superseded and true is returned. Otherwise, any older Pending
+// Promotions to the same Stage that have not yet begun are marked Superseded
+// and false is returned.
+func (r *reconciler) supersedePendingPromotions(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (bool, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	project, err := r.getProjectFn(ctx, r.kargoClient, promo.Namespace)
+	if err != nil {
+		return false, errors.Wrapf(err, "error finding Project %q", promo.Namespace)
+	}
+	if project == nil || project.Spec == nil {
+		return false, nil
+	}
+	var supersede bool
+	for _, policy := range project.Spec.PromotionPolicies {
+		if policy.Stage == promo.Spec.Stage {
+			supersede =
+				policy.QueuePolicy == kargoapi.PromotionQueuePolicySupersedePending
+			break
+		}
+	}
+	if !supersede {
+		return false, nil
+	}
+
+	promos := kargoapi.PromotionList{}
+	if err = r.listPromosFn(
+		ctx,
+		&promos,
+		&client.ListOptions{
+			Namespace: promo.Namespace,
+			FieldSelector: fields.Set(map[string]string{
+				kubeclient.NonTerminalPromotionsByStageIndexField: promo.Spec.Stage,
+			}).AsSelector(),
+		},
+	); err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error listing Promotions in non-terminal phases for Stage %q in "+
+				"namespace %q",
+			promo.Spec.Stage,
+			promo.Namespace,
+		)
+	}
+
+	// Find the newest Promotion to the Stage that is due. Promotions scheduled
+	// for later never supersede anything before they are due.
+	newest := promo
+	for i := range promos.Items {
+		other := &promos.Items[i]
+		if isScheduledForLater(other) {
+			continue
+		}
+		if isNewerPromotion(other, newest) {
+			newest = other
+		}
+	}
+
+	supersedeFn := func(p *kargoapi.Promotion) error {
+		return kubeclient.PatchStatus(ctx, r.kargoClient, p, func(status *kargoapi.PromotionStatus) {
+			status.Phase = kargoapi.PromotionPhaseSuperseded
+			status.Message = fmt.Sprintf("superseded by Promotion %q", newest.Name)
+		})
+	}
+
+	if newest.Name != promo.Name {
+		if err = supersedeFn(promo); err != nil {
+			return false, errors.Wrapf(
+				err,
+				"error marking Promotion %q in namespace %q as superseded",
+				promo.Name,
+				promo.Namespace,
+			)
+		}
+		return true, nil
+	}
+
+	if isScheduledForLater(promo) {
+		// This promo cannot supersede anything before it is due
+		return false, nil
+	}
+
+	for i := range promos.Items {
+		other := &promos.Items[i]
+		if other.Name == promo.Name ||
+			other.Status.Phase == kargoapi.PromotionPhaseRunning ||
+			r.pqs.isActive(other) ||
+			!isNewerPromotion(promo, other) {
+			continue
+		}
+		if err = supersedeFn(other); err != nil {
+			return false, errors.Wrapf(
+				err,
+				"error marking Promotion %q in namespace %q as superseded",
+				other.Name,
+				other.Namespace,
+			)
+		}
+		logger.WithField("superseded", other.Name).
+			Debug("superseded older pending Promotion")
+	}
+	return false, nil
+}
+
+// isNewerPromotion returns true if the left Promotion was created after the
+// right one. Ties are broken by name.
+func isNewerPromotion(left, right *kargoapi.Promotion) bool {
+	leftCreated, rightCreated := left.CreationTimestamp.Time, right.CreationTimestamp.Time
+	if leftCreated.Equal(rightCreated) {
+		return left.Name > right.Name
+	}
+	return leftCreated.After(rightCreated)
+}
+
+// claimPromotion marks the specified Promotion as Running and attributes it to
+// this controller instance. It uses optimistic locking, so it fails if the
+// Promotion has been modified since it was retrieved (e.g. by another
+// controller instance beginning or claiming it). This only serializes claims.
+// It is the caller's responsibility to ensure that any other instance the
+// Promotion is attributed to is no longer executing it.
+func (r *reconciler) claimPromotion(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) error {
+	patch := client.MergeFromWithOptions(
+		promo.DeepCopy(),
+		client.MergeFromWithOptimisticLock{},
+	)
+	if promo.Status.Phase != kargoapi.PromotionPhaseRunning {
+		promo.Status.Phase = kargoapi.PromotionPhaseRunning
+		// Clear any message left over from while the promo was Pending
+		promo.Status.Message = ""
+	}
+	promo.Status.ControllerInstance = r.instanceID
+	return errors.Wrapf(
+		r.kargoClient.Status().Patch(ctx, promo, patch),
+		"error claiming Promotion %q in namespace %q",
+		promo.Name,
+		promo.Namespace,
+	)
+}
+
 func (r *reconciler) promote(
 	ctx context.Context,
 	promo kargoapi.Promotion,
@@ -295,12 +634,37 @@ func (r *reconciler) promote(
 	logger.Debug("found associated Stage")
 
 	if stage.Status.CurrentFreight != nil && stage.Status.CurrentFreight.ID == freightName {
+		if promotion.HasUnfinishedHooks(promo.Status.Hooks, kargoapi.PromotionHookTypePost) {
+			// This Promotion already transitioned the Stage into the desired
+			// Freight, but is still waiting on one or more post-promotion hooks.
+			return r.runPostHooks(
+				ctx,
+				stage,
+				&promo,
+				*stage.Status.CurrentFreight,
+				promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+			), nil
+		}
 		return &kargoapi.PromotionStatus{
 			Phase:   kargoapi.PromotionPhaseSucceeded,
 			Message: "Stage already has the desired Freight",
 		}, nil
 	}
 
+	// The Stage may have been locked since the Promotion was created, so the
+	// lock is checked again here.
+	if lock := stage.Status.Lock; lock != nil {
+		if _, breakGlass := promo.Annotations[kargoapi.AnnotationKeyBreakGlass]; !breakGlass {
+			return nil, errors.Errorf(
+				"Stage %q in namespace %q is locked by %q: %s",
+				stageName,
+				stageNamespace,
+				lock.LockedBy,
+				lock.Reason,
+			)
+		}
+	}
+
 	targetFreight, err := kargoapi.GetFreight(
 		ctx,
 		r.kargoClient,
@@ -323,11 +687,12 @@ func (r *reconciler) promote(
 			promo.Namespace,
 		)
 	}
-	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
-	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
-		upstreamStages[i] = upstreamStage.Name
-	}
-	if !kargoapi.IsFreightAvailable(targetFreight, stageName, upstreamStages) {
+	if !kargoapi.IsFreightAvailable(
+		targetFreight,
+		stageName,
+		stage.Spec.Subscriptions.UpstreamStages,
+		stage.Spec.Subscriptions.UpstreamStagesMode,
+	) {
 		return nil, errors.Errorf(
 			"Freight %q is not available to Stage %q in namespace %q",
 			promo.Spec.Freight,
@@ -336,11 +701,54 @@ func (r *reconciler) promote(
 		)
 	}
 
+	// Approvals may have expired or been revoked since the Promotion was
+	// created, so the Stage's approval policy, if any, is checked again here.
+	project, err := r.getProjectFn(ctx, r.kargoClient, stageNamespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error finding Project %q", stageNamespace)
+	}
+	if satisfied, msg, err := kargo.IsApprovalPolicySatisfied(
+		kargo.GetApprovalPolicy(project, stageName),
+		targetFreight,
+		stageName,
+		time.Now(),
+	); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error evaluating approval policy for Stage %q in namespace %q",
+			stageName,
+			stageNamespace,
+		)
+	} else if !satisfied {
+		return nil, errors.New(msg)
+	}
+
+	// Promotion windows may have closed since the Promotion was created, e.g.
+	// if it was scheduled or queued, so they are checked again here.
+	if _, breakGlass := promo.Annotations[kargoapi.AnnotationKeyBreakGlass]; !breakGlass {
+		permitted, msg, err := kargo.IsPromotionPermittedAt(
+			kargo.GetPromotionWindows(project, stageName),
+			time.Now(),
+		)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error evaluating promotion windows for Stage %q in namespace %q",
+				stageName,
+				stageNamespace,
+			)
+		}
+		if !permitted {
+			return nil, errors.New(msg)
+		}
+	}
+
 	simpleTargetFreight := kargoapi.FreightReference{
-		ID:      targetFreight.ID,
-		Commits: targetFreight.Commits,
-		Images:  targetFreight.Images,
-		Charts:  targetFreight.Charts,
+		ID:           targetFreight.ID,
+		Commits:      targetFreight.Commits,
+		Images:       targetFreight.Images,
+		Charts:       targetFreight.Charts,
+		OCIArtifacts: targetFreight.OCIArtifacts,
 	}
 
 	err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
@@ -354,14 +762,55 @@ func (r *reconciler) promote(
 		return nil, err
 	}
 
+	var preHooks []kargoapi.PromotionHook
+	if stage.Spec.PromotionMechanisms != nil {
+		preHooks = stage.Spec.PromotionMechanisms.PreHooks
+	}
+
+	hookResults, hooksPhase, err := r.hookRunner.RunHooks(
+		ctx,
+		kargoapi.PromotionHookTypePre,
+		preHooks,
+		stage,
+		&promo,
+		simpleTargetFreight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	promo.Status.Hooks = hookResults
+	switch hooksPhase {
+	case kargoapi.PromotionHookPhaseRunning:
+		status := promo.Status.WithPhase(kargoapi.PromotionPhaseRunning)
+		status.Message = "waiting for pre-promotion hooks to complete"
+		return status, nil
+	case kargoapi.PromotionHookPhaseFailed:
+		// A failed pre-hook blocks the promotion
+		status := promo.Status.WithPhase(kargoapi.PromotionPhaseFailed)
+		status.Message = promotion.FailedHooksMessage(
+			hookResults,
+			kargoapi.PromotionHookTypePre,
+		)
+		return status, nil
+	}
+
 	newStatus, nextFreight, err := r.promoMechanisms.Promote(ctx, stage, &promo, simpleTargetFreight)
 	if err != nil {
 		return nil, err
 	}
+	// Not all promotion mechanisms carry the results of hooks forward.
+	newStatus.Hooks = promo.Status.Hooks
 
 	logger.Debugf("promotion %s", newStatus.Phase)
 
 	if newStatus.Phase == kargoapi.PromotionPhaseSucceeded {
+		// Record how the Stage came to have this Freight, so that this remains
+		// known after the Promotion itself has been garbage collected
+		promotedAt := metav1.Now()
+		nextFreight.Promotion = promo.Name
+		nextFreight.PromotedBy = promo.Annotations[kargoapi.AnnotationKeyCreatedBy]
+		nextFreight.PromotedAt = &promotedAt
+
 		// Only update Stage status if the promotion succeeded
 		// The assumption is that controller does not process multiple promotions in one stage
 		// so we are safe from race conditions and can just update the status
@@ -375,7 +824,7 @@ func (r *reconciler) promote(
 			// control-flow stages in the first place)
 			if stage.Spec.PromotionMechanisms != nil {
 				status.CurrentFreight = &nextFreight
-				status.History.Push(nextFreight)
+				status.History.PushWithDepth(stage.Spec.GetHistoryDepth(), nextFreight)
 			}
 		})
 		if err != nil {
@@ -386,7 +835,65 @@ func (r *reconciler) promote(
 				stageNamespace,
 			)
 		}
+		return r.runPostHooks(ctx, stage, &promo, nextFreight, newStatus), nil
 	}
 
 	return newStatus, nil
 }
+
+// runPostHooks executes any post-promotion hooks defined by the specified
+// Stage and returns an updated copy of the provided, otherwise successful,
+// PromotionStatus. Because the Stage has already been transitioned into the
+// new Freight by the time post-promotion hooks are executed, neither the
+// failure of a hook nor any error encountered while executing it causes the
+// Promotion to fail. Failures are reported through the status message, while
+// errors leave the Promotion running so that execution will be retried.
+func (r *reconciler) runPostHooks(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	freight kargoapi.FreightReference,
+	status *kargoapi.PromotionStatus,
+) *kargoapi.PromotionStatus {
+	status = status.DeepCopy()
+	var postHooks []kargoapi.PromotionHook
+	if stage.Spec.PromotionMechanisms != nil {
+		postHooks = stage.Spec.PromotionMechanisms.PostHooks
+	}
+	if len(postHooks) == 0 {
+		return status
+	}
+	hookResults, hooksPhase, err := r.hookRunner.RunHooks(
+		ctx,
+		kargoapi.PromotionHookTypePost,
+		postHooks,
+		stage,
+		promo,
+		freight,
+	)
+	status.Hooks = hookResults
+	if err != nil {
+		logging.LoggerFromContext(ctx).Errorf(
+			"error executing post-promotion hooks: %s",
+			err,
+		)
+		status.Phase = kargoapi.PromotionPhaseRunning
+		status.Message = err.Error()
+		return status
+	}
+	switch hooksPhase {
+	case kargoapi.PromotionHookPhaseRunning:
+		status.Phase = kargoapi.PromotionPhaseRunning
+		status.Message = "waiting for post-promotion hooks to complete"
+	case kargoapi.PromotionHookPhaseFailed:
+		status.Phase = kargoapi.PromotionPhaseSucceeded
+		status.Message = promotion.FailedHooksMessage(
+			hookResults,
+			kargoapi.PromotionHookTypePost,
+		)
+	default:
+		status.Phase = kargoapi.PromotionPhaseSucceeded
+		status.Message = ""
+	}
+	return status
+}
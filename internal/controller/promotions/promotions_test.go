@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -23,10 +26,15 @@ func TestNewPromotionReconciler(t *testing.T) {
 		kubeClient,
 		kubeClient,
 		&credentials.FakeDB{},
+		ReconcilerConfig{},
 	)
 	require.NotNil(t, r.kargoClient)
+	require.NotNil(t, r.hookRunner)
 	require.NotNil(t, r.pqs.pendingPromoQueuesByStage)
 	require.NotNil(t, r.promoteFn)
+	require.NotNil(t, r.lease)
+	require.NotNil(t, r.holdsInstanceLeaseFn)
+	require.NotNil(t, r.isInstanceLeaseExpiredFn)
 }
 
 func newFakeReconciler(t *testing.T, objects ...client.Object) *reconciler {
@@ -35,11 +43,17 @@ func newFakeReconciler(t *testing.T, objects ...client.Object) *reconciler {
 	kargoClient := fake.NewClientBuilder().WithScheme(scheme).
 		WithObjects(objects...).WithStatusSubresource(objects...).Build()
 	kubeClient := fake.NewClientBuilder().Build()
-	return newReconciler(
+	r := newReconciler(
 		kargoClient,
 		kubeClient,
 		&credentials.FakeDB{},
+		ReconcilerConfig{},
 	)
+	r.holdsInstanceLeaseFn = func() bool { return true }
+	r.isInstanceLeaseExpiredFn = func(context.Context, string) (bool, error) {
+		return true, nil
+	}
+	return r
 }
 
 func TestReconcile(t *testing.T) {
@@ -50,6 +64,9 @@ func TestReconcile(t *testing.T) {
 		promoToReconcile      *types.NamespacedName // if nil, uses the first of the promos
 		expectPromoteFnCalled bool
 		expectedPhase         kargoapi.PromotionPhase
+		expectedInstance      string
+		holdsLease            *bool
+		otherLeaseExpired     *bool
 	}{
 		{
 			name:                  "normal reconcile",
@@ -80,6 +97,42 @@ func TestReconcile(t *testing.T) {
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now),
 			},
 		},
+		{
+			name:                  "promo running on another controller instance",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			expectedInstance:      "fake-instance",
+			promos: []client.Object{
+				func() client.Object {
+					promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+					promo.Status.ControllerInstance = "previous-instance"
+					return promo
+				}(),
+			},
+		},
+		{
+			name:                  "promo running on another live controller instance",
+			expectPromoteFnCalled: false,
+			expectedPhase:         kargoapi.PromotionPhaseRunning,
+			expectedInstance:      "previous-instance",
+			otherLeaseExpired:     ptr.To(false),
+			promos: []client.Object{
+				func() client.Object {
+					promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, now)
+					promo.Status.ControllerInstance = "previous-instance"
+					return promo
+				}(),
+			},
+		},
+		{
+			name:                  "controller instance Lease not held",
+			expectPromoteFnCalled: false,
+			expectedPhase:         kargoapi.PromotionPhasePending,
+			holdsLease:            ptr.To(false),
+			promos: []client.Object{
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
+			},
+		},
 		{
 			name:                  "promo does not have highest priority",
 			expectPromoteFnCalled: false,
@@ -100,6 +153,32 @@ func TestReconcile(t *testing.T) {
 				newPromo("fake-namespace", "fake-promo2", "fake-stage", kargoapi.PromotionPhasePending, now),
 			},
 		},
+		{
+			name:                  "promo scheduled for later",
+			expectPromoteFnCalled: false,
+			expectedPhase:         kargoapi.PromotionPhasePending,
+			promos: []client.Object{
+				func() client.Object {
+					promo := newPromo("fake-namespace", "fake-promo", "fake-stage", "", before)
+					notBefore := metav1.NewTime(time.Now().Add(time.Hour))
+					promo.Spec.NotBefore = &notBefore
+					return promo
+				}(),
+			},
+		},
+		{
+			name:                  "promo scheduled in the past",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			promos: []client.Object{
+				func() client.Object {
+					promo := newPromo("fake-namespace", "fake-promo", "fake-stage", "", before)
+					notBefore := metav1.NewTime(time.Now().Add(-time.Hour))
+					promo.Spec.NotBefore = &notBefore
+					return promo
+				}(),
+			},
+		},
 		{
 			name:                  "promoteFn panics",
 			expectPromoteFnCalled: true,
@@ -127,6 +206,19 @@ func TestReconcile(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.TODO()
 			r := newFakeReconciler(t, tc.promos...)
+			r.instanceID = "fake-instance"
+			if tc.holdsLease != nil {
+				r.holdsInstanceLeaseFn = func() bool { return *tc.holdsLease }
+			}
+			if tc.otherLeaseExpired != nil {
+				r.isInstanceLeaseExpiredFn = func(
+					_ context.Context,
+					instanceID string,
+				) (bool, error) {
+					require.Equal(t, "previous-instance", instanceID)
+					return *tc.otherLeaseExpired, nil
+				}
+			}
 			promoteWasCalled := false
 			r.promoteFn = func(ctx context.Context, p v1alpha1.Promotion) (*kargoapi.PromotionStatus, error) {
 				promoteWasCalled = true
@@ -156,6 +248,12 @@ func TestReconcile(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, tc.expectedPhase, updatedPromo.Status.Phase)
 			}
+			if tc.expectedInstance != "" {
+				var updatedPromo kargoapi.Promotion
+				err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedInstance, updatedPromo.Status.ControllerInstance)
+			}
 		})
 	}
 }
@@ -179,3 +277,342 @@ func TestReconcileInitializeQueues(t *testing.T) {
 	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
 	require.Equal(t, 2, r.pqs.pendingPromoQueuesByStage[stageKey].Depth())
 }
+
+func TestSupersedePendingPromotions(t *testing.T) {
+	newProject := func(queuePolicy kargoapi.PromotionQueuePolicy) *kargoapi.Project {
+		return &kargoapi.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "fake-namespace",
+			},
+			Spec: &kargoapi.ProjectSpec{
+				PromotionPolicies: []kargoapi.PromotionPolicy{
+					{
+						Stage:       "fake-stage",
+						QueuePolicy: queuePolicy,
+					},
+				},
+			},
+		}
+	}
+	later := metav1.NewTime(now.Add(time.Second))
+	testCases := []struct {
+		name               string
+		objects            []client.Object
+		promoToCheck       string
+		expectedSuperseded bool
+		expectedPhases     map[string]kargoapi.PromotionPhase
+	}{
+		{
+			name: "no Project",
+			objects: []client.Object{
+				newPromo("fake-namespace", "fake-promo1", "fake-stage", kargoapi.PromotionPhasePending, before),
+				newPromo("fake-namespace", "fake-promo2", "fake-stage", kargoapi.PromotionPhasePending, now),
+			},
+			promoToCheck: "fake-promo1",
+			expectedPhases: map[string]kargoapi.PromotionPhase{
+				"fake-promo1": kargoapi.PromotionPhasePending,
+				"fake-promo2": kargoapi.PromotionPhasePending,
+			},
+		},
+		{
+			name: "sequential queue policy",
+			objects: []client.Object{
+				newProject(kargoapi.PromotionQueuePolicySequential),
+				newPromo("fake-namespace", "fake-promo1", "fake-stage", kargoapi.PromotionPhasePending, before),
+				newPromo("fake-namespace", "fake-promo2", "fake-stage", kargoapi.PromotionPhasePending, now),
+			},
+			promoToCheck: "fake-promo1",
+			expectedPhases: map[string]kargoapi.PromotionPhase{
+				"fake-promo1": kargoapi.PromotionPhasePending,
+				"fake-promo2": kargoapi.PromotionPhasePending,
+			},
+		},
+		{
+			name: "newer promo exists",
+			objects: []client.Object{
+				newProject(kargoapi.PromotionQueuePolicySupersedePending),
+				newPromo("fake-namespace", "fake-promo1", "fake-stage", kargoapi.PromotionPhasePending, before),
+				newPromo("fake-namespace", "fake-promo2", "fake-stage", kargoapi.PromotionPhasePending, now),
+			},
+			promoToCheck:       "fake-promo1",
+			expectedSuperseded: true,
+			expectedPhases: map[string]kargoapi.PromotionPhase{
+				"fake-promo1": kargoapi.PromotionPhaseSuperseded,
+				"fake-promo2": kargoapi.PromotionPhasePending,
+			},
+		},
+		{
+			name: "older promos exist",
+			objects: []client.Object{
+				newProject(kargoapi.PromotionQueuePolicySupersedePending),
+				newPromo("fake-namespace", "fake-promo0", "fake-stage", kargoapi.PromotionPhaseRunning, before),
+				newPromo("fake-namespace", "fake-promo1", "fake-stage", kargoapi.PromotionPhasePending, before),
+				newPromo("fake-namespace", "fake-promo2", "fake-stage", kargoapi.PromotionPhasePending, now),
+			},
+			promoToCheck: "fake-promo2",
+			expectedPhases: map[string]kargoapi.PromotionPhase{
+				"fake-promo0": kargoapi.PromotionPhaseRunning,
+				"fake-promo1": kargoapi.PromotionPhaseSuperseded,
+				"fake-promo2": kargoapi.PromotionPhasePending,
+			},
+		},
+		{
+			name: "newer promo is scheduled for later",
+			objects: []client.Object{
+				newProject(kargoapi.PromotionQueuePolicySupersedePending),
+				newPromo("fake-namespace", "fake-promo1", "fake-stage", kargoapi.PromotionPhasePending, before),
+				func() client.Object {
+					promo := newPromo("fake-namespace", "fake-promo2", "fake-stage", "", later)
+					notBefore := metav1.NewTime(time.Now().Add(time.Hour))
+					promo.Spec.NotBefore = &notBefore
+					return promo
+				}(),
+			},
+			promoToCheck: "fake-promo1",
+			expectedPhases: map[string]kargoapi.PromotionPhase{
+				"fake-promo1": kargoapi.PromotionPhasePending,
+				"fake-promo2": "",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			r := newFakeReconciler(t, tc.objects...)
+			// The fake client has no field indices, so list all Promotions in the
+			// namespace instead
+			r.listPromosFn = func(
+				ctx context.Context,
+				list client.ObjectList,
+				_ ...client.ListOption,
+			) error {
+				return r.kargoClient.List(ctx, list, client.InNamespace("fake-namespace"))
+			}
+			promo, err := kargoapi.GetPromotion(
+				ctx,
+				r.kargoClient,
+				types.NamespacedName{Namespace: "fake-namespace", Name: tc.promoToCheck},
+			)
+			require.NoError(t, err)
+
+			superseded, err := r.supersedePendingPromotions(ctx, promo)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSuperseded, superseded)
+
+			for name, phase := range tc.expectedPhases {
+				promo, err := kargoapi.GetPromotion(
+					ctx,
+					r.kargoClient,
+					types.NamespacedName{Namespace: "fake-namespace", Name: name},
+				)
+				require.NoError(t, err)
+				require.Equal(t, phase, promo.Status.Phase, name)
+			}
+		})
+	}
+}
+
+func TestClaimPromotion(t *testing.T) {
+	ctx := context.TODO()
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+	r := newFakeReconciler(t, promo)
+	r.instanceID = "fake-instance"
+
+	stale, err := kargoapi.GetPromotion(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+	)
+	require.NoError(t, err)
+	current := stale.DeepCopy()
+
+	// The first claim succeeds
+	require.NoError(t, r.claimPromotion(ctx, current))
+	require.Equal(t, kargoapi.PromotionPhaseRunning, current.Status.Phase)
+	require.Equal(t, "fake-instance", current.Status.ControllerInstance)
+
+	// A claim on the basis of a stale copy of the promo fails
+	r.instanceID = "other-instance"
+	err = r.claimPromotion(ctx, stale)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "error claiming Promotion")
+
+	updated, err := kargoapi.GetPromotion(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fake-instance", updated.Status.ControllerInstance)
+}
+
+// fakeMechanism is a promotion.Mechanism that records the Freight it is asked
+// to promote and reports success.
+type fakeMechanism struct {
+	promoted *kargoapi.FreightReference
+}
+
+func (*fakeMechanism) GetName() string {
+	return "fake"
+}
+
+func (f *fakeMechanism) Promote(
+	_ context.Context,
+	_ *kargoapi.Stage,
+	_ *kargoapi.Promotion,
+	freight kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, kargoapi.FreightReference, error) {
+	f.promoted = freight.DeepCopy()
+	return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, freight, nil
+}
+
+func TestPromote(t *testing.T) {
+	// A deny window that is always open
+	alwaysDenied := []kargoapi.PromotionWindow{
+		{
+			Kind:     kargoapi.PromotionWindowKindDeny,
+			Schedule: "* * * * *",
+			Duration: "1h",
+			Reason:   "fake-freeze",
+		},
+	}
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		windows     []kargoapi.PromotionWindow
+		assertions  func(*kargoapi.PromotionStatus, *fakeMechanism, error)
+	}{
+		{
+			name:    "promotion window closed",
+			windows: alwaysDenied,
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				mech *fakeMechanism,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "fake-freeze")
+				require.Nil(t, mech.promoted)
+			},
+		},
+		{
+			name: "promotion window closed but glass broken",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass: "fake-reason",
+			},
+			windows: alwaysDenied,
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				mech *fakeMechanism,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.NotNil(t, mech.promoted)
+			},
+		},
+		{
+			name: "success",
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				mech *fakeMechanism,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(
+					t,
+					&kargoapi.FreightReference{
+						ID: "fake-freight",
+						Commits: []kargoapi.GitCommit{{
+							RepoURL: "fake-git-url",
+							ID:      "fake-commit",
+						}},
+						Images: []kargoapi.Image{{
+							RepoURL: "fake-image-url",
+							Tag:     "fake-tag",
+						}},
+						Charts: []kargoapi.Chart{{
+							RepoURL: "fake-chart-url",
+							Version: "fake-version",
+						}},
+						OCIArtifacts: []kargoapi.OCIArtifact{{
+							RepoURL: "fake-artifact-url",
+							Tag:     "fake-tag",
+							Digest:  "fake-digest",
+						}},
+					},
+					mech.promoted,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			promo := newPromo(
+				"fake-namespace",
+				"fake-promo",
+				"fake-stage",
+				kargoapi.PromotionPhaseRunning,
+				now,
+			)
+			promo.Spec.Freight = "fake-freight"
+			promo.Annotations = testCase.annotations
+			r := newFakeReconciler(
+				t,
+				promo,
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-stage",
+					},
+					Spec: &kargoapi.StageSpec{
+						Subscriptions: &kargoapi.Subscriptions{
+							Warehouse: "fake-warehouse",
+						},
+						PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-freight",
+					},
+					ID: "fake-freight",
+					Commits: []kargoapi.GitCommit{{
+						RepoURL: "fake-git-url",
+						ID:      "fake-commit",
+					}},
+					Images: []kargoapi.Image{{
+						RepoURL: "fake-image-url",
+						Tag:     "fake-tag",
+					}},
+					Charts: []kargoapi.Chart{{
+						RepoURL: "fake-chart-url",
+						Version: "fake-version",
+					}},
+					OCIArtifacts: []kargoapi.OCIArtifact{{
+						RepoURL: "fake-artifact-url",
+						Tag:     "fake-tag",
+						Digest:  "fake-digest",
+					}},
+				},
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-namespace",
+					},
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{{
+							Stage:   "fake-stage",
+							Windows: testCase.windows,
+						}},
+					},
+				},
+			)
+			mech := &fakeMechanism{}
+			r.promoMechanisms = mech
+			status, err := r.promote(context.Background(), *promo)
+			testCase.assertions(status, mech, err)
+		})
+	}
+}
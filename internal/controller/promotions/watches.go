@@ -4,10 +4,13 @@ import (
 	"context"
 
 	log "github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -45,6 +48,7 @@ func (e *EnqueueHighestPriorityPromotionHandler) Delete(
 		}
 		e.pqs.conclude(e.ctx, stageKey, promo.Name)
 		e.enqueueNext(stageKey, wq)
+		e.enqueueThrottled(stageKey.Namespace, wq)
 	}
 }
 
@@ -82,17 +86,41 @@ func (e *EnqueueHighestPriorityPromotionHandler) Update(
 		// the next highest priority promo for reconciliation
 		e.pqs.conclude(e.ctx, stageKey, promo.Name)
 		e.enqueueNext(stageKey, wq)
+		e.enqueueThrottled(stageKey.Namespace, wq)
+	}
+}
+
+// enqueueThrottled enqueues the next highest priority promotion of every Stage
+// that may have been held back by a concurrency limit, now that a slot may have
+// freed up in the specified namespace.
+func (e *EnqueueHighestPriorityPromotionHandler) enqueueThrottled(
+	namespace string,
+	wq workqueue.RateLimitingInterface,
+) {
+	switch {
+	case e.pqs.maxActive > 0:
+		// A slot may have freed up for any Stage
+		namespace = ""
+	case e.pqs.maxActivePerProject > 0:
+		// A slot may have freed up for Stages in the same namespace only
+	default:
+		// No concurrency limits; nothing can have been held back
+		return
+	}
+	for _, stageKey := range e.pqs.idleStages(namespace) {
+		e.enqueueNext(stageKey, wq)
 	}
 }
 
 // enqueueNext enqueues the next highest priority promotion for reconciliation to the workqueue.
-// Also discards pending promotions in the queue that no longer exist
+// Also discards pending promotions in the queue that no longer exist. Because
+// discarding those mutates the queue, the write lock is held throughout.
 func (e *EnqueueHighestPriorityPromotionHandler) enqueueNext(
 	stageKey types.NamespacedName,
 	wq workqueue.RateLimitingInterface,
 ) {
-	e.pqs.promoQueuesByStageMu.RLock()
-	defer e.pqs.promoQueuesByStageMu.RUnlock()
+	e.pqs.promoQueuesByStageMu.Lock()
+	defer e.pqs.promoQueuesByStageMu.Unlock()
 	if e.pqs.activePromoByStage[stageKey] != "" {
 		// there's already an active promotion. don't need to enqueue the next one
 		return
@@ -141,3 +169,48 @@ func (e *EnqueueHighestPriorityPromotionHandler) enqueueNext(
 		return
 	}
 }
+
+// jobFinished is a predicate that only admits updates to Jobs that have just
+// completed or failed. It is used to promptly follow up on Promotions that are
+// waiting on Jobs they have created.
+type jobFinished struct {
+	predicate.Funcs
+}
+
+// Create implements predicate.Predicate.
+func (jobFinished) Create(event.CreateEvent) bool {
+	return false
+}
+
+// Delete implements predicate.Predicate.
+func (jobFinished) Delete(event.DeleteEvent) bool {
+	return false
+}
+
+// Generic implements predicate.Predicate.
+func (jobFinished) Generic(event.GenericEvent) bool {
+	return false
+}
+
+// Update implements predicate.Predicate.
+func (jobFinished) Update(e event.UpdateEvent) bool {
+	oldJob, ok := e.ObjectOld.(*batchv1.Job)
+	if !ok {
+		return false
+	}
+	newJob, ok := e.ObjectNew.(*batchv1.Job)
+	if !ok {
+		return false
+	}
+	return !isJobFinished(oldJob) && isJobFinished(newJob)
+}
+
+func isJobFinished(job *batchv1.Job) bool {
+	for _, cond := range job.Status.Conditions {
+		if (cond.Type == batchv1.JobComplete || cond.Type == batchv1.JobFailed) &&
+			cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package promotions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/runtime"
+)
+
+func TestEnqueueNextDiscardsMissingPromotions(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+	// Only "b" still exists
+	kargoClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newPromo(testNamespace, "b", "foo", "", now),
+	).Build()
+
+	pqs := &promoQueues{
+		activePromoByStage:        map[types.NamespacedName]string{},
+		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+	}
+	pq := newPriorityQueue()
+	pq.Push(newPromo(testNamespace, "a", "foo", "", before))
+	pq.Push(newPromo(testNamespace, "b", "foo", "", now))
+	pqs.pendingPromoQueuesByStage[fooStageKey] = pq
+
+	e := &EnqueueHighestPriorityPromotionHandler{
+		logger:      log.NewEntry(log.New()),
+		ctx:         context.Background(),
+		pqs:         pqs,
+		kargoClient: kargoClient,
+	}
+	wq := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer wq.ShutDown()
+
+	e.enqueueNext(fooStageKey, wq)
+	require.Equal(t, 1, pq.Depth())
+	require.Equal(t, "b", pq.Peek().GetName())
+}
+
+// TestEnqueueNextConcurrently exercises the promotion queues from many
+// goroutines at once. It is most useful when run with the race detector.
+func TestEnqueueNextConcurrently(t *testing.T) {
+	const stageCount = 10
+	const promosPerStage = 10
+
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+	var promos []*kargoapi.Promotion
+	var existing []client.Object
+	for s := 0; s < stageCount; s++ {
+		for p := 0; p < promosPerStage; p++ {
+			promo := newPromo(
+				testNamespace,
+				fmt.Sprintf("promo-%d-%d", s, p),
+				fmt.Sprintf("stage-%d", s),
+				"",
+				now,
+			)
+			promos = append(promos, promo)
+			// Half of the promos no longer exist and must be discarded from the
+			// queues when they reach the front
+			if p%2 == 0 {
+				existing = append(existing, promo)
+			}
+		}
+	}
+	kargoClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(existing...).Build()
+
+	pqs := &promoQueues{
+		activePromoByStage:        map[types.NamespacedName]string{},
+		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+		maxActive:                 stageCount / 2,
+	}
+	e := &EnqueueHighestPriorityPromotionHandler{
+		logger:      log.NewEntry(log.New()),
+		ctx:         context.Background(),
+		pqs:         pqs,
+		kargoClient: kargoClient,
+	}
+	wq := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer wq.ShutDown()
+
+	ctx := context.Background()
+	for _, promo := range promos {
+		pqs.tryBegin(ctx, promo)
+	}
+	active := map[types.NamespacedName]string{}
+	for stageKey, promoName := range pqs.activePromoByStage {
+		active[stageKey] = promoName
+	}
+
+	// Conclude the active promo of every Stage while the next ones are being
+	// enqueued, discarding missing promos from the queues along the way
+	wg := sync.WaitGroup{}
+	for stageKey, promoName := range active {
+		wg.Add(3)
+		go func(stageKey types.NamespacedName, promoName string) {
+			defer wg.Done()
+			pqs.conclude(ctx, stageKey, promoName)
+		}(stageKey, promoName)
+		for i := 0; i < 2; i++ {
+			go func(stageKey types.NamespacedName) {
+				defer wg.Done()
+				e.enqueueNext(stageKey, wq)
+				e.enqueueThrottled(stageKey.Namespace, wq)
+			}(stageKey)
+		}
+	}
+	wg.Wait()
+
+	// Every active promo has concluded
+	for stageKey, promoName := range pqs.activePromoByStage {
+		require.Empty(t, promoName, "Stage %s", stageKey)
+	}
+}
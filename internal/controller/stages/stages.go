@@ -2,12 +2,15 @@ package stages
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -141,6 +144,21 @@ type reconciler struct {
 		newStatus kargoapi.FreightStatus,
 	) error
 
+	// Auto-rollback:
+
+	rollbackFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		failedFreightName string,
+	) (string, error)
+
+	failFreightInStageFn func(
+		ctx context.Context,
+		namespace string,
+		freightName string,
+		stageName string,
+	) error
+
 	// Auto-promotion:
 
 	isAutoPromotionPermittedFn func(
@@ -149,6 +167,12 @@ type reconciler struct {
 		stageName string,
 	) (bool, error)
 
+	isApprovalPolicySatisfiedFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		freight *kargoapi.Freight,
+	) (bool, error)
+
 	getProjectFn func(
 		context.Context,
 		client.Client,
@@ -179,12 +203,14 @@ type reconciler struct {
 		ctx context.Context,
 		namespace string,
 		stageSubs []kargoapi.StageSubscription,
+		mode kargoapi.UpstreamStagesMode,
 	) ([]kargoapi.Freight, error)
 
 	getLatestVerifiedFreightFn func(
 		ctx context.Context,
 		namespace string,
 		stageSubs []kargoapi.StageSubscription,
+		mode kargoapi.UpstreamStagesMode,
 	) (*kargoapi.Freight, error)
 
 	getLatestApprovedFreightFn func(
@@ -459,8 +485,12 @@ func newReconciler(
 	r.getFreightFn = kargoapi.GetFreight
 	r.verifyFreightInStageFn = r.verifyFreightInStage
 	r.patchFreightStatusFn = r.patchFreightStatus
+	// Auto-rollback:
+	r.rollbackFn = r.rollback
+	r.failFreightInStageFn = r.failFreightInStage
 	// Auto-promotion:
 	r.isAutoPromotionPermittedFn = r.isAutoPromotionPermitted
+	r.isApprovalPolicySatisfiedFn = r.isApprovalPolicySatisfied
 	r.getProjectFn = kargoapi.GetProject
 	r.createPromotionFn = kargoClient.Create
 	// Discovering latest Freight:
@@ -589,27 +619,29 @@ func (r *reconciler) syncControlFlowStage(
 	// in a control flow Stage (e.g. require that it was verified in ALL upstreams
 	// Stages)
 	var availableFreight []kargoapi.Freight
-	if stage.Spec.Subscriptions.Warehouse != "" {
-		var freight kargoapi.FreightList
-		if err := r.listFreightFn(
-			ctx,
-			&freight,
-			&client.ListOptions{
-				Namespace: stage.Namespace,
-				FieldSelector: fields.OneTermEqualSelector(
-					kubeclient.FreightByWarehouseIndexField,
-					stage.Spec.Subscriptions.Warehouse,
-				),
-			},
-		); err != nil {
-			return status, errors.Wrapf(
-				err,
-				"error listing Freight from Warehouse %q in namespace %q",
-				stage.Spec.Subscriptions.Warehouse,
-				stage.Namespace,
-			)
+	if warehouses := stage.Spec.Subscriptions.GetWarehouses(); len(warehouses) > 0 {
+		for _, warehouse := range warehouses {
+			var freight kargoapi.FreightList
+			if err := r.listFreightFn(
+				ctx,
+				&freight,
+				&client.ListOptions{
+					Namespace: stage.Namespace,
+					FieldSelector: fields.OneTermEqualSelector(
+						kubeclient.FreightByWarehouseIndexField,
+						warehouse,
+					),
+				},
+			); err != nil {
+				return status, errors.Wrapf(
+					err,
+					"error listing Freight from Warehouse %q in namespace %q",
+					warehouse,
+					stage.Namespace,
+				)
+			}
+			availableFreight = append(availableFreight, freight.Items...)
 		}
-		availableFreight = freight.Items
 	} else {
 		// Get all Freight verified in upstream Stages. Merely being approved for an
 		// upstream Stage is not enough. If Freight is only approved for a Stage,
@@ -622,6 +654,7 @@ func (r *reconciler) syncControlFlowStage(
 			ctx,
 			stage.Namespace,
 			stage.Spec.Subscriptions.UpstreamStages,
+			stage.Spec.Subscriptions.UpstreamStagesMode,
 		); err != nil {
 			return status, errors.Wrapf(
 				err,
@@ -640,7 +673,10 @@ func (r *reconciler) syncControlFlowStage(
 			if newStatus.VerifiedIn == nil {
 				newStatus.VerifiedIn = map[string]kargoapi.VerifiedStage{}
 			}
-			newStatus.VerifiedIn[stage.Name] = kargoapi.VerifiedStage{}
+			now := metav1.Now()
+			newStatus.VerifiedIn[stage.Name] = kargoapi.VerifiedStage{
+				VerifiedAt: &now,
+			}
 			if err := r.patchFreightStatusFn(ctx, &af, newStatus); err != nil {
 				return status, errors.Wrapf(
 					err,
@@ -684,6 +720,7 @@ func (r *reconciler) syncNormalStage(
 	status.ObservedGeneration = stage.Generation
 	status.Health = nil // Reset health
 	status.CurrentPromotion = nil
+	status.Message = ""
 
 	if status.CurrentFreight == nil {
 		status.Phase = kargoapi.StagePhaseNotApplicable
@@ -766,11 +803,34 @@ func (r *reconciler) syncNormalStage(
 				)
 			}
 		}
+
+		// If verification failed or errored and the Stage is configured to roll
+		// back automatically, roll back to the last Freight that was verified in
+		// this Stage.
+		if stage.Spec.Verification != nil && stage.Spec.Verification.AutoRollback &&
+			status.CurrentFreight.VerificationInfo != nil &&
+			(status.CurrentFreight.VerificationInfo.Phase == kargoapi.VerificationPhaseFailed ||
+				status.CurrentFreight.VerificationInfo.Phase == kargoapi.VerificationPhaseError) {
+			msg, err := r.rollbackFn(ctx, stage, status.CurrentFreight.ID)
+			if err != nil {
+				return status, errors.Wrapf(
+					err,
+					"error rolling back Stage %q in namespace %q from Freight %q",
+					stage.Name,
+					stage.Namespace,
+					status.CurrentFreight.ID,
+				)
+			}
+			status.Message = msg
+			// Never auto-promote while a rollback is underway
+			return status, nil
+		}
 	}
 
 	// Stop here if we have no chance of finding any Freight to promote.
 	if stage.Spec.Subscriptions == nil ||
-		(stage.Spec.Subscriptions.Warehouse == "" && len(stage.Spec.Subscriptions.UpstreamStages) == 0) {
+		(len(stage.Spec.Subscriptions.GetWarehouses()) == 0 &&
+			len(stage.Spec.Subscriptions.UpstreamStages) == 0) {
 		logger.Warn(
 			"Stage has no subscriptions. This may indicate an issue with resource" +
 				"validation logic.",
@@ -778,6 +838,11 @@ func (r *reconciler) syncNormalStage(
 		return status, nil
 	}
 
+	if stage.Status.Lock != nil {
+		logger.Debug("Stage is locked; auto-promotion is not permitted")
+		return status, nil
+	}
+
 	logger.Debug("checking if auto-promotion is permitted...")
 	if permitted, err :=
 		r.isAutoPromotionPermittedFn(ctx, stage.Namespace, stage.Name); err != nil {
@@ -821,31 +886,70 @@ func (r *reconciler) syncNormalStage(
 		return status, nil
 	}
 
-	// If a promotion already exists for this Stage + Freight, then we're
-	// disqualified from auto-promotion.
-	promos := kargoapi.PromotionList{}
-	if err := r.listPromosFn(
-		ctx,
-		&promos,
-		&client.ListOptions{
-			Namespace: stage.Namespace,
-			FieldSelector: fields.Set(
-				map[string]string{
-					kubeclient.PromotionsByStageAndFreightIndexField: kubeclient.
-						StageAndFreightKey(stage.Name, latestFreight.Name),
-				},
-			).AsSelector(),
-		},
-	); err != nil {
+	// Never auto-promote Freight that is older than the Stage's current Freight.
+	// This could otherwise happen if, for instance, newer Freight was promoted
+	// to the Stage manually. This does not apply to a Stage that subscribes to
+	// multiple Warehouses, since its current Freight may have come from a
+	// different Warehouse than the latest available Freight.
+	if stage.Status.CurrentFreight != nil && stage.Status.CurrentFreight.ID != "" &&
+		len(stage.Spec.Subscriptions.GetWarehouses()) <= 1 {
+		currentFreight, err := r.getFreightFn(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: stage.Namespace,
+				Name:      stage.Status.CurrentFreight.ID,
+			},
+		)
+		if err != nil {
+			return status, errors.Wrapf(
+				err,
+				"error finding current Freight %q for Stage %q in namespace %q",
+				stage.Status.CurrentFreight.ID,
+				stage.Name,
+				stage.Namespace,
+			)
+		}
+		if currentFreight != nil &&
+			latestFreight.CreationTimestamp.Before(&currentFreight.CreationTimestamp) {
+			logger.WithField("currentFreight", currentFreight.Name).
+				Debug("latest available Freight is older than the Stage's current Freight")
+			return status, nil
+		}
+	}
+
+	// Freight that has previously failed in this Stage is never auto-promoted
+	// to it again.
+	if _, failed := latestFreight.Status.FailedIn[stage.Name]; failed {
+		logger.Debug("latest available Freight has previously failed in Stage")
+		return status, nil
+	}
+
+	// Freight that does not satisfy the Stage's approval policy, if any, must
+	// wait for further approvals.
+	if satisfied, err :=
+		r.isApprovalPolicySatisfiedFn(ctx, stage, latestFreight); err != nil {
 		return status, errors.Wrapf(
 			err,
-			"error listing existing Promotions for Freight %q in namespace %q",
+			"error checking if Freight %q satisfies approval policy for Stage %q "+
+				"in namespace %q",
 			latestFreight.Name,
+			stage.Name,
 			stage.Namespace,
 		)
+	} else if !satisfied {
+		logger.Debug("latest available Freight does not satisfy approval policy")
+		return status, nil
+	}
+
+	// If a promotion already exists for this Stage + Freight, then we're
+	// disqualified from auto-promotion.
+	promos, err := r.listPromosForStageAndFreight(ctx, stage, latestFreight.Name)
+	if err != nil {
+		return status, err
 	}
 
-	if len(promos.Items) > 0 {
+	if len(promos) > 0 {
 		logger.Debug("Promotion already exists for Freight")
 		return status, nil
 	}
@@ -1044,12 +1148,20 @@ func (r *reconciler) verifyFreightInStage(
 	}
 
 	// Only try to mark as verified in this Stage if not already the case.
-	if _, ok := newStatus.VerifiedIn[stageName]; ok {
+	_, verified := newStatus.VerifiedIn[stageName]
+	_, failed := newStatus.FailedIn[stageName]
+	if verified && !failed {
 		logger.Debug("Freight already marked as verified in Stage")
 		return nil
 	}
 
-	newStatus.VerifiedIn[stageName] = kargoapi.VerifiedStage{}
+	now := metav1.Now()
+	newStatus.VerifiedIn[stageName] = kargoapi.VerifiedStage{
+		VerifiedAt: &now,
+	}
+	// Freight that was previously rolled back, but has since been verified
+	// successfully, is no longer regarded as having failed in this Stage.
+	delete(newStatus.FailedIn, stageName)
 	if err = r.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
 		return err
 	}
@@ -1058,6 +1170,295 @@ func (r *reconciler) verifyFreightInStage(
 	return nil
 }
 
+// rollback marks the specified Freight as having failed in the specified Stage
+// and creates a Promotion that returns the Stage to the most recent Freight
+// from its history that was verified in the Stage. If no such Freight exists,
+// or the Stage's lock, promotion policy, promotion windows, or approval policy
+// do not currently permit the Promotion, no Promotion is created and a message
+// explaining why is returned instead.
+func (r *reconciler) rollback(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	failedFreightName string,
+) (string, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("freight", failedFreightName)
+
+	// Find the most recent Freight from the Stage's history that was verified in
+	// the Stage and has not, itself, failed in the Stage.
+	var target *kargoapi.Freight
+	for _, ref := range stage.Status.History {
+		if ref.ID == failedFreightName {
+			continue
+		}
+		freight, err := r.getFreightFn(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{
+				Namespace: stage.Namespace,
+				Name:      ref.ID,
+			},
+		)
+		if err != nil {
+			return "", errors.Wrapf(
+				err,
+				"error finding Freight %q in namespace %q",
+				ref.ID,
+				stage.Namespace,
+			)
+		}
+		if freight == nil {
+			continue
+		}
+		if _, verified := freight.Status.VerifiedIn[stage.Name]; !verified {
+			continue
+		}
+		if _, failed := freight.Status.FailedIn[stage.Name]; failed {
+			continue
+		}
+		target = freight
+		break
+	}
+
+	// The failed Freight is marked as such first so that this happens even if
+	// the rollback itself cannot proceed.
+	if err := r.failFreightInStageFn(
+		ctx,
+		stage.Namespace,
+		failedFreightName,
+		stage.Name,
+	); err != nil {
+		return "", err
+	}
+
+	if target == nil {
+		logger.Debug("found no previously verified Freight to roll back to")
+		return fmt.Sprintf(
+			"Freight %q failed verification and no previously verified Freight "+
+				"was found to roll back to",
+			failedFreightName,
+		), nil
+	}
+
+	reason, err := r.getRollbackBlocker(ctx, stage, target)
+	if err != nil {
+		return "", err
+	}
+	if reason != "" {
+		logger.WithField("target", target.Name).
+			Debugf("rollback is not permitted: %s", reason)
+		return fmt.Sprintf(
+			"Freight %q failed verification, but rollback to Freight %q is not "+
+				"permitted: %s",
+			failedFreightName,
+			target.Name,
+			reason,
+		), nil
+	}
+
+	if err = r.createRollbackPromotion(
+		ctx,
+		stage,
+		failedFreightName,
+		target.Name,
+	); err != nil {
+		// A rejection by the admission webhook will not resolve itself by
+		// retrying, so it is reported rather than returned.
+		if apierrors.IsForbidden(err) || apierrors.IsInvalid(err) {
+			return fmt.Sprintf(
+				"Freight %q failed verification, but rollback to Freight %q was "+
+					"rejected: %s",
+				failedFreightName,
+				target.Name,
+				err,
+			), nil
+		}
+		return "", err
+	}
+	return "", nil
+}
+
+// getRollbackBlocker subjects a rollback Promotion of the specified Stage to
+// the specified Freight to the same checks as any other automatically created
+// Promotion. It returns a reason the Promotion is not permitted or, if it is
+// permitted, an empty string.
+func (r *reconciler) getRollbackBlocker(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	target *kargoapi.Freight,
+) (string, error) {
+	if stage.Status.Lock != nil {
+		return "Stage is locked", nil
+	}
+	permitted, err :=
+		r.isAutoPromotionPermittedFn(ctx, stage.Namespace, stage.Name)
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error checking if auto-promotion is permitted for Stage %q in "+
+				"namespace %q",
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+	if !permitted {
+		return "auto-promotion is not currently permitted for the Stage", nil
+	}
+	satisfied, err := r.isApprovalPolicySatisfiedFn(ctx, stage, target)
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error checking if Freight %q satisfies approval policy for Stage %q "+
+				"in namespace %q",
+			target.Name,
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+	if !satisfied {
+		return "Freight does not satisfy the Stage's approval policy", nil
+	}
+	return "", nil
+}
+
+// createRollbackPromotion creates a Promotion of the specified Stage to the
+// specified target Freight unless one has already been created since the
+// failed Freight was promoted to the Stage.
+func (r *reconciler) createRollbackPromotion(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	failedFreightName string,
+	targetFreightName string,
+) error {
+	logger := logging.LoggerFromContext(ctx).WithField("freight", targetFreightName)
+
+	// Determine when the failed Freight was most recently promoted to the Stage.
+	failedPromos, err := r.listPromosForStageAndFreight(
+		ctx,
+		stage,
+		failedFreightName,
+	)
+	if err != nil {
+		return err
+	}
+	var promotedAt metav1.Time
+	for _, promo := range failedPromos {
+		if promotedAt.Before(&promo.CreationTimestamp) {
+			promotedAt = promo.CreationTimestamp
+		}
+	}
+
+	// If a Promotion to the target Freight was created after that, the rollback
+	// has already been initiated.
+	targetPromos, err := r.listPromosForStageAndFreight(
+		ctx,
+		stage,
+		targetFreightName,
+	)
+	if err != nil {
+		return err
+	}
+	for _, promo := range targetPromos {
+		if promotedAt.Before(&promo.CreationTimestamp) {
+			logger.Debug("rollback Promotion already exists")
+			return nil
+		}
+	}
+
+	promo := kargo.NewPromotion(*stage, targetFreightName)
+	if err := r.createPromotionFn(ctx, &promo); err != nil {
+		return errors.Wrapf(
+			err,
+			"error creating Promotion of Stage %q in namespace %q to Freight %q",
+			stage.Name,
+			stage.Namespace,
+			targetFreightName,
+		)
+	}
+	logger.WithField("promotion", promo.Name).
+		Debug("created rollback Promotion resource")
+	return nil
+}
+
+func (r *reconciler) listPromosForStageAndFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freightName string,
+) ([]kargoapi.Promotion, error) {
+	promos := kargoapi.PromotionList{}
+	if err := r.listPromosFn(
+		ctx,
+		&promos,
+		&client.ListOptions{
+			Namespace: stage.Namespace,
+			FieldSelector: fields.Set(
+				map[string]string{
+					kubeclient.PromotionsByStageAndFreightIndexField: kubeclient.
+						StageAndFreightKey(stage.Name, freightName),
+				},
+			).AsSelector(),
+		},
+	); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error listing existing Promotions for Freight %q in namespace %q",
+			freightName,
+			stage.Namespace,
+		)
+	}
+	return promos.Items, nil
+}
+
+func (r *reconciler) failFreightInStage(
+	ctx context.Context,
+	namespace string,
+	freightName string,
+	stageName string,
+) error {
+	logger := logging.LoggerFromContext(ctx).WithField("freight", freightName)
+
+	freight, err := r.getFreightFn(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      freightName,
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error finding Freight %q in namespace %q",
+			freightName,
+			namespace,
+		)
+	}
+	if freight == nil {
+		return errors.Errorf(
+			"found no Freight %q in namespace %q",
+			freightName,
+			namespace,
+		)
+	}
+
+	// Only try to mark as failed in this Stage if not already the case.
+	if _, ok := freight.Status.FailedIn[stageName]; ok {
+		logger.Debug("Freight already marked as failed in Stage")
+		return nil
+	}
+
+	newStatus := *freight.Status.DeepCopy()
+	if newStatus.FailedIn == nil {
+		newStatus.FailedIn = map[string]kargoapi.FailedStage{}
+	}
+	newStatus.FailedIn[stageName] = kargoapi.FailedStage{}
+	if err = r.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
+		return err
+	}
+
+	logger.Debug("marked Freight as failed in Stage")
+	return nil
+}
+
 func (r *reconciler) patchFreightStatus(
 	ctx context.Context,
 	freight *kargoapi.Freight,
@@ -1100,12 +1501,56 @@ func (r *reconciler) isAutoPromotionPermitted(
 		if policy.Stage == stageName {
 			logger.WithField("autoPromotionEnabled", policy.AutoPromotionEnabled).
 				Debug("found PromotionPolicy associated with the Stage")
-			return policy.AutoPromotionEnabled, nil
+			if !policy.AutoPromotionEnabled {
+				return false, nil
+			}
+			permitted, msg, err := kargo.IsPromotionPermittedAt(policy.Windows, time.Now())
+			if err != nil {
+				return false, errors.Wrapf(
+					err,
+					"error evaluating promotion windows for Stage %q in namespace %q",
+					stageName,
+					namespace,
+				)
+			}
+			if !permitted {
+				logger.Debug(msg)
+			}
+			return permitted, nil
 		}
 	}
 	return false, nil
 }
 
+func (r *reconciler) isApprovalPolicySatisfied(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight *kargoapi.Freight,
+) (bool, error) {
+	project, err := r.getProjectFn(ctx, r.kargoClient, stage.Namespace)
+	if err != nil {
+		return false, errors.Wrapf(err, "error finding Project %q", stage.Namespace)
+	}
+	satisfied, msg, err := kargo.IsApprovalPolicySatisfied(
+		kargo.GetApprovalPolicy(project, stage.Name),
+		freight,
+		stage.Name,
+		time.Now(),
+	)
+	if err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error evaluating approval policy for Stage %q in namespace %q",
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+	if !satisfied {
+		logging.LoggerFromContext(ctx).Debug(msg)
+	}
+	return satisfied, nil
+}
+
 func (r *reconciler) getLatestAvailableFreight(
 	ctx context.Context,
 	namespace string,
@@ -1113,22 +1558,26 @@ func (r *reconciler) getLatestAvailableFreight(
 ) (*kargoapi.Freight, error) {
 	logger := logging.LoggerFromContext(ctx)
 
-	if stage.Spec.Subscriptions.Warehouse != "" {
+	warehouses := stage.Spec.Subscriptions.GetWarehouses()
+	if len(warehouses) > 1 {
+		return r.getNextFreightFromWarehouses(ctx, namespace, stage, warehouses)
+	}
+	if len(warehouses) == 1 {
 		latestFreight, err := r.getLatestFreightFromWarehouseFn(
 			ctx,
 			namespace,
-			stage.Spec.Subscriptions.Warehouse,
+			warehouses[0],
 		)
 		if err != nil {
 			return nil, errors.Wrapf(
 				err,
 				"error checking Warehouse %q in namespace %q for Freight",
-				stage.Spec.Subscriptions.Warehouse,
+				warehouses[0],
 				namespace,
 			)
 		}
 		if latestFreight == nil {
-			logger.WithField("warehouse", stage.Spec.Subscriptions.Warehouse).
+			logger.WithField("warehouse", warehouses[0]).
 				Debug("no Freight found from Warehouse")
 		}
 		return latestFreight, nil
@@ -1138,6 +1587,7 @@ func (r *reconciler) getLatestAvailableFreight(
 		ctx,
 		namespace,
 		stage.Spec.Subscriptions.UpstreamStages,
+		stage.Spec.Subscriptions.UpstreamStagesMode,
 	)
 	if err != nil {
 		return nil, errors.Wrapf(
@@ -1185,6 +1635,80 @@ func (r *reconciler) getLatestAvailableFreight(
 	return latestApprovedFreight, nil
 }
 
+// getNextFreightFromWarehouses treats each of the specified Warehouses as a
+// separate stream of Freight and returns the latest Freight from whichever
+// stream has been waiting longest for it to be promoted to the specified Stage.
+// The latest Freight from a Warehouse is disregarded if it is already the
+// Stage's current Freight, if it has previously failed in the Stage, if it
+// does not (yet) satisfy the Stage's approval policy, or if a Promotion of it
+// to the Stage already exists. This ensures Freight from one Warehouse can
+// never starve Freight from another.
+func (r *reconciler) getNextFreightFromWarehouses(
+	ctx context.Context,
+	namespace string,
+	stage *kargoapi.Stage,
+	warehouses []string,
+) (*kargoapi.Freight, error) {
+	var nextFreight *kargoapi.Freight
+	for _, warehouse := range warehouses {
+		logger := logging.LoggerFromContext(ctx).WithField("warehouse", warehouse)
+		latestFreight, err := r.getLatestFreightFromWarehouseFn(
+			ctx,
+			namespace,
+			warehouse,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error checking Warehouse %q in namespace %q for Freight",
+				warehouse,
+				namespace,
+			)
+		}
+		if latestFreight == nil {
+			logger.Debug("no Freight found from Warehouse")
+			continue
+		}
+		if stage.Status.CurrentFreight != nil &&
+			stage.Status.CurrentFreight.ID == latestFreight.Name {
+			logger.Debug("Stage already has latest Freight from Warehouse")
+			continue
+		}
+		if _, failed := latestFreight.Status.FailedIn[stage.Name]; failed {
+			logger.Debug("latest Freight from Warehouse has previously failed in Stage")
+			continue
+		}
+		satisfied, err := r.isApprovalPolicySatisfiedFn(ctx, stage, latestFreight)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error checking if Freight %q satisfies approval policy for Stage "+
+					"%q in namespace %q",
+				latestFreight.Name,
+				stage.Name,
+				namespace,
+			)
+		}
+		if !satisfied {
+			logger.Debug("latest Freight from Warehouse does not satisfy approval policy")
+			continue
+		}
+		promos, err := r.listPromosForStageAndFreight(ctx, stage, latestFreight.Name)
+		if err != nil {
+			return nil, err
+		}
+		if len(promos) > 0 {
+			logger.Debug("Promotion already exists for latest Freight from Warehouse")
+			continue
+		}
+		if nextFreight == nil ||
+			latestFreight.CreationTimestamp.Before(&nextFreight.CreationTimestamp) {
+			nextFreight = latestFreight
+		}
+	}
+	return nextFreight, nil
+}
+
 func (r *reconciler) getLatestFreightFromWarehouse(
 	ctx context.Context,
 	namespace string,
@@ -1224,6 +1748,7 @@ func (r *reconciler) getAllVerifiedFreight(
 	ctx context.Context,
 	namespace string,
 	stageSubs []kargoapi.StageSubscription,
+	mode kargoapi.UpstreamStagesMode,
 ) ([]kargoapi.Freight, error) {
 	// Start by building a de-duped map of Freight verified in any upstream
 	// Stage(s)
@@ -1249,6 +1774,12 @@ func (r *reconciler) getAllVerifiedFreight(
 			)
 		}
 		for _, freight := range freight.Items {
+			// Disregard Freight that is still soaking upstream or, if verification
+			// in all upstream Stages is required, that has been verified in only
+			// some of them.
+			if !kargoapi.IsFreightVerifiedUpstream(&freight, stageSubs, mode) {
+				continue
+			}
 			verifiedFreight[freight.Name] = freight
 		}
 	}
@@ -1269,9 +1800,10 @@ func (r *reconciler) getLatestVerifiedFreight(
 	ctx context.Context,
 	namespace string,
 	stageSubs []kargoapi.StageSubscription,
+	mode kargoapi.UpstreamStagesMode,
 ) (*kargoapi.Freight, error) {
 	verifiedFreight, err :=
-		r.getAllVerifiedFreightFn(ctx, namespace, stageSubs)
+		r.getAllVerifiedFreightFn(ctx, namespace, stageSubs, mode)
 	if err != nil {
 		return nil, err
 	}
@@ -2,11 +2,13 @@ package stages
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -53,8 +55,12 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, r.getFreightFn)
 	require.NotNil(t, r.verifyFreightInStageFn)
 	require.NotNil(t, r.patchFreightStatusFn)
+	// Auto-rollback:
+	require.NotNil(t, r.rollbackFn)
+	require.NotNil(t, r.failFreightInStageFn)
 	// Auto-promotion:
 	require.NotNil(t, r.isAutoPromotionPermittedFn)
+	require.NotNil(t, r.isApprovalPolicySatisfiedFn)
 	require.NotNil(t, r.getProjectFn)
 	require.NotNil(t, r.createPromotionFn)
 	// Discovering latest Freight:
@@ -70,6 +76,7 @@ func TestNewReconciler(t *testing.T) {
 }
 
 func TestSyncControlFlowStage(t *testing.T) {
+	var verifiedFreight []string
 	testCases := []struct {
 		name       string
 		stage      *kargoapi.Stage
@@ -132,6 +139,7 @@ func TestSyncControlFlowStage(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -242,6 +250,57 @@ func TestSyncControlFlowStage(t *testing.T) {
 				require.Nil(t, newStatus.Health)                          // Cleared
 			},
 		},
+		{
+			name: "success with multiple Warehouses",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-stage",
+				},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouses: []string{"fake-warehouse", "another-fake-warehouse"},
+					},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseNotApplicable,
+				},
+			},
+			reconciler: &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					opts ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					listOpts, ok := opts[0].(*client.ListOptions)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: listOpts.FieldSelector.String(),
+						},
+					}}
+					return nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					freight *kargoapi.Freight,
+					newStatus kargoapi.FreightStatus,
+				) error {
+					require.Contains(t, newStatus.VerifiedIn, "fake-stage")
+					verifiedFreight = append(verifiedFreight, freight.Name)
+					return nil
+				},
+			},
+			assertions: func(
+				_ kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, verifiedFreight, 2)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -469,6 +528,115 @@ func TestSyncNormalStage(t *testing.T) {
 			},
 		},
 
+		{
+			name: "error rolling back after failed verification",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Verification: &kargoapi.Verification{
+						AutoRollback: true,
+					},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseVerifying,
+					CurrentFreight: &kargoapi.FreightReference{
+						ID:               "fake-freight-id",
+						VerificationInfo: &kargoapi.VerificationInfo{},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.FreightReference,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return nil
+				},
+				getVerificationInfoFn: func(context.Context, *kargoapi.Stage) *kargoapi.VerificationInfo {
+					return &kargoapi.VerificationInfo{
+						Phase: kargoapi.VerificationPhaseFailed,
+					}
+				},
+				rollbackFn: func(context.Context, *kargoapi.Stage, string) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error rolling back Stage")
+				require.Equal(t, kargoapi.StagePhaseSteady, newStatus.Phase)
+			},
+		},
+
+		{
+			name: "rollback after failed verification",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Verification: &kargoapi.Verification{
+						AutoRollback: true,
+					},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseVerifying,
+					CurrentFreight: &kargoapi.FreightReference{
+						ID:               "fake-freight-id",
+						VerificationInfo: &kargoapi.VerificationInfo{},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.FreightReference,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return nil
+				},
+				getVerificationInfoFn: func(context.Context, *kargoapi.Stage) *kargoapi.VerificationInfo {
+					return &kargoapi.VerificationInfo{
+						Phase: kargoapi.VerificationPhaseError,
+					}
+				},
+				rollbackFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					failedFreightName string,
+				) (string, error) {
+					require.Equal(t, "fake-freight-id", failedFreightName)
+					return "fake-message", nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					require.FailNow(t, "auto-promotion should not be attempted")
+					return false, nil
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.StagePhaseSteady, newStatus.Phase)
+				require.Equal(t, "fake-message", newStatus.Message)
+			},
+		},
+
 		{
 			name: "error checking if auto-promotion is permitted",
 			stage: &kargoapi.Stage{
@@ -519,6 +687,55 @@ func TestSyncNormalStage(t *testing.T) {
 			},
 		},
 
+		{
+			name: "stage is locked",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.FreightReference{},
+					Lock: &kargoapi.StageLock{
+						Reason:   "fake-reason",
+						LockedBy: "fake-user",
+					},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.FreightReference,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return nil
+				},
+				verifyFreightInStageFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					// A lock should take precedence over any promotion policy
+					return true, nil
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
 		{
 			name: "auto-promotion is not permitted",
 			stage: &kargoapi.Stage{
@@ -729,7 +946,7 @@ func TestSyncNormalStage(t *testing.T) {
 		},
 
 		{
-			name: "Promotion already exists",
+			name: "latest Freight is older than current Freight",
 			stage: &kargoapi.Stage{
 				Spec: &kargoapi.StageSpec{
 					Subscriptions: &kargoapi.Subscriptions{
@@ -738,7 +955,9 @@ func TestSyncNormalStage(t *testing.T) {
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
 				Status: kargoapi.StageStatus{
-					CurrentFreight: &kargoapi.FreightReference{},
+					CurrentFreight: &kargoapi.FreightReference{
+						ID: "fake-current-freight-id",
+					},
 				},
 			},
 			reconciler: &reconciler{
@@ -767,18 +986,29 @@ func TestSyncNormalStage(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{
 						ObjectMeta: metav1.ObjectMeta{
-							Name: "fake-freight-id",
+							Name:              "fake-freight-id",
+							CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
 						},
 					}, nil
 				},
-				listPromosFn: func(
-					_ context.Context,
-					obj client.ObjectList,
-					_ ...client.ListOption,
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              "fake-current-freight-id",
+							CreationTimestamp: metav1.Now(),
+						},
+					}, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
 				) error {
-					promos, ok := obj.(*kargoapi.PromotionList)
-					require.True(t, ok)
-					promos.Items = []kargoapi.Promotion{{}}
+					require.FailNow(t, "Promotion should not be created")
 					return nil
 				},
 			},
@@ -794,7 +1024,7 @@ func TestSyncNormalStage(t *testing.T) {
 		},
 
 		{
-			name: "error creating Promotion",
+			name: "error getting current Freight",
 			stage: &kargoapi.Stage{
 				Spec: &kargoapi.StageSpec{
 					Subscriptions: &kargoapi.Subscriptions{
@@ -803,7 +1033,9 @@ func TestSyncNormalStage(t *testing.T) {
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
 				Status: kargoapi.StageStatus{
-					CurrentFreight: &kargoapi.FreightReference{},
+					CurrentFreight: &kargoapi.FreightReference{
+						ID: "fake-current-freight-id",
+					},
 				},
 			},
 			reconciler: &reconciler{
@@ -836,19 +1068,12 @@ func TestSyncNormalStage(t *testing.T) {
 						},
 					}, nil
 				},
-				listPromosFn: func(
-					context.Context,
-					client.ObjectList,
-					...client.ListOption,
-				) error {
-					return nil
-				},
-				createPromotionFn: func(
+				getFreightFn: func(
 					context.Context,
-					client.Object,
-					...client.CreateOption,
-				) error {
-					return errors.New("something went wrong")
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
 				},
 			},
 			assertions: func(
@@ -858,33 +1083,26 @@ func TestSyncNormalStage(t *testing.T) {
 			) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "something went wrong")
-				require.Contains(t, err.Error(), "error creating Promotion of Stage")
+				require.Contains(t, err.Error(), "error finding current Freight")
 				// Status should be returned unchanged
 				require.Equal(t, initialStatus, newStatus)
 			},
 		},
 
 		{
-			name: "success",
-			// Note: In this final case, we will also assert than anything that should
-			// have been cleared or modified in the Stage's status was.
+			name: "latest Freight previously failed in Stage",
 			stage: &kargoapi.Stage{
 				ObjectMeta: metav1.ObjectMeta{
-					Generation: 42,
+					Name: "fake-stage",
 				},
 				Spec: &kargoapi.StageSpec{
 					Subscriptions: &kargoapi.Subscriptions{
 						Warehouse: "fake-warehouse",
 					},
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
-					Verification:        &kargoapi.Verification{},
 				},
 				Status: kargoapi.StageStatus{
-					Phase:            kargoapi.StagePhaseVerifying,
-					CurrentPromotion: &kargoapi.PromotionInfo{},
-					CurrentFreight: &kargoapi.FreightReference{
-						VerificationInfo: &kargoapi.VerificationInfo{},
-					},
+					CurrentFreight: &kargoapi.FreightReference{},
 				},
 			},
 			reconciler: &reconciler{
@@ -894,22 +1112,7 @@ func TestSyncNormalStage(t *testing.T) {
 					kargoapi.FreightReference,
 					[]kargoapi.ArgoCDAppUpdate,
 				) *kargoapi.Health {
-					return &kargoapi.Health{
-						Status: kargoapi.HealthStateHealthy,
-					}
-				},
-				getVerificationInfoFn: func(
-					context.Context,
-					*kargoapi.Stage,
-				) *kargoapi.VerificationInfo {
-					return &kargoapi.VerificationInfo{
-						Phase: kargoapi.VerificationPhaseSuccessful,
-						AnalysisRun: &kargoapi.AnalysisRunReference{
-							Name:      "fake-analysis-run",
-							Namespace: "fake-namespace",
-							Phase:     string(rollouts.AnalysisPhaseSuccessful),
-						},
-					}
+					return nil
 				},
 				verifyFreightInStageFn: func(context.Context, string, string, string) error {
 					return nil
@@ -930,20 +1133,19 @@ func TestSyncNormalStage(t *testing.T) {
 						ObjectMeta: metav1.ObjectMeta{
 							Name: "fake-freight-id",
 						},
+						Status: kargoapi.FreightStatus{
+							FailedIn: map[string]kargoapi.FailedStage{
+								"fake-stage": {},
+							},
+						},
 					}, nil
 				},
-				listPromosFn: func(
-					context.Context,
-					client.ObjectList,
-					...client.ListOption,
-				) error {
-					return nil
-				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
 					...client.CreateOption,
 				) error {
+					require.FailNow(t, "Promotion should not be created")
 					return nil
 				},
 			},
@@ -953,7 +1155,384 @@ func TestSyncNormalStage(t *testing.T) {
 				err error,
 			) {
 				require.NoError(t, err)
-				require.Equal(t, int64(42), newStatus.ObservedGeneration) // Set
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
+		{
+			name: "latest Freight does not satisfy approval policy",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.FreightReference{},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.FreightReference,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return nil
+				},
+				verifyFreightInStageFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					*kargoapi.Stage,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				isApprovalPolicySatisfiedFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return false, nil
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
+		{
+			name: "error checking approval policy",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.FreightReference{},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.FreightReference,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return nil
+				},
+				verifyFreightInStageFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					*kargoapi.Stage,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				isApprovalPolicySatisfiedFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "satisfies approval policy")
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
+		{
+			name: "Promotion already exists",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.FreightReference{},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.FreightReference,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return nil
+				},
+				verifyFreightInStageFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					*kargoapi.Stage,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				isApprovalPolicySatisfiedFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return true, nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					obj client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := obj.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{{}}
+					return nil
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
+		{
+			name: "error creating Promotion",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					CurrentFreight: &kargoapi.FreightReference{},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.FreightReference,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return nil
+				},
+				verifyFreightInStageFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					*kargoapi.Stage,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				isApprovalPolicySatisfiedFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return true, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error creating Promotion of Stage")
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+
+		{
+			name: "success",
+			// Note: In this final case, we will also assert than anything that should
+			// have been cleared or modified in the Stage's status was.
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 42,
+				},
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Verification:        &kargoapi.Verification{},
+				},
+				Status: kargoapi.StageStatus{
+					Phase:            kargoapi.StagePhaseVerifying,
+					CurrentPromotion: &kargoapi.PromotionInfo{},
+					CurrentFreight: &kargoapi.FreightReference{
+						VerificationInfo: &kargoapi.VerificationInfo{},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				hasNonTerminalPromotionsFn: noNonTerminalPromotionsFn,
+				checkHealthFn: func(
+					context.Context,
+					kargoapi.FreightReference,
+					[]kargoapi.ArgoCDAppUpdate,
+				) *kargoapi.Health {
+					return &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					}
+				},
+				getVerificationInfoFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) *kargoapi.VerificationInfo {
+					return &kargoapi.VerificationInfo{
+						Phase: kargoapi.VerificationPhaseSuccessful,
+						AnalysisRun: &kargoapi.AnalysisRunReference{
+							Name:      "fake-analysis-run",
+							Namespace: "fake-namespace",
+							Phase:     string(rollouts.AnalysisPhaseSuccessful),
+						},
+					}
+				},
+				verifyFreightInStageFn: func(context.Context, string, string, string) error {
+					return nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getLatestAvailableFreightFn: func(
+					context.Context,
+					string,
+					*kargoapi.Stage,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				isApprovalPolicySatisfiedFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return true, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, int64(42), newStatus.ObservedGeneration) // Set
 				require.Equal(t, kargoapi.StagePhaseSteady, newStatus.Phase)
 				require.NotNil(t, newStatus.Health)        // Set
 				require.Nil(t, newStatus.CurrentPromotion) // Cleared
@@ -967,134 +1546,463 @@ func TestSyncNormalStage(t *testing.T) {
 							Namespace: "fake-namespace",
 							Phase:     string(rollouts.AnalysisPhaseSuccessful),
 						},
-					},
-					newStatus.CurrentFreight.VerificationInfo,
-				)
+					},
+					newStatus.CurrentFreight.VerificationInfo,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			newStatus, err :=
+				testCase.reconciler.syncNormalStage(context.Background(), testCase.stage)
+			testCase.assertions(testCase.stage.Status, newStatus, err)
+		})
+	}
+}
+
+func TestSyncStageDelete(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(
+			initialStatus kargoapi.StageStatus,
+			newStatus kargoapi.StageStatus,
+			err error,
+		)
+	}{
+		{
+			name: "error clearing verifications",
+			reconciler: &reconciler{
+				clearVerificationsFn: func(context.Context, *kargoapi.Stage) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(initialStatus, newStatus kargoapi.StageStatus, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error clearing verifications for Stage")
+				require.Contains(t, err.Error(), "something went wrong")
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+		{
+			name: "error clearing approvals",
+			reconciler: &reconciler{
+				clearVerificationsFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
+				clearApprovalsFn: func(context.Context, *kargoapi.Stage) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(initialStatus, newStatus kargoapi.StageStatus, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error clearing approvals for Stage")
+				require.Contains(t, err.Error(), "something went wrong")
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+		{
+			name: "success",
+			reconciler: &reconciler{
+				clearVerificationsFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
+				clearApprovalsFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
+			},
+			assertions: func(initialStatus, newStatus kargoapi.StageStatus, err error) {
+				require.NoError(t, err)
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testStage := &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Finalizers: []string{kargoapi.FinalizerName},
+				},
+			}
+			newStatus, err :=
+				testCase.reconciler.syncStageDelete(context.Background(), testStage)
+			testCase.assertions(testStage.Status, newStatus, err)
+		})
+	}
+}
+
+func TestClearVerification(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(error)
+	}{
+		{
+			name: "error listing verified Freight",
+			reconciler: &reconciler{
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error listing Freight verified in Stage")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "error patching Freight status",
+			reconciler: &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{{
+						Status: kargoapi.FreightStatus{
+							VerifiedIn: map[string]kargoapi.VerifiedStage{},
+						},
+					}}
+					return nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error patching status of Freight")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			reconciler: &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{{
+						Status: kargoapi.FreightStatus{
+							VerifiedIn: map[string]kargoapi.VerifiedStage{},
+						},
+					}}
+					return nil
+				},
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			newStatus, err :=
-				testCase.reconciler.syncNormalStage(context.Background(), testCase.stage)
-			testCase.assertions(testCase.stage.Status, newStatus, err)
+			testCase.assertions(
+				testCase.reconciler.clearVerifications(
+					context.Background(),
+					&kargoapi.Stage{},
+				),
+			)
 		})
 	}
 }
 
-func TestSyncStageDelete(t *testing.T) {
+func TestClearApprovals(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func(
-			initialStatus kargoapi.StageStatus,
-			newStatus kargoapi.StageStatus,
-			err error,
-		)
+		assertions func(error)
 	}{
 		{
-			name: "error clearing verifications",
+			name: "error listing approved Freight",
 			reconciler: &reconciler{
-				clearVerificationsFn: func(context.Context, *kargoapi.Stage) error {
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(initialStatus, newStatus kargoapi.StageStatus, err error) {
+			assertions: func(err error) {
 				require.Error(t, err)
-				require.Contains(t, err.Error(), "error clearing verifications for Stage")
+				require.Contains(t, err.Error(), "error listing Freight approved for Stage")
 				require.Contains(t, err.Error(), "something went wrong")
-				// Status should be returned unchanged
-				require.Equal(t, initialStatus, newStatus)
 			},
 		},
 		{
-			name: "error clearing approvals",
+			name: "error patching Freight status",
 			reconciler: &reconciler{
-				clearVerificationsFn: func(context.Context, *kargoapi.Stage) error {
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{{
+						Status: kargoapi.FreightStatus{
+							ApprovedFor: map[string]kargoapi.ApprovedStage{},
+						},
+					}}
 					return nil
 				},
-				clearApprovalsFn: func(context.Context, *kargoapi.Stage) error {
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(initialStatus, newStatus kargoapi.StageStatus, err error) {
+			assertions: func(err error) {
 				require.Error(t, err)
-				require.Contains(t, err.Error(), "error clearing approvals for Stage")
+				require.Contains(t, err.Error(), "error patching status of Freight")
 				require.Contains(t, err.Error(), "something went wrong")
-				// Status should be returned unchanged
-				require.Equal(t, initialStatus, newStatus)
 			},
 		},
 		{
 			name: "success",
 			reconciler: &reconciler{
-				clearVerificationsFn: func(context.Context, *kargoapi.Stage) error {
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{{
+						Status: kargoapi.FreightStatus{
+							ApprovedFor: map[string]kargoapi.ApprovedStage{},
+						},
+					}}
 					return nil
 				},
-				clearApprovalsFn: func(context.Context, *kargoapi.Stage) error {
+				patchFreightStatusFn: func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
 					return nil
 				},
 			},
-			assertions: func(initialStatus, newStatus kargoapi.StageStatus, err error) {
+			assertions: func(err error) {
 				require.NoError(t, err)
-				// Status should be returned unchanged
-				require.Equal(t, initialStatus, newStatus)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testStage := &kargoapi.Stage{
-				ObjectMeta: metav1.ObjectMeta{
-					Finalizers: []string{kargoapi.FinalizerName},
+			testCase.assertions(
+				testCase.reconciler.clearApprovals(
+					context.Background(),
+					&kargoapi.Stage{},
+				),
+			)
+		})
+	}
+}
+
+func TestHasNonTerminalPromotions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(bool, error)
+	}{
+		{
+			name: "error listing Promotions",
+			reconciler: &reconciler{
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
 				},
-			}
-			newStatus, err :=
-				testCase.reconciler.syncStageDelete(context.Background(), testStage)
-			testCase.assertions(testStage.Status, newStatus, err)
+			},
+			assertions: func(_ bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "has non-terminal Promotions",
+			reconciler: &reconciler{
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{{}}
+					return nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.True(t, result)
+			},
+		},
+		{
+			name: "does not have non-terminal Promotions",
+			reconciler: &reconciler{
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{}
+					return nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := testCase.reconciler.hasNonTerminalPromotions(
+				context.Background(),
+				"fake-namespace",
+				"fake-stage",
+			)
+			testCase.assertions(result, err)
 		})
 	}
 }
 
-func TestClearVerification(t *testing.T) {
+func TestVerifyFreightInStage(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
 		assertions func(error)
 	}{
 		{
-			name: "error listing verified Freight",
+			name: "error getting Freight",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error finding Freight")
+			},
+		},
+		{
+			name: "Freight not found",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "found no Freight")
+			},
+		},
+		{
+			name: "Freight already verified in Stage",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							VerifiedIn: map[string]kargoapi.VerifiedStage{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Freight verified in Stage after previously failing",
 			reconciler: &reconciler{
-				listFreightFn: func(
+				getFreightFn: func(
 					context.Context,
-					client.ObjectList,
-					...client.ListOption,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Status: kargoapi.FreightStatus{
+							VerifiedIn: map[string]kargoapi.VerifiedStage{
+								"fake-stage": {},
+							},
+							FailedIn: map[string]kargoapi.FailedStage{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+				patchFreightStatusFn: func(
+					_ context.Context,
+					_ *kargoapi.Freight,
+					newStatus kargoapi.FreightStatus,
 				) error {
-					return errors.New("something went wrong")
+					require.Contains(t, newStatus.VerifiedIn, "fake-stage")
+					require.NotNil(t, newStatus.VerifiedIn["fake-stage"].VerifiedAt)
+					require.NotContains(t, newStatus.FailedIn, "fake-stage")
+					return nil
 				},
 			},
 			assertions: func(err error) {
-				require.Error(t, err)
-				require.Contains(t, err.Error(), "error listing Freight verified in Stage")
-				require.Contains(t, err.Error(), "something went wrong")
+				require.NoError(t, err)
 			},
 		},
 		{
-			name: "error patching Freight status",
+			name: "error Patching Freight status",
 			reconciler: &reconciler{
-				listFreightFn: func(
-					_ context.Context,
-					objList client.ObjectList,
-					_ ...client.ListOption,
-				) error {
-					freight, ok := objList.(*kargoapi.FreightList)
-					require.True(t, ok)
-					freight.Items = []kargoapi.Freight{{
-						Status: kargoapi.FreightStatus{
-							VerifiedIn: map[string]kargoapi.VerifiedStage{},
-						},
-					}}
-					return nil
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
 				},
 				patchFreightStatusFn: func(
 					context.Context,
@@ -1106,26 +2014,18 @@ func TestClearVerification(t *testing.T) {
 			},
 			assertions: func(err error) {
 				require.Error(t, err)
-				require.Contains(t, err.Error(), "error patching status of Freight")
 				require.Contains(t, err.Error(), "something went wrong")
 			},
 		},
 		{
 			name: "success",
 			reconciler: &reconciler{
-				listFreightFn: func(
-					_ context.Context,
-					objList client.ObjectList,
-					_ ...client.ListOption,
-				) error {
-					freight, ok := objList.(*kargoapi.FreightList)
-					require.True(t, ok)
-					freight.Items = []kargoapi.Freight{{
-						Status: kargoapi.FreightStatus{
-							VerifiedIn: map[string]kargoapi.VerifiedStage{},
-						},
-					}}
-					return nil
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
 				},
 				patchFreightStatusFn: func(
 					context.Context,
@@ -1143,185 +2043,417 @@ func TestClearVerification(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
-				testCase.reconciler.clearVerifications(
+				testCase.reconciler.verifyFreightInStage(
 					context.Background(),
-					&kargoapi.Stage{},
+					"fake-namespace",
+					"fake-freight",
+					"fake-stage",
 				),
 			)
 		})
 	}
 }
 
-func TestClearApprovals(t *testing.T) {
+func TestRollback(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Status: kargoapi.StageStatus{
+			History: kargoapi.FreightReferenceStack{
+				{ID: "failed-freight"},
+				{ID: "unverified-freight"},
+				{ID: "verified-freight"},
+			},
+		},
+	}
+	getFreightFn := func(
+		_ context.Context,
+		_ client.Client,
+		key types.NamespacedName,
+	) (*kargoapi.Freight, error) {
+		freight := &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: key.Namespace,
+				Name:      key.Name,
+			},
+		}
+		if key.Name == "verified-freight" {
+			freight.Status.VerifiedIn = map[string]kargoapi.VerifiedStage{
+				"fake-stage": {},
+			}
+		}
+		return freight, nil
+	}
+	failFreightInStageFn := func(context.Context, string, string, string) error {
+		return nil
+	}
+	isAutoPromotionPermittedFn := func(context.Context, string, string) (bool, error) {
+		return true, nil
+	}
+	isApprovalPolicySatisfiedFn := func(
+		context.Context,
+		*kargoapi.Stage,
+		*kargoapi.Freight,
+	) (bool, error) {
+		return true, nil
+	}
+	now := time.Now()
 	testCases := []struct {
 		name       string
+		stage      *kargoapi.Stage
 		reconciler *reconciler
-		assertions func(error)
+		assertions func(string, error)
 	}{
 		{
-			name: "error listing approved Freight",
+			name: "error getting Freight",
 			reconciler: &reconciler{
-				listFreightFn: func(
+				getFreightFn: func(
 					context.Context,
-					client.ObjectList,
-					...client.ListOption,
-				) error {
-					return errors.New("something went wrong")
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(_ string, err error) {
 				require.Error(t, err)
-				require.Contains(t, err.Error(), "error listing Freight approved for Stage")
 				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error finding Freight")
 			},
 		},
 		{
-			name: "error patching Freight status",
+			name: "no verified Freight to roll back to",
 			reconciler: &reconciler{
-				listFreightFn: func(
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					require.FailNow(t, "Promotion should not be created")
+					return nil
+				},
+				failFreightInStageFn: func(
 					_ context.Context,
-					objList client.ObjectList,
-					_ ...client.ListOption,
+					_ string,
+					freightName string,
+					_ string,
 				) error {
-					freight, ok := objList.(*kargoapi.FreightList)
-					require.True(t, ok)
-					freight.Items = []kargoapi.Freight{{
-						Status: kargoapi.FreightStatus{
-							ApprovedFor: map[string]kargoapi.ApprovedStage{},
-						},
-					}}
+					require.Equal(t, "failed-freight", freightName)
 					return nil
 				},
-				patchFreightStatusFn: func(
+			},
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, msg, "no previously verified Freight")
+			},
+		},
+		{
+			name: "error marking Freight as failed in Stage",
+			reconciler: &reconciler{
+				getFreightFn: getFreightFn,
+				failFreightInStageFn: func(context.Context, string, string, string) error {
+					return errors.New("something went wrong")
+				},
+				createPromotionFn: func(
 					context.Context,
-					*kargoapi.Freight,
-					kargoapi.FreightStatus,
+					client.Object,
+					...client.CreateOption,
 				) error {
-					return errors.New("something went wrong")
+					require.FailNow(t, "Promotion should not be created")
+					return nil
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(_ string, err error) {
 				require.Error(t, err)
-				require.Contains(t, err.Error(), "error patching status of Freight")
 				require.Contains(t, err.Error(), "something went wrong")
 			},
 		},
 		{
-			name: "success",
+			name: "Stage is locked",
+			stage: func() *kargoapi.Stage {
+				stage := testStage.DeepCopy()
+				stage.Status.Lock = &kargoapi.StageLock{}
+				return stage
+			}(),
 			reconciler: &reconciler{
-				listFreightFn: func(
-					_ context.Context,
-					objList client.ObjectList,
-					_ ...client.ListOption,
+				getFreightFn:         getFreightFn,
+				failFreightInStageFn: failFreightInStageFn,
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
 				) error {
-					freight, ok := objList.(*kargoapi.FreightList)
-					require.True(t, ok)
-					freight.Items = []kargoapi.Freight{{
-						Status: kargoapi.FreightStatus{
-							ApprovedFor: map[string]kargoapi.ApprovedStage{},
-						},
-					}}
+					require.FailNow(t, "Promotion should not be created")
 					return nil
 				},
-				patchFreightStatusFn: func(
+			},
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, msg, "Stage is locked")
+			},
+		},
+		{
+			name: "error checking if auto-promotion is permitted",
+			reconciler: &reconciler{
+				getFreightFn:         getFreightFn,
+				failFreightInStageFn: failFreightInStageFn,
+				isAutoPromotionPermittedFn: func(
 					context.Context,
-					*kargoapi.Freight,
-					kargoapi.FreightStatus,
+					string,
+					string,
+				) (bool, error) {
+					return false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error checking if auto-promotion")
+			},
+		},
+		{
+			name: "auto-promotion not permitted",
+			reconciler: &reconciler{
+				getFreightFn:         getFreightFn,
+				failFreightInStageFn: failFreightInStageFn,
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
 				) error {
+					require.FailNow(t, "Promotion should not be created")
 					return nil
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(msg string, err error) {
 				require.NoError(t, err)
+				require.Contains(t, msg, "auto-promotion is not currently permitted")
+			},
+		},
+		{
+			name: "approval policy not satisfied",
+			reconciler: &reconciler{
+				getFreightFn:               getFreightFn,
+				failFreightInStageFn:       failFreightInStageFn,
+				isAutoPromotionPermittedFn: isAutoPromotionPermittedFn,
+				isApprovalPolicySatisfiedFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					freight *kargoapi.Freight,
+				) (bool, error) {
+					require.Equal(t, "verified-freight", freight.Name)
+					return false, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					require.FailNow(t, "Promotion should not be created")
+					return nil
+				},
+			},
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, msg, "approval policy")
 			},
 		},
-	}
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				testCase.reconciler.clearApprovals(
-					context.Background(),
-					&kargoapi.Stage{},
-				),
-			)
-		})
-	}
-}
-
-func TestHasNonTerminalPromotions(t *testing.T) {
-	testCases := []struct {
-		name       string
-		reconciler *reconciler
-		assertions func(bool, error)
-	}{
 		{
 			name: "error listing Promotions",
 			reconciler: &reconciler{
+				getFreightFn:                getFreightFn,
+				failFreightInStageFn:        failFreightInStageFn,
+				isAutoPromotionPermittedFn:  isAutoPromotionPermittedFn,
+				isApprovalPolicySatisfiedFn: isApprovalPolicySatisfiedFn,
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error listing existing Promotions")
+			},
+		},
+		{
+			name: "rollback Promotion already exists",
+			reconciler: func() *reconciler {
+				// The first list is of Promotions to the failed Freight; the second is
+				// of Promotions to the Freight being rolled back to.
+				promoTimes := []time.Time{now.Add(-time.Hour), now}
+				return &reconciler{
+					getFreightFn:                getFreightFn,
+					failFreightInStageFn:        failFreightInStageFn,
+					isAutoPromotionPermittedFn:  isAutoPromotionPermittedFn,
+					isApprovalPolicySatisfiedFn: isApprovalPolicySatisfiedFn,
+					listPromosFn: func(
+						_ context.Context,
+						obj client.ObjectList,
+						_ ...client.ListOption,
+					) error {
+						promos, ok := obj.(*kargoapi.PromotionList)
+						require.True(t, ok)
+						promos.Items = []kargoapi.Promotion{{
+							ObjectMeta: metav1.ObjectMeta{
+								CreationTimestamp: metav1.NewTime(promoTimes[0]),
+							},
+						}}
+						promoTimes = promoTimes[1:]
+						return nil
+					},
+					createPromotionFn: func(
+						context.Context,
+						client.Object,
+						...client.CreateOption,
+					) error {
+						require.FailNow(t, "Promotion should not be created")
+						return nil
+					},
+				}
+			}(),
+			assertions: func(_ string, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error creating Promotion",
+			reconciler: &reconciler{
+				getFreightFn:                getFreightFn,
+				failFreightInStageFn:        failFreightInStageFn,
+				isAutoPromotionPermittedFn:  isAutoPromotionPermittedFn,
+				isApprovalPolicySatisfiedFn: isApprovalPolicySatisfiedFn,
 				listPromosFn: func(
 					context.Context,
 					client.ObjectList,
 					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
 				) error {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(_ bool, err error) {
+			assertions: func(_ string, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error creating Promotion")
 			},
 		},
 		{
-			name: "has non-terminal Promotions",
+			name: "Promotion rejected by webhook",
 			reconciler: &reconciler{
+				getFreightFn:                getFreightFn,
+				failFreightInStageFn:        failFreightInStageFn,
+				isAutoPromotionPermittedFn:  isAutoPromotionPermittedFn,
+				isApprovalPolicySatisfiedFn: isApprovalPolicySatisfiedFn,
 				listPromosFn: func(
-					_ context.Context,
-					objList client.ObjectList,
-					_ ...client.ListOption,
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
 				) error {
-					promos, ok := objList.(*kargoapi.PromotionList)
-					require.True(t, ok)
-					promos.Items = []kargoapi.Promotion{{}}
 					return nil
 				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return apierrors.NewForbidden(
+						kargoapi.GroupVersion.WithResource("promotions").GroupResource(),
+						"fake-promotion",
+						errors.New("something went wrong"),
+					)
+				},
 			},
-			assertions: func(result bool, err error) {
+			assertions: func(msg string, err error) {
 				require.NoError(t, err)
-				require.True(t, result)
+				require.Contains(t, msg, "was rejected")
+				require.Contains(t, msg, "something went wrong")
 			},
 		},
 		{
-			name: "does not have non-terminal Promotions",
+			name: "success",
 			reconciler: &reconciler{
+				getFreightFn:                getFreightFn,
+				isAutoPromotionPermittedFn:  isAutoPromotionPermittedFn,
+				isApprovalPolicySatisfiedFn: isApprovalPolicySatisfiedFn,
 				listPromosFn: func(
 					_ context.Context,
-					objList client.ObjectList,
+					obj client.ObjectList,
 					_ ...client.ListOption,
 				) error {
-					promos, ok := objList.(*kargoapi.PromotionList)
+					return nil
+				},
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					promo, ok := obj.(*kargoapi.Promotion)
 					require.True(t, ok)
-					promos.Items = []kargoapi.Promotion{}
+					require.Equal(t, "fake-stage", promo.Spec.Stage)
+					require.Equal(t, "verified-freight", promo.Spec.Freight)
+					return nil
+				},
+				failFreightInStageFn: func(
+					_ context.Context,
+					_ string,
+					freightName string,
+					stageName string,
+				) error {
+					require.Equal(t, "failed-freight", freightName)
+					require.Equal(t, "fake-stage", stageName)
 					return nil
 				},
 			},
-			assertions: func(result bool, err error) {
+			assertions: func(msg string, err error) {
 				require.NoError(t, err)
-				require.False(t, result)
+				require.Empty(t, msg)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			result, err := testCase.reconciler.hasNonTerminalPromotions(
-				context.Background(),
-				"fake-namespace",
-				"fake-stage",
+			stage := testCase.stage
+			if stage == nil {
+				stage = testStage
+			}
+			testCase.assertions(
+				testCase.reconciler.rollback(
+					context.Background(),
+					stage,
+					"failed-freight",
+				),
 			)
-			testCase.assertions(result, err)
 		})
 	}
 }
 
-func TestVerifyFreightInStage(t *testing.T) {
+func TestFailFreightInStage(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
@@ -1361,7 +2493,7 @@ func TestVerifyFreightInStage(t *testing.T) {
 			},
 		},
 		{
-			name: "Freight already verified in Stage",
+			name: "Freight already failed in Stage",
 			reconciler: &reconciler{
 				getFreightFn: func(
 					context.Context,
@@ -1370,7 +2502,7 @@ func TestVerifyFreightInStage(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{
 						Status: kargoapi.FreightStatus{
-							VerifiedIn: map[string]kargoapi.VerifiedStage{
+							FailedIn: map[string]kargoapi.FailedStage{
 								"fake-stage": {},
 							},
 						},
@@ -1382,7 +2514,7 @@ func TestVerifyFreightInStage(t *testing.T) {
 			},
 		},
 		{
-			name: "error Patching Freight status",
+			name: "error patching Freight status",
 			reconciler: &reconciler{
 				getFreightFn: func(
 					context.Context,
@@ -1415,10 +2547,11 @@ func TestVerifyFreightInStage(t *testing.T) {
 					return &kargoapi.Freight{}, nil
 				},
 				patchFreightStatusFn: func(
-					context.Context,
-					*kargoapi.Freight,
-					kargoapi.FreightStatus,
+					_ context.Context,
+					_ *kargoapi.Freight,
+					newStatus kargoapi.FreightStatus,
 				) error {
+					require.Contains(t, newStatus.FailedIn, "fake-stage")
 					return nil
 				},
 			},
@@ -1430,7 +2563,7 @@ func TestVerifyFreightInStage(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
-				testCase.reconciler.verifyFreightInStage(
+				testCase.reconciler.failFreightInStage(
 					context.Background(),
 					"fake-namespace",
 					"fake-freight",
@@ -1537,6 +2670,64 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 				require.True(t, result)
 			},
 		},
+		{
+			name: "not permitted during deny window",
+			reconciler: &reconciler{
+				getProjectFn: func(ctx context.Context, c client.Client, s string) (*kargoapi.Project, error) {
+					return &kargoapi.Project{
+						Spec: &kargoapi.ProjectSpec{
+							PromotionPolicies: []kargoapi.PromotionPolicy{
+								{
+									Stage:                "fake-stage",
+									AutoPromotionEnabled: true,
+									Windows: []kargoapi.PromotionWindow{
+										{
+											// Always open
+											Kind:     kargoapi.PromotionWindowKindDeny,
+											Schedule: "* * * * *",
+											Duration: "1h",
+										},
+									},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+		{
+			name: "error evaluating promotion windows",
+			reconciler: &reconciler{
+				getProjectFn: func(ctx context.Context, c client.Client, s string) (*kargoapi.Project, error) {
+					return &kargoapi.Project{
+						Spec: &kargoapi.ProjectSpec{
+							PromotionPolicies: []kargoapi.PromotionPolicy{
+								{
+									Stage:                "fake-stage",
+									AutoPromotionEnabled: true,
+									Windows: []kargoapi.PromotionWindow{
+										{
+											Kind:     kargoapi.PromotionWindowKindDeny,
+											Schedule: "bogus",
+											Duration: "1h",
+										},
+									},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error evaluating promotion windows")
+				require.False(t, result)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -1617,6 +2808,127 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 				require.NotNil(t, freight)
 			},
 		},
+		{
+			name: "error getting latest Freight from one of multiple Warehouses",
+			subs: &kargoapi.Subscriptions{
+				Warehouses: []string{"fake-warehouse", "another-fake-warehouse"},
+			},
+			reconciler: &reconciler{
+				getLatestFreightFromWarehouseFn: func(
+					context.Context,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *kargoapi.Freight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error checking Warehouse")
+			},
+		},
+		{
+			name: "error checking approval policy for Freight from multiple Warehouses",
+			subs: &kargoapi.Subscriptions{
+				Warehouses: []string{"fake-warehouse", "another-fake-warehouse"},
+			},
+			reconciler: &reconciler{
+				getLatestFreightFromWarehouseFn: func(
+					context.Context,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isApprovalPolicySatisfiedFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Freight,
+				) (bool, error) {
+					return false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *kargoapi.Freight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "satisfies approval policy")
+			},
+		},
+		{
+			name: "success getting next Freight from multiple Warehouses",
+			subs: &kargoapi.Subscriptions{
+				Warehouses: []string{
+					"promoted-warehouse",
+					"failed-warehouse",
+					"unapproved-warehouse",
+					"newer-warehouse",
+					"older-warehouse",
+					"empty-warehouse",
+				},
+			},
+			reconciler: &reconciler{
+				getLatestFreightFromWarehouseFn: func(
+					_ context.Context,
+					_ string,
+					warehouse string,
+				) (*kargoapi.Freight, error) {
+					if warehouse == "empty-warehouse" {
+						return nil, nil
+					}
+					freight := &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              warehouse + "-freight",
+							CreationTimestamp: metav1.NewTime(now),
+						},
+					}
+					switch warehouse {
+					case "promoted-warehouse", "unapproved-warehouse":
+						freight.CreationTimestamp =
+							metav1.NewTime(now.Add(-3 * time.Hour))
+					case "failed-warehouse":
+						freight.CreationTimestamp =
+							metav1.NewTime(now.Add(-3 * time.Hour))
+						freight.Status.FailedIn = map[string]kargoapi.FailedStage{
+							"fake-stage": {},
+						}
+					case "older-warehouse":
+						freight.CreationTimestamp =
+							metav1.NewTime(now.Add(-time.Hour))
+					}
+					return freight, nil
+				},
+				isApprovalPolicySatisfiedFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					freight *kargoapi.Freight,
+				) (bool, error) {
+					return freight.Name != "unapproved-warehouse-freight", nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					opts ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					listOpts, ok := opts[0].(*client.ListOptions)
+					require.True(t, ok)
+					if strings.Contains(
+						listOpts.FieldSelector.String(),
+						"promoted-warehouse-freight",
+					) {
+						promos.Items = []kargoapi.Promotion{{}}
+					}
+					return nil
+				},
+			},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, freight)
+				require.Equal(t, "older-warehouse-freight", freight.Name)
+			},
+		},
 		{
 			name: "error getting latest Freight verified in upstream Stages",
 			subs: &kargoapi.Subscriptions{
@@ -1627,6 +2939,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -1651,6 +2964,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -1682,6 +2996,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -1708,6 +3023,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -1734,6 +3050,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -1760,6 +3077,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{
 						ObjectMeta: metav1.ObjectMeta{
@@ -1801,6 +3119,7 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{
 						ObjectMeta: metav1.ObjectMeta{
@@ -1840,6 +3159,9 @@ func TestGetLatestAvailableFreight(t *testing.T) {
 					context.Background(),
 					"fake-namespace",
 					&kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-stage",
+						},
 						Spec: &kargoapi.StageSpec{
 							Subscriptions: testCase.subs,
 						},
@@ -1943,6 +3265,8 @@ func TestGetLatestFreightFromWarehouse(t *testing.T) {
 func TestGetAllVerifiedFreight(t *testing.T) {
 	testCases := []struct {
 		name       string
+		stageSubs  []kargoapi.StageSubscription
+		mode       kargoapi.UpstreamStagesMode
 		reconciler *reconciler
 		assertions func([]kargoapi.Freight, error)
 	}{
@@ -1998,6 +3322,11 @@ func TestGetAllVerifiedFreight(t *testing.T) {
 							ObjectMeta: metav1.ObjectMeta{
 								Name: "fake-freight",
 							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {},
+								},
+							},
 						},
 						{
 							ObjectMeta: metav1.ObjectMeta{
@@ -2006,6 +3335,11 @@ func TestGetAllVerifiedFreight(t *testing.T) {
 									Time: time.Now(),
 								},
 							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"another-fake-stage": {},
+								},
+							},
 						},
 					}
 					return nil
@@ -2017,18 +3351,122 @@ func TestGetAllVerifiedFreight(t *testing.T) {
 				require.Len(t, freight, 2)
 			},
 		},
+		{
+			name: "only Freight verified in all upstream Stages",
+			mode: kargoapi.UpstreamStagesModeAll,
+			reconciler: &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage":         {},
+									"another-fake-stage": {},
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "another-fake-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {},
+								},
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(freight []kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, freight, 1)
+				require.Equal(t, "fake-freight", freight[0].Name)
+			},
+		},
+		{
+			name: "only Freight that has soaked in upstream Stages",
+			stageSubs: []kargoapi.StageSubscription{
+				{
+					Name:             "fake-stage",
+					RequiredSoakTime: "24h",
+				},
+			},
+			reconciler: &reconciler{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					recently := metav1.NewTime(time.Now().Add(-time.Hour))
+					longAgo := metav1.NewTime(time.Now().Add(-48 * time.Hour))
+					freight.Items = []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {
+										VerifiedAt: &longAgo,
+									},
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "another-fake-freight",
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{
+									"fake-stage": {
+										VerifiedAt: &recently,
+									},
+								},
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(freight []kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, freight, 1)
+				require.Equal(t, "fake-freight", freight[0].Name)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			stageSubs := testCase.stageSubs
+			if stageSubs == nil {
+				stageSubs = []kargoapi.StageSubscription{
+					{
+						Name: "fake-stage",
+					},
+					{
+						Name: "another-fake-stage",
+					},
+				}
+			}
 			testCase.assertions(
 				testCase.reconciler.getAllVerifiedFreight(
 					context.Background(),
 					"fake-namespace",
-					[]kargoapi.StageSubscription{
-						{
-							Name: "fake-stage",
-						},
-					},
+					stageSubs,
+					testCase.mode,
 				),
 			)
 		})
@@ -2048,6 +3486,7 @@ func TestGetLatestVerifiedFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -2064,6 +3503,7 @@ func TestGetLatestVerifiedFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -2080,6 +3520,7 @@ func TestGetLatestVerifiedFreight(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.StageSubscription,
+					kargoapi.UpstreamStagesMode,
 				) ([]kargoapi.Freight, error) {
 					return []kargoapi.Freight{
 						{
@@ -2116,6 +3557,7 @@ func TestGetLatestVerifiedFreight(t *testing.T) {
 					context.Background(),
 					"fake-namespace",
 					[]kargoapi.StageSubscription{},
+					kargoapi.UpstreamStagesModeAny,
 				),
 			)
 		})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
@@ -201,10 +202,14 @@ func (a *approvedFreightEventHandler) Update(
 	}
 }
 
+// getNewlyApprovedStages returns the Stages for which Freight has been newly
+// approved, including Stages for which additional approvals have been recorded,
+// since these may now satisfy a Stage's approval policy.
 func getNewlyApprovedStages(old, new *kargoapi.Freight) []string {
 	var stages []string
-	for stage := range new.Status.ApprovedFor {
-		if _, ok := old.Status.ApprovedFor[stage]; !ok {
+	for stage, approved := range new.Status.ApprovedFor {
+		oldApproved, ok := old.Status.ApprovedFor[stage]
+		if !ok || !equality.Semantic.DeepEqual(approved, oldApproved) {
 			stages = append(stages, stage)
 		}
 	}
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 )
 
 func TestAppHealthOrSyncStatusChanged(t *testing.T) {
@@ -190,3 +192,63 @@ func TestAnalysisRunPhaseChanged(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNewlyApprovedStages(t *testing.T) {
+	testCases := []struct {
+		name     string
+		old      map[string]kargoapi.ApprovedStage
+		new      map[string]kargoapi.ApprovedStage
+		expected []string
+	}{
+		{
+			name: "no changes",
+			old: map[string]kargoapi.ApprovedStage{
+				"fake-stage": {},
+			},
+			new: map[string]kargoapi.ApprovedStage{
+				"fake-stage": {},
+			},
+		},
+		{
+			name: "newly approved",
+			old:  nil,
+			new: map[string]kargoapi.ApprovedStage{
+				"fake-stage": {},
+			},
+			expected: []string{"fake-stage"},
+		},
+		{
+			name: "additional approval",
+			old: map[string]kargoapi.ApprovedStage{
+				"fake-stage": {
+					Approvals: []kargoapi.Approval{{Subject: "alice"}},
+				},
+			},
+			new: map[string]kargoapi.ApprovedStage{
+				"fake-stage": {
+					Approvals: []kargoapi.Approval{
+						{Subject: "alice"},
+						{Subject: "bob"},
+					},
+				},
+			},
+			expected: []string{"fake-stage"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				getNewlyApprovedStages(
+					&kargoapi.Freight{
+						Status: kargoapi.FreightStatus{ApprovedFor: testCase.old},
+					},
+					&kargoapi.Freight{
+						Status: kargoapi.FreightStatus{ApprovedFor: testCase.new},
+					},
+				),
+			)
+		})
+	}
+}
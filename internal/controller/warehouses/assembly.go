@@ -0,0 +1,251 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
+)
+
+// applyAssemblyRules determines whether the provided Freight, assembled from
+// the artifacts most recently selected from the provided Warehouse's
+// subscriptions, may be created according to the Warehouse's Freight assembly
+// rules. If it may not be created yet, the reason is recorded in the provided
+// WarehouseStatus.
+func (r *reconciler) applyAssemblyRules(
+	ctx context.Context,
+	warehouse *kargoapi.Warehouse,
+	freight *kargoapi.Freight,
+	status *kargoapi.WarehouseStatus,
+) (bool, error) {
+	if warehouse.Spec == nil || warehouse.Spec.FreightAssembly == nil {
+		status.PendingFreight = nil
+		return true, nil
+	}
+	rules := warehouse.Spec.FreightAssembly
+
+	// Rules only govern the creation of new Freight
+	existing, err := r.getFreightFn(
+		ctx,
+		r.client,
+		types.NamespacedName{
+			Namespace: freight.Namespace,
+			Name:      freight.Name,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	if existing != nil {
+		status.PendingFreight = nil
+		return true, nil
+	}
+
+	since := metav1.Now()
+	if status.PendingFreight != nil && status.PendingFreight.ID == freight.ID {
+		since = status.PendingFreight.Since
+	}
+
+	reason, err := r.checkAssemblyConstraints(
+		ctx,
+		warehouse.Namespace,
+		rules.Constraints,
+		freight,
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "error checking Freight assembly constraints")
+	}
+	if reason == "" {
+		if remaining := getSettleTime(rules) - time.Since(since.Time); remaining > 0 {
+			reason = fmt.Sprintf(
+				"waiting %s for artifacts to settle",
+				remaining.Round(time.Second),
+			)
+		}
+	}
+	if reason != "" {
+		status.PendingFreight = &kargoapi.PendingFreight{
+			ID:     freight.ID,
+			Since:  since,
+			Reason: reason,
+		}
+		return false, nil
+	}
+
+	status.PendingFreight = nil
+	return true, nil
+}
+
+// getSettleTime returns the settle time specified by the provided
+// FreightAssemblyRules. If no valid, positive settle time is specified, zero
+// is returned.
+func getSettleTime(rules *kargoapi.FreightAssemblyRules) time.Duration {
+	if rules == nil || rules.SettleTime == "" {
+		return 0
+	}
+	settleTime, err := time.ParseDuration(rules.SettleTime)
+	if err != nil || settleTime < 0 {
+		return 0
+	}
+	return settleTime
+}
+
+// checkAssemblyConstraints checks the provided Freight against the provided
+// FreightAssemblyConstraints. If any constraint is violated, a description of
+// the violation is returned. Otherwise, the empty string is returned.
+func (r *reconciler) checkAssemblyConstraints(
+	ctx context.Context,
+	namespace string,
+	constraints []kargoapi.FreightAssemblyConstraint,
+	freight *kargoapi.Freight,
+) (string, error) {
+	for _, constraint := range constraints {
+		var firstRef kargoapi.ArtifactVersionReference
+		var firstVersion string
+		for i, ref := range constraint.Match {
+			version, err := r.getArtifactVersion(ctx, namespace, ref, freight)
+			if err != nil {
+				return "", err
+			}
+			version = strings.TrimPrefix(version, ref.TrimPrefix)
+			if i == 0 {
+				firstRef, firstVersion = ref, version
+				continue
+			}
+			if version != firstVersion {
+				return fmt.Sprintf(
+					"%s %q of %s does not match %s %q of %s",
+					ref.Field,
+					version,
+					describeArtifact(ref),
+					firstRef.Field,
+					firstVersion,
+					describeArtifact(firstRef),
+				), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// getArtifactVersion returns the version of an artifact in the provided
+// Freight that is referenced by the provided ArtifactVersionReference.
+func (r *reconciler) getArtifactVersion(
+	ctx context.Context,
+	namespace string,
+	ref kargoapi.ArtifactVersionReference,
+	freight *kargoapi.Freight,
+) (string, error) {
+	switch ref.Field {
+	case kargoapi.ArtifactVersionFieldTag, kargoapi.ArtifactVersionFieldCommit:
+		for _, commit := range freight.Commits {
+			if commit.RepoURL != ref.RepoURL {
+				continue
+			}
+			if ref.Field == kargoapi.ArtifactVersionFieldCommit {
+				return commit.ID, nil
+			}
+			if commit.Tag == "" {
+				return "", errors.Errorf(
+					"commit selected from git repo %q is not tagged",
+					ref.RepoURL,
+				)
+			}
+			return commit.Tag, nil
+		}
+		if ref.Field == kargoapi.ArtifactVersionFieldTag {
+			for _, image := range freight.Images {
+				if image.RepoURL == ref.RepoURL {
+					return image.Tag, nil
+				}
+			}
+			for _, artifact := range freight.OCIArtifacts {
+				if artifact.RepoURL == ref.RepoURL {
+					return artifact.Tag, nil
+				}
+			}
+		}
+	case kargoapi.ArtifactVersionFieldVersion, kargoapi.ArtifactVersionFieldAppVersion:
+		for _, chart := range freight.Charts {
+			if chart.RepoURL != ref.RepoURL || chart.Name != ref.Chart {
+				continue
+			}
+			if ref.Field == kargoapi.ArtifactVersionFieldVersion {
+				return chart.Version, nil
+			}
+			return r.getChartAppVersion(ctx, namespace, chart)
+		}
+	default:
+		return "", errors.Errorf("unknown artifact version field %q", ref.Field)
+	}
+	return "", errors.Errorf(
+		"Freight includes no artifact with a %s from %s",
+		ref.Field,
+		describeArtifact(ref),
+	)
+}
+
+// getChartAppVersion obtains any credentials required to access the
+// repository of the provided Chart and uses them to retrieve the chart's
+// appVersion. The appVersion of each version of a chart is only retrieved once
+// per namespace until its cache entry expires.
+func (r *reconciler) getChartAppVersion(
+	ctx context.Context,
+	namespace string,
+	chart kargoapi.Chart,
+) (string, error) {
+	// Namespaces may have different credentials, so they do not share entries
+	cacheKey := strings.Join(
+		[]string{namespace, chart.RepoURL, chart.Name, chart.Version},
+		"\x00",
+	)
+	if entry, ok := r.chartAppVersionCache.Get(cacheKey); ok {
+		return entry.(string), nil // nolint: forcetypeassert
+	}
+	creds, ok, err :=
+		r.credentialsDB.Get(ctx, namespace, credentials.TypeHelm, chart.RepoURL)
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error obtaining credentials for chart repository %q",
+			chart.RepoURL,
+		)
+	}
+	var helmCreds *helm.Credentials
+	if ok {
+		helmCreds = &helm.Credentials{
+			Username: creds.Username,
+			Password: creds.Password,
+		}
+	}
+	appVersion, err := r.getChartAppVersionFn(
+		ctx,
+		chart.RepoURL,
+		chart.Name,
+		chart.Version,
+		helmCreds,
+	)
+	if err != nil {
+		return "", err
+	}
+	r.chartAppVersionCache.Set(cacheKey, appVersion, cache.DefaultExpiration)
+	return appVersion, nil
+}
+
+// describeArtifact returns a human-readable description of the artifact
+// referenced by the provided ArtifactVersionReference.
+func describeArtifact(ref kargoapi.ArtifactVersionReference) string {
+	if ref.Chart != "" {
+		return fmt.Sprintf("chart %q from %q", ref.Chart, ref.RepoURL)
+	}
+	return fmt.Sprintf("%q", ref.RepoURL)
+}
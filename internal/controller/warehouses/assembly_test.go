@@ -0,0 +1,540 @@
+package warehouses
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
+)
+
+func TestApplyAssemblyRules(t *testing.T) {
+	testFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-id",
+		},
+		ID: "fake-id",
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "fake-git-repo",
+				Tag:     "v1.0.0",
+			},
+		},
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "fake-image-repo",
+				Tag:     "1.0.0",
+			},
+		},
+	}
+	matchConstraint := kargoapi.FreightAssemblyConstraint{
+		Match: []kargoapi.ArtifactVersionReference{
+			{
+				RepoURL:    "fake-git-repo",
+				Field:      kargoapi.ArtifactVersionFieldTag,
+				TrimPrefix: "v",
+			},
+			{
+				RepoURL: "fake-image-repo",
+				Field:   kargoapi.ArtifactVersionFieldTag,
+			},
+		},
+	}
+	noFreightFn := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Freight, error) {
+		return nil, nil
+	}
+	testCases := []struct {
+		name       string
+		rules      *kargoapi.FreightAssemblyRules
+		status     kargoapi.WarehouseStatus
+		reconciler *reconciler
+		assertions func(bool, kargoapi.WarehouseStatus, error)
+	}{
+		{
+			name: "no rules",
+			status: kargoapi.WarehouseStatus{
+				PendingFreight: &kargoapi.PendingFreight{ID: "fake-id"},
+			},
+			reconciler: &reconciler{},
+			assertions: func(ok bool, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Nil(t, status.PendingFreight)
+			},
+		},
+		{
+			name:  "error getting Freight",
+			rules: &kargoapi.FreightAssemblyRules{},
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(ok bool, _ kargoapi.WarehouseStatus, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.False(t, ok)
+			},
+		},
+		{
+			name: "Freight already exists",
+			rules: &kargoapi.FreightAssemblyRules{
+				SettleTime: "1h",
+			},
+			status: kargoapi.WarehouseStatus{
+				PendingFreight: &kargoapi.PendingFreight{ID: "fake-id"},
+			},
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+			},
+			assertions: func(ok bool, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Nil(t, status.PendingFreight)
+			},
+		},
+		{
+			name: "constraint violated",
+			rules: &kargoapi.FreightAssemblyRules{
+				Constraints: []kargoapi.FreightAssemblyConstraint{
+					{
+						Match: []kargoapi.ArtifactVersionReference{
+							{
+								RepoURL: "fake-git-repo",
+								Field:   kargoapi.ArtifactVersionFieldTag,
+							},
+							{
+								RepoURL: "fake-image-repo",
+								Field:   kargoapi.ArtifactVersionFieldTag,
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				getFreightFn: noFreightFn,
+			},
+			assertions: func(ok bool, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.NotNil(t, status.PendingFreight)
+				require.Equal(t, "fake-id", status.PendingFreight.ID)
+				require.Contains(t, status.PendingFreight.Reason, "does not match")
+			},
+		},
+		{
+			name: "error checking constraints",
+			rules: &kargoapi.FreightAssemblyRules{
+				Constraints: []kargoapi.FreightAssemblyConstraint{
+					{
+						Match: []kargoapi.ArtifactVersionReference{
+							{
+								RepoURL: "fake-chart-repo",
+								Chart:   "fake-chart",
+								Field:   kargoapi.ArtifactVersionFieldVersion,
+							},
+							{
+								RepoURL: "fake-image-repo",
+								Field:   kargoapi.ArtifactVersionFieldTag,
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				getFreightFn: noFreightFn,
+			},
+			assertions: func(ok bool, _ kargoapi.WarehouseStatus, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "Freight includes no artifact")
+				require.False(t, ok)
+			},
+		},
+		{
+			name: "waiting to settle",
+			rules: &kargoapi.FreightAssemblyRules{
+				SettleTime:  "1h",
+				Constraints: []kargoapi.FreightAssemblyConstraint{matchConstraint},
+			},
+			status: kargoapi.WarehouseStatus{
+				PendingFreight: &kargoapi.PendingFreight{
+					ID:    "fake-id",
+					Since: metav1.NewTime(time.Now().Add(-time.Minute)),
+				},
+			},
+			reconciler: &reconciler{
+				getFreightFn: noFreightFn,
+			},
+			assertions: func(ok bool, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.NotNil(t, status.PendingFreight)
+				require.Contains(t, status.PendingFreight.Reason, "settle")
+				// Since is retained because the pending Freight hasn't changed
+				require.True(
+					t,
+					time.Since(status.PendingFreight.Since.Time) >= time.Minute,
+				)
+			},
+		},
+		{
+			name: "different Freight pending",
+			rules: &kargoapi.FreightAssemblyRules{
+				SettleTime: "1h",
+			},
+			status: kargoapi.WarehouseStatus{
+				PendingFreight: &kargoapi.PendingFreight{
+					ID:    "other-id",
+					Since: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+				},
+			},
+			reconciler: &reconciler{
+				getFreightFn: noFreightFn,
+			},
+			assertions: func(ok bool, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.NotNil(t, status.PendingFreight)
+				require.Equal(t, "fake-id", status.PendingFreight.ID)
+				require.True(
+					t,
+					time.Since(status.PendingFreight.Since.Time) < time.Minute,
+				)
+			},
+		},
+		{
+			name: "settled",
+			rules: &kargoapi.FreightAssemblyRules{
+				SettleTime:  "1h",
+				Constraints: []kargoapi.FreightAssemblyConstraint{matchConstraint},
+			},
+			status: kargoapi.WarehouseStatus{
+				PendingFreight: &kargoapi.PendingFreight{
+					ID:    "fake-id",
+					Since: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+				},
+			},
+			reconciler: &reconciler{
+				getFreightFn: noFreightFn,
+			},
+			assertions: func(ok bool, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Nil(t, status.PendingFreight)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status := *testCase.status.DeepCopy()
+			ok, err := testCase.reconciler.applyAssemblyRules(
+				context.Background(),
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+					},
+					Spec: &kargoapi.WarehouseSpec{
+						FreightAssembly: testCase.rules,
+					},
+				},
+				testFreight,
+				&status,
+			)
+			testCase.assertions(ok, status, err)
+		})
+	}
+}
+
+func TestGetChartAppVersionCached(t *testing.T) {
+	var calls int
+	var err error
+	r := &reconciler{
+		credentialsDB:        &credentials.FakeDB{},
+		chartAppVersionCache: cache.New(time.Hour, time.Hour),
+		getChartAppVersionFn: func(
+			context.Context,
+			string,
+			string,
+			string,
+			*helm.Credentials,
+		) (string, error) {
+			calls++
+			return "1.0.0", err
+		},
+	}
+	chart := kargoapi.Chart{
+		RepoURL: "fake-chart-repo",
+		Name:    "fake-chart",
+		Version: "0.1.0",
+	}
+
+	// Errors are not cached
+	err = errors.New("something went wrong")
+	_, getErr := r.getChartAppVersion(context.Background(), "fake-namespace", chart)
+	require.ErrorContains(t, getErr, "something went wrong")
+	err = nil
+
+	for i := 0; i < 3; i++ {
+		appVersion, getErr :=
+			r.getChartAppVersion(context.Background(), "fake-namespace", chart)
+		require.NoError(t, getErr)
+		require.Equal(t, "1.0.0", appVersion)
+	}
+	require.Equal(t, 2, calls)
+
+	// Other namespaces and versions do not share the cached appVersion
+	_, getErr = r.getChartAppVersion(context.Background(), "other-namespace", chart)
+	require.NoError(t, getErr)
+	chart.Version = "0.2.0"
+	_, getErr = r.getChartAppVersion(context.Background(), "fake-namespace", chart)
+	require.NoError(t, getErr)
+	require.Equal(t, 4, calls)
+}
+
+func TestGetSettleTime(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rules    *kargoapi.FreightAssemblyRules
+		expected time.Duration
+	}{
+		{
+			name: "no rules",
+		},
+		{
+			name:  "settle time not specified",
+			rules: &kargoapi.FreightAssemblyRules{},
+		},
+		{
+			name: "settle time invalid",
+			rules: &kargoapi.FreightAssemblyRules{
+				SettleTime: "bogus",
+			},
+		},
+		{
+			name: "settle time specified",
+			rules: &kargoapi.FreightAssemblyRules{
+				SettleTime: "5m",
+			},
+			expected: 5 * time.Minute,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, getSettleTime(testCase.rules))
+		})
+	}
+}
+
+func TestGetArtifactVersion(t *testing.T) {
+	testFreight := &kargoapi.Freight{
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "fake-git-repo",
+				ID:      "fake-commit",
+				Tag:     "v1.0.0",
+			},
+			{
+				RepoURL: "untagged-git-repo",
+				ID:      "fake-commit",
+			},
+		},
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "fake-image-repo",
+				Tag:     "1.0.0",
+			},
+		},
+		Charts: []kargoapi.Chart{
+			{
+				RepoURL: "fake-chart-repo",
+				Name:    "fake-chart",
+				Version: "0.1.0",
+			},
+		},
+		OCIArtifacts: []kargoapi.OCIArtifact{
+			{
+				RepoURL: "fake-artifact-repo",
+				Tag:     "2.0.0",
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		ref        kargoapi.ArtifactVersionReference
+		reconciler *reconciler
+		assertions func(string, error)
+	}{
+		{
+			name: "git tag",
+			ref: kargoapi.ArtifactVersionReference{
+				RepoURL: "fake-git-repo",
+				Field:   kargoapi.ArtifactVersionFieldTag,
+			},
+			assertions: func(version string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "v1.0.0", version)
+			},
+		},
+		{
+			name: "untagged git commit",
+			ref: kargoapi.ArtifactVersionReference{
+				RepoURL: "untagged-git-repo",
+				Field:   kargoapi.ArtifactVersionFieldTag,
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is not tagged")
+			},
+		},
+		{
+			name: "git commit",
+			ref: kargoapi.ArtifactVersionReference{
+				RepoURL: "fake-git-repo",
+				Field:   kargoapi.ArtifactVersionFieldCommit,
+			},
+			assertions: func(version string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-commit", version)
+			},
+		},
+		{
+			name: "image tag",
+			ref: kargoapi.ArtifactVersionReference{
+				RepoURL: "fake-image-repo",
+				Field:   kargoapi.ArtifactVersionFieldTag,
+			},
+			assertions: func(version string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.0.0", version)
+			},
+		},
+		{
+			name: "OCI artifact tag",
+			ref: kargoapi.ArtifactVersionReference{
+				RepoURL: "fake-artifact-repo",
+				Field:   kargoapi.ArtifactVersionFieldTag,
+			},
+			assertions: func(version string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "2.0.0", version)
+			},
+		},
+		{
+			name: "chart version",
+			ref: kargoapi.ArtifactVersionReference{
+				RepoURL: "fake-chart-repo",
+				Chart:   "fake-chart",
+				Field:   kargoapi.ArtifactVersionFieldVersion,
+			},
+			assertions: func(version string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "0.1.0", version)
+			},
+		},
+		{
+			name: "error getting chart appVersion",
+			ref: kargoapi.ArtifactVersionReference{
+				RepoURL: "fake-chart-repo",
+				Chart:   "fake-chart",
+				Field:   kargoapi.ArtifactVersionFieldAppVersion,
+			},
+			reconciler: &reconciler{
+				credentialsDB:        &credentials.FakeDB{},
+				chartAppVersionCache: cache.New(time.Hour, time.Hour),
+				getChartAppVersionFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+					*helm.Credentials,
+				) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "chart appVersion",
+			ref: kargoapi.ArtifactVersionReference{
+				RepoURL: "fake-chart-repo",
+				Chart:   "fake-chart",
+				Field:   kargoapi.ArtifactVersionFieldAppVersion,
+			},
+			reconciler: &reconciler{
+				credentialsDB:        &credentials.FakeDB{},
+				chartAppVersionCache: cache.New(time.Hour, time.Hour),
+				getChartAppVersionFn: func(
+					_ context.Context,
+					repoURL string,
+					chart string,
+					version string,
+					_ *helm.Credentials,
+				) (string, error) {
+					require.Equal(t, "fake-chart-repo", repoURL)
+					require.Equal(t, "fake-chart", chart)
+					require.Equal(t, "0.1.0", version)
+					return "1.0.0", nil
+				},
+			},
+			assertions: func(version string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.0.0", version)
+			},
+		},
+		{
+			name: "artifact not found",
+			ref: kargoapi.ArtifactVersionReference{
+				RepoURL: "fake-chart-repo",
+				Chart:   "other-chart",
+				Field:   kargoapi.ArtifactVersionFieldVersion,
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "Freight includes no artifact")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := testCase.reconciler
+			if r == nil {
+				r = &reconciler{}
+			}
+			testCase.assertions(
+				r.getArtifactVersion(
+					context.Background(),
+					"fake-namespace",
+					testCase.ref,
+					testFreight,
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package warehouses
+
+import (
+	"context"
+	"path"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+// ArtifactVersion specifies the version of the artifact to be taken from one
+// of a Warehouse's subscriptions.
+type ArtifactVersion struct {
+	// RepoURL is the URL of the subscribed repository. For a chart in a classic
+	// (HTTP/S) chart repository, the name of the chart may either be specified
+	// by the Chart field or appended to the repository URL as a path element.
+	RepoURL string
+	// Chart is the name of a chart in a classic (HTTP/S) chart repository.
+	Chart string
+	// Version is a tag or, if the subscription selects commits from a branch, a
+	// commit ID for a Git repository, a tag for an image or OCI artifact
+	// repository, or a version for a chart repository.
+	Version string
+}
+
+// AssembleFreight assembles Freight for the provided Warehouse from the
+// specified artifact versions, using any credentials available to the
+// Warehouse's namespace to verify that each of those versions exists.
+// Subscriptions for which no version is specified contribute the version the
+// Warehouse would currently select. The Freight is assigned an available alias,
+// but is not created.
+func AssembleFreight(
+	ctx context.Context,
+	kubeClient client.Client,
+	credentialsDB credentials.Database,
+	warehouse *kargoapi.Warehouse,
+	versions []ArtifactVersion,
+) (*kargoapi.Freight, error) {
+	r := newReconciler(kubeClient, credentialsDB)
+	freight, err := r.assembleFreight(ctx, warehouse, versions)
+	if err != nil {
+		return nil, err
+	}
+	alias, err := r.getAvailableFreightAliasFn(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting available Freight alias")
+	}
+	freight.Labels = map[string]string{kargoapi.AliasLabelKey: alias}
+	return freight, nil
+}
+
+func (r *reconciler) assembleFreight(
+	ctx context.Context,
+	warehouse *kargoapi.Warehouse,
+	versions []ArtifactVersion,
+) (*kargoapi.Freight, error) {
+	if warehouse.Spec == nil {
+		return nil, errors.Errorf("Warehouse %q has no subscriptions", warehouse.Name)
+	}
+	// Repositories are identified the same way they are when calculating a
+	// Freight's ID: by their URL, joined with the name of the chart, if any.
+	used := make([]bool, len(versions))
+	findVersion := func(key string) (string, bool) {
+		for i, v := range versions {
+			if path.Join(v.RepoURL, v.Chart) == key {
+				used[i] = true
+				return v.Version, true
+			}
+		}
+		return "", false
+	}
+
+	var commits []kargoapi.GitCommit
+	var images []kargoapi.Image
+	var charts []kargoapi.Chart
+	var ociArtifacts []kargoapi.OCIArtifact
+	for _, s := range warehouse.Spec.Subscriptions {
+		switch {
+		case s.Git != nil:
+			sub := *s.Git
+			var gm *gitMeta
+			var err error
+			if version, ok := findVersion(path.Join(sub.RepoURL)); ok {
+				gm, err = r.getCommit(ctx, warehouse.Namespace, sub, version)
+			} else {
+				gm, err = r.selectCommit(ctx, warehouse.Namespace, sub)
+			}
+			if err != nil {
+				return nil, err
+			}
+			commits = append(
+				commits,
+				kargoapi.GitCommit{
+					RepoURL:     sub.RepoURL,
+					ID:          gm.Commit,
+					Branch:      sub.Branch,
+					Tag:         gm.Tag,
+					Message:     gm.Message,
+					Author:      gm.Author,
+					CommittedAt: gm.CommittedAt,
+				},
+			)
+		case s.Image != nil:
+			sub := *s.Image
+			if version, ok := findVersion(path.Join(sub.RepoURL)); ok {
+				// The digest selection strategy selects exactly the image with the
+				// tag specified by the constraint, if it exists.
+				sub.ImageSelectionStrategy = kargoapi.ImageSelectionStrategyDigest
+				sub.SemverConstraint = version
+				sub.AllowTags = ""
+				sub.IgnoreTags = nil
+			}
+			img, _, err := r.selectImage(ctx, warehouse.Namespace, sub)
+			if err != nil {
+				return nil, err
+			}
+			images = append(images, *img)
+		case s.Chart != nil:
+			sub := *s.Chart
+			version, ok := findVersion(path.Join(sub.RepoURL, sub.Name))
+			if ok {
+				sub.SemverConstraint = ""
+			}
+			chart, candidates, err := r.selectChart(ctx, warehouse.Namespace, sub)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				if !slices.Contains(candidates, version) {
+					return nil, errors.Errorf(
+						"found no version %q of chart %q in repository %q",
+						version,
+						sub.Name,
+						sub.RepoURL,
+					)
+				}
+				chart.Version = version
+			}
+			charts = append(charts, *chart)
+		case s.OCIArtifact != nil:
+			sub := *s.OCIArtifact
+			if version, ok := findVersion(path.Join(sub.RepoURL)); ok {
+				// As with images, the digest selection strategy selects exactly the
+				// artifact with the tag specified by the constraint, if it exists.
+				sub.SelectionStrategy = kargoapi.OCIArtifactSelectionStrategyDigest
+				sub.SemverConstraint = version
+				sub.AllowTags = ""
+				sub.IgnoreTags = nil
+			}
+			artifact, _, err := r.selectOCIArtifact(ctx, warehouse.Namespace, sub)
+			if err != nil {
+				return nil, err
+			}
+			ociArtifacts = append(ociArtifacts, *artifact)
+		}
+	}
+	for i, v := range versions {
+		if !used[i] {
+			return nil, errors.Errorf(
+				"Warehouse %q has no subscription to repository %q",
+				warehouse.Name,
+				path.Join(v.RepoURL, v.Chart),
+			)
+		}
+	}
+	return newFreight(warehouse, commits, images, charts, ociArtifacts), nil
+}
+
+// getCommit obtains any credentials and trusted signers required by the
+// provided GitSubscription and uses them to find the specified revision of the
+// repository specified by the subscription. The revision is a commit ID if the
+// subscription selects commits from a branch and a tag otherwise.
+func (r *reconciler) getCommit(
+	ctx context.Context,
+	namespace string,
+	sub kargoapi.GitSubscription,
+	version string,
+) (*gitMeta, error) {
+	if sub.CommitSelectionStrategy == "" ||
+		sub.CommitSelectionStrategy == kargoapi.CommitSelectionStrategyNewestFromBranch {
+		creds, signers, err := r.getGitCredentialsAndSigners(ctx, namespace, sub)
+		if err != nil {
+			return nil, err
+		}
+		gm, err := r.getCommitMetaFn(ctx, sub, version, creds, signers)
+		return gm, errors.Wrapf(
+			err,
+			"error finding commit %q in git repo %q",
+			version,
+			sub.RepoURL,
+		)
+	}
+	// Any tag-based selection strategy selects exactly the specified tag, if it
+	// exists, when no other tags are allowed.
+	sub.CommitSelectionStrategy = kargoapi.CommitSelectionStrategyNewestTag
+	sub.AllowTags = "^" + regexp.QuoteMeta(version) + "$"
+	sub.IgnoreTags = nil
+	sub.SemverConstraint = ""
+	return r.selectCommit(ctx, namespace, sub)
+}
+
+// newFreight returns Freight, owned by the provided Warehouse, that references
+// the provided artifacts.
+func newFreight(
+	warehouse *kargoapi.Warehouse,
+	commits []kargoapi.GitCommit,
+	images []kargoapi.Image,
+	charts []kargoapi.Chart,
+	ociArtifacts []kargoapi.OCIArtifact,
+) *kargoapi.Freight {
+	ownerRef := metav1.NewControllerRef(
+		warehouse,
+		kargoapi.GroupVersion.WithKind("Warehouse"),
+	)
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       warehouse.Namespace,
+			OwnerReferences: []metav1.OwnerReference{*ownerRef},
+		},
+		Commits:      commits,
+		Images:       images,
+		Charts:       charts,
+		OCIArtifacts: ociArtifacts,
+	}
+	freight.UpdateID()
+	freight.ObjectMeta.Name = freight.ID
+	return freight
+}
@@ -0,0 +1,222 @@
+package warehouses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
+	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/oci"
+)
+
+func TestAssembleFreight(t *testing.T) {
+	testWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-warehouse",
+		},
+		Spec: &kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{
+				{
+					Git: &kargoapi.GitSubscription{
+						RepoURL: "https://example.com/branch.git",
+					},
+				},
+				{
+					Git: &kargoapi.GitSubscription{
+						RepoURL:                 "https://example.com/tags.git",
+						CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+						SemverConstraint:        "^2.0.0",
+					},
+				},
+				{
+					Image: &kargoapi.ImageSubscription{
+						RepoURL:          "fake-image-repo",
+						SemverConstraint: "^2.0.0",
+					},
+				},
+				{
+					Chart: &kargoapi.ChartSubscription{
+						RepoURL:          "https://charts.example.com",
+						Name:             "fake-chart",
+						SemverConstraint: "^2.0.0",
+					},
+				},
+				{
+					OCIArtifact: &kargoapi.OCIArtifactSubscription{
+						RepoURL:          "fake-artifact-repo",
+						SemverConstraint: "^2.0.0",
+					},
+				},
+			},
+		},
+	}
+	newTestReconciler := func() *reconciler {
+		return &reconciler{
+			credentialsDB: &credentials.FakeDB{},
+			selectCommitMetaFn: func(
+				_ context.Context,
+				sub kargoapi.GitSubscription,
+				_ *git.RepoCredentials,
+				_ *git.TrustedSigners,
+			) (*gitMeta, error) {
+				if sub.AllowTags != "" {
+					require.Equal(
+						t,
+						kargoapi.CommitSelectionStrategyNewestTag,
+						sub.CommitSelectionStrategy,
+					)
+					require.Equal(t, `^v1\.0\.0$`, sub.AllowTags)
+					require.Empty(t, sub.SemverConstraint)
+					return &gitMeta{Commit: "tagged-commit", Tag: "v1.0.0"}, nil
+				}
+				return &gitMeta{Commit: "head-commit"}, nil
+			},
+			getCommitMetaFn: func(
+				_ context.Context,
+				_ kargoapi.GitSubscription,
+				id string,
+				_ *git.RepoCredentials,
+				_ *git.TrustedSigners,
+			) (*gitMeta, error) {
+				return &gitMeta{Commit: id}, nil
+			},
+			getImageRefsFn: func(
+				_ context.Context,
+				_ string,
+				strategy kargoapi.ImageSelectionStrategy,
+				constraint string,
+				_ string,
+				_ []string,
+				_ string,
+				_ *image.Credentials,
+			) (string, string, []string, error) {
+				if strategy == kargoapi.ImageSelectionStrategyDigest {
+					return constraint, "fake-digest", []string{constraint}, nil
+				}
+				return "2.0.0", "fake-digest", []string{"2.0.0"}, nil
+			},
+			selectChartVersionsFn: func(
+				_ context.Context,
+				_ string,
+				_ string,
+				semverConstraint string,
+				_ *helm.Credentials,
+			) ([]string, error) {
+				if semverConstraint == "" {
+					return []string{"2.0.0", "1.0.0"}, nil
+				}
+				return []string{"2.0.0"}, nil
+			},
+			selectArtifactFn: func(
+				_ context.Context,
+				_ string,
+				strategy oci.SelectionStrategy,
+				opts *oci.SelectorOptions,
+			) (*oci.Artifact, []string, error) {
+				if strategy == oci.SelectionStrategyDigest {
+					return &oci.Artifact{Tag: opts.Constraint}, []string{opts.Constraint}, nil
+				}
+				return &oci.Artifact{Tag: "2.0.0"}, []string{"2.0.0"}, nil
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		versions   []ArtifactVersion
+		assertions func(*kargoapi.Freight, error)
+	}{
+		{
+			name: "no versions specified",
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "head-commit", freight.Commits[0].ID)
+				require.Equal(t, "2.0.0", freight.Images[0].Tag)
+				require.Equal(t, "2.0.0", freight.Charts[0].Version)
+				require.Equal(t, "2.0.0", freight.OCIArtifacts[0].Tag)
+			},
+		},
+		{
+			name: "versions specified",
+			versions: []ArtifactVersion{
+				{
+					RepoURL: "https://example.com/branch.git",
+					Version: "fake-commit",
+				},
+				{
+					RepoURL: "https://example.com/tags.git",
+					Version: "v1.0.0",
+				},
+				{
+					RepoURL: "fake-image-repo",
+					Version: "1.0.0",
+				},
+				{
+					RepoURL: "https://charts.example.com/fake-chart",
+					Version: "1.0.0",
+				},
+				{
+					RepoURL: "fake-artifact-repo",
+					Version: "1.0.0",
+				},
+			},
+			assertions: func(freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-namespace", freight.Namespace)
+				require.NotEmpty(t, freight.ID)
+				require.Equal(t, freight.ID, freight.Name)
+				require.Len(t, freight.OwnerReferences, 1)
+				require.Equal(t, "fake-commit", freight.Commits[0].ID)
+				require.Equal(t, "tagged-commit", freight.Commits[1].ID)
+				require.Equal(t, "v1.0.0", freight.Commits[1].Tag)
+				require.Equal(t, "1.0.0", freight.Images[0].Tag)
+				require.Equal(t, "1.0.0", freight.Charts[0].Version)
+				require.Equal(t, "1.0.0", freight.OCIArtifacts[0].Tag)
+			},
+		},
+		{
+			name: "chart version not found",
+			versions: []ArtifactVersion{
+				{
+					RepoURL: "https://charts.example.com",
+					Chart:   "fake-chart",
+					Version: "3.0.0",
+				},
+			},
+			assertions: func(_ *kargoapi.Freight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "found no version")
+			},
+		},
+		{
+			name: "no subscription to repository",
+			versions: []ArtifactVersion{
+				{
+					RepoURL: "other-image-repo",
+					Version: "1.0.0",
+				},
+			},
+			assertions: func(_ *kargoapi.Freight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "has no subscription to repository")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				newTestReconciler().assembleFreight(
+					context.Background(),
+					testWarehouse,
+					testCase.versions,
+				),
+			)
+		})
+	}
+}
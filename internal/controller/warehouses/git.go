@@ -7,7 +7,9 @@ import (
 	"strings"
 
 	"github.com/Masterminds/semver"
+	"github.com/gobwas/glob"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
@@ -15,68 +17,151 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+const (
+	// gpgKeysSecretKey is the key in a Secret referenced by a
+	// GitSignatureVerification under which ASCII-armored GPG public keys of
+	// trusted signers are stored.
+	gpgKeysSecretKey = "gpgKeys"
+	// allowedSignersSecretKey is the key in a Secret referenced by a
+	// GitSignatureVerification under which an SSH allowed signers file is
+	// stored.
+	allowedSignersSecretKey = "allowedSigners"
+
+	// maxUnverifiedCandidates is the maximum number of candidate commits or tags
+	// whose signatures will be examined and found wanting before selection from
+	// a single subscription gives up.
+	maxUnverifiedCandidates = 20
+	// maxCommitsExamined is the maximum number of commits, starting from the
+	// head of a branch, that are examined when selecting the newest commit that
+	// satisfies a subscription's commit filters or signature requirements.
+	maxCommitsExamined = 1000
+)
+
 type gitMeta struct {
-	Commit  string
-	Tag     string
-	Message string
-	Author  string
+	Commit      string
+	Tag         string
+	Message     string
+	Author      string
+	CommittedAt *metav1.Time
+	// Unverified describes candidate revisions that would have been preferred
+	// over the selected one, but were disregarded because their signatures
+	// could not be verified.
+	Unverified []kargoapi.UnverifiedGitRevision
+	// Candidates lists the tags or, when selecting from a branch, the commit IDs
+	// that satisfied the subscription's criteria, ordered from most to least
+	// preferred.
+	Candidates []string
 }
 
-func (r *reconciler) selectCommits(
+// selectCommit obtains any credentials and trusted signers required by the
+// provided GitSubscription and uses them to select an appropriate revision of
+// the repository specified by the subscription.
+func (r *reconciler) selectCommit(
 	ctx context.Context,
 	namespace string,
-	subs []kargoapi.RepoSubscription,
-) ([]kargoapi.GitCommit, error) {
-	latestCommits := make([]kargoapi.GitCommit, 0, len(subs))
-	for _, s := range subs {
-		if s.Git == nil {
-			continue
-		}
-		sub := s.Git
-		logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
-		creds, ok, err :=
-			r.credentialsDB.Get(ctx, namespace, credentials.TypeGit, sub.RepoURL)
-		if err != nil {
-			return nil, errors.Wrapf(
-				err,
-				"error obtaining credentials for git repo %q",
-				sub.RepoURL,
-			)
-		}
-		var repoCreds *git.RepoCredentials
-		if ok {
-			repoCreds = &git.RepoCredentials{
-				Username:      creds.Username,
-				Password:      creds.Password,
-				SSHPrivateKey: creds.SSHPrivateKey,
-			}
-			logger.Debug("obtained credentials for git repo")
-		} else {
-			logger.Debug("found no credentials for git repo")
+	sub kargoapi.GitSubscription,
+) (*gitMeta, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
+	repoCreds, signers, err := r.getGitCredentialsAndSigners(ctx, namespace, sub)
+	if err != nil {
+		return nil, err
+	}
+	gm, err := r.selectCommitMetaFn(ctx, sub, repoCreds, signers)
+	if err != nil {
+		return gm, errors.Wrapf(
+			err,
+			"error determining latest commit ID of git repo %q",
+			sub.RepoURL,
+		)
+	}
+	logger.WithField("commit", gm.Commit).
+		Debug("found latest commit from repo")
+	return gm, nil
+}
+
+// getGitCredentialsAndSigners obtains any credentials and trusted signers
+// required by the provided GitSubscription. Either or both may be nil.
+func (r *reconciler) getGitCredentialsAndSigners(
+	ctx context.Context,
+	namespace string,
+	sub kargoapi.GitSubscription,
+) (*git.RepoCredentials, *git.TrustedSigners, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
+	creds, ok, err :=
+		r.credentialsDB.Get(ctx, namespace, credentials.TypeGit, sub.RepoURL)
+	if err != nil {
+		return nil, nil, errors.Wrapf(
+			err,
+			"error obtaining credentials for git repo %q",
+			sub.RepoURL,
+		)
+	}
+	var repoCreds *git.RepoCredentials
+	if ok {
+		repoCreds = &git.RepoCredentials{
+			Username:      creds.Username,
+			Password:      creds.Password,
+			SSHPrivateKey: creds.SSHPrivateKey,
 		}
+		logger.Debug("obtained credentials for git repo")
+	} else {
+		logger.Debug("found no credentials for git repo")
+	}
 
-		gm, err := r.selectCommitMetaFn(ctx, *s.Git, repoCreds)
-		if err != nil {
-			return nil, errors.Wrapf(
+	var signers *git.TrustedSigners
+	if sub.SignatureVerification != nil {
+		if signers, err = r.getTrustedSigners(
+			ctx,
+			namespace,
+			sub.SignatureVerification,
+		); err != nil {
+			return nil, nil, errors.Wrapf(
 				err,
-				"error determining latest commit ID of git repo %q",
+				"error obtaining trusted signers for git repo %q",
 				sub.RepoURL,
 			)
 		}
-		logger.WithField("commit", gm.Commit).
-			Debug("found latest commit from repo")
-		latestCommits = append(
-			latestCommits,
-			kargoapi.GitCommit{
-				RepoURL: sub.RepoURL,
-				ID:      gm.Commit,
-				Branch:  sub.Branch,
-				Tag:     gm.Tag,
-				Message: gm.Message,
-			},
+	}
+	return repoCreds, signers, nil
+}
+
+// getTrustedSigners returns the key material of trusted signers found in the
+// Secret referenced by the provided GitSignatureVerification.
+func (r *reconciler) getTrustedSigners(
+	ctx context.Context,
+	namespace string,
+	verification *kargoapi.GitSignatureVerification,
+) (*git.TrustedSigners, error) {
+	secret, err := r.getSecretFn(ctx, namespace, verification.SecretName)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error getting Secret %q in namespace %q",
+			verification.SecretName,
+			namespace,
 		)
 	}
-	return latestCommits, nil
+	if secret == nil {
+		return nil, errors.Errorf(
+			"Secret %q not found in namespace %q",
+			verification.SecretName,
+			namespace,
+		)
+	}
+	signers := &git.TrustedSigners{
+		GPGKeys:           string(secret.Data[gpgKeysSecretKey]),
+		SSHAllowedSigners: string(secret.Data[allowedSignersSecretKey]),
+	}
+	if signers.GPGKeys == "" && signers.SSHAllowedSigners == "" {
+		return nil, errors.Errorf(
+			"Secret %q in namespace %q has neither a %q nor an %q key",
+			verification.SecretName,
+			namespace,
+			gpgKeysSecretKey,
+			allowedSignersSecretKey,
+		)
+	}
+	return signers, nil
 }
 
 // selectCommitMeta uses criteria from the provided GitSubscription to select
@@ -86,6 +171,7 @@ func (r *reconciler) selectCommitMeta(
 	ctx context.Context,
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	signers *git.TrustedSigners,
 ) (*gitMeta, error) {
 	logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
 	if creds == nil {
@@ -94,71 +180,166 @@ func (r *reconciler) selectCommitMeta(
 	if sub.CommitSelectionStrategy == "" {
 		sub.CommitSelectionStrategy = kargoapi.CommitSelectionStrategyNewestFromBranch
 	}
+	// A shallow clone suffices unless commit filters or signature verification
+	// require the branch's history to be examined
+	cloneOpts := &git.CloneOptions{
+		Branch:                sub.Branch,
+		SingleBranch:          true,
+		Shallow:               !hasCommitFilters(sub) && !requiresSignedCommits(sub),
+		InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+	}
+	if sub.CommitSelectionStrategy == kargoapi.CommitSelectionStrategyNewestFromBranch {
+		// Only this much of the branch's history is ever examined. The oldest
+		// commit in such a clone reports no paths, so it never matches path
+		// filters.
+		cloneOpts.Depth = maxCommitsExamined
+	}
+	repo, err := git.Clone(sub.RepoURL, *creds, cloneOpts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error cloning git repo %q", sub.RepoURL)
+	}
+	defer repo.Close()
+	if signers != nil {
+		if err = repo.TrustSigners(*signers); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error configuring trusted signers for git repo %q",
+				sub.RepoURL,
+			)
+		}
+	}
+	gm, err := r.selectTagAndCommitID(repo, sub)
+	if err != nil {
+		return gm, errors.Wrapf(
+			err,
+			"error selecting commit from git repo %q",
+			sub.RepoURL,
+		)
+	}
+	commit, err := repo.CommitMetadata(gm.Commit)
+	if err != nil {
+		// This is best effort, so just log the error
+		logger.Warnf("failed to get metadata of commit %q: %v", gm.Commit, err)
+		return gm, nil
+	}
+	// Since we currently store commit messages in Stage status, we only capture
+	// the first line of the commit message for brevity
+	gm.Message = strings.Split(commit.Message, "\n")[0]
+	gm.Author = commit.Author()
+	committedAt := metav1.NewTime(commit.CommitDate)
+	gm.CommittedAt = &committedAt
+	return gm, nil
+}
+
+// getCommitMeta finds the specified commit on the branch of the repository
+// specified by the provided GitSubscription and returns metadata associated
+// with it. If the subscription requires signed commits, the commit's signature
+// must also be verified.
+func (r *reconciler) getCommitMeta(
+	_ context.Context,
+	sub kargoapi.GitSubscription,
+	id string,
+	creds *git.RepoCredentials,
+	signers *git.TrustedSigners,
+) (*gitMeta, error) {
+	if creds == nil {
+		creds = &git.RepoCredentials{}
+	}
+	// The branch's full history is required to find an arbitrary commit
 	repo, err := git.Clone(
 		sub.RepoURL,
 		*creds,
 		&git.CloneOptions{
 			Branch:                sub.Branch,
 			SingleBranch:          true,
-			Shallow:               true,
 			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
 		},
 	)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error cloning git repo %q", sub.RepoURL)
 	}
-	selectedTag, selectedCommit, err := r.selectTagAndCommitID(repo, sub)
+	defer repo.Close()
+	commit, err := repo.CommitMetadata(id)
 	if err != nil {
-		return nil, errors.Wrapf(
-			err,
-			"error selecting commit from git repo %q",
-			sub.RepoURL,
-		)
+		return nil, err
 	}
-	msg, err := repo.CommitMessage(selectedCommit)
+	onBranch, err := repo.IsAncestor(commit.ID, "HEAD")
 	if err != nil {
-		// This is best effort, so just log the error
-		logger.Warnf("failed to get message from commit %q: %v", selectedCommit, err)
+		return nil, err
+	}
+	if !onBranch {
+		return nil, errors.Errorf(
+			"commit %q is not on the subscribed branch",
+			commit.ID,
+		)
+	}
+	if requiresSignedCommits(sub) {
+		if signers != nil {
+			if err = repo.TrustSigners(*signers); err != nil {
+				return nil, errors.Wrapf(
+					err,
+					"error configuring trusted signers for git repo %q",
+					sub.RepoURL,
+				)
+			}
+		}
+		if err = r.verifyCommitFn(repo, commit.ID); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error verifying signature of commit %q",
+				commit.ID,
+			)
+		}
 	}
+	committedAt := metav1.NewTime(commit.CommitDate)
 	return &gitMeta{
-		Commit: selectedCommit,
-		Tag:    selectedTag,
-		// Since we currently store commit messages in Stage status, we only capture
-		// the first line of the commit message for brevity
-		Message: strings.Split(strings.TrimSpace(msg), "\n")[0],
-		// TODO: support git author
+		Commit: commit.ID,
+		// Only the first line of the commit message is captured for brevity
+		Message:     strings.Split(commit.Message, "\n")[0],
+		Author:      commit.Author(),
+		CommittedAt: &committedAt,
 	}, nil
 }
 
 // selectTagAndCommitID uses criteria from the provided GitSubscription to
-// select and return an appropriate revision of the repository also specified by
-// the subscription.
+// select an appropriate revision of the repository also specified by the
+// subscription and returns the selected tag, if any, and commit ID along with
+// the candidates the selection was made from. If the subscription requires
+// signatures to be verified, any candidate revisions that were disregarded
+// because their signatures could not be verified are also returned, even if
+// an error is also returned.
 func (r *reconciler) selectTagAndCommitID(
 	repo git.Repo,
 	sub kargoapi.GitSubscription,
-) (string, string, error) {
+) (*gitMeta, error) {
 	if sub.CommitSelectionStrategy == kargoapi.CommitSelectionStrategyNewestFromBranch {
+		if hasCommitFilters(sub) || requiresSignedCommits(sub) {
+			return r.selectNewestMatchingCommit(repo, sub)
+		}
 		// In this case, there is nothing to do except return the commit ID at the
 		// head of the branch.
 		commit, err := r.getLastCommitIDFn(repo)
-		return "", commit, errors.Wrapf(
-			err,
-			"error determining commit ID at head of branch %q in git repo %q",
-			sub.Branch,
-			sub.RepoURL,
-		)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error determining commit ID at head of branch %q in git repo %q",
+				sub.Branch,
+				sub.RepoURL,
+			)
+		}
+		return &gitMeta{Commit: commit, Candidates: []string{commit}}, nil
 	}
 
 	tags, err := r.listTagsFn(repo) // These are ordered newest to oldest
 	if err != nil {
-		return "", "",
+		return nil,
 			errors.Wrapf(err, "error listing tags from git repo %q", sub.RepoURL)
 	}
 
 	// Narrow down the list of tags to those that are allowed and not ignored
 	allowRegex, err := regexp.Compile(sub.AllowTags)
 	if err != nil {
-		return "", "",
+		return nil,
 			errors.Wrapf(err, "error compiling regular expression %q", sub.AllowTags)
 	}
 	filteredTags := make([]string, 0, len(tags))
@@ -168,49 +349,316 @@ func (r *reconciler) selectTagAndCommitID(
 		}
 	}
 	if len(filteredTags) == 0 {
-		return "", "",
-			errors.Errorf("found no applicable tags in repo %q", sub.RepoURL)
+		return nil, errors.Errorf("found no applicable tags in repo %q", sub.RepoURL)
 	}
 
-	var selectedTag string
+	// Order the candidate tags from most to least preferred
+	var candidateTags []string
 	switch sub.CommitSelectionStrategy {
 	case kargoapi.CommitSelectionStrategyLexical:
-		selectedTag = selectLexicallyLastTag(filteredTags)
+		candidateTags = sortTagsLexically(filteredTags)
 	case kargoapi.CommitSelectionStrategyNewestTag:
-		selectedTag = filteredTags[0] // These are already ordered newest to oldest
+		candidateTags = filteredTags // These are already ordered newest to oldest
 	case kargoapi.CommitSelectionStrategySemVer:
-		if selectedTag, err =
-			selectSemverTag(filteredTags, sub.SemverConstraint); err != nil {
-			return "", "", err
+		if candidateTags, err =
+			sortSemverTags(filteredTags, sub.SemverConstraint); err != nil {
+			return nil, err
 		}
 	default:
-		return "", "", errors.Errorf(
+		return nil, errors.Errorf(
 			"unknown commit selection strategy %q",
 			sub.CommitSelectionStrategy,
 		)
 	}
-	if selectedTag == "" {
-		return "", "", errors.Errorf("found no applicable tags in repo %q", sub.RepoURL)
+	if len(candidateTags) == 0 {
+		return nil, errors.Errorf("found no applicable tags in repo %q", sub.RepoURL)
+	}
+	gm := &gitMeta{Candidates: candidateTags}
+	if !requiresSignedCommits(sub) && !requiresSignedTags(sub) {
+		// Only the most preferred tag is of any interest
+		candidateTags = candidateTags[:1]
 	}
 
-	// Checkout the selected tag and return the commit ID
-	if err = r.checkoutTagFn(repo, selectedTag); err != nil {
-		return "", "", errors.Wrapf(
+	for _, selectedTag := range candidateTags {
+		if len(gm.Unverified) == maxUnverifiedCandidates {
+			break
+		}
+		if requiresSignedTags(sub) {
+			if err = r.verifyTagFn(repo, selectedTag); err != nil {
+				gm.Unverified = append(
+					gm.Unverified,
+					kargoapi.UnverifiedGitRevision{
+						RepoURL: sub.RepoURL,
+						Tag:     selectedTag,
+						Reason:  err.Error(),
+					},
+				)
+				continue
+			}
+		}
+		// Checkout the selected tag and determine the commit ID
+		if err = r.checkoutTagFn(repo, selectedTag); err != nil {
+			return gm, errors.Wrapf(
+				err,
+				"error checking out tag %q from git repo %q",
+				selectedTag,
+				sub.RepoURL,
+			)
+		}
+		commit, err := r.getLastCommitIDFn(repo)
+		if err != nil {
+			return gm, errors.Wrapf(
+				err,
+				"error determining commit ID of tag %q in git repo %q",
+				selectedTag,
+				sub.RepoURL,
+			)
+		}
+		if requiresSignedCommits(sub) {
+			if err = r.verifyCommitFn(repo, commit); err != nil {
+				gm.Unverified = append(
+					gm.Unverified,
+					kargoapi.UnverifiedGitRevision{
+						RepoURL: sub.RepoURL,
+						ID:      commit,
+						Tag:     selectedTag,
+						Reason:  err.Error(),
+					},
+				)
+				continue
+			}
+		}
+		gm.Tag = selectedTag
+		gm.Commit = commit
+		return gm, nil
+	}
+	return gm, errors.Errorf(
+		"found no applicable tags with verified signatures in repo %q; "+
+			"most recently, tag %q was disregarded because: %s",
+		sub.RepoURL,
+		gm.Unverified[0].Tag,
+		gm.Unverified[0].Reason,
+	)
+}
+
+// selectNewestMatchingCommit selects the newest commit on the current branch
+// that is selected by the path, message, and author filters of the provided
+// GitSubscription and, if required, bears a verified signature. Any newer
+// candidate commits that were disregarded because their signatures could not
+// be verified are also returned, even if an error is also returned.
+func (r *reconciler) selectNewestMatchingCommit(
+	repo git.Repo,
+	sub kargoapi.GitSubscription,
+) (*gitMeta, error) {
+	filters, err := newCommitFilters(sub)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := r.listCommitsFn(repo) // These are ordered newest to oldest
+	if err != nil {
+		return nil, errors.Wrapf(
 			err,
-			"error checking out tag %q from git repo %q",
-			selectedTag,
+			"error listing commits from branch %q in git repo %q",
+			sub.Branch,
+			sub.RepoURL,
+		)
+	}
+	gm := &gitMeta{}
+	for _, commit := range commits {
+		if filters.matches(commit) {
+			gm.Candidates = append(gm.Candidates, commit.ID)
+		}
+	}
+	if len(gm.Candidates) == 0 {
+		return nil, errors.Errorf(
+			"found no commits matching commit filters on branch %q in git repo %q",
+			sub.Branch,
 			sub.RepoURL,
 		)
 	}
-	commit, err := r.getLastCommitIDFn(repo)
-	return selectedTag, commit, errors.Wrapf(
-		err,
-		"error determining commit ID of tag %q in git repo %q",
-		selectedTag,
+	if !requiresSignedCommits(sub) {
+		gm.Commit = gm.Candidates[0]
+		return gm, nil
+	}
+	for _, commitID := range gm.Candidates {
+		if len(gm.Unverified) == maxUnverifiedCandidates {
+			break
+		}
+		if err = r.verifyCommitFn(repo, commitID); err != nil {
+			gm.Unverified = append(
+				gm.Unverified,
+				kargoapi.UnverifiedGitRevision{
+					RepoURL: sub.RepoURL,
+					ID:      commitID,
+					Reason:  err.Error(),
+				},
+			)
+			continue
+		}
+		gm.Commit = commitID
+		return gm, nil
+	}
+	return gm, errors.Errorf(
+		"found no commits with verified signatures on branch %q in git repo "+
+			"%q; most recently, commit %q was disregarded because: %s",
+		sub.Branch,
 		sub.RepoURL,
+		gm.Unverified[0].ID,
+		gm.Unverified[0].Reason,
 	)
 }
 
+// requiresSignedCommits returns true if the provided GitSubscription requires
+// the signatures of commits to be verified. It returns false otherwise.
+func requiresSignedCommits(sub kargoapi.GitSubscription) bool {
+	v := sub.SignatureVerification
+	return v != nil && v.Require != kargoapi.GitSignatureRequirementTags
+}
+
+// requiresSignedTags returns true if the provided GitSubscription requires the
+// signatures of tags to be verified. It returns false otherwise.
+func requiresSignedTags(sub kargoapi.GitSubscription) bool {
+	v := sub.SignatureVerification
+	return v != nil && (v.Require == kargoapi.GitSignatureRequirementTags ||
+		v.Require == kargoapi.GitSignatureRequirementCommitsAndTags)
+}
+
+// hasCommitFilters returns true if the provided GitSubscription specifies any
+// path, message, or author filters. It returns false otherwise.
+func hasCommitFilters(sub kargoapi.GitSubscription) bool {
+	return hasPathFilters(sub) ||
+		sub.IncludeMessages != "" || sub.ExcludeMessages != "" ||
+		len(sub.IncludeAuthors) > 0 || len(sub.ExcludeAuthors) > 0
+}
+
+// hasPathFilters returns true if the provided GitSubscription specifies any
+// include or exclude path filters. It returns false otherwise.
+func hasPathFilters(sub kargoapi.GitSubscription) bool {
+	return len(sub.IncludePaths) > 0 || len(sub.ExcludePaths) > 0
+}
+
+// commitFilters is a compiled form of the path, message, and author filters of
+// a GitSubscription.
+type commitFilters struct {
+	filterPaths     bool
+	includePaths    []glob.Glob
+	excludePaths    []glob.Glob
+	includeMessages *regexp.Regexp
+	excludeMessages *regexp.Regexp
+	includeAuthors  []string
+	excludeAuthors  []string
+}
+
+// newCommitFilters compiles the path, message, and author filters of the
+// provided GitSubscription.
+func newCommitFilters(sub kargoapi.GitSubscription) (*commitFilters, error) {
+	f := &commitFilters{
+		filterPaths:    hasPathFilters(sub),
+		includeAuthors: sub.IncludeAuthors,
+		excludeAuthors: sub.ExcludeAuthors,
+	}
+	var err error
+	if f.includePaths, err = compilePathGlobs(sub.IncludePaths); err != nil {
+		return nil, err
+	}
+	if f.excludePaths, err = compilePathGlobs(sub.ExcludePaths); err != nil {
+		return nil, err
+	}
+	if sub.IncludeMessages != "" {
+		if f.includeMessages, err = regexp.Compile(sub.IncludeMessages); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error compiling regular expression %q",
+				sub.IncludeMessages,
+			)
+		}
+	}
+	if sub.ExcludeMessages != "" {
+		if f.excludeMessages, err = regexp.Compile(sub.ExcludeMessages); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error compiling regular expression %q",
+				sub.ExcludeMessages,
+			)
+		}
+	}
+	return f, nil
+}
+
+// matches returns true if the provided commit is selected by all of the
+// filters. It returns false otherwise.
+func (f *commitFilters) matches(commit git.CommitMetadata) bool {
+	if f.includeMessages != nil && !f.includeMessages.MatchString(commit.Message) {
+		return false
+	}
+	if f.excludeMessages != nil && f.excludeMessages.MatchString(commit.Message) {
+		return false
+	}
+	if len(f.includeAuthors) > 0 && !matchesAuthor(commit, f.includeAuthors) {
+		return false
+	}
+	if matchesAuthor(commit, f.excludeAuthors) {
+		return false
+	}
+	if !f.filterPaths {
+		return true
+	}
+	for _, path := range commit.Paths {
+		if matchesPathFilters(path, f.includePaths, f.excludePaths) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAuthor returns true if the author of the provided commit is
+// identified, by name or by email address, by any of the given authors. Email
+// addresses are compared case-insensitively. It returns false otherwise.
+func matchesAuthor(commit git.CommitMetadata, authors []string) bool {
+	for _, author := range authors {
+		if author == commit.AuthorName ||
+			(commit.AuthorEmail != "" && strings.EqualFold(author, commit.AuthorEmail)) {
+			return true
+		}
+	}
+	return false
+}
+
+// compilePathGlobs compiles the provided path glob patterns. Within a pattern,
+// "*" does not match the path separator, but "**" does.
+func compilePathGlobs(patterns []string) ([]glob.Glob, error) {
+	globs := make([]glob.Glob, len(patterns))
+	for i, pattern := range patterns {
+		var err error
+		if globs[i], err = glob.Compile(pattern, '/'); err != nil {
+			return nil, errors.Wrapf(err, "error compiling path glob %q", pattern)
+		}
+	}
+	return globs, nil
+}
+
+// matchesPathFilters returns true if the given path matches at least one of
+// the given include globs (or if there are none) and matches none of the given
+// exclude globs. It returns false otherwise.
+func matchesPathFilters(path string, includeGlobs, excludeGlobs []glob.Glob) bool {
+	included := len(includeGlobs) == 0
+	for _, g := range includeGlobs {
+		if g.Match(path) {
+			included = true
+			break
+		}
+	}
+	if !included {
+		return false
+	}
+	for _, g := range excludeGlobs {
+		if g.Match(path) {
+			return false
+		}
+	}
+	return true
+}
+
 // allows returns true if the given tag name matches the given regular
 // expression or if the regular expression is nil. It returns false otherwise.
 func allows(tagName string, allowRegex *regexp.Regexp) bool {
@@ -238,10 +686,16 @@ func selectLexicallyLastTag(tagNames []string) string {
 	if len(tagNames) == 0 {
 		return ""
 	}
+	return sortTagsLexically(tagNames)[0]
+}
+
+// sortTagsLexically sorts the provided tag names in reverse lexicographic order
+// and returns them.
+func sortTagsLexically(tagNames []string) []string {
 	sort.Slice(tagNames, func(i, j int) bool {
 		return tagNames[i] > tagNames[j]
 	})
-	return tagNames[0]
+	return tagNames
 }
 
 // selectSemverTag narrows the provided list of tag names to those that are
@@ -251,11 +705,22 @@ func selectLexicallyLastTag(tagNames []string) string {
 // tag name in the sorted list. If the narrowed list is empty, it returns an
 // empty string.
 func selectSemverTag(tagNames []string, constraintStr string) (string, error) {
+	semverTags, err := sortSemverTags(tagNames, constraintStr)
+	if err != nil || len(semverTags) == 0 {
+		return "", err
+	}
+	return semverTags[0], nil
+}
+
+// sortSemverTags narrows the provided list of tag names to those that are
+// valid semantic versions and, if constraintStr is non-empty, satisfy the
+// constraint. It returns the narrowed list sorted in reverse semver order.
+func sortSemverTags(tagNames []string, constraintStr string) ([]string, error) {
 	var constraint *semver.Constraints
 	if constraintStr != "" {
 		var err error
 		if constraint, err = semver.NewConstraint(constraintStr); err != nil {
-			return "", errors.Wrapf(
+			return nil, errors.Wrapf(
 				err,
 				"error parsing semver constraint %q",
 				constraintStr,
@@ -272,9 +737,6 @@ func selectSemverTag(tagNames []string, constraintStr string) (string, error) {
 			semvers = append(semvers, sv)
 		}
 	}
-	if len(semvers) == 0 {
-		return "", nil
-	}
 	sort.Slice(semvers, func(i, j int) bool {
 		if comp := semvers[i].Compare(semvers[j]); comp != 0 {
 			return comp > 0
@@ -284,7 +746,11 @@ func selectSemverTag(tagNames []string, constraintStr string) (string, error) {
 		// of equivalent semvers, e.g., 1.0 and 1.0.0.
 		return semvers[i].Original() > semvers[j].Original()
 	})
-	return semvers[0].Original(), nil
+	semverTags := make([]string, len(semvers))
+	for i, sv := range semvers {
+		semverTags[i] = sv.Original()
+	}
+	return semverTags, nil
 }
 
 func (r *reconciler) getLastCommitID(repo git.Repo) (string, error) {
@@ -295,6 +761,18 @@ func (r *reconciler) listTags(repo git.Repo) ([]string, error) {
 	return repo.ListTags()
 }
 
+func (r *reconciler) listCommits(repo git.Repo) ([]git.CommitMetadata, error) {
+	return repo.ListCommits(maxCommitsExamined)
+}
+
 func (r *reconciler) checkoutTag(repo git.Repo, tag string) error {
 	return repo.Checkout(tag)
 }
+
+func (r *reconciler) verifyCommit(repo git.Repo, id string) error {
+	return repo.VerifyCommit(id)
+}
+
+func (r *reconciler) verifyTag(repo git.Repo, tag string) error {
+	return repo.VerifyTag(tag)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -15,11 +16,11 @@ import (
 	"github.com/akuity/kargo/internal/credentials"
 )
 
-func TestSelectCommits(t *testing.T) {
+func TestSelectCommit(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func(commits []kargoapi.GitCommit, err error)
+		assertions func(*gitMeta, error)
 	}{
 		{
 			name: "error getting repo credentials",
@@ -36,7 +37,7 @@ func TestSelectCommits(t *testing.T) {
 					},
 				},
 			},
-			assertions: func(commits []kargoapi.GitCommit, err error) {
+			assertions: func(gm *gitMeta, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -44,7 +45,7 @@ func TestSelectCommits(t *testing.T) {
 					"error obtaining credentials for git repo",
 				)
 				require.Contains(t, err.Error(), "something went wrong")
-				require.Empty(t, commits)
+				require.Nil(t, gm)
 			},
 		},
 
@@ -65,11 +66,12 @@ func TestSelectCommits(t *testing.T) {
 					context.Context,
 					kargoapi.GitSubscription,
 					*git.RepoCredentials,
+					*git.TrustedSigners,
 				) (*gitMeta, error) {
 					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(commits []kargoapi.GitCommit, err error) {
+			assertions: func(gm *gitMeta, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -77,7 +79,7 @@ func TestSelectCommits(t *testing.T) {
 					"error determining latest commit ID of git repo",
 				)
 				require.Contains(t, err.Error(), "something went wrong")
-				require.Empty(t, commits)
+				require.Nil(t, gm)
 			},
 		},
 
@@ -98,21 +100,116 @@ func TestSelectCommits(t *testing.T) {
 					context.Context,
 					kargoapi.GitSubscription,
 					*git.RepoCredentials,
+					*git.TrustedSigners,
 				) (*gitMeta, error) {
 					return &gitMeta{Commit: "fake-commit", Message: "message"}, nil
 				},
 			},
-			assertions: func(commits []kargoapi.GitCommit, err error) {
+			assertions: func(gm *gitMeta, err error) {
 				require.NoError(t, err)
-				require.Len(t, commits, 1)
 				require.Equal(
 					t,
-					kargoapi.GitCommit{
+					&gitMeta{Commit: "fake-commit", Message: "message"},
+					gm,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.reconciler.selectCommit(
+					context.Background(),
+					"fake-namespace",
+					kargoapi.GitSubscription{
 						RepoURL: "fake-url",
-						ID:      "fake-commit",
-						Message: "message",
 					},
-					commits[0],
+				),
+			)
+		})
+	}
+}
+
+func TestGetTrustedSigners(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(*git.TrustedSigners, error)
+	}{
+		{
+			name: "error getting Secret",
+			reconciler: &reconciler{
+				getSecretFn: func(
+					context.Context,
+					string,
+					string,
+				) (*corev1.Secret, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *git.TrustedSigners, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error getting Secret")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "Secret not found",
+			reconciler: &reconciler{
+				getSecretFn: func(
+					context.Context,
+					string,
+					string,
+				) (*corev1.Secret, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(_ *git.TrustedSigners, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "not found in namespace")
+			},
+		},
+		{
+			name: "Secret has no key material",
+			reconciler: &reconciler{
+				getSecretFn: func(
+					context.Context,
+					string,
+					string,
+				) (*corev1.Secret, error) {
+					return &corev1.Secret{}, nil
+				},
+			},
+			assertions: func(_ *git.TrustedSigners, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "has neither")
+			},
+		},
+		{
+			name: "success",
+			reconciler: &reconciler{
+				getSecretFn: func(
+					context.Context,
+					string,
+					string,
+				) (*corev1.Secret, error) {
+					return &corev1.Secret{
+						Data: map[string][]byte{
+							gpgKeysSecretKey:        []byte("fake-keys"),
+							allowedSignersSecretKey: []byte("fake-signers"),
+						},
+					}, nil
+				},
+			},
+			assertions: func(signers *git.TrustedSigners, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&git.TrustedSigners{
+						GPGKeys:           "fake-keys",
+						SSHAllowedSigners: "fake-signers",
+					},
+					signers,
 				)
 			},
 		},
@@ -120,15 +217,11 @@ func TestSelectCommits(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
-				testCase.reconciler.selectCommits(
+				testCase.reconciler.getTrustedSigners(
 					context.Background(),
 					"fake-namespace",
-					[]kargoapi.RepoSubscription{
-						{
-							Git: &kargoapi.GitSubscription{
-								RepoURL: "fake-url",
-							},
-						},
+					&kargoapi.GitSignatureVerification{
+						SecretName: "fake-secret",
 					},
 				),
 			)
@@ -175,6 +268,7 @@ func TestSelectCommitMeta(t *testing.T) {
 					context.Background(),
 					testCase.sub,
 					nil,
+					nil,
 				),
 			)
 		})
@@ -186,7 +280,12 @@ func TestSelectCommitID(t *testing.T) {
 		name       string
 		sub        kargoapi.GitSubscription
 		reconciler *reconciler
-		assertions func(tag string, commit string, err error)
+		assertions func(
+			tag string,
+			commit string,
+			unverified []kargoapi.UnverifiedGitRevision,
+			err error,
+		)
 	}{
 		{
 			name: "newest from branch; error getting commit ID",
@@ -198,7 +297,11 @@ func TestSelectCommitID(t *testing.T) {
 					return "", errors.New("something went wrong")
 				},
 			},
-			assertions: func(_, _ string, err error) {
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -218,12 +321,159 @@ func TestSelectCommitID(t *testing.T) {
 					return "fake-commit", nil
 				},
 			},
-			assertions: func(tag, commit string, err error) {
+			assertions: func(
+				tag, commit string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.NoError(t, err)
 				require.Empty(t, tag)
 				require.Equal(t, "fake-commit", commit)
 			},
 		},
+		{
+			name: "newest from branch with path filters; error compiling glob",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestFromBranch,
+				IncludePaths:            []string{"["}, // This should force a failure
+			},
+			reconciler: &reconciler{},
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error compiling path glob")
+			},
+		},
+		{
+			name: "newest from branch with path filters; error listing commits",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestFromBranch,
+				IncludePaths:            []string{"services/foo/**"},
+			},
+			reconciler: &reconciler{
+				listCommitsFn: func(git.Repo) ([]git.CommitMetadata, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error listing commits from branch")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "newest from branch with path filters; no matching commits",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestFromBranch,
+				IncludePaths:            []string{"services/foo/**"},
+			},
+			reconciler: &reconciler{
+				listCommitsFn: func(git.Repo) ([]git.CommitMetadata, error) {
+					return []git.CommitMetadata{
+						{ID: "fake-commit", Paths: []string{"services/bar/main.go"}},
+					}, nil
+				},
+			},
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "found no commits matching commit filters")
+			},
+		},
+		{
+			name: "newest from branch with path filters; success",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestFromBranch,
+				IncludePaths:            []string{"services/foo/**"},
+				ExcludePaths:            []string{"**/*.md"},
+			},
+			reconciler: &reconciler{
+				listCommitsFn: func(git.Repo) ([]git.CommitMetadata, error) {
+					return []git.CommitMetadata{
+						{ID: "fake-commit-4", Paths: []string{"services/bar/main.go"}},
+						{ID: "fake-commit-3", Paths: []string{"services/foo/README.md"}},
+						{ID: "fake-commit-2", Paths: []string{"README.md", "services/foo/main.go"}},
+						{ID: "fake-commit-1", Paths: []string{"services/foo/main.go"}},
+					}, nil
+				},
+			},
+			assertions: func(
+				tag, commit string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, tag)
+				require.Equal(t, "fake-commit-2", commit)
+			},
+		},
+		{
+			name: "newest from branch with message filters; error compiling regex",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestFromBranch,
+				ExcludeMessages:         "(", // This should force a failure
+			},
+			reconciler: &reconciler{},
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error compiling regular expression")
+			},
+		},
+		{
+			name: "newest from branch with message and author filters; success",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestFromBranch,
+				ExcludeMessages:         `\[skip kargo\]`,
+				ExcludeAuthors:          []string{"kargo@example.com"},
+			},
+			reconciler: &reconciler{
+				listCommitsFn: func(git.Repo) ([]git.CommitMetadata, error) {
+					return []git.CommitMetadata{
+						{
+							ID:          "fake-commit-3",
+							AuthorName:  "Kargo",
+							AuthorEmail: "Kargo@example.com",
+							Message:     "Update image",
+						},
+						{
+							ID:          "fake-commit-2",
+							AuthorName:  "Jane Doe",
+							AuthorEmail: "jane@example.com",
+							Message:     "Fix typo\n\n[skip kargo]",
+						},
+						{
+							ID:          "fake-commit-1",
+							AuthorName:  "Jane Doe",
+							AuthorEmail: "jane@example.com",
+							Message:     "Add feature",
+						},
+					}, nil
+				},
+			},
+			assertions: func(
+				tag, commit string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, tag)
+				require.Equal(t, "fake-commit-1", commit)
+			},
+		},
 		{
 			name: "error listing tags",
 			sub: kargoapi.GitSubscription{
@@ -234,7 +484,11 @@ func TestSelectCommitID(t *testing.T) {
 					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(_, _ string, err error) {
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error listing tags from git repo")
 				require.Contains(t, err.Error(), "something went wrong")
@@ -251,7 +505,11 @@ func TestSelectCommitID(t *testing.T) {
 					return []string{"abc"}, nil
 				},
 			},
-			assertions: func(_, _ string, err error) {
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error compiling regular expression")
 			},
@@ -267,7 +525,11 @@ func TestSelectCommitID(t *testing.T) {
 					return []string{"abc"}, nil
 				},
 			},
-			assertions: func(_, _ string, err error) {
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "found no applicable tags in repo")
 			},
@@ -282,7 +544,11 @@ func TestSelectCommitID(t *testing.T) {
 					return []string{"abc"}, nil
 				},
 			},
-			assertions: func(_, _ string, err error) {
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "unknown commit selection strategy")
 			},
@@ -300,7 +566,11 @@ func TestSelectCommitID(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(_, _ string, err error) {
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error checking out tag")
 				require.Contains(t, err.Error(), "something went wrong")
@@ -322,7 +592,11 @@ func TestSelectCommitID(t *testing.T) {
 					return "", errors.New("something went wrong")
 				},
 			},
-			assertions: func(_, _ string, err error) {
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error determining commit ID of tag")
 				require.Contains(t, err.Error(), "something went wrong")
@@ -344,7 +618,11 @@ func TestSelectCommitID(t *testing.T) {
 					return "fake-commit", nil
 				},
 			},
-			assertions: func(tag, commit string, err error) {
+			assertions: func(
+				tag, commit string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.NoError(t, err)
 				require.Equal(t, "xyz", tag)
 				require.Equal(t, "fake-commit", commit)
@@ -366,7 +644,11 @@ func TestSelectCommitID(t *testing.T) {
 					return "fake-commit", nil
 				},
 			},
-			assertions: func(tag, commit string, err error) {
+			assertions: func(
+				tag, commit string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.Equal(t, "abc", tag)
 				require.NoError(t, err)
 				require.Equal(t, "fake-commit", commit)
@@ -383,7 +665,11 @@ func TestSelectCommitID(t *testing.T) {
 					return []string{"1.0.0", "2.0.0"}, nil
 				},
 			},
-			assertions: func(_, _ string, err error) {
+			assertions: func(
+				_, _ string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error parsing semver constraint")
 			},
@@ -404,25 +690,324 @@ func TestSelectCommitID(t *testing.T) {
 					return "fake-commit", nil
 				},
 			},
-			assertions: func(tag, commit string, err error) {
+			assertions: func(
+				tag, commit string,
+				_ []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
 				require.NoError(t, err)
 				require.Equal(t, "2.0.0", tag)
 				require.Equal(t, "fake-commit", commit)
 			},
 		},
+		{
+			name: "newest from branch with signed commits; none verified",
+			sub: kargoapi.GitSubscription{
+				RepoURL:                 "fake-url",
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestFromBranch,
+				SignatureVerification:   &kargoapi.GitSignatureVerification{},
+			},
+			reconciler: &reconciler{
+				listCommitsFn: func(git.Repo) ([]git.CommitMetadata, error) {
+					return []git.CommitMetadata{{ID: "newer"}, {ID: "older"}}, nil
+				},
+				verifyCommitFn: func(git.Repo, string) error {
+					return errors.New("commit is not signed")
+				},
+			},
+			assertions: func(
+				_, _ string,
+				unverified []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"found no commits with verified signatures",
+				)
+				require.Contains(t, err.Error(), "commit is not signed")
+				require.Len(t, unverified, 2)
+				require.Equal(t, "newer", unverified[0].ID)
+				require.Equal(t, "fake-url", unverified[0].RepoURL)
+				require.Equal(t, "commit is not signed", unverified[0].Reason)
+			},
+		},
+		{
+			name: "newest from branch with signed commits; success",
+			sub: kargoapi.GitSubscription{
+				RepoURL:                 "fake-url",
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestFromBranch,
+				SignatureVerification:   &kargoapi.GitSignatureVerification{},
+			},
+			reconciler: &reconciler{
+				listCommitsFn: func(git.Repo) ([]git.CommitMetadata, error) {
+					return []git.CommitMetadata{{ID: "newer"}, {ID: "older"}}, nil
+				},
+				verifyCommitFn: func(_ git.Repo, id string) error {
+					if id == "newer" {
+						return errors.New("commit is not signed")
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				tag, commit string,
+				unverified []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, tag)
+				require.Equal(t, "older", commit)
+				require.Len(t, unverified, 1)
+				require.Equal(t, "newer", unverified[0].ID)
+			},
+		},
+		{
+			name: "semver with signed tags; none verified",
+			sub: kargoapi.GitSubscription{
+				RepoURL:                 "fake-url",
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+				SignatureVerification: &kargoapi.GitSignatureVerification{
+					Require: kargoapi.GitSignatureRequirementTags,
+				},
+			},
+			reconciler: &reconciler{
+				listTagsFn: func(git.Repo) ([]string, error) {
+					return []string{"1.0.0", "2.0.0"}, nil
+				},
+				verifyTagFn: func(git.Repo, string) error {
+					return errors.New("tag is not signed")
+				},
+			},
+			assertions: func(
+				_, _ string,
+				unverified []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"found no applicable tags with verified signatures",
+				)
+				require.Len(t, unverified, 2)
+				require.Equal(t, "2.0.0", unverified[0].Tag)
+				require.Equal(t, "1.0.0", unverified[1].Tag)
+			},
+		},
+		{
+			name: "semver with signed commits and tags; success",
+			sub: kargoapi.GitSubscription{
+				RepoURL:                 "fake-url",
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+				SignatureVerification: &kargoapi.GitSignatureVerification{
+					Require: kargoapi.GitSignatureRequirementCommitsAndTags,
+				},
+			},
+			reconciler: func() *reconciler {
+				var checkedOut string
+				return &reconciler{
+					listTagsFn: func(git.Repo) ([]string, error) {
+						return []string{"1.0.0", "2.0.0", "3.0.0"}, nil
+					},
+					verifyTagFn: func(_ git.Repo, tag string) error {
+						if tag == "3.0.0" {
+							return errors.New("tag is not signed")
+						}
+						return nil
+					},
+					checkoutTagFn: func(_ git.Repo, tag string) error {
+						checkedOut = tag
+						return nil
+					},
+					getLastCommitIDFn: func(git.Repo) (string, error) {
+						return "commit-" + checkedOut, nil
+					},
+					verifyCommitFn: func(_ git.Repo, id string) error {
+						if id == "commit-2.0.0" {
+							return errors.New("commit is not signed")
+						}
+						return nil
+					},
+				}
+			}(),
+			assertions: func(
+				tag, commit string,
+				unverified []kargoapi.UnverifiedGitRevision,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "1.0.0", tag)
+				require.Equal(t, "commit-1.0.0", commit)
+				require.Equal(
+					t,
+					[]kargoapi.UnverifiedGitRevision{
+						{
+							RepoURL: "fake-url",
+							Tag:     "3.0.0",
+							Reason:  "tag is not signed",
+						},
+						{
+							RepoURL: "fake-url",
+							ID:      "commit-2.0.0",
+							Tag:     "2.0.0",
+							Reason:  "commit is not signed",
+						},
+					},
+					unverified,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				testCase.reconciler.selectTagAndCommitID(
-					nil,
-					testCase.sub,
-				),
+			gm, err := testCase.reconciler.selectTagAndCommitID(nil, testCase.sub)
+			if gm == nil {
+				gm = &gitMeta{}
+			}
+			testCase.assertions(gm.Tag, gm.Commit, gm.Unverified, err)
+		})
+	}
+}
+
+func TestMatchesPathFilters(t *testing.T) {
+	testCases := []struct {
+		name         string
+		includePaths []string
+		excludePaths []string
+		path         string
+		matches      bool
+	}{
+		{
+			name:    "no filters",
+			path:    "services/foo/main.go",
+			matches: true,
+		},
+		{
+			name:         "included",
+			includePaths: []string{"services/foo/**"},
+			path:         "services/foo/cmd/main.go",
+			matches:      true,
+		},
+		{
+			name:         "not included",
+			includePaths: []string{"services/foo/**"},
+			path:         "services/bar/main.go",
+			matches:      false,
+		},
+		{
+			name:         "single star does not cross path segments",
+			includePaths: []string{"services/*.go"},
+			path:         "services/foo/main.go",
+			matches:      false,
+		},
+		{
+			name:         "excluded",
+			excludePaths: []string{"**/*.md"},
+			path:         "services/foo/README.md",
+			matches:      false,
+		},
+		{
+			name:         "included but excluded",
+			includePaths: []string{"services/foo/**"},
+			excludePaths: []string{"services/foo/docs/**"},
+			path:         "services/foo/docs/index.html",
+			matches:      false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			includeGlobs, err := compilePathGlobs(testCase.includePaths)
+			require.NoError(t, err)
+			excludeGlobs, err := compilePathGlobs(testCase.excludePaths)
+			require.NoError(t, err)
+			require.Equal(
+				t,
+				testCase.matches,
+				matchesPathFilters(testCase.path, includeGlobs, excludeGlobs),
 			)
 		})
 	}
 }
 
+func TestCommitFiltersMatches(t *testing.T) {
+	commit := git.CommitMetadata{
+		ID:          "fake-commit",
+		AuthorName:  "Jane Doe",
+		AuthorEmail: "jane@example.com",
+		Message:     "Add feature",
+		Paths:       []string{"services/foo/main.go"},
+	}
+	testCases := []struct {
+		name    string
+		sub     kargoapi.GitSubscription
+		matches bool
+	}{
+		{
+			name:    "no filters",
+			matches: true,
+		},
+		{
+			name: "message included",
+			sub: kargoapi.GitSubscription{
+				IncludeMessages: "^Add",
+			},
+			matches: true,
+		},
+		{
+			name: "message not included",
+			sub: kargoapi.GitSubscription{
+				IncludeMessages: "^Fix",
+			},
+			matches: false,
+		},
+		{
+			name: "message excluded",
+			sub: kargoapi.GitSubscription{
+				ExcludeMessages: "feature",
+			},
+			matches: false,
+		},
+		{
+			name: "author included by name",
+			sub: kargoapi.GitSubscription{
+				IncludeAuthors: []string{"Jane Doe"},
+			},
+			matches: true,
+		},
+		{
+			name: "author not included",
+			sub: kargoapi.GitSubscription{
+				IncludeAuthors: []string{"john@example.com"},
+			},
+			matches: false,
+		},
+		{
+			name: "author excluded by email",
+			sub: kargoapi.GitSubscription{
+				ExcludeAuthors: []string{"JANE@example.com"},
+			},
+			matches: false,
+		},
+		{
+			name: "author matches but paths do not",
+			sub: kargoapi.GitSubscription{
+				IncludeAuthors: []string{"Jane Doe"},
+				IncludePaths:   []string{"services/bar/**"},
+			},
+			matches: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			filters, err := newCommitFilters(testCase.sub)
+			require.NoError(t, err)
+			require.Equal(t, testCase.matches, filters.matches(commit))
+		})
+	}
+}
+
 func TestAllows(t *testing.T) {
 	testCases := []struct {
 		name    string
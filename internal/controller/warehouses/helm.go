@@ -11,95 +11,84 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
-func (r *reconciler) selectCharts(
+// selectChart obtains any credentials required by the provided
+// ChartSubscription and uses them to select an appropriate version of the
+// chart specified by the subscription. All versions the selection was made
+// from are also returned, ordered from most to least preferred.
+func (r *reconciler) selectChart(
 	ctx context.Context,
 	namespace string,
-	subs []kargoapi.RepoSubscription,
-) ([]kargoapi.Chart, error) {
-	charts := make([]kargoapi.Chart, 0, len(subs))
-
-	for _, s := range subs {
-		if s.Chart == nil {
-			continue
-		}
+	sub kargoapi.ChartSubscription,
+) (*kargoapi.Chart, []string, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repoURL", sub.RepoURL)
+	if sub.Name != "" {
+		logger = logger.WithField("chart", sub.Name)
+	}
 
-		sub := s.Chart
+	creds, ok, err :=
+		r.credentialsDB.Get(ctx, namespace, credentials.TypeHelm, sub.RepoURL)
+	if err != nil {
+		return nil, nil, errors.Wrapf(
+			err,
+			"error obtaining credentials for chart repository %q",
+			sub.RepoURL,
+		)
+	}
 
-		logger := logging.LoggerFromContext(ctx).WithField("repoURL", sub.RepoURL)
-		if sub.Name != "" {
-			logger = logger.WithField("chart", sub.Name)
+	var helmCreds *helm.Credentials
+	if ok {
+		helmCreds = &helm.Credentials{
+			Username: creds.Username,
+			Password: creds.Password,
 		}
+		logger.Debug("obtained credentials for chart repo")
+	} else {
+		logger.Debug("found no credentials for chart repo")
+	}
 
-		creds, ok, err :=
-			r.credentialsDB.Get(ctx, namespace, credentials.TypeHelm, sub.RepoURL)
-		if err != nil {
-			return nil, errors.Wrapf(
+	versions, err := r.selectChartVersionsFn(
+		ctx,
+		sub.RepoURL,
+		sub.Name,
+		sub.SemverConstraint,
+		helmCreds,
+	)
+	if err != nil {
+		if sub.Name == "" {
+			return nil, nil, errors.Wrapf(
 				err,
-				"error obtaining credentials for chart repository %q",
+				"error searching for latest version of chart in repository %q",
 				sub.RepoURL,
 			)
 		}
-
-		var helmCreds *helm.Credentials
-		if ok {
-			helmCreds = &helm.Credentials{
-				Username: creds.Username,
-				Password: creds.Password,
-			}
-			logger.Debug("obtained credentials for chart repo")
-		} else {
-			logger.Debug("found no credentials for chart repo")
-		}
-
-		vers, err := r.selectChartVersionFn(
-			ctx,
-			sub.RepoURL,
+		return nil, nil, errors.Wrapf(
+			err,
+			"error searching for latest version of chart %q in repository %q",
 			sub.Name,
-			sub.SemverConstraint,
-			helmCreds,
+			sub.RepoURL,
 		)
-		if err != nil {
-			if sub.Name == "" {
-				return nil, errors.Wrapf(
-					err,
-					"error searching for latest version of chart in repository %q",
-					sub.RepoURL,
-				)
-			}
-			return nil, errors.Wrapf(
-				err,
-				"error searching for latest version of chart %q in repository %q",
-				sub.Name,
-				sub.RepoURL,
-			)
-		}
+	}
 
-		if vers == "" {
-			logger.Error("found no suitable chart version")
-			if sub.Name == "" {
-				return nil, errors.Errorf(
-					"found no suitable version of chart in repository %q",
-					sub.RepoURL,
-				)
-			}
-			return nil, errors.Errorf(
-				"found no suitable version of chart %q in repository %q",
-				sub.Name,
+	if len(versions) == 0 {
+		logger.Error("found no suitable chart version")
+		if sub.Name == "" {
+			return nil, nil, errors.Errorf(
+				"found no suitable version of chart in repository %q",
 				sub.RepoURL,
 			)
 		}
-		logger.WithField("version", vers).
-			Debug("found latest suitable chart version")
-
-		charts = append(
-			charts,
-			kargoapi.Chart{
-				RepoURL: sub.RepoURL,
-				Name:    sub.Name,
-				Version: vers,
-			},
+		return nil, nil, errors.Errorf(
+			"found no suitable version of chart %q in repository %q",
+			sub.Name,
+			sub.RepoURL,
 		)
 	}
+	logger.WithField("version", versions[0]).
+		Debug("found latest suitable chart version")
 
-	return charts, nil
+	return &kargoapi.Chart{
+		RepoURL: sub.RepoURL,
+		Name:    sub.Name,
+		Version: versions[0],
+	}, versions, nil
 }
@@ -12,18 +12,18 @@ import (
 	"github.com/akuity/kargo/internal/helm"
 )
 
-func TestSelectCharts(t *testing.T) {
+func TestSelectChart(t *testing.T) {
 	testCases := []struct {
-		name                 string
-		credentialsDB        credentials.Database
-		selectChartVersionFn func(
+		name                  string
+		credentialsDB         credentials.Database
+		selectChartVersionsFn func(
 			context.Context,
 			string,
 			string,
 			string,
 			*helm.Credentials,
-		) (string, error)
-		assertions func([]kargoapi.Chart, error)
+		) ([]string, error)
+		assertions func(*kargoapi.Chart, []string, error)
 	}{
 		{
 			name: "error getting repository credentials",
@@ -38,7 +38,7 @@ func TestSelectCharts(t *testing.T) {
 						errors.New("something went wrong")
 				},
 			},
-			assertions: func(_ []kargoapi.Chart, err error) {
+			assertions: func(_ *kargoapi.Chart, _ []string, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -61,16 +61,16 @@ func TestSelectCharts(t *testing.T) {
 					return credentials.Credentials{}, false, nil
 				},
 			},
-			selectChartVersionFn: func(
+			selectChartVersionsFn: func(
 				context.Context,
 				string,
 				string,
 				string,
 				*helm.Credentials,
-			) (string, error) {
-				return "", errors.New("something went wrong")
+			) ([]string, error) {
+				return nil, errors.New("something went wrong")
 			},
-			assertions: func(_ []kargoapi.Chart, err error) {
+			assertions: func(_ *kargoapi.Chart, _ []string, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -93,16 +93,16 @@ func TestSelectCharts(t *testing.T) {
 					return credentials.Credentials{}, false, nil
 				},
 			},
-			selectChartVersionFn: func(
+			selectChartVersionsFn: func(
 				context.Context,
 				string,
 				string,
 				string,
 				*helm.Credentials,
-			) (string, error) {
-				return "", nil
+			) ([]string, error) {
+				return nil, nil
 			},
-			assertions: func(_ []kargoapi.Chart, err error) {
+			assertions: func(_ *kargoapi.Chart, _ []string, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "found no suitable version of chart")
 			},
@@ -120,46 +120,42 @@ func TestSelectCharts(t *testing.T) {
 					return credentials.Credentials{}, false, nil
 				},
 			},
-			selectChartVersionFn: func(
+			selectChartVersionsFn: func(
 				context.Context,
 				string,
 				string,
 				string,
 				*helm.Credentials,
-			) (string, error) {
-				return "1.0.0", nil
+			) ([]string, error) {
+				return []string{"1.0.0"}, nil
 			},
-			assertions: func(charts []kargoapi.Chart, err error) {
+			assertions: func(chart *kargoapi.Chart, candidates []string, err error) {
 				require.NoError(t, err)
-				require.Len(t, charts, 1)
 				require.Equal(
 					t,
-					kargoapi.Chart{
+					&kargoapi.Chart{
 						RepoURL: "fake-url",
 						Name:    "fake-chart",
 						Version: "1.0.0",
 					},
-					charts[0],
+					chart,
 				)
+				require.Equal(t, []string{"1.0.0"}, candidates)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			r := reconciler{
-				credentialsDB:        testCase.credentialsDB,
-				selectChartVersionFn: testCase.selectChartVersionFn,
+				credentialsDB:         testCase.credentialsDB,
+				selectChartVersionsFn: testCase.selectChartVersionsFn,
 			}
-			testCase.assertions(r.selectCharts(
+			testCase.assertions(r.selectChart(
 				context.Background(),
 				"fake-namespace",
-				[]kargoapi.RepoSubscription{
-					{
-						Chart: &kargoapi.ChartSubscription{
-							RepoURL: "fake-url",
-							Name:    "fake-chart",
-						},
-					},
+				kargoapi.ChartSubscription{
+					RepoURL: "fake-url",
+					Name:    "fake-chart",
 				},
 			))
 		})
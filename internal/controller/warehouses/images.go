@@ -15,73 +15,65 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
-func (r *reconciler) selectImages(
+// selectImage obtains any credentials required by the provided
+// ImageSubscription and uses them to select an appropriate image from the
+// repository specified by the subscription. The tags of all images the
+// selection was made from are also returned, ordered from most to least
+// preferred.
+func (r *reconciler) selectImage(
 	ctx context.Context,
 	namespace string,
-	subs []kargoapi.RepoSubscription,
-) ([]kargoapi.Image, error) {
-	imgs := make([]kargoapi.Image, 0, len(subs))
-	for _, s := range subs {
-		if s.Image == nil {
-			continue
-		}
-
-		sub := s.Image
-
-		logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
-
-		creds, ok, err :=
-			r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
-		if err != nil {
-			return nil, errors.Wrapf(
-				err,
-				"error obtaining credentials for image repo %q",
-				sub.RepoURL,
-			)
-		}
-		var regCreds *image.Credentials
-		if ok {
-			regCreds = &image.Credentials{
-				Username: creds.Username,
-				Password: creds.Password,
-			}
-			logger.Debug("obtained credentials for image repo")
-		} else {
-			logger.Debug("found no credentials for image repo")
-		}
+	sub kargoapi.ImageSubscription,
+) (*kargoapi.Image, []string, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
 
-		tag, digest, err := r.getImageRefsFn(
-			ctx,
+	creds, ok, err :=
+		r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
+	if err != nil {
+		return nil, nil, errors.Wrapf(
+			err,
+			"error obtaining credentials for image repo %q",
 			sub.RepoURL,
-			sub.ImageSelectionStrategy,
-			sub.SemverConstraint,
-			sub.AllowTags,
-			sub.IgnoreTags, // TODO: KR: Fix this
-			sub.Platform,
-			regCreds,
 		)
-		if err != nil {
-			return nil, errors.Wrapf(
-				err,
-				"error getting latest suitable image %q",
-				sub.RepoURL,
-			)
+	}
+	var regCreds *image.Credentials
+	if ok {
+		regCreds = &image.Credentials{
+			Username: creds.Username,
+			Password: creds.Password,
 		}
-		imgs = append(
-			imgs,
-			kargoapi.Image{
-				RepoURL:    sub.RepoURL,
-				GitRepoURL: r.getImageSourceURL(sub.GitRepoURL, tag),
-				Tag:        tag,
-				Digest:     digest,
-			},
+		logger.Debug("obtained credentials for image repo")
+	} else {
+		logger.Debug("found no credentials for image repo")
+	}
+
+	tag, digest, candidates, err := r.getImageRefsFn(
+		ctx,
+		sub.RepoURL,
+		sub.ImageSelectionStrategy,
+		sub.SemverConstraint,
+		sub.AllowTags,
+		sub.IgnoreTags, // TODO: KR: Fix this
+		sub.Platform,
+		regCreds,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrapf(
+			err,
+			"error getting latest suitable image %q",
+			sub.RepoURL,
 		)
-		logger.WithFields(log.Fields{
-			"tag":    tag,
-			"digest": digest,
-		}).Debug("found latest suitable image")
 	}
-	return imgs, nil
+	logger.WithFields(log.Fields{
+		"tag":    tag,
+		"digest": digest,
+	}).Debug("found latest suitable image")
+	return &kargoapi.Image{
+		RepoURL:    sub.RepoURL,
+		GitRepoURL: r.getImageSourceURL(sub.GitRepoURL, tag),
+		Tag:        tag,
+		Digest:     digest,
+	}, candidates, nil
 }
 
 const (
@@ -110,7 +102,7 @@ func getImageRefs(
 	ignoreTags []string,
 	platform string,
 	creds *image.Credentials,
-) (string, string, error) {
+) (string, string, []string, error) {
 	imageSelector, err := image.NewSelector(
 		repoURL,
 		image.SelectionStrategy(imageSelectionStrategy),
@@ -123,7 +115,7 @@ func getImageRefs(
 		},
 	)
 	if err != nil {
-		return "", "", errors.Wrapf(
+		return "", "", nil, errors.Wrapf(
 			err,
 			"error creating image selector for image %q",
 			repoURL,
@@ -131,14 +123,14 @@ func getImageRefs(
 	}
 	img, err := imageSelector.Select(ctx)
 	if err != nil {
-		return "", "", errors.Wrapf(
+		return "", "", nil, errors.Wrapf(
 			err,
 			"error fetching newest applicable image %q",
 			repoURL,
 		)
 	}
 	if img == nil {
-		return "", "", errors.Errorf("found no applicable image %q", repoURL)
+		return "", "", nil, errors.Errorf("found no applicable image %q", repoURL)
 	}
-	return img.Tag, img.Digest.String(), nil
+	return img.Tag, img.Digest.String(), imageSelector.Candidates(), nil
 }
@@ -13,11 +13,11 @@ import (
 	"github.com/akuity/kargo/internal/image"
 )
 
-func TestSelectImages(t *testing.T) {
+func TestSelectImage(t *testing.T) {
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func([]kargoapi.Image, error)
+		assertions func(*kargoapi.Image, []string, error)
 	}{
 		{
 			name: "error getting latest version of an image",
@@ -41,11 +41,11 @@ func TestSelectImages(t *testing.T) {
 					[]string,
 					string,
 					*image.Credentials,
-				) (string, string, error) {
-					return "", "", errors.New("something went wrong")
+				) (string, string, []string, error) {
+					return "", "", nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(_ []kargoapi.Image, err error) {
+			assertions: func(_ *kargoapi.Image, _ []string, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -78,37 +78,33 @@ func TestSelectImages(t *testing.T) {
 					[]string,
 					string,
 					*image.Credentials,
-				) (string, string, error) {
-					return "fake-tag", "fake-digest", nil
+				) (string, string, []string, error) {
+					return "fake-tag", "fake-digest", []string{"fake-tag"}, nil
 				},
 			},
-			assertions: func(images []kargoapi.Image, err error) {
+			assertions: func(img *kargoapi.Image, candidates []string, err error) {
 				require.NoError(t, err)
-				require.Len(t, images, 1)
 				require.Equal(
 					t,
-					kargoapi.Image{
+					&kargoapi.Image{
 						RepoURL: "fake-url",
 						Tag:     "fake-tag",
 						Digest:  "fake-digest",
 					},
-					images[0],
+					img,
 				)
+				require.Equal(t, []string{"fake-tag"}, candidates)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
-				testCase.reconciler.selectImages(
+				testCase.reconciler.selectImage(
 					context.Background(),
 					"fake-namespace",
-					[]kargoapi.RepoSubscription{
-						{
-							Image: &kargoapi.ImageSubscription{
-								RepoURL: "fake-url",
-							},
-						},
+					kargoapi.ImageSubscription{
+						RepoURL: "fake-url",
 					},
 				),
 			)
@@ -0,0 +1,80 @@
+package warehouses
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/oci"
+)
+
+// selectOCIArtifact obtains any credentials required by the provided
+// OCIArtifactSubscription and uses them to select an appropriate artifact from
+// the repository specified by the subscription. Credentials are the same ones
+// used for image repositories. The tags of all artifacts the selection was made
+// from are also returned, ordered from most to least preferred.
+func (r *reconciler) selectOCIArtifact(
+	ctx context.Context,
+	namespace string,
+	sub kargoapi.OCIArtifactSubscription,
+) (*kargoapi.OCIArtifact, []string, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repo", sub.RepoURL)
+
+	creds, ok, err :=
+		r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
+	if err != nil {
+		return nil, nil, errors.Wrapf(
+			err,
+			"error obtaining credentials for artifact repo %q",
+			sub.RepoURL,
+		)
+	}
+	var regCreds *oci.Credentials
+	if ok {
+		regCreds = &oci.Credentials{
+			Username: creds.Username,
+			Password: creds.Password,
+		}
+		logger.Debug("obtained credentials for artifact repo")
+	} else {
+		logger.Debug("found no credentials for artifact repo")
+	}
+
+	artifact, candidates, err := r.selectArtifactFn(
+		ctx,
+		sub.RepoURL,
+		oci.SelectionStrategy(sub.SelectionStrategy),
+		&oci.SelectorOptions{
+			Constraint:   sub.SemverConstraint,
+			AllowRegex:   sub.AllowTags,
+			Ignore:       sub.IgnoreTags,
+			ArtifactType: sub.ArtifactType,
+			Creds:        regCreds,
+		},
+	)
+	if err != nil {
+		return nil, nil, errors.Wrapf(
+			err,
+			"error getting latest suitable artifact %q",
+			sub.RepoURL,
+		)
+	}
+	if artifact == nil {
+		return nil, nil,
+			errors.Errorf("found no applicable artifact %q", sub.RepoURL)
+	}
+	logger.WithFields(log.Fields{
+		"tag":    artifact.Tag,
+		"digest": artifact.Digest,
+	}).Debug("found latest suitable artifact")
+	return &kargoapi.OCIArtifact{
+		RepoURL:      sub.RepoURL,
+		Tag:          artifact.Tag,
+		Digest:       artifact.Digest,
+		ArtifactType: artifact.ArtifactType,
+	}, candidates, nil
+}
@@ -0,0 +1,184 @@
+package warehouses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/oci"
+)
+
+func TestSelectOCIArtifact(t *testing.T) {
+	testCases := []struct {
+		name             string
+		credentialsDB    credentials.Database
+		selectArtifactFn func(
+			context.Context,
+			string,
+			oci.SelectionStrategy,
+			*oci.SelectorOptions,
+		) (*oci.Artifact, []string, error)
+		assertions func(*kargoapi.OCIArtifact, []string, error)
+	}{
+		{
+			name: "error getting repository credentials",
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false,
+						errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *kargoapi.OCIArtifact, _ []string, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"error obtaining credentials for artifact repo",
+				)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+
+		{
+			name: "error selecting artifact",
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+			},
+			selectArtifactFn: func(
+				context.Context,
+				string,
+				oci.SelectionStrategy,
+				*oci.SelectorOptions,
+			) (*oci.Artifact, []string, error) {
+				return nil, nil, errors.New("something went wrong")
+			},
+			assertions: func(_ *kargoapi.OCIArtifact, _ []string, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"error getting latest suitable artifact",
+				)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+
+		{
+			name: "no artifact found",
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+			},
+			selectArtifactFn: func(
+				context.Context,
+				string,
+				oci.SelectionStrategy,
+				*oci.SelectorOptions,
+			) (*oci.Artifact, []string, error) {
+				return nil, nil, nil
+			},
+			assertions: func(_ *kargoapi.OCIArtifact, _ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "found no applicable artifact")
+			},
+		},
+
+		{
+			name: "success",
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					_ context.Context,
+					_ string,
+					credType credentials.Type,
+					_ string,
+				) (credentials.Credentials, bool, error) {
+					require.Equal(t, credentials.TypeImage, credType)
+					return credentials.Credentials{
+						Username: "fake-username",
+						Password: "fake-password",
+					}, true, nil
+				},
+			},
+			selectArtifactFn: func(
+				_ context.Context,
+				repoURL string,
+				strategy oci.SelectionStrategy,
+				opts *oci.SelectorOptions,
+			) (*oci.Artifact, []string, error) {
+				require.Equal(t, "fake-url", repoURL)
+				require.Equal(t, oci.SelectionStrategySemVer, strategy)
+				require.Equal(t, "fake-type", opts.ArtifactType)
+				require.Equal(
+					t,
+					&oci.Credentials{
+						Username: "fake-username",
+						Password: "fake-password",
+					},
+					opts.Creds,
+				)
+				return &oci.Artifact{
+					Tag:          "1.0.0",
+					Digest:       "fake-digest",
+					ArtifactType: "fake-type",
+				}, []string{"1.0.0", "0.9.0"}, nil
+			},
+			assertions: func(
+				artifact *kargoapi.OCIArtifact,
+				candidates []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&kargoapi.OCIArtifact{
+						RepoURL:      "fake-url",
+						Tag:          "1.0.0",
+						Digest:       "fake-digest",
+						ArtifactType: "fake-type",
+					},
+					artifact,
+				)
+				require.Equal(t, []string{"1.0.0", "0.9.0"}, candidates)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := reconciler{
+				credentialsDB:    testCase.credentialsDB,
+				selectArtifactFn: testCase.selectArtifactFn,
+			}
+			testCase.assertions(r.selectOCIArtifact(
+				context.Background(),
+				"fake-namespace",
+				kargoapi.OCIArtifactSubscription{
+					RepoURL:           "fake-url",
+					SelectionStrategy: kargoapi.OCIArtifactSelectionStrategySemVer,
+					ArtifactType:      "fake-type",
+				},
+			))
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package warehouses
+
+import (
+	"context"
+	goerrors "errors"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/distribution/distribution/v3/reference"
+	"golang.org/x/sync/semaphore"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/git"
+)
+
+const (
+	// maxConcurrentPolls is the maximum number of a single Warehouse's
+	// subscriptions, of all kinds, that are polled concurrently.
+	maxConcurrentPolls = 10
+	// maxConcurrentPollsPerHost is the maximum number of subscriptions, across
+	// all Warehouses, that concurrently poll repositories on any one image or
+	// artifact registry, chart repository host, or Git host. This complements
+	// the rate limiting applied to individual requests to image registries by
+	// bounding the number of expensive operations, such as clones, that are in
+	// flight.
+	maxConcurrentPollsPerHost = 5
+)
+
+// pollSemaphore limits the number of concurrent polls of repositories on a
+// single host. It counts the polls that reference it so that it can be
+// discarded once none do.
+type pollSemaphore struct {
+	*semaphore.Weighted
+	refs int
+}
+
+var (
+	// pollSemsByHost is a map of semaphores, indexed by host, that limit the
+	// number of concurrent polls of repositories on each host. Only hosts with
+	// polls pending or in flight have an entry, so the map does not grow with
+	// every host ever polled.
+	pollSemsByHost = map[string]*pollSemaphore{}
+	// pollSemsByHostMu is for preventing concurrent access to pollSemsByHost.
+	pollSemsByHostMu sync.Mutex
+)
+
+// getPollSemaphore returns the semaphore that limits the number of concurrent
+// polls of repositories on the specified host, initializing it if necessary.
+// Every call MUST be followed by a call to releasePollSemaphore for the same
+// host once the semaphore is no longer needed.
+func getPollSemaphore(host string) *semaphore.Weighted {
+	pollSemsByHostMu.Lock()
+	defer pollSemsByHostMu.Unlock()
+	sem, ok := pollSemsByHost[host]
+	if !ok {
+		sem = &pollSemaphore{
+			Weighted: semaphore.NewWeighted(maxConcurrentPollsPerHost),
+		}
+		pollSemsByHost[host] = sem
+	}
+	sem.refs++
+	return sem.Weighted
+}
+
+// releasePollSemaphore releases a reference obtained by getPollSemaphore to
+// the semaphore for the specified host, discarding the semaphore once no
+// references to it remain.
+func releasePollSemaphore(host string) {
+	pollSemsByHostMu.Lock()
+	defer pollSemsByHostMu.Unlock()
+	sem, ok := pollSemsByHost[host]
+	if !ok {
+		return
+	}
+	if sem.refs--; sem.refs <= 0 {
+		delete(pollSemsByHost, host)
+	}
+}
+
+// pollConcurrently concurrently invokes the provided poll function with the
+// index of each of the provided subscriptions, subject to maxConcurrentPolls
+// and to the per-host limit for the host of each subscription's repository.
+// It waits for all invocations to complete and returns any errors they
+// returned, joined in the order of the subscriptions.
+func pollConcurrently(
+	ctx context.Context,
+	subs []kargoapi.RepoSubscription,
+	poll func(i int) error,
+) error {
+	sem := semaphore.NewWeighted(maxConcurrentPolls)
+	errs := make([]error, len(subs))
+	var wg sync.WaitGroup
+	for i, sub := range subs {
+		wg.Add(1)
+		host := getSubscriptionHost(sub)
+		go func(i int, hostSem *semaphore.Weighted) {
+			defer wg.Done()
+			defer releasePollSemaphore(host)
+			if errs[i] = sem.Acquire(ctx, 1); errs[i] != nil {
+				return
+			}
+			defer sem.Release(1)
+			if errs[i] = hostSem.Acquire(ctx, 1); errs[i] != nil {
+				return
+			}
+			defer hostSem.Release(1)
+			errs[i] = poll(i)
+		}(i, getPollSemaphore(host))
+	}
+	wg.Wait()
+	return goerrors.Join(errs...)
+}
+
+// getSubscriptionHost returns the host of the repository specified by the
+// provided subscription. If the host cannot be determined, the repository URL
+// is returned instead.
+func getSubscriptionHost(sub kargoapi.RepoSubscription) string {
+	switch {
+	case sub.Git != nil:
+		return getGitHost(sub.Git.RepoURL)
+	case sub.Image != nil:
+		return getRegistryHost(sub.Image.RepoURL)
+	case sub.OCIArtifact != nil:
+		return getRegistryHost(sub.OCIArtifact.RepoURL)
+	case sub.Chart != nil:
+		u, err := url.Parse(sub.Chart.RepoURL)
+		if err != nil || u.Host == "" {
+			return sub.Chart.RepoURL
+		}
+		return u.Host
+	default:
+		return ""
+	}
+}
+
+// getRegistryHost returns the host of the registry containing the image or OCI
+// artifact repository with the provided URL. If the host cannot be determined,
+// the URL is returned instead.
+func getRegistryHost(repoURL string) string {
+	ref, err := reference.ParseNormalizedNamed(repoURL)
+	if err != nil {
+		return repoURL
+	}
+	return reference.Domain(ref)
+}
+
+// getGitHost returns the host of the Git repository with the provided URL,
+// which may be an HTTP/S URL, an SSH URL, or an SCP-like SSH address. If the
+// host cannot be determined, the URL is returned instead.
+func getGitHost(repoURL string) string {
+	normalized := git.NormalizeGitURL(repoURL)
+	if strings.Contains(normalized, "://") {
+		u, err := url.Parse(normalized)
+		if err != nil || u.Host == "" {
+			return repoURL
+		}
+		return u.Host
+	}
+	// SSH URLs are normalized to the form user@host/path
+	if _, hostAndPath, ok := strings.Cut(normalized, "@"); ok {
+		host, _, _ := strings.Cut(hostAndPath, "/")
+		return host
+	}
+	return repoURL
+}
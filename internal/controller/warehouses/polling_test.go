@@ -0,0 +1,240 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestPollConcurrently(t *testing.T) {
+	t.Run("polls every subscription and joins errors in order", func(t *testing.T) {
+		subs := []kargoapi.RepoSubscription{
+			{Image: &kargoapi.ImageSubscription{RepoURL: "example.com/a"}},
+			{Git: &kargoapi.GitSubscription{RepoURL: "https://example.com/b.git"}},
+			{Image: &kargoapi.ImageSubscription{RepoURL: "example.com/c"}},
+			{Image: &kargoapi.ImageSubscription{RepoURL: "example.com/d"}},
+		}
+		var mu sync.Mutex
+		var polled []int
+		err := pollConcurrently(
+			context.Background(),
+			subs,
+			func(i int) error {
+				mu.Lock()
+				polled = append(polled, i)
+				mu.Unlock()
+				if i == 0 {
+					// Finish last, so errors can't simply be in completion order
+					time.Sleep(10 * time.Millisecond)
+				}
+				if i == 3 {
+					return errors.New("error three")
+				}
+				if i == 0 {
+					return errors.New("error zero")
+				}
+				return nil
+			},
+		)
+		require.ElementsMatch(t, []int{0, 1, 2, 3}, polled)
+		require.Error(t, err)
+		require.Equal(t, "error zero\nerror three", err.Error())
+	})
+
+	t.Run("bounds concurrent polls of a single host", func(t *testing.T) {
+		subs := make([]kargoapi.RepoSubscription, 3*maxConcurrentPollsPerHost)
+		for i := range subs {
+			subs[i] = kargoapi.RepoSubscription{
+				Image: &kargoapi.ImageSubscription{
+					RepoURL: "bounded.example.com/image",
+				},
+			}
+		}
+		var inFlight, maxInFlight int32
+		err := pollConcurrently(
+			context.Background(),
+			subs,
+			func(int) error {
+				n := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					peak := atomic.LoadInt32(&maxInFlight)
+					if n <= peak || atomic.CompareAndSwapInt32(&maxInFlight, peak, n) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		require.LessOrEqual(t, maxInFlight, int32(maxConcurrentPollsPerHost))
+	})
+	t.Run("bounds concurrent polls of all kinds", func(t *testing.T) {
+		subs := make([]kargoapi.RepoSubscription, 3*maxConcurrentPolls)
+		for i := range subs {
+			// Every subscription is for a distinct host, so only the overall
+			// limit applies
+			host := fmt.Sprintf("host-%d.example.com", i)
+			switch i % 4 {
+			case 0:
+				subs[i].Git = &kargoapi.GitSubscription{
+					RepoURL: "https://" + host + "/repo.git",
+				}
+			case 1:
+				subs[i].Image = &kargoapi.ImageSubscription{RepoURL: host + "/image"}
+			case 2:
+				subs[i].Chart = &kargoapi.ChartSubscription{
+					RepoURL: "oci://" + host + "/chart",
+				}
+			case 3:
+				subs[i].OCIArtifact = &kargoapi.OCIArtifactSubscription{
+					RepoURL: host + "/artifact",
+				}
+			}
+		}
+		var inFlight, maxInFlight int32
+		err := pollConcurrently(
+			context.Background(),
+			subs,
+			func(int) error {
+				n := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					peak := atomic.LoadInt32(&maxInFlight)
+					if n <= peak || atomic.CompareAndSwapInt32(&maxInFlight, peak, n) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		require.LessOrEqual(t, maxInFlight, int32(maxConcurrentPolls))
+	})
+
+	t.Run("discards semaphores of hosts no longer polled", func(t *testing.T) {
+		subs := make([]kargoapi.RepoSubscription, 2*maxConcurrentPolls)
+		for i := range subs {
+			subs[i].Image = &kargoapi.ImageSubscription{
+				RepoURL: fmt.Sprintf("discarded-%d.example.com/image", i%3),
+			}
+		}
+		err := pollConcurrently(
+			context.Background(),
+			subs,
+			func(i int) error {
+				// The semaphore of a host is retained while it is being polled
+				host := getSubscriptionHost(subs[i])
+				pollSemsByHostMu.Lock()
+				defer pollSemsByHostMu.Unlock()
+				if _, ok := pollSemsByHost[host]; !ok {
+					return fmt.Errorf("no semaphore for %s", host)
+				}
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		pollSemsByHostMu.Lock()
+		defer pollSemsByHostMu.Unlock()
+		for host := range pollSemsByHost {
+			require.NotContains(t, host, "discarded-")
+		}
+	})
+}
+
+func TestGetSubscriptionHost(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sub      kargoapi.RepoSubscription
+		expected string
+	}{
+		{
+			name: "git HTTPS URL",
+			sub: kargoapi.RepoSubscription{
+				Git: &kargoapi.GitSubscription{
+					RepoURL: "https://github.com/akuity/kargo.git",
+				},
+			},
+			expected: "github.com",
+		},
+		{
+			name: "git SSH URL",
+			sub: kargoapi.RepoSubscription{
+				Git: &kargoapi.GitSubscription{
+					RepoURL: "ssh://git@github.com/akuity/kargo.git",
+				},
+			},
+			expected: "github.com",
+		},
+		{
+			name: "git SCP-like address",
+			sub: kargoapi.RepoSubscription{
+				Git: &kargoapi.GitSubscription{
+					RepoURL: "git@github.com:akuity/kargo.git",
+				},
+			},
+			expected: "github.com",
+		},
+		{
+			name: "Docker Hub image",
+			sub: kargoapi.RepoSubscription{
+				Image: &kargoapi.ImageSubscription{
+					RepoURL: "nginx",
+				},
+			},
+			expected: "docker.io",
+		},
+		{
+			name: "image with registry",
+			sub: kargoapi.RepoSubscription{
+				Image: &kargoapi.ImageSubscription{
+					RepoURL: "ghcr.io/akuity/kargo",
+				},
+			},
+			expected: "ghcr.io",
+		},
+		{
+			name: "OCI artifact",
+			sub: kargoapi.RepoSubscription{
+				OCIArtifact: &kargoapi.OCIArtifactSubscription{
+					RepoURL: "ghcr.io/akuity/kargo-manifests",
+				},
+			},
+			expected: "ghcr.io",
+		},
+		{
+			name: "classic chart repository",
+			sub: kargoapi.RepoSubscription{
+				Chart: &kargoapi.ChartSubscription{
+					RepoURL: "https://charts.example.com",
+					Name:    "fake-chart",
+				},
+			},
+			expected: "charts.example.com",
+		},
+		{
+			name: "OCI chart repository",
+			sub: kargoapi.RepoSubscription{
+				Chart: &kargoapi.ChartSubscription{
+					RepoURL: "oci://ghcr.io/akuity/kargo-charts/kargo",
+				},
+			},
+			expected: "ghcr.io",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, getSubscriptionHost(testCase.sub))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package warehouses
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+// SubscriptionPreview describes what polling a single subscription would
+// yield.
+type SubscriptionPreview struct {
+	// Candidates lists every version found in the subscribed repository that
+	// satisfied the subscription's criteria, ordered from most to least
+	// preferred. Versions are tags or commit IDs for Git repositories, tags for
+	// image repositories, and versions for chart repositories.
+	Candidates []string
+	// Selected is the version that would be selected.
+	Selected string
+	// UnverifiedRevisions describes any candidate revisions from a Git repository
+	// that would have been preferred over the selected one, but were disregarded
+	// because their signatures could not be verified.
+	UnverifiedRevisions []kargoapi.UnverifiedGitRevision
+}
+
+// PreviewSubscription polls the repository specified by the provided
+// RepoSubscription exactly as a Warehouse in the specified namespace would,
+// using the same selection logic and any credentials available to that
+// namespace, and describes the result. No Freight is created.
+func PreviewSubscription(
+	ctx context.Context,
+	kubeClient client.Client,
+	credentialsDB credentials.Database,
+	namespace string,
+	sub kargoapi.RepoSubscription,
+) (*SubscriptionPreview, error) {
+	r := newReconciler(kubeClient, credentialsDB)
+	switch {
+	case sub.Git != nil:
+		gm, err := r.selectCommit(ctx, namespace, *sub.Git)
+		if err != nil {
+			return nil, err
+		}
+		selected := gm.Commit
+		if gm.Tag != "" {
+			selected = gm.Tag
+		}
+		return &SubscriptionPreview{
+			Candidates:          gm.Candidates,
+			Selected:            selected,
+			UnverifiedRevisions: gm.Unverified,
+		}, nil
+	case sub.Image != nil:
+		img, candidates, err := r.selectImage(ctx, namespace, *sub.Image)
+		if err != nil {
+			return nil, err
+		}
+		return &SubscriptionPreview{
+			Candidates: candidates,
+			Selected:   img.Tag,
+		}, nil
+	case sub.Chart != nil:
+		chart, candidates, err := r.selectChart(ctx, namespace, *sub.Chart)
+		if err != nil {
+			return nil, err
+		}
+		return &SubscriptionPreview{
+			Candidates: candidates,
+			Selected:   chart.Version,
+		}, nil
+	case sub.OCIArtifact != nil:
+		artifact, candidates, err :=
+			r.selectOCIArtifact(ctx, namespace, *sub.OCIArtifact)
+		if err != nil {
+			return nil, err
+		}
+		return &SubscriptionPreview{
+			Candidates: candidates,
+			Selected:   artifact.Tag,
+		}, nil
+	default:
+		return nil, errors.New("subscription does not specify a repository")
+	}
+}
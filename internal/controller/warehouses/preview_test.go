@@ -0,0 +1,24 @@
+package warehouses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+func TestPreviewSubscription(t *testing.T) {
+	_, err := PreviewSubscription(
+		context.Background(),
+		fake.NewClientBuilder().Build(),
+		&credentials.FakeDB{},
+		"fake-namespace",
+		kargoapi.RepoSubscription{},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "subscription does not specify a repository")
+}
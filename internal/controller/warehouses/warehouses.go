@@ -4,11 +4,15 @@ import (
 	"context"
 	"time"
 
+	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/technosophos/moniker"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -24,39 +28,69 @@ import (
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/oci"
 )
 
+// minPollingInterval is the most often a Warehouse polls its subscriptions,
+// regardless of the interval it specifies.
+const minPollingInterval = time.Minute
+
+// defaultPollingInterval is how often a Warehouse polls its subscriptions when
+// it does not specify an interval of its own.
+const defaultPollingInterval = 5 * time.Minute
+
+// maxRecentVersions is the maximum number of candidate versions recorded in
+// the status of each of a Warehouse's subscriptions.
+const maxRecentVersions = 10
+
 // reconciler reconciles Warehouse resources.
 type reconciler struct {
 	client                     client.Client
 	credentialsDB              credentials.Database
 	imageSourceURLFnsByBaseURL map[string]func(string, string) string
 	freightAliasGenerator      moniker.Namer
+	// chartAppVersionCache caches the appVersion of each version of each chart
+	// by namespace, repository URL, chart name, and version. A published chart
+	// version is immutable, so this spares polling from downloading it again.
+	chartAppVersionCache *cache.Cache
 
 	// The following behaviors are overridable for testing purposes:
 
 	getLatestFreightFromReposFn func(
 		context.Context,
 		*kargoapi.Warehouse,
+		*kargoapi.WarehouseStatus,
 	) (*kargoapi.Freight, error)
 
-	selectCommitsFn func(
+	selectCommitFn func(
+		ctx context.Context,
+		namespace string,
+		sub kargoapi.GitSubscription,
+	) (*gitMeta, error)
+
+	getSecretFn func(
 		ctx context.Context,
 		namespace string,
-		subs []kargoapi.RepoSubscription,
-	) ([]kargoapi.GitCommit, error)
+		name string,
+	) (*corev1.Secret, error)
 
 	getLastCommitIDFn func(repo git.Repo) (string, error)
 
 	listTagsFn func(repo git.Repo) ([]string, error)
 
+	listCommitsFn func(repo git.Repo) ([]git.CommitMetadata, error)
+
 	checkoutTagFn func(repo git.Repo, tag string) error
 
-	selectImagesFn func(
+	verifyCommitFn func(repo git.Repo, id string) error
+
+	verifyTagFn func(repo git.Repo, tag string) error
+
+	selectImageFn func(
 		ctx context.Context,
 		namespace string,
-		subs []kargoapi.RepoSubscription,
-	) ([]kargoapi.Image, error)
+		sub kargoapi.ImageSubscription,
+	) (*kargoapi.Image, []string, error)
 
 	getImageRefsFn func(
 		ctx context.Context,
@@ -67,26 +101,62 @@ type reconciler struct {
 		ignoreTags []string,
 		platform string,
 		creds *image.Credentials,
-	) (string, string, error)
+	) (string, string, []string, error)
 
-	selectChartsFn func(
+	selectChartFn func(
 		ctx context.Context,
 		namespace string,
-		subs []kargoapi.RepoSubscription,
-	) ([]kargoapi.Chart, error)
+		sub kargoapi.ChartSubscription,
+	) (*kargoapi.Chart, []string, error)
 
-	selectChartVersionFn func(
+	selectChartVersionsFn func(
 		ctx context.Context,
 		repoURL string,
 		chart string,
 		semverConstraint string,
 		creds *helm.Credentials,
-	) (string, error)
+	) ([]string, error)
+
+	selectOCIArtifactFn func(
+		ctx context.Context,
+		namespace string,
+		sub kargoapi.OCIArtifactSubscription,
+	) (*kargoapi.OCIArtifact, []string, error)
+
+	selectArtifactFn func(
+		ctx context.Context,
+		repoURL string,
+		strategy oci.SelectionStrategy,
+		opts *oci.SelectorOptions,
+	) (*oci.Artifact, []string, error)
 
 	selectCommitMetaFn func(
 		context.Context,
 		kargoapi.GitSubscription,
 		*git.RepoCredentials,
+		*git.TrustedSigners,
+	) (*gitMeta, error)
+
+	getFreightFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Freight, error)
+
+	getChartAppVersionFn func(
+		ctx context.Context,
+		repoURL string,
+		chart string,
+		version string,
+		creds *helm.Credentials,
+	) (string, error)
+
+	getCommitMetaFn func(
+		ctx context.Context,
+		sub kargoapi.GitSubscription,
+		id string,
+		creds *git.RepoCredentials,
+		signers *git.TrustedSigners,
 	) (*gitMeta, error)
 
 	getAvailableFreightAliasFn func(context.Context) (string, error)
@@ -147,17 +217,44 @@ func newReconciler(
 			githubURLPrefix: getGithubImageSourceURL,
 		},
 		freightAliasGenerator: moniker.New(),
+		chartAppVersionCache: cache.New(
+			30*time.Minute, // Default ttl for each entry
+			time.Hour,      // Cleanup interval
+		),
 	}
 	r.getLatestFreightFromReposFn = r.getLatestFreightFromRepos
-	r.selectCommitsFn = r.selectCommits
+	r.selectCommitFn = r.selectCommit
+	r.getSecretFn = func(
+		ctx context.Context,
+		namespace string,
+		name string,
+	) (*corev1.Secret, error) {
+		secret := &corev1.Secret{}
+		if err := kubeClient.Get(
+			ctx,
+			types.NamespacedName{Namespace: namespace, Name: name},
+			secret,
+		); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		return secret, nil
+	}
 	r.getLastCommitIDFn = r.getLastCommitID
 	r.listTagsFn = r.listTags
+	r.listCommitsFn = r.listCommits
 	r.checkoutTagFn = r.checkoutTag
-	r.selectImagesFn = r.selectImages
+	r.verifyCommitFn = r.verifyCommit
+	r.verifyTagFn = r.verifyTag
+	r.selectImageFn = r.selectImage
 	r.getImageRefsFn = getImageRefs
-	r.selectChartsFn = r.selectCharts
-	r.selectChartVersionFn = helm.SelectChartVersion
+	r.selectChartFn = r.selectChart
+	r.selectChartVersionsFn = helm.SelectChartVersions
+	r.selectOCIArtifactFn = r.selectOCIArtifact
+	r.selectArtifactFn = oci.SelectArtifact
 	r.selectCommitMetaFn = r.selectCommitMeta
+	r.getCommitMetaFn = r.getCommitMeta
+	r.getFreightFn = kargoapi.GetFreight
+	r.getChartAppVersionFn = helm.GetChartAppVersion
 	r.getAvailableFreightAliasFn = r.getAvailableFreightAlias
 	r.createFreightFn = kubeClient.Create
 	return r
@@ -173,9 +270,7 @@ func (r *reconciler) Reconcile(
 		// Note: If there is a failure, controller runtime ignores this and uses
 		// progressive backoff instead. So this value only affects when we will
 		// reconcile next if THIS reconciliation succeeds.
-		//
-		// TODO: Make this configurable
-		RequeueAfter: 5 * time.Minute,
+		RequeueAfter: defaultPollingInterval,
 	}
 
 	logger := logging.LoggerFromContext(ctx)
@@ -197,11 +292,31 @@ func (r *reconciler) Reconcile(
 		// the current reconciliation request was issued.
 		return result, nil
 	}
+	result.RequeueAfter = getPollingInterval(warehouse)
 
 	newStatus, err := r.syncWarehouse(ctx, warehouse)
+	if remaining := getRemainingSettleTime(warehouse, newStatus); remaining > 0 &&
+		remaining < result.RequeueAfter {
+		// Don't wait for the next poll to create Freight that has settled
+		result.RequeueAfter = remaining
+	}
 	if err != nil {
 		newStatus.Error = err.Error()
 		logger.Errorf("error syncing Warehouse: %s", err)
+		meta.SetStatusCondition(&newStatus.Conditions, metav1.Condition{
+			Type:               kargoapi.WarehouseConditionTypeReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: warehouse.Generation,
+			Reason:             kargoapi.WarehouseReasonSyncFailed,
+			Message:            err.Error(),
+		})
+	} else {
+		meta.SetStatusCondition(&newStatus.Conditions, metav1.Condition{
+			Type:               kargoapi.WarehouseConditionTypeReady,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: warehouse.Generation,
+			Reason:             kargoapi.WarehouseReasonSynced,
+		})
 	}
 
 	updateErr := kubeclient.PatchStatus(
@@ -232,6 +347,39 @@ func (r *reconciler) Reconcile(
 	return result, err
 }
 
+// getPollingInterval returns the interval at which the provided Warehouse's
+// subscriptions should be polled. If the Warehouse does not specify a valid,
+// positive interval, the default interval is returned. An interval shorter
+// than the minimum, which should have been rejected when the Warehouse was
+// admitted, is raised to the minimum.
+func getPollingInterval(warehouse *kargoapi.Warehouse) time.Duration {
+	if warehouse.Spec == nil || warehouse.Spec.Interval == "" {
+		return defaultPollingInterval
+	}
+	interval, err := time.ParseDuration(warehouse.Spec.Interval)
+	if err != nil || interval <= 0 {
+		return defaultPollingInterval
+	}
+	if interval < minPollingInterval {
+		return minPollingInterval
+	}
+	return interval
+}
+
+// getRemainingSettleTime returns how much longer the Freight pending creation
+// for the provided Warehouse must remain unchanged before it may be created.
+// If no Freight is pending creation, zero is returned.
+func getRemainingSettleTime(
+	warehouse *kargoapi.Warehouse,
+	status kargoapi.WarehouseStatus,
+) time.Duration {
+	if warehouse.Spec == nil || status.PendingFreight == nil {
+		return 0
+	}
+	return getSettleTime(warehouse.Spec.FreightAssembly) -
+		time.Since(status.PendingFreight.Since.Time)
+}
+
 func (r *reconciler) syncWarehouse(
 	ctx context.Context,
 	warehouse *kargoapi.Warehouse,
@@ -239,10 +387,11 @@ func (r *reconciler) syncWarehouse(
 	status := *warehouse.Status.DeepCopy()
 	status.ObservedGeneration = warehouse.Generation
 	status.Error = "" // Clear any previous error
+	status.UnverifiedRevisions = nil
 
 	logger := logging.LoggerFromContext(ctx)
 
-	freight, err := r.getLatestFreightFromReposFn(ctx, warehouse)
+	freight, err := r.getLatestFreightFromReposFn(ctx, warehouse, &status)
 	if err != nil {
 		return status,
 			errors.Wrap(err, "error getting latest Freight from repositories")
@@ -253,6 +402,16 @@ func (r *reconciler) syncWarehouse(
 	}
 	logger.Debug("got latest Freight from repositories")
 
+	ok, err := r.applyAssemblyRules(ctx, warehouse, freight, &status)
+	if err != nil {
+		return status, errors.Wrap(err, "error applying Freight assembly rules")
+	}
+	if !ok {
+		logger.WithField("reason", status.PendingFreight.Reason).
+			Debug("Freight is pending creation")
+		return status, nil
+	}
+
 	freight.Labels = map[string]string{}
 	if freight.Labels[kargoapi.AliasLabelKey], err =
 		r.getAvailableFreightAliasFn(ctx); err != nil {
@@ -284,56 +443,225 @@ func (r *reconciler) syncWarehouse(
 	return status, nil
 }
 
+// getLatestFreightFromRepos assembles Freight from the newest artifacts of
+// interest found in each of the provided Warehouse's subscriptions. Any
+// observations about the subscriptions that are worth surfacing are recorded
+// in the provided WarehouseStatus.
 func (r *reconciler) getLatestFreightFromRepos(
 	ctx context.Context,
 	warehouse *kargoapi.Warehouse,
+	status *kargoapi.WarehouseStatus,
 ) (*kargoapi.Freight, error) {
 	logger := logging.LoggerFromContext(ctx)
 
-	selectedCommits, err := r.selectCommitsFn(
-		ctx,
-		warehouse.Namespace,
-		warehouse.Spec.Subscriptions,
-	)
-	if err != nil {
-		return nil, errors.Wrap(err, "error syncing git repo subscriptions")
+	subs := warehouse.Spec.Subscriptions
+	status.Subscriptions = newSubscriptionStatuses(subs, status.Subscriptions)
+
+	// Subscriptions of all kinds are polled through a single pool. Every
+	// subscription is polled, even if polling another fails, so that the status
+	// of each is always current.
+	selections := make([]subscriptionSelection, len(subs))
+	err := pollConcurrently(ctx, subs, func(i int) error {
+		var err error
+		selections[i], err = r.pollSubscription(ctx, warehouse.Namespace, subs[i])
+		if err != nil {
+			recordPollError(status.Subscriptions, i, err)
+			return err
+		}
+		recordPolled(
+			status.Subscriptions,
+			i,
+			selections[i].version,
+			selections[i].candidates,
+		)
+		return nil
+	})
+
+	status.UnverifiedRevisions = nil
+	for _, sel := range selections {
+		status.UnverifiedRevisions =
+			append(status.UnverifiedRevisions, sel.unverified...)
 	}
-	logger.Debug("synced git repo subscriptions")
 
-	selectedImages, err := r.selectImagesFn(
-		ctx,
-		warehouse.Namespace,
-		warehouse.Spec.Subscriptions,
-	)
 	if err != nil {
-		return nil, errors.Wrap(err, "error syncing image repo subscriptions")
+		// Freight is never assembled from a subset of the subscriptions, but the
+		// status of every subscription that was polled successfully has been
+		// recorded, so report how many subscriptions were affected.
+		var failed int
+		for _, s := range status.Subscriptions {
+			if s.Error != "" {
+				failed++
+			}
+		}
+		return nil, errors.Wrapf(
+			err,
+			"error polling %d of %d subscriptions",
+			failed,
+			len(subs),
+		)
+	}
+	logger.Debug("polled subscriptions")
+
+	var commits []kargoapi.GitCommit
+	var images []kargoapi.Image
+	var charts []kargoapi.Chart
+	var artifacts []kargoapi.OCIArtifact
+	for _, sel := range selections {
+		switch {
+		case sel.commit != nil:
+			commits = append(commits, *sel.commit)
+		case sel.image != nil:
+			images = append(images, *sel.image)
+		case sel.chart != nil:
+			charts = append(charts, *sel.chart)
+		case sel.artifact != nil:
+			artifacts = append(artifacts, *sel.artifact)
+		}
 	}
-	logger.Debug("synced image repo subscriptions")
+	return newFreight(warehouse, commits, images, charts, artifacts), nil
+}
 
-	selectedCharts, err := r.selectChartsFn(
-		ctx,
-		warehouse.Namespace,
-		warehouse.Spec.Subscriptions,
-	)
-	if err != nil {
-		return nil, errors.Wrap(err, "error syncing chart repo subscriptions")
+// subscriptionSelection is the outcome of polling a single subscription.
+// Exactly one of commit, image, chart, or artifact is set if polling
+// succeeded.
+type subscriptionSelection struct {
+	commit   *kargoapi.GitCommit
+	image    *kargoapi.Image
+	chart    *kargoapi.Chart
+	artifact *kargoapi.OCIArtifact
+	// version is the selected version, as recorded in the subscription's
+	// status.
+	version string
+	// candidates are the versions the selection was made from, ordered from
+	// most to least preferred.
+	candidates []string
+	// unverified describes any candidate Git revisions that were disregarded
+	// because their signatures could not be verified. These are reported even
+	// if polling failed.
+	unverified []kargoapi.UnverifiedGitRevision
+}
+
+// pollSubscription selects the newest artifact of interest from the provided
+// subscription using the function appropriate to the subscription's kind.
+func (r *reconciler) pollSubscription(
+	ctx context.Context,
+	namespace string,
+	sub kargoapi.RepoSubscription,
+) (subscriptionSelection, error) {
+	var sel subscriptionSelection
+	var err error
+	switch {
+	case sub.Git != nil:
+		var gm *gitMeta
+		if gm, err = r.selectCommitFn(ctx, namespace, *sub.Git); gm != nil {
+			sel.unverified = gm.Unverified
+		}
+		if err != nil {
+			return sel, err
+		}
+		sel.commit = &kargoapi.GitCommit{
+			RepoURL:     sub.Git.RepoURL,
+			ID:          gm.Commit,
+			Branch:      sub.Git.Branch,
+			Tag:         gm.Tag,
+			Message:     gm.Message,
+			Author:      gm.Author,
+			CommittedAt: gm.CommittedAt,
+		}
+		sel.version = gm.Commit
+		if gm.Tag != "" {
+			sel.version = gm.Tag
+		}
+		sel.candidates = gm.Candidates
+	case sub.Image != nil:
+		if sel.image, sel.candidates, err =
+			r.selectImageFn(ctx, namespace, *sub.Image); err != nil {
+			return sel, err
+		}
+		sel.version = sel.image.Tag
+	case sub.Chart != nil:
+		if sel.chart, sel.candidates, err =
+			r.selectChartFn(ctx, namespace, *sub.Chart); err != nil {
+			return sel, err
+		}
+		sel.version = sel.chart.Version
+	case sub.OCIArtifact != nil:
+		if sel.artifact, sel.candidates, err =
+			r.selectOCIArtifactFn(ctx, namespace, *sub.OCIArtifact); err != nil {
+			return sel, err
+		}
+		sel.version = sel.artifact.Tag
 	}
-	logger.Debug("synced chart repo subscriptions")
+	return sel, nil
+}
 
-	ownerRef := metav1.NewControllerRef(
-		warehouse,
-		kargoapi.GroupVersion.WithKind("Warehouse"),
-	)
-	freight := &kargoapi.Freight{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace:       warehouse.Namespace,
-			OwnerReferences: []metav1.OwnerReference{*ownerRef},
-		},
-		Commits: selectedCommits,
-		Images:  selectedImages,
-		Charts:  selectedCharts,
+// newSubscriptionStatuses returns a SubscriptionStatus for each of the provided
+// subscriptions. Observations recorded in the provided, previous statuses are
+// carried over for any subscription that has not changed position or
+// repository since they were recorded. Any previous error is not.
+func newSubscriptionStatuses(
+	subs []kargoapi.RepoSubscription,
+	prevStatuses []kargoapi.SubscriptionStatus,
+) []kargoapi.SubscriptionStatus {
+	statuses := make([]kargoapi.SubscriptionStatus, len(subs))
+	for i, sub := range subs {
+		status := &statuses[i]
+		switch {
+		case sub.Git != nil:
+			status.RepoURL = sub.Git.RepoURL
+		case sub.Image != nil:
+			status.RepoURL = sub.Image.RepoURL
+		case sub.Chart != nil:
+			status.RepoURL = sub.Chart.RepoURL
+			status.Chart = sub.Chart.Name
+		case sub.OCIArtifact != nil:
+			status.RepoURL = sub.OCIArtifact.RepoURL
+		}
+		if i < len(prevStatuses) &&
+			prevStatuses[i].RepoURL == status.RepoURL &&
+			prevStatuses[i].Chart == status.Chart {
+			status.LastPolled = prevStatuses[i].LastPolled
+			status.LatestVersion = prevStatuses[i].LatestVersion
+			status.RecentVersions = prevStatuses[i].RecentVersions
+		}
+	}
+	return statuses
+}
+
+// recordPolled records, in the status at index i of the provided statuses, that
+// the corresponding subscription was just polled successfully, resulting in
+// the selection of the provided version from the provided candidates. If there
+// is no status at index i, this is a no-op.
+func recordPolled(
+	statuses []kargoapi.SubscriptionStatus,
+	i int,
+	version string,
+	candidates []string,
+) {
+	if i >= len(statuses) {
+		return
+	}
+	if len(candidates) > maxRecentVersions {
+		candidates = candidates[:maxRecentVersions]
+	}
+	now := metav1.Now()
+	statuses[i].LastPolled = &now
+	statuses[i].LatestVersion = version
+	statuses[i].RecentVersions = append([]string(nil), candidates...)
+	statuses[i].Error = ""
+}
+
+// recordPollError records, in the status at index i of the provided statuses,
+// that polling the corresponding subscription failed with the provided error.
+// Observations from the last successful poll are left intact. If there is no
+// status at index i, this is a no-op.
+func recordPollError(
+	statuses []kargoapi.SubscriptionStatus,
+	i int,
+	err error,
+) {
+	if i >= len(statuses) {
+		return
 	}
-	freight.UpdateID()
-	freight.ObjectMeta.Name = freight.ID
-	return freight, nil
+	statuses[i].Error = err.Error()
 }
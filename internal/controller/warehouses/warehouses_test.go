@@ -2,7 +2,9 @@ package warehouses
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -26,18 +28,24 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, e.client)
 	require.NotNil(t, e.credentialsDB)
 	require.NotEmpty(t, e.imageSourceURLFnsByBaseURL)
+	require.NotNil(t, e.chartAppVersionCache)
 
 	// Assert that all overridable behaviors were initialized to a default:
 	require.NotNil(t, e.getLatestFreightFromReposFn)
-	require.NotNil(t, e.selectCommitsFn)
+	require.NotNil(t, e.selectCommitFn)
 	require.NotNil(t, e.getLastCommitIDFn)
 	require.NotNil(t, e.listTagsFn)
 	require.NotNil(t, e.checkoutTagFn)
-	require.NotNil(t, e.selectImagesFn)
+	require.NotNil(t, e.selectImageFn)
 	require.NotNil(t, e.getImageRefsFn)
-	require.NotNil(t, e.selectChartsFn)
-	require.NotNil(t, e.selectChartVersionFn)
+	require.NotNil(t, e.selectChartFn)
+	require.NotNil(t, e.selectChartVersionsFn)
+	require.NotNil(t, e.selectOCIArtifactFn)
+	require.NotNil(t, e.selectArtifactFn)
 	require.NotNil(t, e.selectCommitMetaFn)
+	require.NotNil(t, e.getCommitMetaFn)
+	require.NotNil(t, e.getFreightFn)
+	require.NotNil(t, e.getChartAppVersionFn)
 	require.NotNil(t, e.getAvailableFreightAliasFn)
 	require.NotNil(t, e.createFreightFn)
 }
@@ -60,6 +68,7 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
+					*kargoapi.WarehouseStatus,
 				) (*kargoapi.Freight, error) {
 					return nil, errors.New("something went wrong")
 				},
@@ -81,6 +90,7 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
+					*kargoapi.WarehouseStatus,
 				) (*kargoapi.Freight, error) {
 					return nil, nil
 				},
@@ -96,6 +106,7 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
+					*kargoapi.WarehouseStatus,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -120,6 +131,7 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
+					*kargoapi.WarehouseStatus,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -151,6 +163,7 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
+					*kargoapi.WarehouseStatus,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
@@ -178,6 +191,7 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
+					*kargoapi.WarehouseStatus,
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{
 						ObjectMeta: metav1.ObjectMeta{
@@ -212,136 +226,164 @@ func TestSyncWarehouse(t *testing.T) {
 }
 
 func TestGetLatestFreightFromRepos(t *testing.T) {
+	// The single-subscription selection functions used by every test case
+	// unless overridden
+	selectCommit := func(
+		context.Context,
+		string,
+		kargoapi.GitSubscription,
+	) (*gitMeta, error) {
+		return &gitMeta{
+			Commit:     "fake-commit",
+			Tag:        "v1.0.0",
+			Candidates: []string{"v1.0.0", "v0.9.0"},
+		}, nil
+	}
+	selectImage := func(
+		_ context.Context,
+		_ string,
+		sub kargoapi.ImageSubscription,
+	) (*kargoapi.Image, []string, error) {
+		return &kargoapi.Image{
+			RepoURL: sub.RepoURL,
+			Tag:     "fake-tag",
+		}, []string{"fake-tag"}, nil
+	}
+	selectChart := func(
+		_ context.Context,
+		_ string,
+		sub kargoapi.ChartSubscription,
+	) (*kargoapi.Chart, []string, error) {
+		return &kargoapi.Chart{
+			RepoURL: sub.RepoURL,
+			Name:    sub.Name,
+			Version: "fake-version",
+		}, []string{"fake-version"}, nil
+	}
+	selectOCIArtifact := func(
+		_ context.Context,
+		_ string,
+		sub kargoapi.OCIArtifactSubscription,
+	) (*kargoapi.OCIArtifact, []string, error) {
+		return &kargoapi.OCIArtifact{
+			RepoURL: sub.RepoURL,
+			Tag:     "fake-tag",
+			Digest:  "fake-digest",
+		}, []string{"fake-tag"}, nil
+	}
+
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func(*kargoapi.Freight, error)
+		assertions func(*kargoapi.Freight, *kargoapi.WarehouseStatus, error)
 	}{
 		{
-			name: "error getting latest git commits",
+			name: "error polling git subscription",
 			reconciler: &reconciler{
-				selectCommitsFn: func(
+				selectCommitFn: func(
 					context.Context,
 					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.GitCommit, error) {
-					return nil, errors.New("something went wrong")
+					kargoapi.GitSubscription,
+				) (*gitMeta, error) {
+					return &gitMeta{
+						Unverified: []kargoapi.UnverifiedGitRevision{
+							{
+								RepoURL: "fake-git-repo",
+								Tag:     "v2.0.0",
+								Reason:  "tag is not signed",
+							},
+						},
+					}, errors.New("something went wrong")
 				},
+				selectImageFn:       selectImage,
+				selectChartFn:       selectChart,
+				selectOCIArtifactFn: selectOCIArtifact,
 			},
-			assertions: func(freight *kargoapi.Freight, err error) {
+			assertions: func(
+				freight *kargoapi.Freight,
+				status *kargoapi.WarehouseStatus,
+				err error,
+			) {
 				require.Error(t, err)
-				require.Contains(t, err.Error(), "error syncing git repo subscription")
+				require.Contains(t, err.Error(), "error polling 1 of 5 subscriptions")
 				require.Contains(t, err.Error(), "something went wrong")
-			},
-		},
-
-		{
-			name: "error getting latest images",
-			reconciler: &reconciler{
-				selectCommitsFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.GitCommit, error) {
-					return nil, nil
-				},
-				selectImagesFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Image, error) {
-					return nil, errors.New("something went wrong")
-				},
-			},
-			assertions: func(freight *kargoapi.Freight, err error) {
-				require.Error(t, err)
-				require.Contains(
+				require.Nil(t, freight)
+				require.Equal(t, "something went wrong", status.Subscriptions[0].Error)
+				// Subscriptions of other kinds were still polled
+				for _, s := range status.Subscriptions[1:] {
+					require.Empty(t, s.Error)
+					require.NotNil(t, s.LastPolled)
+				}
+				// Revisions that could not be verified are reported even though
+				// polling failed
+				require.Equal(
 					t,
-					err.Error(),
-					"error syncing image repo subscriptions",
+					[]kargoapi.UnverifiedGitRevision{
+						{
+							RepoURL: "fake-git-repo",
+							Tag:     "v2.0.0",
+							Reason:  "tag is not signed",
+						},
+					},
+					status.UnverifiedRevisions,
 				)
-				require.Contains(t, err.Error(), "something went wrong")
 			},
 		},
 
 		{
-			name: "error getting latest charts",
+			name: "error polling subscriptions of several kinds",
 			reconciler: &reconciler{
-				selectCommitsFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.GitCommit, error) {
-					return nil, nil
+				selectCommitFn: selectCommit,
+				selectImageFn: func(
+					_ context.Context,
+					_ string,
+					sub kargoapi.ImageSubscription,
+				) (*kargoapi.Image, []string, error) {
+					if sub.RepoURL == "other-image-repo" {
+						return nil, nil, errors.New("image went wrong")
+					}
+					return selectImage(context.Background(), "", sub)
 				},
-				selectImagesFn: func(
+				selectChartFn: selectChart,
+				selectOCIArtifactFn: func(
 					context.Context,
 					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Image, error) {
-					return nil, nil
-				},
-				selectChartsFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Chart, error) {
-					return nil, errors.New("something went wrong")
+					kargoapi.OCIArtifactSubscription,
+				) (*kargoapi.OCIArtifact, []string, error) {
+					return nil, nil, errors.New("artifact went wrong")
 				},
 			},
-			assertions: func(freight *kargoapi.Freight, err error) {
+			assertions: func(
+				freight *kargoapi.Freight,
+				status *kargoapi.WarehouseStatus,
+				err error,
+			) {
 				require.Error(t, err)
-				require.Contains(
-					t,
-					err.Error(),
-					"error syncing chart repo subscriptions",
-				)
-				require.Contains(t, err.Error(), "something went wrong")
+				require.Contains(t, err.Error(), "error polling 2 of 5 subscriptions")
+				// Errors are reported in the order of the subscriptions
+				require.Contains(t, err.Error(), "image went wrong\nartifact went wrong")
+				require.Nil(t, freight)
+				require.Empty(t, status.Subscriptions[0].Error)
+				require.Empty(t, status.Subscriptions[1].Error)
+				require.Equal(t, "image went wrong", status.Subscriptions[2].Error)
+				require.Empty(t, status.Subscriptions[3].Error)
+				require.Equal(t, "artifact went wrong", status.Subscriptions[4].Error)
 			},
 		},
 
 		{
 			name: "success",
 			reconciler: &reconciler{
-				selectCommitsFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.GitCommit, error) {
-					return []kargoapi.GitCommit{
-						{
-							RepoURL: "fake-url",
-							ID:      "fake-commit",
-						},
-					}, nil
-				},
-				selectImagesFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Image, error) {
-					return []kargoapi.Image{
-						{
-							RepoURL: "fake-url",
-							Tag:     "fake-tag",
-						},
-					}, nil
-				},
-				selectChartsFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Chart, error) {
-					return []kargoapi.Chart{
-						{
-							RepoURL: "fake-repo",
-							Name:    "fake-chart",
-							Version: "fake-version",
-						},
-					}, nil
-				},
+				selectCommitFn:      selectCommit,
+				selectImageFn:       selectImage,
+				selectChartFn:       selectChart,
+				selectOCIArtifactFn: selectOCIArtifact,
 			},
-			assertions: func(freight *kargoapi.Freight, err error) {
+			assertions: func(
+				freight *kargoapi.Freight,
+				status *kargoapi.WarehouseStatus,
+				err error,
+			) {
 				require.NoError(t, err)
 				require.NotNil(t, freight)
 				require.NotEmpty(t, freight.Name)
@@ -359,42 +401,237 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 						},
 						Commits: []kargoapi.GitCommit{
 							{
-								RepoURL: "fake-url",
+								RepoURL: "fake-git-repo",
 								ID:      "fake-commit",
+								Branch:  "main",
+								Tag:     "v1.0.0",
 							},
 						},
 						Images: []kargoapi.Image{
 							{
-								RepoURL: "fake-url",
+								RepoURL: "fake-image-repo",
+								Tag:     "fake-tag",
+							},
+							{
+								RepoURL: "other-image-repo",
 								Tag:     "fake-tag",
 							},
 						},
 						Charts: []kargoapi.Chart{
 							{
-								RepoURL: "fake-repo",
+								RepoURL: "fake-chart-repo",
 								Name:    "fake-chart",
 								Version: "fake-version",
 							},
 						},
+						OCIArtifacts: []kargoapi.OCIArtifact{
+							{
+								RepoURL: "fake-artifact-repo",
+								Tag:     "fake-tag",
+								Digest:  "fake-digest",
+							},
+						},
 					},
 					freight,
 				)
+				require.Len(t, status.Subscriptions, 5)
+				for _, s := range status.Subscriptions {
+					require.Empty(t, s.Error)
+					require.NotNil(t, s.LastPolled)
+				}
+				// A Git subscription's latest version is its tag, if it has one
+				require.Equal(t, "v1.0.0", status.Subscriptions[0].LatestVersion)
+				require.Equal(
+					t,
+					[]string{"v1.0.0", "v0.9.0"},
+					status.Subscriptions[0].RecentVersions,
+				)
+				require.Equal(t, "fake-tag", status.Subscriptions[1].LatestVersion)
+				require.Equal(t, "fake-version", status.Subscriptions[3].LatestVersion)
+				require.Equal(t, "fake-tag", status.Subscriptions[4].LatestVersion)
+				require.Empty(t, status.UnverifiedRevisions)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				testCase.reconciler.getLatestFreightFromRepos(
-					context.Background(),
+			status := &kargoapi.WarehouseStatus{}
+			freight, err := testCase.reconciler.getLatestFreightFromRepos(
+				context.Background(),
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+					},
+					Spec: &kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{
+							{
+								Git: &kargoapi.GitSubscription{
+									RepoURL: "fake-git-repo",
+									Branch:  "main",
+								},
+							},
+							{Image: &kargoapi.ImageSubscription{RepoURL: "fake-image-repo"}},
+							{Image: &kargoapi.ImageSubscription{RepoURL: "other-image-repo"}},
+							{
+								Chart: &kargoapi.ChartSubscription{
+									RepoURL: "fake-chart-repo",
+									Name:    "fake-chart",
+								},
+							},
+							{
+								OCIArtifact: &kargoapi.OCIArtifactSubscription{
+									RepoURL: "fake-artifact-repo",
+								},
+							},
+						},
+					},
+				},
+				status,
+			)
+			testCase.assertions(freight, status, err)
+		})
+	}
+}
+
+func TestGetPollingInterval(t *testing.T) {
+	testCases := []struct {
+		name     string
+		interval string
+		expected time.Duration
+	}{
+		{
+			name:     "interval not specified",
+			expected: defaultPollingInterval,
+		},
+		{
+			name:     "interval invalid",
+			interval: "bogus",
+			expected: defaultPollingInterval,
+		},
+		{
+			name:     "interval not positive",
+			interval: "0s",
+			expected: defaultPollingInterval,
+		},
+		{
+			name:     "interval below minimum",
+			interval: "30s",
+			expected: minPollingInterval,
+		},
+		{
+			name:     "interval specified",
+			interval: "10m",
+			expected: 10 * time.Minute,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				getPollingInterval(
 					&kargoapi.Warehouse{
-						ObjectMeta: metav1.ObjectMeta{
-							Namespace: "fake-namespace",
+						Spec: &kargoapi.WarehouseSpec{
+							Interval: testCase.interval,
 						},
-						Spec: &kargoapi.WarehouseSpec{},
 					},
 				),
 			)
 		})
 	}
 }
+
+func TestNewSubscriptionStatuses(t *testing.T) {
+	lastPolled := metav1.Now()
+	subs := []kargoapi.RepoSubscription{
+		{
+			Git: &kargoapi.GitSubscription{
+				RepoURL: "fake-git-url",
+			},
+		},
+		{
+			Chart: &kargoapi.ChartSubscription{
+				RepoURL: "fake-chart-url",
+				Name:    "fake-chart",
+			},
+		},
+	}
+	testCases := []struct {
+		name         string
+		prevStatuses []kargoapi.SubscriptionStatus
+		assertions   func([]kargoapi.SubscriptionStatus)
+	}{
+		{
+			name: "no previous statuses",
+			assertions: func(statuses []kargoapi.SubscriptionStatus) {
+				require.Equal(
+					t,
+					[]kargoapi.SubscriptionStatus{
+						{RepoURL: "fake-git-url"},
+						{RepoURL: "fake-chart-url", Chart: "fake-chart"},
+					},
+					statuses,
+				)
+			},
+		},
+		{
+			name: "previous statuses carried over where repo is unchanged",
+			prevStatuses: []kargoapi.SubscriptionStatus{
+				{
+					RepoURL:        "fake-git-url",
+					LastPolled:     &lastPolled,
+					LatestVersion:  "fake-commit",
+					RecentVersions: []string{"fake-commit"},
+					Error:          "something went wrong",
+				},
+				{
+					RepoURL:       "fake-chart-url",
+					Chart:         "another-fake-chart",
+					LastPolled:    &lastPolled,
+					LatestVersion: "1.0.0",
+				},
+			},
+			assertions: func(statuses []kargoapi.SubscriptionStatus) {
+				require.Equal(
+					t,
+					[]kargoapi.SubscriptionStatus{
+						{
+							RepoURL:        "fake-git-url",
+							LastPolled:     &lastPolled,
+							LatestVersion:  "fake-commit",
+							RecentVersions: []string{"fake-commit"},
+						},
+						{RepoURL: "fake-chart-url", Chart: "fake-chart"},
+					},
+					statuses,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(newSubscriptionStatuses(subs, testCase.prevStatuses))
+		})
+	}
+}
+
+func TestRecordPolled(t *testing.T) {
+	statuses := []kargoapi.SubscriptionStatus{
+		{
+			RepoURL: "fake-url",
+			Error:   "something went wrong",
+		},
+	}
+	candidates := make([]string, maxRecentVersions+1)
+	for i := range candidates {
+		candidates[i] = fmt.Sprintf("fake-version-%d", i)
+	}
+	recordPolled(statuses, 0, candidates[0], candidates)
+	require.NotNil(t, statuses[0].LastPolled)
+	require.Equal(t, candidates[0], statuses[0].LatestVersion)
+	require.Equal(t, candidates[:maxRecentVersions], statuses[0].RecentVersions)
+	require.Empty(t, statuses[0].Error)
+	// Out of range indices should be ignored
+	recordPolled(statuses, 1, "", nil)
+	recordPollError(nil, 0, errors.New("something went wrong"))
+}
@@ -98,77 +98,24 @@ func (k *kubernetesDatabase) Get(
 	credType Type,
 	repoURL string,
 ) (Credentials, bool, error) {
-	creds := Credentials{}
-
-	var secret *corev1.Secret
-	var err error
-
 	// Check namespace for credentials
-	if secret, err = getCredentialsSecret(
-		ctx,
-		k.kargoClient,
-		namespace,
-		labels.Set(map[string]string{
-			kargoSecretTypeLabelKey: repositorySecretTypeLabelValue,
-		}).AsSelector(),
-		credType,
-		repoURL,
-		false, // repoURL is not a prefix
-	); err != nil {
-		return creds, false, err
-	}
-
-	if secret == nil {
-		// Check namespace for credentials template
-		if secret, err = getCredentialsSecret(
-			ctx,
-			k.kargoClient,
-			namespace,
-			labels.Set(map[string]string{
-				kargoSecretTypeLabelKey: repoCredsSecretTypeLabelValue,
-			}).AsSelector(),
-			credType,
-			repoURL,
-			true, // repoURL is a prefix
-		); err != nil {
-			return creds, false, err
-		}
+	secret, err :=
+		GetCredentialsSecret(ctx, k.kargoClient, namespace, credType, repoURL)
+	if err != nil {
+		return Credentials{}, false, err
 	}
 
 	if secret == nil {
 		// Check global credentials namespaces for credentials
 		for _, globalCredsNamespace := range k.cfg.GlobalCredentialsNamespaces {
-			// Check shared creds namespace for credentials
-			if secret, err = getCredentialsSecret(
+			if secret, err = GetCredentialsSecret(
 				ctx,
 				k.kargoClient,
 				globalCredsNamespace,
-				labels.Set(map[string]string{
-					kargoSecretTypeLabelKey: repositorySecretTypeLabelValue,
-				}).AsSelector(),
 				credType,
 				repoURL,
-				false, // repoURL is not a prefix
 			); err != nil {
-				return creds, false, err
-			}
-			if secret != nil {
-				break
-			}
-
-			// Check shared creds namespace for credentials template
-			if secret, err = getCredentialsSecret(
-				ctx,
-				k.kargoClient,
-				globalCredsNamespace,
-				labels.Set(map[string]string{
-					kargoSecretTypeLabelKey: repoCredsSecretTypeLabelValue,
-				}).AsSelector(),
-				credType,
-				repoURL,
-				true, // repoURL is a prefix
-			); err != nil {
-				return creds, false, err
+				return Credentials{}, false, err
 			}
 			if secret != nil {
 				break
@@ -177,12 +124,60 @@ func (k *kubernetesDatabase) Get(
 	}
 
 	if secret == nil {
-		return creds, false, nil
+		return Credentials{}, false, nil
 	}
 
 	return secretToCreds(secret), true, nil
 }
 
+// IsCredentialsSecret returns true if the provided Secret is labeled as holding
+// repository credentials for use by Kargo. It returns false otherwise.
+func IsCredentialsSecret(secret *corev1.Secret) bool {
+	_, ok := secret.Labels[kargoSecretTypeLabelKey]
+	return ok
+}
+
+// GetCredentialsSecret returns the Secret, if any, from the specified
+// namespace that holds credentials of the specified type for the specified
+// repository. A Secret containing credentials for that exact repository is
+// preferred over a Secret containing credentials for any repository whose URL
+// begins with a specific prefix. Unlike the Database returned from
+// NewKubernetesDatabase, this never consults global credentials namespaces.
+func GetCredentialsSecret(
+	ctx context.Context,
+	kubeClient client.Client,
+	namespace string,
+	credType Type,
+	repoURL string,
+) (*corev1.Secret, error) {
+	secret, err := getCredentialsSecret(
+		ctx,
+		kubeClient,
+		namespace,
+		labels.Set(map[string]string{
+			kargoSecretTypeLabelKey: repositorySecretTypeLabelValue,
+		}).AsSelector(),
+		credType,
+		repoURL,
+		false, // repoURL is not a prefix
+	)
+	if err != nil || secret != nil {
+		return secret, err
+	}
+	// Check namespace for credentials template
+	return getCredentialsSecret(
+		ctx,
+		kubeClient,
+		namespace,
+		labels.Set(map[string]string{
+			kargoSecretTypeLabelKey: repoCredsSecretTypeLabelValue,
+		}).AsSelector(),
+		credType,
+		repoURL,
+		true, // repoURL is a prefix
+	)
+}
+
 func getCredentialsSecret(
 	ctx context.Context,
 	kubeClient client.Client,
@@ -164,6 +164,20 @@ func TestGet(t *testing.T) {
 
 }
 
+func TestIsCredentialsSecret(t *testing.T) {
+	require.False(t, IsCredentialsSecret(&corev1.Secret{}))
+	require.True(
+		t,
+		IsCredentialsSecret(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					kargoSecretTypeLabelKey: repoCredsSecretTypeLabelValue,
+				},
+			},
+		}),
+	)
+}
+
 func TestGetCredentialsSecret(t *testing.T) {
 	const testSecretName = "fake-secret"
 	const testNamespace = "fake-namespace"
@@ -2,6 +2,7 @@ package helm
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -40,6 +41,51 @@ func SelectChartVersion(
 	semverConstraint string,
 	creds *Credentials,
 ) (string, error) {
+	versions, err := getChartVersions(ctx, repoURL, chart, creds)
+	if err != nil {
+		return "", err
+	}
+	latestVersion, err := getLatestVersion(versions, semverConstraint)
+	return latestVersion, errors.Wrapf(
+		err,
+		"error determining latest version of chart %q from repository %q",
+		chart,
+		repoURL,
+	)
+}
+
+// SelectChartVersions works like SelectChartVersion, but returns every
+// available version of the chart that satisfies the provided semverConstraint,
+// ordered from semantically greatest to least, instead of only the greatest.
+// If no version satisfies the constraint, an empty slice is returned.
+func SelectChartVersions(
+	ctx context.Context,
+	repoURL string,
+	chart string,
+	semverConstraint string,
+	creds *Credentials,
+) ([]string, error) {
+	versions, err := getChartVersions(ctx, repoURL, chart, creds)
+	if err != nil {
+		return nil, err
+	}
+	satisfyingVersions, err := getSatisfyingVersions(versions, semverConstraint)
+	return satisfyingVersions, errors.Wrapf(
+		err,
+		"error determining versions of chart %q from repository %q",
+		chart,
+		repoURL,
+	)
+}
+
+// getChartVersions retrieves all available versions of the specified chart
+// from the classic or OCI chart repository specified by repoURL.
+func getChartVersions(
+	ctx context.Context,
+	repoURL string,
+	chart string,
+	creds *Credentials,
+) ([]string, error) {
 	var versions []string
 	var err error
 	if strings.HasPrefix(repoURL, "http://") ||
@@ -50,20 +96,11 @@ func SelectChartVersion(
 		versions, err =
 			getChartVersionsFromOCIRepo(ctx, repoURL, creds)
 	} else {
-		return "", errors.Errorf("repository URL %q is invalid", repoURL)
-	}
-	if err != nil {
-		return "", errors.Wrapf(
-			err,
-			"error retrieving versions of chart %q from repository %q",
-			chart,
-			repoURL,
-		)
+		return nil, errors.Errorf("repository URL %q is invalid", repoURL)
 	}
-	latestVersion, err := getLatestVersion(versions, semverConstraint)
-	return latestVersion, errors.Wrapf(
+	return versions, errors.Wrapf(
 		err,
-		"error determining latest version of chart %q from repository %q",
+		"error retrieving versions of chart %q from repository %q",
 		chart,
 		repoURL,
 	)
@@ -79,6 +116,34 @@ func getChartVersionsFromClassicRepo(
 	chart string,
 	creds *Credentials,
 ) ([]string, error) {
+	entries, err := getClassicRepoIndexEntries(repoURL, chart, creds)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]string, len(entries))
+	for i, entry := range entries {
+		versions[i] = entry.Version
+	}
+	return versions, nil
+}
+
+// classicRepoIndexEntry is an entry in the index of a classic (HTTP/S) chart
+// repository describing a single version of a chart.
+type classicRepoIndexEntry struct {
+	Version    string `yaml:"version,omitempty"`
+	AppVersion string `yaml:"appVersion,omitempty"`
+}
+
+// getClassicRepoIndexEntries connects to the classic (HTTP/S) chart repository
+// specified by repoURL and retrieves the index entries for all available
+// versions of the specified chart. The provided repoURL MUST begin with
+// protocol http:// or https://. Provided credentials may be nil for public
+// repositories, but must be non-nil for private repositories.
+func getClassicRepoIndexEntries(
+	repoURL string,
+	chart string,
+	creds *Credentials,
+) ([]classicRepoIndexEntry, error) {
 	indexURL := fmt.Sprintf("%s/index.yaml", strings.TrimSuffix(repoURL, "/"))
 	req, err := http.NewRequest(http.MethodGet, indexURL, nil)
 	if err != nil {
@@ -108,9 +173,7 @@ func getChartVersionsFromClassicRepo(
 			errors.Wrapf(err, "error reading repository index from %q", indexURL)
 	}
 	index := struct {
-		Entries map[string][]struct {
-			Version string `json:"version,omitempty"`
-		} `json:"entries,omitempty"`
+		Entries map[string][]classicRepoIndexEntry `json:"entries,omitempty"`
 	}{}
 	if err = yaml.Unmarshal(resBodyBytes, &index); err != nil {
 		return nil,
@@ -124,11 +187,7 @@ func getChartVersionsFromClassicRepo(
 			indexURL,
 		)
 	}
-	versions := make([]string, len(entries))
-	for i, entry := range entries {
-		versions[i] = entry.Version
-	}
-	return versions, nil
+	return entries, nil
 }
 
 // getChartVersionsFromOCIRepo connects to the OCI repository specified by
@@ -169,6 +228,206 @@ func getChartVersionsFromOCIRepo(
 	)
 }
 
+// GetChartAppVersion connects to the Helm chart repository specified by
+// repoURL and retrieves the appVersion, as specified by the chart's Chart.yaml,
+// of the specified version of the chart. As with SelectChartVersion, the chart
+// argument must specify the name of the chart when repoURL points to a classic
+// chart repository and must be empty when it points to a repository within an
+// OCI registry. If the chart does not specify an appVersion, the empty string
+// is returned. Provided credentials may be nil for public repositories, but
+// must be non-nil for private repositories.
+func GetChartAppVersion(
+	ctx context.Context,
+	repoURL string,
+	chart string,
+	version string,
+	creds *Credentials,
+) (string, error) {
+	var appVersion string
+	var err error
+	if strings.HasPrefix(repoURL, "http://") ||
+		strings.HasPrefix(repoURL, "https://") {
+		appVersion, err =
+			getChartAppVersionFromClassicRepo(repoURL, chart, version, creds)
+	} else if strings.HasPrefix(repoURL, "oci://") {
+		appVersion, err =
+			getChartAppVersionFromOCIRepo(ctx, repoURL, version, creds)
+	} else {
+		return "", errors.Errorf("repository URL %q is invalid", repoURL)
+	}
+	return appVersion, errors.Wrapf(
+		err,
+		"error retrieving appVersion of version %q of chart %q from repository %q",
+		version,
+		chart,
+		repoURL,
+	)
+}
+
+// getChartAppVersionFromClassicRepo retrieves the appVersion of the specified
+// version of the specified chart from the index of the classic (HTTP/S) chart
+// repository specified by repoURL.
+func getChartAppVersionFromClassicRepo(
+	repoURL string,
+	chart string,
+	version string,
+	creds *Credentials,
+) (string, error) {
+	entries, err := getClassicRepoIndexEntries(repoURL, chart, creds)
+	if err != nil {
+		return "", err
+	}
+	for _, entry := range entries {
+		if entry.Version == version {
+			return entry.AppVersion, nil
+		}
+	}
+	return "", errors.Errorf(
+		"version %q of chart %q not found in repository index",
+		version,
+		chart,
+	)
+}
+
+// getChartAppVersionFromOCIRepo retrieves the appVersion of the specified
+// version of the chart stored in the OCI repository specified by repoURL. The
+// appVersion is read from the chart's config blob, which is a JSON
+// representation of its Chart.yaml.
+func getChartAppVersionFromOCIRepo(
+	ctx context.Context,
+	repoURL string,
+	version string,
+	creds *Credentials,
+) (string, error) {
+	ref, err := registry.ParseReference(strings.TrimPrefix(repoURL, "oci://"))
+	if err != nil {
+		return "", errors.Wrapf(err, "error parsing repository URL %q", repoURL)
+	}
+	c := &auth.Client{
+		Credential: func(context.Context, string) (auth.Credential, error) {
+			if creds != nil {
+				return auth.Credential{
+					Username: creds.Username,
+					Password: creds.Password,
+				}, nil
+			}
+			return auth.Credential{}, nil
+		},
+	}
+	ctx = auth.AppendScopes(
+		ctx,
+		auth.ScopeRepository(ref.Repository, auth.ActionPull),
+	)
+	manifest := struct {
+		Config struct {
+			Digest string `json:"digest"`
+		} `json:"config"`
+	}{}
+	if err = getOCIRegistryJSON(
+		ctx,
+		c,
+		fmt.Sprintf(
+			"https://%s/v2/%s/manifests/%s",
+			ref.Host(),
+			ref.Repository,
+			version,
+		),
+		"application/vnd.oci.image.manifest.v1+json",
+		&manifest,
+	); err != nil {
+		return "", errors.Wrap(err, "error retrieving chart manifest")
+	}
+	if manifest.Config.Digest == "" {
+		return "", errors.New("chart manifest does not reference a config blob")
+	}
+	chartMeta := struct {
+		AppVersion string `json:"appVersion"`
+	}{}
+	if err = getOCIRegistryJSON(
+		ctx,
+		c,
+		fmt.Sprintf(
+			"https://%s/v2/%s/blobs/%s",
+			ref.Host(),
+			ref.Repository,
+			manifest.Config.Digest,
+		),
+		"application/vnd.cncf.helm.config.v1+json",
+		&chartMeta,
+	); err != nil {
+		return "", errors.Wrap(err, "error retrieving chart config")
+	}
+	return chartMeta.AppVersion, nil
+}
+
+// getOCIRegistryJSON sends a GET request for the specified URL to an OCI
+// registry using the provided client and unmarshals the JSON response body into
+// the provided value.
+func getOCIRegistryJSON(
+	ctx context.Context,
+	c *auth.Client,
+	url string,
+	accept string,
+	v any,
+) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrapf(err, "error preparing HTTP/S request to %q", url)
+	}
+	req.Header.Set("Accept", accept)
+	res, err := c.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "error querying %q", url)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf(
+			"received unexpected HTTP %d when querying %q",
+			res.StatusCode,
+			url,
+		)
+	}
+	return errors.Wrapf(
+		json.NewDecoder(res.Body).Decode(v),
+		"error unmarshaling response from %q",
+		url,
+	)
+}
+
+// getSatisfyingVersions returns those of the versions provided which satisfy
+// the provided constraints, ordered from semantically greatest to least. If no
+// constraints are specified (the empty string is passed), all versions are
+// returned.
+func getSatisfyingVersions(
+	versions []string,
+	constraintStr string,
+) ([]string, error) {
+	var constraint *semver.Constraints
+	if constraintStr != "" {
+		var err error
+		if constraint, err = semver.NewConstraint(constraintStr); err != nil {
+			return nil,
+				errors.Wrapf(err, "error parsing constraint %q", constraintStr)
+		}
+	}
+	semvers := make([]*semver.Version, 0, len(versions))
+	for _, version := range versions {
+		sv, err := semver.NewVersion(version)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing version %q", version)
+		}
+		if constraint == nil || constraint.Check(sv) {
+			semvers = append(semvers, sv)
+		}
+	}
+	sort.Sort(sort.Reverse(semver.Collection(semvers)))
+	satisfyingVersions := make([]string, len(semvers))
+	for i, sv := range semvers {
+		satisfyingVersions[i] = sv.String()
+	}
+	return satisfyingVersions, nil
+}
+
 // getLatestVersion returns the semantically greatest version from the versions
 // provided which satisfies the provided constraints. If no constraints are
 // specified (the empty string is passed), the absolute semantically greatest
@@ -95,6 +95,67 @@ func TestGetChartVersionsFromClassicRepo(t *testing.T) {
 	}
 }
 
+func TestGetChartAppVersionFromClassicRepo(t *testing.T) {
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`entries:
+  fake-chart:
+    - version: 1.0.0
+      appVersion: v1.0.0
+    - version: 1.1.0
+`))
+				require.NoError(t, err)
+			},
+		),
+	)
+	defer testServer.Close()
+	testCases := []struct {
+		name       string
+		version    string
+		assertions func(appVersion string, err error)
+	}{
+		{
+			name:    "version not found",
+			version: "2.0.0",
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "not found in repository index")
+			},
+		},
+		{
+			name:    "no appVersion",
+			version: "1.1.0",
+			assertions: func(appVersion string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, appVersion)
+			},
+		},
+		{
+			name:    "success",
+			version: "1.0.0",
+			assertions: func(appVersion string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "v1.0.0", appVersion)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				getChartAppVersionFromClassicRepo(
+					testServer.URL,
+					"fake-chart",
+					testCase.version,
+					nil,
+				),
+			)
+		})
+	}
+}
+
 func TestGetChartVersionsFromOCIRepo(t *testing.T) {
 	// Instead of mocking out an OCI registry, it's more expedient to use Kargo's
 	// own chart repo on ghcr.io to test this.
@@ -107,6 +168,66 @@ func TestGetChartVersionsFromOCIRepo(t *testing.T) {
 	require.NotEmpty(t, versions)
 }
 
+func TestGetSatisfyingVersions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		unsorted   []string
+		constraint string
+		assertions func(versions []string, err error)
+	}{
+		{
+			name:     "error parsing versions",
+			unsorted: []string{"not-semantic"},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing version")
+			},
+		},
+		{
+			name:       "error parsing constraint",
+			unsorted:   []string{"1.0.0"},
+			constraint: "invalid",
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing constraint")
+			},
+		},
+		{
+			name:       "success with constraint",
+			unsorted:   []string{"2.0.0", "1.0.0", "1.1.0"},
+			constraint: "^1.0.0",
+			assertions: func(versions []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"1.1.0", "1.0.0"}, versions)
+			},
+		},
+		{
+			name:     "success with no constraint",
+			unsorted: []string{"2.0.0", "1.0.0", "1.1.0"},
+			assertions: func(versions []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"2.0.0", "1.1.0", "1.0.0"}, versions)
+			},
+		},
+		{
+			name:       "no versions satisfy constraint",
+			unsorted:   []string{"2.0.0", "1.0.0", "1.1.0"},
+			constraint: "^3.0.0",
+			assertions: func(versions []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, versions)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				getSatisfyingVersions(testCase.unsorted, testCase.constraint),
+			)
+		})
+	}
+}
+
 func TestGetLatestVersion(t *testing.T) {
 	testCases := []struct {
 		name       string
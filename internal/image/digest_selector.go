@@ -14,6 +14,7 @@ type digestSelector struct {
 	repoClient *repositoryClient
 	constraint string
 	platform   *platformConstraint
+	candidates []string
 }
 
 // newDigestSelector returns an implementation of the Selector interface for
@@ -59,6 +60,7 @@ func (d *digestSelector) Select(ctx context.Context) (*Image, error) {
 		if tag != d.constraint {
 			continue
 		}
+		d.candidates = []string{tag}
 		image, err := d.repoClient.getImageByTag(ctx, tag, d.platform)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error retrieving image with tag %q", tag)
@@ -80,3 +82,8 @@ func (d *digestSelector) Select(ctx context.Context) (*Image, error) {
 	logger.Trace("no images matched criteria")
 	return nil, nil
 }
+
+// Candidates implements the Selector interface.
+func (d *digestSelector) Candidates() []string {
+	return d.candidates
+}
@@ -18,6 +18,7 @@ type lexicalSelector struct {
 	allowRegex *regexp.Regexp
 	ignore     []string
 	platform   *platformConstraint
+	candidates []string
 }
 
 // newLexicalSelector returns an implementation of the Selector interface for
@@ -75,6 +76,7 @@ func (l *lexicalSelector) Select(ctx context.Context) (*Image, error) {
 
 	logger.Trace("sorting tags lexically")
 	sortTagsLexically(tags)
+	l.candidates = tags
 
 	tag := tags[0]
 	image, err := l.repoClient.getImageByTag(ctx, tag, l.platform)
@@ -103,3 +105,8 @@ func sortTagsLexically(tags []string) {
 		return tags[i] > tags[j]
 	})
 }
+
+// Candidates implements the Selector interface.
+func (l *lexicalSelector) Candidates() []string {
+	return l.candidates
+}
@@ -19,6 +19,7 @@ type newestBuildSelector struct {
 	allowRegex *regexp.Regexp
 	ignore     []string
 	platform   *platformConstraint
+	candidates []string
 }
 
 // newNewestBuildSelector returns an implementation of the Selector interface
@@ -87,6 +88,10 @@ func (n *newestBuildSelector) Select(ctx context.Context) (*Image, error) {
 
 	logger.Trace("sorting images by date")
 	sortImagesByDate(images)
+	n.candidates = make([]string, len(images))
+	for i, image := range images {
+		n.candidates[i] = image.Tag
+	}
 
 	if n.platform == nil {
 		image := images[0]
@@ -205,3 +210,8 @@ func sortImagesByDate(images []Image) {
 		return images[i].CreatedAt.After(*images[j].CreatedAt)
 	})
 }
+
+// Candidates implements the Selector interface.
+func (n *newestBuildSelector) Candidates() []string {
+	return n.candidates
+}
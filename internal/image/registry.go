@@ -2,6 +2,7 @@ package image
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -78,6 +79,17 @@ func getRegistry(imagePrefix string) *registry {
 	return registry
 }
 
+// NewRateLimitedTransport returns an http.RoundTripper for requests to the
+// registry having the provided host, e.g. docker.io or ghcr.io. Requests made
+// using it count against the same rate limit as all of this package's own
+// requests to that registry.
+func NewRateLimitedTransport(host string) http.RoundTripper {
+	return &rateLimitedRoundTripper{
+		limiter:              getRegistry(host).rateLimiter,
+		internalRoundTripper: http.DefaultTransport,
+	}
+}
+
 // normalizeImageName returns a normalized image name that accounts for the fact
 // that some registries have a default namespace that is used when the image
 // name doesn't specify one. For example on Docker Hub, "debian" officially
@@ -55,6 +55,14 @@ func TestGetRegistry(t *testing.T) {
 	}
 }
 
+func TestNewRateLimitedTransport(t *testing.T) {
+	rt, ok := NewRateLimitedTransport("fake-prefix").(*rateLimitedRoundTripper)
+	require.True(t, ok)
+	// Requests share the registry's rate limit
+	require.True(t, getRegistry("fake-prefix").rateLimiter == rt.limiter)
+	require.NotNil(t, rt.internalRoundTripper)
+}
+
 func TestNormalizeImageName(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -44,6 +44,9 @@ const (
 type Selector interface {
 	// Select selects a single image from a container image repository.
 	Select(context.Context) (*Image, error)
+	// Candidates returns the tags that satisfied the Selector's criteria during
+	// the most recent call to Select, ordered from most to least preferred.
+	Candidates() []string
 }
 
 // SelectorOptions represents options for creating a Selector.
@@ -19,6 +19,7 @@ type semVerSelector struct {
 	ignore     []string
 	constraint *semver.Constraints
 	platform   *platformConstraint
+	candidates []string
 }
 
 // newSemVerSelector returns an implementation of the Selector interface for
@@ -99,6 +100,10 @@ func (s *semVerSelector) Select(ctx context.Context) (*Image, error) {
 
 	logger.Trace("sorting images by semantic version")
 	sortImagesBySemVer(images)
+	s.candidates = make([]string, len(images))
+	for i, image := range images {
+		s.candidates[i] = image.Tag
+	}
 
 	tag := images[0].Tag
 	image, err := s.repoClient.getImageByTag(ctx, tag, s.platform)
@@ -133,3 +138,8 @@ func sortImagesBySemVer(images []Image) {
 		return images[i].semVer.Original() > images[j].semVer.Original()
 	})
 }
+
+// Candidates implements the Selector interface.
+func (s *semVerSelector) Candidates() []string {
+	return s.candidates
+}
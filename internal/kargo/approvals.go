@@ -0,0 +1,178 @@
+package kargo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// GetApprovalPolicy returns the ApprovalPolicy, if any, that governs promotion
+// of Freight into the specified Stage of the specified Project.
+func GetApprovalPolicy(
+	project *kargoapi.Project,
+	stage string,
+) *kargoapi.ApprovalPolicy {
+	if project == nil || project.Spec == nil {
+		return nil
+	}
+	for _, policy := range project.Spec.PromotionPolicies {
+		if policy.Stage == stage {
+			return policy.ApprovalPolicy
+		}
+	}
+	return nil
+}
+
+// IsApprovalPolicySatisfied checks whether the specified Freight has enough
+// unexpired approvals from distinct users for the specified Stage to satisfy
+// the specified ApprovalPolicy at the specified time. A nil ApprovalPolicy is
+// always satisfied. If the ApprovalPolicy is not satisfied, a message
+// explaining why is also returned.
+func IsApprovalPolicySatisfied(
+	policy *kargoapi.ApprovalPolicy,
+	freight *kargoapi.Freight,
+	stage string,
+	t time.Time,
+) (bool, string, error) {
+	if policy == nil {
+		return true, "", nil
+	}
+	var expiry time.Duration
+	if policy.Expiry != "" {
+		var err error
+		if expiry, err = time.ParseDuration(policy.Expiry); err != nil {
+			return false, "",
+				errors.Wrapf(err, "error parsing approval expiry %q", policy.Expiry)
+		}
+	}
+	approvers := map[string]struct{}{}
+	for _, approval := range freight.Status.ApprovedFor[stage].Approvals {
+		if approval.Subject == "" {
+			continue
+		}
+		if expiry > 0 &&
+			(approval.ApprovedAt == nil || !approval.ApprovedAt.Add(expiry).After(t)) {
+			continue
+		}
+		approvers[approval.Subject] = struct{}{}
+	}
+	if int32(len(approvers)) >= policy.RequiredApprovals {
+		return true, "", nil
+	}
+	return false, fmt.Sprintf(
+		"Freight %q has %d of %d required approvals for Stage %q",
+		freight.Name,
+		len(approvers),
+		policy.RequiredApprovals,
+		stage,
+	), nil
+}
+
+// IsEligibleApprover checks whether the user having the specified subject,
+// email address, and groups is eligible to approve the specified Freight
+// under the specified ApprovalPolicy. Any user is eligible under a nil
+// ApprovalPolicy. If the user is not eligible, a message explaining why is
+// also returned.
+func IsEligibleApprover(
+	policy *kargoapi.ApprovalPolicy,
+	freight *kargoapi.Freight,
+	subject string,
+	email string,
+	groups []string,
+) (bool, string) {
+	if policy == nil {
+		return true, ""
+	}
+	if subject == "" {
+		return false, "approver identity is unknown"
+	}
+	if policy.ExcludeFreightAuthors && len(freight.Commits) > 0 {
+		if email == "" {
+			// Without an email address, the user cannot reliably be told apart
+			// from the authors of the Freight's commits
+			return false, fmt.Sprintf(
+				"%q has no email address to compare with the authors of commits "+
+					"referenced by Freight %q and may not approve it",
+				subject,
+				freight.Name,
+			)
+		}
+		for _, commit := range freight.Commits {
+			if isAuthor(commit.Author, subject, email) {
+				return false, fmt.Sprintf(
+					"%q authored a commit referenced by Freight %q and may not approve it",
+					email,
+					freight.Name,
+				)
+			}
+		}
+	}
+	if len(policy.Subjects) == 0 && len(policy.Groups) == 0 {
+		return true, ""
+	}
+	for _, s := range policy.Subjects {
+		if s == subject || (email != "" && strings.EqualFold(s, email)) {
+			return true, ""
+		}
+	}
+	for _, g := range policy.Groups {
+		for _, group := range groups {
+			if g == group {
+				return true, ""
+			}
+		}
+	}
+	return false, fmt.Sprintf(
+		"%q is not an eligible approver according to the approval policy",
+		subject,
+	)
+}
+
+// isAuthor returns true if the specified commit author's email address matches
+// the specified email address or if either the author's email address or name
+// matches the specified subject. All comparisons are case-insensitive.
+func isAuthor(author, subject, email string) bool {
+	if addr := authorEmail(author); addr != "" &&
+		(strings.EqualFold(addr, email) || strings.EqualFold(addr, subject)) {
+		return true
+	}
+	name := authorName(author)
+	return name != "" && strings.EqualFold(name, subject)
+}
+
+// authorName returns the name from a commit author in the conventional
+// "name <email>" form. If the author is not in that form, and is not itself an
+// email address, it is returned as is. Otherwise, an empty string is returned.
+func authorName(author string) string {
+	author = strings.TrimSpace(author)
+	if start := strings.LastIndex(author, "<"); start != -1 {
+		return strings.TrimSpace(author[:start])
+	}
+	if authorEmail(author) != "" {
+		return ""
+	}
+	return author
+}
+
+// authorEmail returns the email address from a commit author in the
+// conventional "name <email>" form. If the author is not in that form, but is
+// itself an email address, it is returned as is. Otherwise, an empty string is
+// returned.
+func authorEmail(author string) string {
+	author = strings.TrimSpace(author)
+	if start := strings.LastIndex(author, "<"); start != -1 {
+		end := strings.LastIndex(author, ">")
+		if end < start {
+			return ""
+		}
+		return strings.TrimSpace(author[start+1 : end])
+	}
+	if strings.Contains(author, "@") && !strings.ContainsAny(author, " \t") {
+		return author
+	}
+	return ""
+}
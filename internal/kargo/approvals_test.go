@@ -0,0 +1,345 @@
+package kargo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestGetApprovalPolicy(t *testing.T) {
+	policy := &kargoapi.ApprovalPolicy{RequiredApprovals: 2}
+	project := &kargoapi.Project{
+		Spec: &kargoapi.ProjectSpec{
+			PromotionPolicies: []kargoapi.PromotionPolicy{
+				{
+					Stage: "fake-stage",
+				},
+				{
+					Stage:          "another-fake-stage",
+					ApprovalPolicy: policy,
+				},
+			},
+		},
+	}
+	require.Nil(t, GetApprovalPolicy(nil, "fake-stage"))
+	require.Nil(t, GetApprovalPolicy(project, "fake-stage"))
+	require.Nil(t, GetApprovalPolicy(project, "unknown-stage"))
+	require.Same(t, policy, GetApprovalPolicy(project, "another-fake-stage"))
+}
+
+func TestIsApprovalPolicySatisfied(t *testing.T) {
+	now := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)
+	recently := metav1.NewTime(now.Add(-time.Hour))
+	longAgo := metav1.NewTime(now.Add(-48 * time.Hour))
+	testCases := []struct {
+		name       string
+		policy     *kargoapi.ApprovalPolicy
+		approvals  []kargoapi.Approval
+		assertions func(bool, string, error)
+	}{
+		{
+			name: "nil policy",
+			assertions: func(satisfied bool, _ string, err error) {
+				require.NoError(t, err)
+				require.True(t, satisfied)
+			},
+		},
+		{
+			name: "invalid expiry",
+			policy: &kargoapi.ApprovalPolicy{
+				RequiredApprovals: 1,
+				Expiry:            "bogus",
+			},
+			assertions: func(_ bool, _ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing approval expiry")
+			},
+		},
+		{
+			name: "no approvals",
+			policy: &kargoapi.ApprovalPolicy{
+				RequiredApprovals: 1,
+			},
+			assertions: func(satisfied bool, msg string, err error) {
+				require.NoError(t, err)
+				require.False(t, satisfied)
+				require.Contains(t, msg, "has 0 of 1 required approvals")
+			},
+		},
+		{
+			name: "repeated approvals by the same user",
+			policy: &kargoapi.ApprovalPolicy{
+				RequiredApprovals: 2,
+			},
+			approvals: []kargoapi.Approval{
+				{Subject: "alice", ApprovedAt: &recently},
+				{Subject: "alice", ApprovedAt: &recently},
+			},
+			assertions: func(satisfied bool, msg string, err error) {
+				require.NoError(t, err)
+				require.False(t, satisfied)
+				require.Contains(t, msg, "has 1 of 2 required approvals")
+			},
+		},
+		{
+			name: "expired approvals",
+			policy: &kargoapi.ApprovalPolicy{
+				RequiredApprovals: 2,
+				Expiry:            "24h",
+			},
+			approvals: []kargoapi.Approval{
+				{Subject: "alice", ApprovedAt: &recently},
+				{Subject: "bob", ApprovedAt: &longAgo},
+			},
+			assertions: func(satisfied bool, msg string, err error) {
+				require.NoError(t, err)
+				require.False(t, satisfied)
+				require.Contains(t, msg, "has 1 of 2 required approvals")
+			},
+		},
+		{
+			name: "satisfied",
+			policy: &kargoapi.ApprovalPolicy{
+				RequiredApprovals: 2,
+				Expiry:            "72h",
+			},
+			approvals: []kargoapi.Approval{
+				{Subject: "alice", ApprovedAt: &recently},
+				{Subject: "bob", ApprovedAt: &longAgo},
+			},
+			assertions: func(satisfied bool, _ string, err error) {
+				require.NoError(t, err)
+				require.True(t, satisfied)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			freight := &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-freight",
+				},
+				Status: kargoapi.FreightStatus{
+					ApprovedFor: map[string]kargoapi.ApprovedStage{
+						"fake-stage": {
+							Approvals: testCase.approvals,
+						},
+					},
+				},
+			}
+			testCase.assertions(
+				IsApprovalPolicySatisfied(testCase.policy, freight, "fake-stage", now),
+			)
+		})
+	}
+}
+
+func TestIsEligibleApprover(t *testing.T) {
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-freight",
+		},
+		Commits: []kargoapi.GitCommit{
+			{
+				Author: "Alice <Alice@example.com>",
+			},
+			{
+				Author: "Jim Bob <jimbob@example.com>",
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		policy     *kargoapi.ApprovalPolicy
+		subject    string
+		email      string
+		groups     []string
+		assertions func(bool, string)
+	}{
+		{
+			name:    "nil policy",
+			subject: "alice",
+			assertions: func(eligible bool, _ string) {
+				require.True(t, eligible)
+			},
+		},
+		{
+			name:   "unknown approver",
+			policy: &kargoapi.ApprovalPolicy{},
+			assertions: func(eligible bool, msg string) {
+				require.False(t, eligible)
+				require.Equal(t, "approver identity is unknown", msg)
+			},
+		},
+		{
+			name: "approver authored Freight",
+			policy: &kargoapi.ApprovalPolicy{
+				ExcludeFreightAuthors: true,
+			},
+			subject: "alice",
+			email:   "alice@example.com",
+			assertions: func(eligible bool, msg string) {
+				require.False(t, eligible)
+				require.Contains(t, msg, "authored a commit")
+			},
+		},
+		{
+			name: "approver without email when authors are excluded",
+			policy: &kargoapi.ApprovalPolicy{
+				ExcludeFreightAuthors: true,
+			},
+			subject: "alice",
+			assertions: func(eligible bool, msg string) {
+				require.False(t, eligible)
+				require.Contains(t, msg, "has no email address")
+			},
+		},
+		{
+			name: "approver subject matches an author's email",
+			policy: &kargoapi.ApprovalPolicy{
+				ExcludeFreightAuthors: true,
+			},
+			subject: "jimbob@example.com",
+			email:   "jb@example.org",
+			assertions: func(eligible bool, msg string) {
+				require.False(t, eligible)
+				require.Contains(t, msg, "authored a commit")
+			},
+		},
+		{
+			name: "approver subject matches an author's name",
+			policy: &kargoapi.ApprovalPolicy{
+				ExcludeFreightAuthors: true,
+			},
+			subject: "alice",
+			email:   "alice@example.org",
+			assertions: func(eligible bool, msg string) {
+				require.False(t, eligible)
+				require.Contains(t, msg, "authored a commit")
+			},
+		},
+		{
+			name: "approver email is part of an author's email",
+			policy: &kargoapi.ApprovalPolicy{
+				ExcludeFreightAuthors: true,
+			},
+			subject: "bob",
+			email:   "bob@example.com",
+			assertions: func(eligible bool, _ string) {
+				require.True(t, eligible)
+			},
+		},
+		{
+			name:    "no restrictions",
+			policy:  &kargoapi.ApprovalPolicy{},
+			subject: "alice",
+			email:   "alice@example.com",
+			assertions: func(eligible bool, _ string) {
+				require.True(t, eligible)
+			},
+		},
+		{
+			name: "eligible by email",
+			policy: &kargoapi.ApprovalPolicy{
+				Subjects: []string{"bob@example.com"},
+			},
+			subject: "bob",
+			email:   "bob@example.com",
+			assertions: func(eligible bool, _ string) {
+				require.True(t, eligible)
+			},
+		},
+		{
+			name: "eligible by group",
+			policy: &kargoapi.ApprovalPolicy{
+				Groups: []string{"release-managers"},
+			},
+			subject: "bob",
+			groups:  []string{"developers", "release-managers"},
+			assertions: func(eligible bool, _ string) {
+				require.True(t, eligible)
+			},
+		},
+		{
+			name: "not eligible",
+			policy: &kargoapi.ApprovalPolicy{
+				Subjects: []string{"carol"},
+				Groups:   []string{"release-managers"},
+			},
+			subject: "bob",
+			groups:  []string{"developers"},
+			assertions: func(eligible bool, msg string) {
+				require.False(t, eligible)
+				require.Contains(t, msg, "is not an eligible approver")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				IsEligibleApprover(
+					testCase.policy,
+					freight,
+					testCase.subject,
+					testCase.email,
+					testCase.groups,
+				),
+			)
+		})
+	}
+}
+
+func TestIsEligibleApproverWithoutCommits(t *testing.T) {
+	// With no commits to compare against, an email address is not required
+	eligible, _ := IsEligibleApprover(
+		&kargoapi.ApprovalPolicy{ExcludeFreightAuthors: true},
+		&kargoapi.Freight{},
+		"alice",
+		"",
+		nil,
+	)
+	require.True(t, eligible)
+}
+
+func TestAuthorName(t *testing.T) {
+	testCases := []struct {
+		author string
+		name   string
+	}{
+		{author: "Alice <alice@example.com>", name: "Alice"},
+		{author: "Alice Smith <alice@example.com>", name: "Alice Smith"},
+		{author: "<alice@example.com>", name: ""},
+		{author: "alice@example.com", name: ""},
+		{author: "Alice", name: "Alice"},
+		{author: "", name: ""},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.author, func(t *testing.T) {
+			require.Equal(t, testCase.name, authorName(testCase.author))
+		})
+	}
+}
+
+func TestAuthorEmail(t *testing.T) {
+	testCases := []struct {
+		author string
+		email  string
+	}{
+		{author: "Alice <alice@example.com>", email: "alice@example.com"},
+		{author: "Alice Smith <alice@example.com>", email: "alice@example.com"},
+		{author: "<alice@example.com>", email: "alice@example.com"},
+		{author: "alice@example.com", email: "alice@example.com"},
+		{author: "Alice", email: ""},
+		{author: "Alice <alice@example.com", email: ""},
+		{author: "", email: ""},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.author, func(t *testing.T) {
+			require.Equal(t, testCase.email, authorEmail(testCase.author))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package kargo
+
+import (
+	"fmt"
+	"time"
+	// Embed the time zone database so that time zones can be loaded even if the
+	// host lacks one.
+	_ "time/tzdata"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// GetPromotionWindows returns the PromotionWindows from the PromotionPolicy, if
+// any, for the specified Stage in the specified Project.
+func GetPromotionWindows(
+	project *kargoapi.Project,
+	stage string,
+) []kargoapi.PromotionWindow {
+	if project == nil || project.Spec == nil {
+		return nil
+	}
+	for _, policy := range project.Spec.PromotionPolicies {
+		if policy.Stage == stage {
+			return policy.Windows
+		}
+	}
+	return nil
+}
+
+// ValidatePromotionWindow returns an error if the specified PromotionWindow
+// has an invalid schedule, duration, or time zone.
+func ValidatePromotionWindow(window kargoapi.PromotionWindow) error {
+	_, _, _, err := parsePromotionWindow(window)
+	return err
+}
+
+// IsPromotionPermittedAt checks the specified PromotionWindows to determine
+// whether promotion is permitted at the specified time. Promotion is never
+// permitted while any Deny window is open. If any Allow windows are defined,
+// promotion is only permitted while at least one of them is open. If promotion
+// is not permitted, a message explaining why is also returned.
+func IsPromotionPermittedAt(
+	windows []kargoapi.PromotionWindow,
+	t time.Time,
+) (bool, string, error) {
+	var hasAllowWindows, inAllowWindow bool
+	for _, window := range windows {
+		open, err := isPromotionWindowOpen(window, t)
+		if err != nil {
+			return false, "", err
+		}
+		switch window.Kind {
+		case kargoapi.PromotionWindowKindDeny:
+			if open {
+				return false, fmt.Sprintf(
+					"promotion is denied by window %q%s",
+					window.Schedule,
+					reasonSuffix(window),
+				), nil
+			}
+		case kargoapi.PromotionWindowKindAllow:
+			hasAllowWindows = true
+			inAllowWindow = inAllowWindow || open
+		}
+	}
+	if hasAllowWindows && !inAllowWindow {
+		return false, "promotion is only permitted during allow windows, and none are open", nil
+	}
+	return true, "", nil
+}
+
+// isPromotionWindowOpen returns true if the specified PromotionWindow is open
+// at the specified time.
+func isPromotionWindowOpen(
+	window kargoapi.PromotionWindow,
+	t time.Time,
+) (bool, error) {
+	schedule, duration, loc, err := parsePromotionWindow(window)
+	if err != nil {
+		return false, err
+	}
+	// The window is open at t if an occurrence of it opened after t - duration,
+	// but not after t.
+	opened := schedule.Next(t.Add(-duration).In(loc))
+	return !opened.After(t), nil
+}
+
+func parsePromotionWindow(
+	window kargoapi.PromotionWindow,
+) (cron.Schedule, time.Duration, *time.Location, error) {
+	schedule, err := cron.ParseStandard(window.Schedule)
+	if err != nil {
+		return nil, 0, nil,
+			errors.Wrapf(err, "error parsing schedule %q", window.Schedule)
+	}
+	duration, err := time.ParseDuration(window.Duration)
+	if err != nil {
+		return nil, 0, nil,
+			errors.Wrapf(err, "error parsing duration %q", window.Duration)
+	}
+	if duration <= 0 {
+		return nil, 0, nil, errors.Errorf("duration %q must be positive", window.Duration)
+	}
+	loc := time.UTC
+	if window.TimeZone != "" {
+		if loc, err = time.LoadLocation(window.TimeZone); err != nil {
+			return nil, 0, nil,
+				errors.Wrapf(err, "error loading time zone %q", window.TimeZone)
+		}
+	}
+	return schedule, duration, loc, nil
+}
+
+func reasonSuffix(window kargoapi.PromotionWindow) string {
+	if window.Reason == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", window.Reason)
+}
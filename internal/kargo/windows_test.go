@@ -0,0 +1,209 @@
+package kargo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestValidatePromotionWindow(t *testing.T) {
+	testCases := []struct {
+		name       string
+		window     kargoapi.PromotionWindow
+		assertions func(error)
+	}{
+		{
+			name: "invalid schedule",
+			window: kargoapi.PromotionWindow{
+				Schedule: "not a schedule",
+				Duration: "1h",
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing schedule")
+			},
+		},
+		{
+			name: "invalid duration",
+			window: kargoapi.PromotionWindow{
+				Schedule: "0 9 * * 1-5",
+				Duration: "forever",
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing duration")
+			},
+		},
+		{
+			name: "non-positive duration",
+			window: kargoapi.PromotionWindow{
+				Schedule: "0 9 * * 1-5",
+				Duration: "0s",
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "must be positive")
+			},
+		},
+		{
+			name: "invalid time zone",
+			window: kargoapi.PromotionWindow{
+				Schedule: "0 9 * * 1-5",
+				Duration: "7h",
+				TimeZone: "Nowhere/Nothing",
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error loading time zone")
+			},
+		},
+		{
+			name: "valid",
+			window: kargoapi.PromotionWindow{
+				Schedule: "0 9 * * 1-5",
+				Duration: "7h",
+				TimeZone: "Europe/Berlin",
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(ValidatePromotionWindow(testCase.window))
+		})
+	}
+}
+
+func TestIsPromotionPermittedAt(t *testing.T) {
+	// Weekdays, 09:00 - 16:00 UTC
+	businessHours := kargoapi.PromotionWindow{
+		Kind:     kargoapi.PromotionWindowKindAllow,
+		Schedule: "0 9 * * 1-5",
+		Duration: "7h",
+	}
+	// December 20th through January 2nd
+	holidays := kargoapi.PromotionWindow{
+		Kind:     kargoapi.PromotionWindowKindDeny,
+		Schedule: "0 0 20 12 *",
+		Duration: "312h",
+		Reason:   "holiday freeze",
+	}
+	testCases := []struct {
+		name       string
+		windows    []kargoapi.PromotionWindow
+		time       time.Time
+		assertions func(bool, string, error)
+	}{
+		{
+			name: "no windows",
+			time: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			assertions: func(permitted bool, msg string, err error) {
+				require.NoError(t, err)
+				require.True(t, permitted)
+				require.Empty(t, msg)
+			},
+		},
+		{
+			name: "invalid window",
+			windows: []kargoapi.PromotionWindow{
+				{
+					Kind:     kargoapi.PromotionWindowKindAllow,
+					Schedule: "bogus",
+					Duration: "1h",
+				},
+			},
+			time: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			assertions: func(_ bool, _ string, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:    "inside allow window",
+			windows: []kargoapi.PromotionWindow{businessHours, holidays},
+			// A Wednesday
+			time: time.Date(2024, time.March, 6, 10, 0, 0, 0, time.UTC),
+			assertions: func(permitted bool, _ string, err error) {
+				require.NoError(t, err)
+				require.True(t, permitted)
+			},
+		},
+		{
+			name:    "at the very start of an allow window",
+			windows: []kargoapi.PromotionWindow{businessHours},
+			time:    time.Date(2024, time.March, 6, 9, 0, 0, 0, time.UTC),
+			assertions: func(permitted bool, _ string, err error) {
+				require.NoError(t, err)
+				require.True(t, permitted)
+			},
+		},
+		{
+			name:    "at the very end of an allow window",
+			windows: []kargoapi.PromotionWindow{businessHours},
+			time:    time.Date(2024, time.March, 6, 16, 0, 0, 0, time.UTC),
+			assertions: func(permitted bool, msg string, err error) {
+				require.NoError(t, err)
+				require.False(t, permitted)
+				require.Contains(t, msg, "only permitted during allow windows")
+			},
+		},
+		{
+			name:    "outside allow window",
+			windows: []kargoapi.PromotionWindow{businessHours},
+			// A Saturday
+			time: time.Date(2024, time.March, 9, 10, 0, 0, 0, time.UTC),
+			assertions: func(permitted bool, _ string, err error) {
+				require.NoError(t, err)
+				require.False(t, permitted)
+			},
+		},
+		{
+			name:    "inside deny window and allow window",
+			windows: []kargoapi.PromotionWindow{businessHours, holidays},
+			// A Monday
+			time: time.Date(2023, time.December, 25, 10, 0, 0, 0, time.UTC),
+			assertions: func(permitted bool, msg string, err error) {
+				require.NoError(t, err)
+				require.False(t, permitted)
+				require.Contains(t, msg, "holiday freeze")
+			},
+		},
+		{
+			name:    "deny window spanning year end",
+			windows: []kargoapi.PromotionWindow{holidays},
+			time:    time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC),
+			assertions: func(permitted bool, _ string, err error) {
+				require.NoError(t, err)
+				require.False(t, permitted)
+			},
+		},
+		{
+			name: "time zone is respected",
+			windows: []kargoapi.PromotionWindow{
+				{
+					Kind:     kargoapi.PromotionWindowKindAllow,
+					Schedule: "0 9 * * *",
+					Duration: "1h",
+					TimeZone: "America/New_York",
+				},
+			},
+			// 09:30 in New York
+			time: time.Date(2024, time.March, 6, 14, 30, 0, 0, time.UTC),
+			assertions: func(permitted bool, _ string, err error) {
+				require.NoError(t, err)
+				require.True(t, permitted)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				IsPromotionPermittedAt(testCase.windows, testCase.time),
+			)
+		})
+	}
+}
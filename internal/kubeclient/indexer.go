@@ -300,10 +300,7 @@ func IndexStagesByWarehouse(ctx context.Context, mgr ctrl.Manager) error {
 
 func indexStagesByWarehouse(obj client.Object) []string {
 	stage := obj.(*kargoapi.Stage) // nolint: forcetypeassert
-	if stage.Spec.Subscriptions.Warehouse != "" {
-		return []string{stage.Spec.Subscriptions.Warehouse}
-	}
-	return nil
+	return stage.Spec.Subscriptions.GetWarehouses()
 }
 
 func IndexServiceAccountsByOIDCEmail(ctx context.Context, mgr ctrl.Manager) error {
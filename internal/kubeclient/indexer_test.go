@@ -445,3 +445,52 @@ func TestIndexStagesByUpstreamStages(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexStagesByWarehouse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stage    *kargoapi.Stage
+		expected []string
+	}{
+		{
+			name: "Stage has no Warehouses",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "Stage has a Warehouse",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouse: "fake-warehouse",
+					},
+				},
+			},
+			expected: []string{"fake-warehouse"},
+		},
+		{
+			name: "Stage has multiple Warehouses",
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					Subscriptions: &kargoapi.Subscriptions{
+						Warehouses: []string{"fake-warehouse", "another-fake-warehouse"},
+					},
+				},
+			},
+			expected: []string{"fake-warehouse", "another-fake-warehouse"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				indexStagesByWarehouse(testCase.stage),
+			)
+		})
+	}
+}
@@ -0,0 +1,419 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/distribution/distribution/v3/reference"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"oras.land/oras-go/pkg/registry"
+	"oras.land/oras-go/pkg/registry/remote"
+	"oras.land/oras-go/pkg/registry/remote/auth"
+
+	"github.com/akuity/kargo/internal/image"
+)
+
+// SelectionStrategy represents a strategy for selecting a single artifact from
+// an OCI artifact repository.
+type SelectionStrategy string
+
+const (
+	// SelectionStrategyDigest represents an artifact selection strategy that is
+	// useful for finding the digest of an artifact that is currently referenced
+	// by a mutable tag, e.g. latest. This strategy requires the use of a
+	// constraint that must exactly match the name of a, presumably, mutable tag.
+	SelectionStrategyDigest SelectionStrategy = "Digest"
+	// SelectionStrategyLexical represents an artifact selection strategy that is
+	// useful for finding the artifact referenced by the tag that is lexically
+	// last among those matched by a regular expression and not explicitly
+	// ignored.
+	SelectionStrategyLexical SelectionStrategy = "Lexical"
+	// SelectionStrategySemVer represents an artifact selection strategy that is
+	// useful for finding the artifact referenced by the tag that is the highest
+	// among tags from the repository that are valid semantic versions. An
+	// optional constraint can limit the eligible range of semantic versions.
+	SelectionStrategySemVer SelectionStrategy = "SemVer"
+)
+
+const (
+	// maxManifestBytes is the maximum size of a manifest that will be read from
+	// a registry.
+	maxManifestBytes = 4 << 20
+	// maxManifestsExamined is the maximum number of manifests that will be
+	// retrieved from a registry to determine the types of candidate artifacts
+	// before selection from a single repository gives up.
+	maxManifestsExamined = 20
+)
+
+// manifestMediaTypes are the media types of manifests that are accepted when
+// retrieving an artifact's manifest from a registry.
+var manifestMediaTypes = []string{
+	ocispec.MediaTypeImageManifest,
+	ocispec.MediaTypeImageIndex,
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+}
+
+// Artifact describes a specific version of an OCI artifact.
+type Artifact struct {
+	// Tag is the tag that references the artifact.
+	Tag string
+	// Digest is the digest of the artifact's manifest.
+	Digest string
+	// ArtifactType is the artifactType of the artifact's manifest or, if that is
+	// not set, the media type of its config.
+	ArtifactType string
+}
+
+// SelectorOptions represents options for selecting an artifact.
+type SelectorOptions struct {
+	// Constraint holds a selection strategy-specific value for constraining
+	// artifact selection.
+	Constraint string
+	// AllowRegex is an optional regular expression that can be used to constrain
+	// artifact selection based on eligible tags.
+	AllowRegex string
+	// Ignore is an optional list of tags that should explicitly be ignored when
+	// selecting an artifact.
+	Ignore []string
+	// ArtifactType optionally limits selection to artifacts of the specified
+	// type.
+	ArtifactType string
+	// Creds holds optional credentials for authenticating to the artifact
+	// repository.
+	Creds *Credentials
+}
+
+// SelectArtifact connects to the OCI artifact repository specified by repoURL
+// and selects a single artifact based on the provided selection strategy and
+// options. The tags that satisfied the selection criteria are also returned,
+// ordered from most to least preferred and beginning with the tag of the
+// selected artifact. Because the type of an artifact can only be determined by
+// retrieving its manifest, when a type is required, no more than
+// maxManifestsExamined candidates are examined, in order of preference, and
+// only the tags of those confirmed to be of the required type are returned. If
+// no artifact satisfies the selection criteria, a nil Artifact is returned.
+func SelectArtifact(
+	ctx context.Context,
+	repoURL string,
+	strategy SelectionStrategy,
+	opts *SelectorOptions,
+) (*Artifact, []string, error) {
+	if opts == nil {
+		opts = &SelectorOptions{}
+	}
+	rep, err := newRepository(repoURL, opts.Creds)
+	if err != nil {
+		return nil, nil, err
+	}
+	return selectArtifact(ctx, rep, strategy, opts)
+}
+
+// newRepository returns a client for the OCI artifact repository specified by
+// repoURL, which is interpreted the same way as the name of a container image.
+// e.g. A repoURL without a registry host refers to a repository on Docker Hub.
+// Provided credentials may be nil for public repositories, but must be non-nil
+// for private repositories. Requests made using the client are subject to the
+// same per-registry rate limits as requests made by the image package.
+func newRepository(repoURL string, creds *Credentials) (*remote.Repository, error) {
+	named, err := reference.ParseNormalizedNamed(repoURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing repository URL %q", repoURL)
+	}
+	domain := reference.Domain(named)
+	host := domain
+	if host == "docker.io" {
+		host = "registry-1.docker.io"
+	}
+	return &remote.Repository{
+		Reference: registry.Reference{
+			Registry:   host,
+			Repository: reference.Path(named),
+		},
+		Client: &auth.Client{
+			Client: &http.Client{
+				Transport: image.NewRateLimitedTransport(domain),
+			},
+			Credential: func(context.Context, string) (auth.Credential, error) {
+				if creds != nil {
+					return auth.Credential{
+						Username: creds.Username,
+						Password: creds.Password,
+					}, nil
+				}
+				return auth.Credential{}, nil
+			},
+		},
+	}, nil
+}
+
+// selectArtifact implements SelectArtifact using the provided repository
+// client.
+func selectArtifact(
+	ctx context.Context,
+	rep *remote.Repository,
+	strategy SelectionStrategy,
+	opts *SelectorOptions,
+) (*Artifact, []string, error) {
+	tags := make([]string, 0, rep.TagListPageSize)
+	if err := rep.Tags(ctx, func(t []string) error {
+		tags = append(tags, t...)
+		return nil
+	}); err != nil {
+		return nil, nil, errors.Wrapf(
+			err,
+			"error listing tags of repository %q",
+			rep.Reference.String(),
+		)
+	}
+	candidates, err := selectTags(tags, strategy, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(candidates) == 0 {
+		return nil, nil, nil
+	}
+	if opts.ArtifactType == "" {
+		artifact, err := getArtifact(ctx, rep, candidates[0])
+		if err != nil {
+			return nil, nil, errors.Wrapf(
+				err,
+				"error retrieving artifact with tag %q",
+				candidates[0],
+			)
+		}
+		return artifact, candidates, nil
+	}
+	// The type of each candidate must be confirmed before it is selected or
+	// reported as a candidate
+	var selected *Artifact
+	var confirmed []string
+	for i, tag := range candidates {
+		if i == maxManifestsExamined {
+			break
+		}
+		artifact, err := getArtifact(ctx, rep, tag)
+		if err != nil {
+			return nil, nil, errors.Wrapf(
+				err,
+				"error retrieving artifact with tag %q",
+				tag,
+			)
+		}
+		if artifact.ArtifactType != opts.ArtifactType {
+			continue
+		}
+		if selected == nil {
+			selected = artifact
+		}
+		confirmed = append(confirmed, tag)
+	}
+	if selected == nil {
+		return nil, nil, nil
+	}
+	return selected, confirmed, nil
+}
+
+// selectTags returns those of the provided tags that satisfy the provided
+// selection strategy and options, ordered from most to least preferred.
+func selectTags(
+	tags []string,
+	strategy SelectionStrategy,
+	opts *SelectorOptions,
+) ([]string, error) {
+	var allowRegex *regexp.Regexp
+	if opts.AllowRegex != "" {
+		var err error
+		if allowRegex, err = regexp.Compile(opts.AllowRegex); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error compiling regular expression %q",
+				opts.AllowRegex,
+			)
+		}
+	}
+	eligible := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if allowRegex != nil && !allowRegex.MatchString(tag) {
+			continue
+		}
+		if ignoresTag(tag, opts.Ignore) {
+			continue
+		}
+		eligible = append(eligible, tag)
+	}
+
+	switch strategy {
+	case SelectionStrategyDigest:
+		if opts.Constraint == "" {
+			return nil,
+				errors.New("digest selection strategy requires a constraint")
+		}
+		for _, tag := range eligible {
+			if tag == opts.Constraint {
+				return []string{tag}, nil
+			}
+		}
+		return nil, nil
+	case SelectionStrategyLexical:
+		sort.Sort(sort.Reverse(sort.StringSlice(eligible)))
+		return eligible, nil
+	case SelectionStrategySemVer, "":
+		return selectSemverTags(eligible, opts.Constraint)
+	default:
+		return nil, errors.Errorf("invalid artifact selection strategy %q", strategy)
+	}
+}
+
+// selectSemverTags returns those of the provided tags that are valid semantic
+// versions satisfying the provided constraint, ordered from semantically
+// greatest to least. If no constraint is specified (the empty string is
+// passed), all such tags are returned.
+func selectSemverTags(tags []string, constraintStr string) ([]string, error) {
+	var constraint *semver.Constraints
+	if constraintStr != "" {
+		var err error
+		if constraint, err = semver.NewConstraint(constraintStr); err != nil {
+			return nil,
+				errors.Wrapf(err, "error parsing constraint %q", constraintStr)
+		}
+	}
+	semvers := make([]*semver.Version, 0, len(tags))
+	for _, tag := range tags {
+		sv, err := semver.NewVersion(tag)
+		if err != nil {
+			continue // tag wasn't a semantic version
+		}
+		if constraint == nil || constraint.Check(sv) {
+			semvers = append(semvers, sv)
+		}
+	}
+	sort.Slice(semvers, func(i, j int) bool {
+		if comp := semvers[i].Compare(semvers[j]); comp != 0 {
+			return comp > 0
+		}
+		// If the semvers tie, break the tie lexically using the original tags.
+		// This ensures a deterministic order of equivalent semvers, e.g., 1.0 and
+		// 1.0.0.
+		return semvers[i].Original() > semvers[j].Original()
+	})
+	selected := make([]string, len(semvers))
+	for i, sv := range semvers {
+		selected[i] = sv.Original()
+	}
+	return selected, nil
+}
+
+// ignoresTag returns true if the given tag is in the given list of ignored
+// tags. It returns false otherwise.
+func ignoresTag(tag string, ignore []string) bool {
+	for _, i := range ignore {
+		if i == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// getArtifact retrieves the manifest referenced by the specified tag from the
+// provided repository and returns a description of the artifact. Manifests
+// larger than maxManifestBytes are refused rather than truncated. If the
+// registry reports the manifest's digest, the manifest is verified against it.
+func getArtifact(
+	ctx context.Context,
+	rep *remote.Repository,
+	tag string,
+) (*Artifact, error) {
+	scheme := "https"
+	if rep.PlainHTTP {
+		scheme = "http"
+	}
+	url := fmt.Sprintf(
+		"%s://%s/v2/%s/manifests/%s",
+		scheme,
+		rep.Reference.Host(),
+		rep.Reference.Repository,
+		tag,
+	)
+	ctx = auth.AppendScopes(
+		ctx,
+		auth.ScopeRepository(rep.Reference.Repository, auth.ActionPull),
+	)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error preparing HTTP/S request to %q", url)
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	res, err := rep.Client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error querying %q", url)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf(
+			"received unexpected HTTP %d when querying %q",
+			res.StatusCode,
+			url,
+		)
+	}
+	// Read one byte more than permitted to tell a manifest of exactly the
+	// maximum size apart from a larger one
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxManifestBytes+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading response from %q", url)
+	}
+	if len(body) > maxManifestBytes {
+		return nil, errors.Errorf(
+			"manifest too large: response from %q exceeds %d bytes",
+			url,
+			maxManifestBytes,
+		)
+	}
+	dgst := digest.FromBytes(body)
+	if header := res.Header.Get("Docker-Content-Digest"); header != "" {
+		if dgst, err = digest.Parse(header); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error parsing Docker-Content-Digest header %q from %q",
+				header,
+				url,
+			)
+		}
+		verifier := dgst.Verifier()
+		_, _ = verifier.Write(body)
+		if !verifier.Verified() {
+			return nil, errors.Errorf(
+				"manifest from %q does not match digest %s reported by the registry",
+				url,
+				dgst,
+			)
+		}
+	}
+	manifest := struct {
+		ArtifactType string `json:"artifactType"`
+		Config       struct {
+			MediaType string `json:"mediaType"`
+		} `json:"config"`
+	}{}
+	if err = json.Unmarshal(body, &manifest); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling response from %q", url)
+	}
+	artifactType := manifest.ArtifactType
+	if artifactType == "" {
+		artifactType = manifest.Config.MediaType
+	}
+	return &Artifact{
+		Tag:          tag,
+		Digest:       dgst.String(),
+		ArtifactType: artifactType,
+	}, nil
+}
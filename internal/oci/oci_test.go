@@ -0,0 +1,345 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/pkg/registry"
+	"oras.land/oras-go/pkg/registry/remote"
+	"oras.land/oras-go/pkg/registry/remote/auth"
+)
+
+func TestSelectArtifact(t *testing.T) {
+	const fluxType = "application/vnd.cncf.flux.config.v1+json"
+	manifests := map[string]string{
+		// An artifact whose type is specified by its config's media type
+		"1.0.0": `{"config":{"mediaType":"` + fluxType + `"}}`,
+		// An artifact whose type is specified explicitly
+		"1.1.0": `{"artifactType":"` + fluxType + `","config":{"mediaType":"application/vnd.oci.empty.v1+json"}}`,
+		// An artifact of a different type
+		"1.2.0":  `{"config":{"mediaType":"application/vnd.wasm.config.v1+json"}}`,
+		"0.9.0":  `{"config":{"mediaType":"application/vnd.wasm.config.v1+json"}}`,
+		"latest": `{"config":{"mediaType":"` + fluxType + `"}}`,
+	}
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch {
+				case r.URL.Path == "/v2/fake-repo/tags/list":
+					w.Header().Set("Content-Type", "application/json")
+					require.NoError(
+						t,
+						json.NewEncoder(w).Encode(map[string][]string{
+							"tags": {"0.9.0", "1.0.0", "1.1.0", "1.2.0", "latest"},
+						}),
+					)
+				case strings.HasPrefix(r.URL.Path, "/v2/fake-repo/manifests/"):
+					manifest, ok :=
+						manifests[strings.TrimPrefix(r.URL.Path, "/v2/fake-repo/manifests/")]
+					if !ok {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					_, err := w.Write([]byte(manifest))
+					require.NoError(t, err)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			},
+		),
+	)
+	defer testServer.Close()
+	serverURL, err := url.Parse(testServer.URL)
+	require.NoError(t, err)
+	rep := &remote.Repository{
+		Reference: registry.Reference{
+			Registry:   serverURL.Host,
+			Repository: "fake-repo",
+		},
+		Client:    &auth.Client{},
+		PlainHTTP: true,
+	}
+
+	testCases := []struct {
+		name       string
+		strategy   SelectionStrategy
+		opts       SelectorOptions
+		assertions func(*testing.T, *Artifact, []string, error)
+	}{
+		{
+			name: "semver without artifact type",
+			assertions: func(t *testing.T, artifact *Artifact, candidates []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&Artifact{
+						Tag:          "1.2.0",
+						Digest:       digest.FromString(manifests["1.2.0"]).String(),
+						ArtifactType: "application/vnd.wasm.config.v1+json",
+					},
+					artifact,
+				)
+				require.Equal(t, []string{"1.2.0", "1.1.0", "1.0.0", "0.9.0"}, candidates)
+			},
+		},
+		{
+			name: "semver with artifact type",
+			opts: SelectorOptions{ArtifactType: fluxType},
+			assertions: func(t *testing.T, artifact *Artifact, candidates []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.1.0", artifact.Tag)
+				require.Equal(t, fluxType, artifact.ArtifactType)
+				// Candidates of other types are not reported
+				require.Equal(t, []string{"1.1.0", "1.0.0"}, candidates)
+			},
+		},
+		{
+			name: "semver with constraint",
+			opts: SelectorOptions{
+				Constraint:   "<1.1.0",
+				ArtifactType: fluxType,
+			},
+			assertions: func(t *testing.T, artifact *Artifact, candidates []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.0.0", artifact.Tag)
+				require.Equal(t, []string{"1.0.0"}, candidates)
+			},
+		},
+		{
+			name:     "lexical with ignored tags",
+			strategy: SelectionStrategyLexical,
+			opts:     SelectorOptions{Ignore: []string{"latest"}},
+			assertions: func(t *testing.T, artifact *Artifact, candidates []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.2.0", artifact.Tag)
+				require.Equal(t, []string{"1.2.0", "1.1.0", "1.0.0", "0.9.0"}, candidates)
+			},
+		},
+		{
+			name:     "digest",
+			strategy: SelectionStrategyDigest,
+			opts:     SelectorOptions{Constraint: "latest"},
+			assertions: func(t *testing.T, artifact *Artifact, candidates []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					digest.FromString(manifests["latest"]).String(),
+					artifact.Digest,
+				)
+				require.Equal(t, []string{"latest"}, candidates)
+			},
+		},
+		{
+			name:     "digest without constraint",
+			strategy: SelectionStrategyDigest,
+			assertions: func(t *testing.T, _ *Artifact, _ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "requires a constraint")
+			},
+		},
+		{
+			name: "no artifact of the required type",
+			opts: SelectorOptions{ArtifactType: "application/vnd.fake+json"},
+			assertions: func(t *testing.T, artifact *Artifact, candidates []string, err error) {
+				require.NoError(t, err)
+				require.Nil(t, artifact)
+				require.Nil(t, candidates)
+			},
+		},
+		{
+			name:     "invalid strategy",
+			strategy: "Bogus",
+			assertions: func(t *testing.T, _ *Artifact, _ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid artifact selection strategy")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			opts := testCase.opts
+			artifact, candidates, err :=
+				selectArtifact(context.Background(), rep, testCase.strategy, &opts)
+			testCase.assertions(t, artifact, candidates, err)
+		})
+	}
+}
+
+func TestSelectArtifactExaminesLimitedManifests(t *testing.T) {
+	// Every tag but the least preferred references an artifact of the wrong type
+	tags := make([]string, maxManifestsExamined+1)
+	for i := range tags {
+		tags[i] = fmt.Sprintf("1.0.%d", i)
+	}
+	var manifestRequests int32
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch {
+				case r.URL.Path == "/v2/fake-repo/tags/list":
+					w.Header().Set("Content-Type", "application/json")
+					require.NoError(
+						t,
+						json.NewEncoder(w).Encode(map[string][]string{"tags": tags}),
+					)
+				case r.URL.Path == "/v2/fake-repo/manifests/1.0.0":
+					atomic.AddInt32(&manifestRequests, 1)
+					_, err := w.Write([]byte(`{"config":{"mediaType":"fake-type"}}`))
+					require.NoError(t, err)
+				case strings.HasPrefix(r.URL.Path, "/v2/fake-repo/manifests/"):
+					atomic.AddInt32(&manifestRequests, 1)
+					_, err := w.Write([]byte(`{"config":{"mediaType":"other-type"}}`))
+					require.NoError(t, err)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			},
+		),
+	)
+	defer testServer.Close()
+	serverURL, err := url.Parse(testServer.URL)
+	require.NoError(t, err)
+	rep := &remote.Repository{
+		Reference: registry.Reference{
+			Registry:   serverURL.Host,
+			Repository: "fake-repo",
+		},
+		Client:    &auth.Client{},
+		PlainHTTP: true,
+	}
+
+	artifact, candidates, err := selectArtifact(
+		context.Background(),
+		rep,
+		SelectionStrategySemVer,
+		&SelectorOptions{ArtifactType: "fake-type"},
+	)
+	require.NoError(t, err)
+	require.Nil(t, artifact)
+	require.Nil(t, candidates)
+	require.Equal(t, int32(maxManifestsExamined), manifestRequests)
+}
+
+func TestGetArtifact(t *testing.T) {
+	const manifest = `{"config":{"mediaType":"fake-type"}}`
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				body := []byte(manifest)
+				switch strings.TrimPrefix(r.URL.Path, "/v2/fake-repo/manifests/") {
+				case "verified":
+					w.Header().Set("Docker-Content-Digest", digest.FromBytes(body).String())
+				case "mismatched":
+					w.Header().Set("Docker-Content-Digest", digest.FromString("other").String())
+				case "invalid-digest":
+					w.Header().Set("Docker-Content-Digest", "bogus")
+				case "max-size":
+					body = append(body, strings.Repeat(" ", maxManifestBytes-len(body))...)
+				case "too-large":
+					body = append(body, strings.Repeat(" ", maxManifestBytes-len(body)+1)...)
+				case "unverified":
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, err := w.Write(body)
+				require.NoError(t, err)
+			},
+		),
+	)
+	defer testServer.Close()
+	serverURL, err := url.Parse(testServer.URL)
+	require.NoError(t, err)
+	rep := &remote.Repository{
+		Reference: registry.Reference{
+			Registry:   serverURL.Host,
+			Repository: "fake-repo",
+		},
+		Client:    &auth.Client{},
+		PlainHTTP: true,
+	}
+
+	testCases := []struct {
+		tag        string
+		assertions func(*testing.T, *Artifact, error)
+	}{
+		{
+			tag: "verified",
+			assertions: func(t *testing.T, artifact *Artifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, digest.FromString(manifest).String(), artifact.Digest)
+				require.Equal(t, "fake-type", artifact.ArtifactType)
+			},
+		},
+		{
+			tag: "unverified",
+			assertions: func(t *testing.T, artifact *Artifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, digest.FromString(manifest).String(), artifact.Digest)
+			},
+		},
+		{
+			tag: "mismatched",
+			assertions: func(t *testing.T, _ *Artifact, err error) {
+				require.ErrorContains(t, err, "does not match digest")
+			},
+		},
+		{
+			tag: "invalid-digest",
+			assertions: func(t *testing.T, _ *Artifact, err error) {
+				require.ErrorContains(t, err, "error parsing Docker-Content-Digest header")
+			},
+		},
+		{
+			tag: "max-size",
+			assertions: func(t *testing.T, artifact *Artifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-type", artifact.ArtifactType)
+			},
+		},
+		{
+			tag: "too-large",
+			assertions: func(t *testing.T, _ *Artifact, err error) {
+				require.ErrorContains(t, err, "manifest too large")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.tag, func(t *testing.T) {
+			artifact, err := getArtifact(context.Background(), rep, testCase.tag)
+			testCase.assertions(t, artifact, err)
+		})
+	}
+}
+
+func TestNewRepository(t *testing.T) {
+	rep, err := newRepository("nginx", nil)
+	require.NoError(t, err)
+	require.Equal(t, "registry-1.docker.io", rep.Reference.Registry)
+	require.Equal(t, "library/nginx", rep.Reference.Repository)
+	// Requests are subject to the image package's rate limits
+	client, ok := rep.Client.(*auth.Client)
+	require.True(t, ok)
+	require.NotNil(t, client.Client)
+	require.NotNil(t, client.Client.Transport)
+
+	rep, err = newRepository("ghcr.io/akuity/kargo-manifests", nil)
+	require.NoError(t, err)
+	require.Equal(t, "ghcr.io", rep.Reference.Registry)
+	require.Equal(t, "akuity/kargo-manifests", rep.Reference.Repository)
+
+	_, err = newRepository("not a valid URL", nil)
+	require.Error(t, err)
+}
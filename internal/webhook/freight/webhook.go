@@ -121,7 +121,8 @@ func (w *webhook) ValidateCreate(
 
 	if len(freight.Commits) == 0 &&
 		len(freight.Images) == 0 &&
-		len(freight.Charts) == 0 {
+		len(freight.Charts) == 0 &&
+		len(freight.OCIArtifacts) == 0 {
 		return nil, apierrors.NewInvalid(
 			freightGroupKind,
 			freight.Name,
@@ -129,7 +130,7 @@ func (w *webhook) ValidateCreate(
 				field.Invalid(
 					field.NewPath(""),
 					freight,
-					"freight must contain at least one commit, image, or chart",
+					"freight must contain at least one commit, image, chart, or OCI artifact",
 				),
 			},
 		)
@@ -155,7 +155,7 @@ func TestValidateCreate(t *testing.T) {
 				require.Contains(
 					t,
 					err.Error(),
-					"freight must contain at least one commit, image, or chart",
+					"freight must contain at least one commit, image, chart, or OCI artifact",
 				)
 			},
 		},
@@ -21,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/logging"
 )
 
@@ -173,7 +174,7 @@ func (w *webhook) validatePromotionPolicies(
 	promotionPolicies []kargoapi.PromotionPolicy,
 ) field.ErrorList {
 	stageNames := make(map[string]struct{}, len(promotionPolicies))
-	for _, promotionPolicy := range promotionPolicies {
+	for i, promotionPolicy := range promotionPolicies {
 		if _, found := stageNames[promotionPolicy.Stage]; found {
 			return field.ErrorList{
 				field.Invalid(
@@ -188,6 +189,17 @@ func (w *webhook) validatePromotionPolicies(
 			}
 		}
 		stageNames[promotionPolicy.Stage] = struct{}{}
+		for j, window := range promotionPolicy.Windows {
+			if err := kargo.ValidatePromotionWindow(window); err != nil {
+				return field.ErrorList{
+					field.Invalid(
+						f.Index(i).Child("windows").Index(j),
+						window,
+						err.Error(),
+					),
+				}
+			}
+		}
 	}
 	return nil
 }
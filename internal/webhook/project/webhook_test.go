@@ -140,11 +140,42 @@ func TestValidateSpec(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "invalid promotion window",
+			spec: &kargoapi.ProjectSpec{
+				PromotionPolicies: []kargoapi.PromotionPolicy{
+					{
+						Stage: "fake-stage",
+						Windows: []kargoapi.PromotionWindow{
+							{
+								Kind:     kargoapi.PromotionWindowKindDeny,
+								Schedule: "bogus",
+								Duration: "1h",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(_ *kargoapi.ProjectSpec, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.promotionPolicies[0].windows[0]", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "error parsing schedule")
+			},
+		},
 		{
 			name: "valid",
 			spec: &kargoapi.ProjectSpec{
 				PromotionPolicies: []kargoapi.PromotionPolicy{
-					{Stage: "fake-stage"},
+					{
+						Stage: "fake-stage",
+						Windows: []kargoapi.PromotionWindow{
+							{
+								Kind:     kargoapi.PromotionWindowKindAllow,
+								Schedule: "0 9 * * 1-5",
+								Duration: "7h",
+							},
+						},
+					},
 				},
 			},
 			assertions: func(_ *kargoapi.ProjectSpec, errs field.ErrorList) {
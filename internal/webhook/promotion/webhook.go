@@ -2,9 +2,14 @@ package promotion
 
 import (
 	"context"
+	"time"
 
+	"github.com/kelseyhightower/envconfig"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	admissionv1 "k8s.io/api/admission/v1"
 	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -16,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/logging"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
@@ -31,8 +37,23 @@ var (
 	}
 )
 
+type WebhookConfig struct {
+	// APIServerUsername is the username with which the Kargo API server
+	// authenticates to Kubernetes. e.g.
+	// system:serviceaccount:kargo:kargo-api. Only Promotions created by this
+	// user may specify, on behalf of one of its own users, who created them.
+	APIServerUsername string `envconfig:"API_SERVER_USERNAME" default:""`
+}
+
+func WebhookConfigFromEnv() WebhookConfig {
+	cfg := WebhookConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
 type webhook struct {
 	client client.Client
+	cfg    WebhookConfig
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -42,6 +63,18 @@ type webhook struct {
 		types.NamespacedName,
 	) (*kargoapi.Stage, error)
 
+	getFreightFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Freight, error)
+
+	getProjectFn func(
+		context.Context,
+		client.Client,
+		string,
+	) (*kargoapi.Project, error)
+
 	validateProjectFn func(
 		context.Context,
 		client.Client,
@@ -49,6 +82,21 @@ type webhook struct {
 		client.Object,
 	) error
 
+	validatePromotionWindowsFn func(
+		ctx context.Context,
+		promo *kargoapi.Promotion,
+	) error
+
+	validateStageLockFn func(
+		ctx context.Context,
+		promo *kargoapi.Promotion,
+	) error
+
+	validateApprovalsFn func(
+		ctx context.Context,
+		promo *kargoapi.Promotion,
+	) error
+
 	authorizeFn func(
 		ctx context.Context,
 		promo *kargoapi.Promotion,
@@ -64,8 +112,8 @@ type webhook struct {
 	) error
 }
 
-func SetupWebhookWithManager(mgr ctrl.Manager) error {
-	w := newWebhook(mgr.GetClient())
+func SetupWebhookWithManager(mgr ctrl.Manager, cfg WebhookConfig) error {
+	w := newWebhook(mgr.GetClient(), cfg)
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&kargoapi.Promotion{}).
 		WithDefaulter(w).
@@ -73,12 +121,18 @@ func SetupWebhookWithManager(mgr ctrl.Manager) error {
 		Complete()
 }
 
-func newWebhook(kubeClient client.Client) *webhook {
+func newWebhook(kubeClient client.Client, cfg WebhookConfig) *webhook {
 	w := &webhook{
 		client: kubeClient,
+		cfg:    cfg,
 	}
 	w.getStageFn = kargoapi.GetStage
+	w.getFreightFn = kargoapi.GetFreight
+	w.getProjectFn = kargoapi.GetProject
 	w.validateProjectFn = libWebhook.ValidateProject
+	w.validatePromotionWindowsFn = w.validatePromotionWindows
+	w.validateStageLockFn = w.validateStageLock
+	w.validateApprovalsFn = w.validateApprovals
 	w.authorizeFn = w.authorize
 	w.admissionRequestFromContextFn = admission.RequestFromContext
 	w.createSubjectAccessReviewFn = w.client.Create
@@ -113,6 +167,38 @@ func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 	ownerRef :=
 		metav1.NewControllerRef(stage, kargoapi.GroupVersion.WithKind("Stage"))
 	promo.ObjectMeta.OwnerReferences = []metav1.OwnerReference{*ownerRef}
+
+	req, err := w.admissionRequestFromContextFn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error retrieving admission request from context")
+	}
+	if req.Operation != admissionv1.Create {
+		return nil
+	}
+
+	// Record who requested the Promotion. The only client trusted to have
+	// already done this is the Kargo API server, which creates Promotions on
+	// behalf of its own users.
+	if promo.Annotations == nil {
+		promo.Annotations = map[string]string{}
+	}
+	if w.cfg.APIServerUsername == "" ||
+		req.UserInfo.Username != w.cfg.APIServerUsername ||
+		promo.Annotations[kargoapi.AnnotationKeyCreatedBy] == "" {
+		promo.Annotations[kargoapi.AnnotationKeyCreatedBy] = req.UserInfo.Username
+	}
+
+	// Record who broke glass, so that it cannot be attributed to anyone else
+	_, breakGlass := promo.Annotations[kargoapi.AnnotationKeyBreakGlass]
+	_, breakGlassBy := promo.Annotations[kargoapi.AnnotationKeyBreakGlassBy]
+	if !breakGlass && !breakGlassBy {
+		return nil
+	}
+	if breakGlass {
+		promo.Annotations[kargoapi.AnnotationKeyBreakGlassBy] = req.UserInfo.Username
+	} else {
+		delete(promo.Annotations, kargoapi.AnnotationKeyBreakGlassBy)
+	}
 	return nil
 }
 
@@ -125,7 +211,16 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, promotionGroupKind, promo); err != nil {
 		return nil, err
 	}
-	return nil, w.authorizeFn(ctx, promo, "create")
+	if err := w.authorizeFn(ctx, promo, "create"); err != nil {
+		return nil, err
+	}
+	if err := w.validatePromotionWindowsFn(ctx, promo); err != nil {
+		return nil, err
+	}
+	if err := w.validateStageLockFn(ctx, promo); err != nil {
+		return nil, err
+	}
+	return nil, w.validateApprovalsFn(ctx, promo)
 }
 
 func (w *webhook) ValidateUpdate(
@@ -138,8 +233,30 @@ func (w *webhook) ValidateUpdate(
 		return nil, err
 	}
 
+	// Break-glass and created-by annotations are part of the audit trail and are
+	// meant to be immutable
+	oldPromo := oldObj.(*kargoapi.Promotion) // nolint: forcetypeassert
+	for _, key := range []string{
+		kargoapi.AnnotationKeyBreakGlass,
+		kargoapi.AnnotationKeyBreakGlassBy,
+		kargoapi.AnnotationKeyCreatedBy,
+	} {
+		if promo.Annotations[key] != oldPromo.Annotations[key] {
+			return nil, apierrors.NewInvalid(
+				promotionGroupKind,
+				promo.Name,
+				field.ErrorList{
+					field.Forbidden(
+						field.NewPath("metadata", "annotations").Key(key),
+						"annotation is immutable",
+					),
+				},
+			)
+		}
+	}
+
 	// PromotionSpecs are meant to be immutable
-	if *promo.Spec != *(oldObj.(*kargoapi.Promotion).Spec) { // nolint: forcetypeassert
+	if !equality.Semantic.DeepEqual(promo.Spec, oldPromo.Spec) {
 		return nil, apierrors.NewInvalid(
 			promotionGroupKind,
 			promo.Name,
@@ -184,43 +301,258 @@ func (w *webhook) authorize(
 		)
 	}
 
-	accessReview := &authzv1.SubjectAccessReview{
-		Spec: authzv1.SubjectAccessReviewSpec{
-			User:   req.UserInfo.Username,
-			Groups: req.UserInfo.Groups,
-			ResourceAttributes: &authzv1.ResourceAttributes{
-				Group:     kargoapi.GroupVersion.Group,
-				Resource:  "stages",
-				Name:      promo.Spec.Stage,
-				Verb:      "promote",
-				Namespace: promo.Namespace,
+	verbs := []string{"promote"}
+	if action == "create" && promo.Spec.Priority > 0 {
+		// Jumping the queue requires additional permission
+		verbs = append(verbs, "prioritize")
+	}
+	if _, ok := promo.Annotations[kargoapi.AnnotationKeyBreakGlass]; ok &&
+		action == "create" {
+		// Overriding promotion windows or a Stage lock requires additional
+		// permission
+		verbs = append(verbs, "breakglass")
+	}
+
+	for _, verb := range verbs {
+		accessReview := &authzv1.SubjectAccessReview{
+			Spec: authzv1.SubjectAccessReviewSpec{
+				User:   req.UserInfo.Username,
+				Groups: req.UserInfo.Groups,
+				ResourceAttributes: &authzv1.ResourceAttributes{
+					Group:     kargoapi.GroupVersion.Group,
+					Resource:  "stages",
+					Name:      promo.Spec.Stage,
+					Verb:      verb,
+					Namespace: promo.Namespace,
+				},
 			},
+		}
+		if err := w.createSubjectAccessReviewFn(ctx, accessReview); err != nil {
+			logger.Error(err)
+			return apierrors.NewForbidden(
+				promotionGroupResource,
+				promo.Name,
+				errors.Errorf(
+					"error creating SubjectAccessReview; refusing to %s Promotion",
+					action,
+				),
+			)
+		}
+
+		if !accessReview.Status.Allowed {
+			switch verb {
+			case "prioritize":
+				return apierrors.NewForbidden(
+					promotionGroupResource,
+					promo.Name,
+					errors.Errorf(
+						"subject %q is not permitted to %s Promotions with a priority "+
+							"greater than zero for Stage %q",
+						req.UserInfo.Username,
+						action,
+						promo.Spec.Stage,
+					),
+				)
+			case "breakglass":
+				return apierrors.NewForbidden(
+					promotionGroupResource,
+					promo.Name,
+					errors.Errorf(
+						"subject %q is not permitted to override promotion windows "+
+							"or locks for Stage %q",
+						req.UserInfo.Username,
+						promo.Spec.Stage,
+					),
+				)
+			}
+			return apierrors.NewForbidden(
+				promotionGroupResource,
+				promo.Name,
+				errors.Errorf(
+					"subject %q is not permitted to %s Promotions for Stage %q",
+					req.UserInfo.Username,
+					action,
+					promo.Spec.Stage,
+				),
+			)
+		}
+	}
+
+	return nil
+}
+
+// validatePromotionWindows refuses a new Promotion if the PromotionPolicy for
+// its Stage does not permit promotion at the time the Promotion may begin,
+// i.e. now or, if it is scheduled for later, at its scheduled time, unless the
+// Promotion carries a break-glass annotation. Permission to break glass is
+// verified separately, by authorize. Every Promotion that breaks glass is
+// logged for audit. Windows are checked again immediately before the
+// Promotion is executed.
+func (w *webhook) validatePromotionWindows(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) error {
+	project, err := w.getProjectFn(ctx, w.client, promo.Namespace)
+	if err != nil {
+		return errors.Wrapf(err, "error finding Project %q", promo.Namespace)
+	}
+	if project == nil || project.Spec == nil {
+		return nil
+	}
+	at := time.Now()
+	if promo.Spec.NotBefore != nil && promo.Spec.NotBefore.After(at) {
+		at = promo.Spec.NotBefore.Time
+	}
+	for _, policy := range project.Spec.PromotionPolicies {
+		if policy.Stage != promo.Spec.Stage {
+			continue
+		}
+		permitted, msg, err := kargo.IsPromotionPermittedAt(policy.Windows, at)
+		if err != nil {
+			return errors.Wrapf(
+				err,
+				"error evaluating promotion windows for Stage %q in namespace %q",
+				promo.Spec.Stage,
+				promo.Namespace,
+			)
+		}
+		if permitted {
+			return nil
+		}
+		reason, breakGlass := promo.Annotations[kargoapi.AnnotationKeyBreakGlass]
+		if !breakGlass {
+			return apierrors.NewForbidden(
+				promotionGroupResource,
+				promo.Name,
+				errors.Errorf(
+					"%s; to override this, annotate the Promotion with %s=<reason>",
+					msg,
+					kargoapi.AnnotationKeyBreakGlass,
+				),
+			)
+		}
+		logging.LoggerFromContext(ctx).WithFields(log.Fields{
+			"namespace": promo.Namespace,
+			"promotion": promo.Name,
+			"stage":     promo.Spec.Stage,
+			"user":      promo.Annotations[kargoapi.AnnotationKeyBreakGlassBy],
+			"reason":    reason,
+		}).Info("promotion windows overridden by break-glass")
+		return nil
+	}
+	return nil
+}
+
+// validateStageLock refuses a new Promotion if its Stage is locked, unless the
+// Promotion carries a break-glass annotation. As with promotion windows,
+// permission to break glass is verified separately, by authorize, and every
+// Promotion that overrides a lock is logged for audit.
+func (w *webhook) validateStageLock(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) error {
+	stage, err := w.getStageFn(
+		ctx,
+		w.client,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
 		},
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error finding Stage %q in namespace %q",
+			promo.Spec.Stage,
+			promo.Namespace,
+		)
 	}
-	if err := w.createSubjectAccessReviewFn(ctx, accessReview); err != nil {
-		logger.Error(err)
+	if stage == nil || stage.Status.Lock == nil {
+		return nil
+	}
+	reason, breakGlass := promo.Annotations[kargoapi.AnnotationKeyBreakGlass]
+	if !breakGlass {
 		return apierrors.NewForbidden(
 			promotionGroupResource,
 			promo.Name,
 			errors.Errorf(
-				"error creating SubjectAccessReview; refusing to %s Promotion",
-				action,
+				"Stage %q is locked by %q: %s; to override this, annotate the "+
+					"Promotion with %s=<reason>",
+				promo.Spec.Stage,
+				stage.Status.Lock.LockedBy,
+				stage.Status.Lock.Reason,
+				kargoapi.AnnotationKeyBreakGlass,
 			),
 		)
 	}
+	logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"namespace": promo.Namespace,
+		"promotion": promo.Name,
+		"stage":     promo.Spec.Stage,
+		"user":      promo.Annotations[kargoapi.AnnotationKeyBreakGlassBy],
+		"reason":    reason,
+	}).Info("stage lock overridden by break-glass")
+	return nil
+}
 
-	if !accessReview.Status.Allowed {
+// validateApprovals refuses a new Promotion if the Freight it references does
+// not satisfy the ApprovalPolicy, if any, for its Stage. Unlike promotion
+// windows, approval policies cannot be overridden.
+func (w *webhook) validateApprovals(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) error {
+	project, err := w.getProjectFn(ctx, w.client, promo.Namespace)
+	if err != nil {
+		return errors.Wrapf(err, "error finding Project %q", promo.Namespace)
+	}
+	policy := kargo.GetApprovalPolicy(project, promo.Spec.Stage)
+	if policy == nil {
+		return nil
+	}
+	freight, err := w.getFreightFn(
+		ctx,
+		w.client,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Freight,
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error finding Freight %q in namespace %q",
+			promo.Spec.Freight,
+			promo.Namespace,
+		)
+	}
+	if freight == nil {
+		return errors.Errorf(
+			"could not find Freight %q in namespace %q",
+			promo.Spec.Freight,
+			promo.Namespace,
+		)
+	}
+	satisfied, msg, err := kargo.IsApprovalPolicySatisfied(
+		policy,
+		freight,
+		promo.Spec.Stage,
+		time.Now(),
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error evaluating approval policy for Stage %q in namespace %q",
+			promo.Spec.Stage,
+			promo.Namespace,
+		)
+	}
+	if !satisfied {
 		return apierrors.NewForbidden(
 			promotionGroupResource,
 			promo.Name,
-			errors.Errorf(
-				"subject %q is not permitted to %s Promotions for Stage %q",
-				req.UserInfo.Username,
-				action,
-				promo.Spec.Stage,
-			),
+			errors.New(msg),
 		)
 	}
-
 	return nil
 }
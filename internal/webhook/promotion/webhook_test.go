@@ -3,10 +3,14 @@ package promotion
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	authzv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -19,20 +23,47 @@ import (
 
 func TestNewWebhook(t *testing.T) {
 	kubeClient := fake.NewClientBuilder().Build()
-	w := newWebhook(kubeClient)
+	w := newWebhook(kubeClient, WebhookConfig{})
 	// Assert that all overridable behaviors were initialized to a default:
 	require.NotNil(t, w.getStageFn)
+	require.NotNil(t, w.getProjectFn)
 	require.NotNil(t, w.validateProjectFn)
+	require.NotNil(t, w.validatePromotionWindowsFn)
+	require.NotNil(t, w.validateStageLockFn)
+	require.NotNil(t, w.getFreightFn)
+	require.NotNil(t, w.validateApprovalsFn)
 	require.NotNil(t, w.authorizeFn)
 	require.NotNil(t, w.admissionRequestFromContextFn)
 	require.NotNil(t, w.createSubjectAccessReviewFn)
 }
 
 func TestDefault(t *testing.T) {
+	getStageFn := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error) {
+		return &kargoapi.Stage{}, nil
+	}
+	admissionRequestFn := func(op admissionv1.Operation) func(
+		context.Context,
+	) (admission.Request, error) {
+		return func(context.Context) (admission.Request, error) {
+			return admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: op,
+					UserInfo: authenticationv1.UserInfo{
+						Username: "fake-user",
+					},
+				},
+			}, nil
+		}
+	}
 	testCases := []struct {
-		name       string
-		webhook    *webhook
-		assertions func(*kargoapi.Promotion, error)
+		name        string
+		annotations map[string]string
+		webhook     *webhook
+		assertions  func(*kargoapi.Promotion, error)
 	}{
 		{
 			name: "error getting stage",
@@ -76,16 +107,137 @@ func TestDefault(t *testing.T) {
 				) (*kargoapi.Stage, error) {
 					return &kargoapi.Stage{}, nil
 				},
+				admissionRequestFromContextFn: admissionRequestFn(admissionv1.Create),
 			},
 			assertions: func(promo *kargoapi.Promotion, err error) {
 				require.NoError(t, err)
 				require.NotEmpty(t, promo.OwnerReferences)
+				require.Equal(
+					t,
+					"fake-user",
+					promo.Annotations[kargoapi.AnnotationKeyCreatedBy],
+				)
+			},
+		},
+		{
+			name: "spoofed created-by",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyCreatedBy: "someone-else",
+			},
+			webhook: &webhook{
+				cfg: WebhookConfig{
+					APIServerUsername: "system:serviceaccount:kargo:kargo-api",
+				},
+				getStageFn:                    getStageFn,
+				admissionRequestFromContextFn: admissionRequestFn(admissionv1.Create),
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"fake-user",
+					promo.Annotations[kargoapi.AnnotationKeyCreatedBy],
+				)
+			},
+		},
+		{
+			name: "created-by recorded by API server",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyCreatedBy: "someone-else",
+			},
+			webhook: &webhook{
+				cfg: WebhookConfig{
+					APIServerUsername: "fake-user",
+				},
+				getStageFn:                    getStageFn,
+				admissionRequestFromContextFn: admissionRequestFn(admissionv1.Create),
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"someone-else",
+					promo.Annotations[kargoapi.AnnotationKeyCreatedBy],
+				)
+			},
+		},
+		{
+			name: "error getting admission request",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass: "fake-reason",
+			},
+			webhook: &webhook{
+				getStageFn: getStageFn,
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{}, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *kargoapi.Promotion, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "break-glass on create",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass:   "fake-reason",
+				kargoapi.AnnotationKeyBreakGlassBy: "someone-else",
+			},
+			webhook: &webhook{
+				getStageFn:                    getStageFn,
+				admissionRequestFromContextFn: admissionRequestFn(admissionv1.Create),
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"fake-user",
+					promo.Annotations[kargoapi.AnnotationKeyBreakGlassBy],
+				)
+			},
+		},
+		{
+			name: "spoofed break-glass-by on create",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlassBy: "someone-else",
+			},
+			webhook: &webhook{
+				getStageFn:                    getStageFn,
+				admissionRequestFromContextFn: admissionRequestFn(admissionv1.Create),
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.NotContains(t, promo.Annotations, kargoapi.AnnotationKeyBreakGlassBy)
+			},
+		},
+		{
+			name: "break-glass on update",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass:   "fake-reason",
+				kargoapi.AnnotationKeyBreakGlassBy: "someone-else",
+			},
+			webhook: &webhook{
+				getStageFn:                    getStageFn,
+				admissionRequestFromContextFn: admissionRequestFn(admissionv1.Update),
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"someone-else",
+					promo.Annotations[kargoapi.AnnotationKeyBreakGlassBy],
+				)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			promo := &kargoapi.Promotion{
+				ObjectMeta: v1.ObjectMeta{
+					Annotations: testCase.annotations,
+				},
 				Spec: &kargoapi.PromotionSpec{
 					Stage: "fake-stage",
 				},
@@ -139,6 +291,102 @@ func TestValidateCreate(t *testing.T) {
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "error validating promotion windows",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				validatePromotionWindowsFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error validating stage lock",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				validatePromotionWindowsFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+				) error {
+					return nil
+				},
+				validateStageLockFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "success",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				validatePromotionWindowsFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+				) error {
+					return nil
+				},
+				validateStageLockFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+				) error {
+					return nil
+				},
+				validateApprovalsFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -153,6 +401,24 @@ func TestValidateCreate(t *testing.T) {
 				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
 					return nil
 				},
+				validatePromotionWindowsFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+				) error {
+					return nil
+				},
+				validateStageLockFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+				) error {
+					return nil
+				},
+				validateApprovalsFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+				) error {
+					return nil
+				},
 			},
 			assertions: func(err error) {
 				require.NoError(t, err)
@@ -222,6 +488,65 @@ func TestValidateUpdate(t *testing.T) {
 			},
 		},
 
+		{
+			name: "attempt to mutate break-glass annotation",
+			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
+				oldPromo := &kargoapi.Promotion{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+						Annotations: map[string]string{
+							kargoapi.AnnotationKeyBreakGlass:   "fake-reason",
+							kargoapi.AnnotationKeyBreakGlassBy: "fake-user",
+						},
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+					},
+				}
+				newPromo := oldPromo.DeepCopy()
+				newPromo.Annotations[kargoapi.AnnotationKeyBreakGlassBy] = "another-user"
+				return oldPromo, newPromo
+			},
+			authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "annotation is immutable")
+			},
+		},
+
+		{
+			name: "attempt to mutate created-by annotation",
+			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
+				oldPromo := &kargoapi.Promotion{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+						Annotations: map[string]string{
+							kargoapi.AnnotationKeyCreatedBy: "fake-user",
+						},
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+					},
+				}
+				newPromo := oldPromo.DeepCopy()
+				newPromo.Annotations[kargoapi.AnnotationKeyCreatedBy] = "another-user"
+				return oldPromo, newPromo
+			},
+			authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "annotation is immutable")
+			},
+		},
+
 		{
 			name: "update without mutation",
 			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
@@ -245,6 +570,60 @@ func TestValidateUpdate(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+
+		{
+			name: "attempt to reschedule",
+			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
+				notBefore := v1.NewTime(time.Date(2024, time.January, 1, 2, 0, 0, 0, time.UTC))
+				oldPromo := &kargoapi.Promotion{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:     "fake-stage",
+						Freight:   "fake-freight",
+						NotBefore: &notBefore,
+					},
+				}
+				newPromo := oldPromo.DeepCopy()
+				newPromo.Spec.NotBefore = &v1.Time{Time: notBefore.Add(time.Hour)}
+				return oldPromo, newPromo
+			},
+			authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "spec is immutable")
+			},
+		},
+
+		{
+			name: "update scheduled promotion without mutation",
+			setup: func() (*kargoapi.Promotion, *kargoapi.Promotion) {
+				notBefore := v1.NewTime(time.Date(2024, time.January, 1, 2, 0, 0, 0, time.UTC))
+				oldPromo := &kargoapi.Promotion{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:     "fake-stage",
+						Freight:   "fake-freight",
+						NotBefore: &notBefore,
+					},
+				}
+				newPromo := oldPromo.DeepCopy()
+				return oldPromo, newPromo
+			},
+			authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+				return nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -302,6 +681,8 @@ func TestValidateDelete(t *testing.T) {
 func TestAuthorize(t *testing.T) {
 	testCases := []struct {
 		name                          string
+		priority                      int32
+		annotations                   map[string]string
 		admissionRequestFromContextFn func(
 			context.Context,
 		) (admission.Request, error)
@@ -386,6 +767,94 @@ func TestAuthorize(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name:     "subject is not authorized to prioritize",
+			priority: 100,
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+				review.Status.Allowed = review.Spec.ResourceAttributes.Verb == "promote"
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "with a priority greater than zero")
+			},
+		},
+		{
+			name:     "subject is authorized to prioritize",
+			priority: 100,
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				obj.(*authzv1.SubjectAccessReview).Status.Allowed = true // nolint: forcetypeassert
+				return nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "subject is not authorized to break glass",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass: "fake-reason",
+			},
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+				review.Status.Allowed = review.Spec.ResourceAttributes.Verb == "promote"
+				return nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "not permitted to override promotion windows or locks")
+			},
+		},
+		{
+			name: "subject is authorized to break glass",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass: "fake-reason",
+			},
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				obj.(*authzv1.SubjectAccessReview).Status.Allowed = true // nolint: forcetypeassert
+				return nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -395,6 +864,465 @@ func TestAuthorize(t *testing.T) {
 			}
 			testCase.assertions(
 				w.authorize(
+					context.Background(),
+					&kargoapi.Promotion{
+						ObjectMeta: v1.ObjectMeta{
+							Name:        "fake-promotion",
+							Namespace:   "fake-namespace",
+							Annotations: testCase.annotations,
+						},
+						Spec: &kargoapi.PromotionSpec{
+							Stage:    "fake-stage",
+							Priority: testCase.priority,
+						},
+					},
+					"create",
+				),
+			)
+		})
+	}
+}
+
+func TestValidatePromotionWindows(t *testing.T) {
+	// A deny window that is always open
+	alwaysDenied := []kargoapi.PromotionWindow{
+		{
+			Kind:     kargoapi.PromotionWindowKindDeny,
+			Schedule: "* * * * *",
+			Duration: "1h",
+			Reason:   "fake-freeze",
+		},
+	}
+	// Half an hour into next New Year's Day
+	newYear := time.Date(time.Now().UTC().Year()+1, 1, 1, 0, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		name         string
+		annotations  map[string]string
+		notBefore    *v1.Time
+		getProjectFn func(
+			context.Context,
+			client.Client,
+			string,
+		) (*kargoapi.Project, error)
+		assertions func(error)
+	}{
+		{
+			name: "error getting project",
+			getProjectFn: func(
+				context.Context,
+				client.Client,
+				string,
+			) (*kargoapi.Project, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error finding Project")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "no promotion policy for stage",
+			getProjectFn: func(
+				context.Context,
+				client.Client,
+				string,
+			) (*kargoapi.Project, error) {
+				return &kargoapi.Project{
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:   "another-fake-stage",
+								Windows: alwaysDenied,
+							},
+						},
+					},
+				}, nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error evaluating windows",
+			getProjectFn: func(
+				context.Context,
+				client.Client,
+				string,
+			) (*kargoapi.Project, error) {
+				return &kargoapi.Project{
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage: "fake-stage",
+								Windows: []kargoapi.PromotionWindow{
+									{
+										Kind:     kargoapi.PromotionWindowKindDeny,
+										Schedule: "bogus",
+										Duration: "1h",
+									},
+								},
+							},
+						},
+					},
+				}, nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error evaluating promotion windows")
+			},
+		},
+		{
+			name: "promotion denied",
+			getProjectFn: func(
+				context.Context,
+				client.Client,
+				string,
+			) (*kargoapi.Project, error) {
+				return &kargoapi.Project{
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:   "fake-stage",
+								Windows: alwaysDenied,
+							},
+						},
+					},
+				}, nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "fake-freeze")
+				require.Contains(t, err.Error(), kargoapi.AnnotationKeyBreakGlass)
+			},
+		},
+		{
+			name:      "promotion denied at scheduled time",
+			notBefore: &v1.Time{Time: newYear},
+			getProjectFn: func(
+				context.Context,
+				client.Client,
+				string,
+			) (*kargoapi.Project, error) {
+				return &kargoapi.Project{
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage: "fake-stage",
+								Windows: []kargoapi.PromotionWindow{
+									{
+										Kind:     kargoapi.PromotionWindowKindDeny,
+										Schedule: "0 0 1 1 *",
+										Duration: "1h",
+										Reason:   "fake-holiday",
+									},
+								},
+							},
+						},
+					},
+				}, nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "fake-holiday")
+			},
+		},
+		{
+			name: "promotion denied but glass broken",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass:   "fake-reason",
+				kargoapi.AnnotationKeyBreakGlassBy: "fake-user",
+			},
+			getProjectFn: func(
+				context.Context,
+				client.Client,
+				string,
+			) (*kargoapi.Project, error) {
+				return &kargoapi.Project{
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:   "fake-stage",
+								Windows: alwaysDenied,
+							},
+						},
+					},
+				}, nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				getProjectFn: testCase.getProjectFn,
+			}
+			testCase.assertions(
+				w.validatePromotionWindows(
+					context.Background(),
+					&kargoapi.Promotion{
+						ObjectMeta: v1.ObjectMeta{
+							Name:        "fake-promotion",
+							Namespace:   "fake-namespace",
+							Annotations: testCase.annotations,
+						},
+						Spec: &kargoapi.PromotionSpec{
+							Stage:     "fake-stage",
+							NotBefore: testCase.notBefore,
+						},
+					},
+				),
+			)
+		})
+	}
+}
+
+func TestValidateStageLock(t *testing.T) {
+	lockedStage := &kargoapi.Stage{
+		Status: kargoapi.StageStatus{
+			Lock: &kargoapi.StageLock{
+				Reason:   "fake-incident",
+				LockedBy: "fake-user",
+			},
+		},
+	}
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		getStageFn  func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Stage, error)
+		assertions func(error)
+	}{
+		{
+			name: "error getting stage",
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error finding Stage")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "stage not locked",
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{}, nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "stage locked",
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return lockedStage, nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.True(t, apierrors.IsForbidden(err))
+				require.Contains(t, err.Error(), "is locked by \"fake-user\"")
+				require.Contains(t, err.Error(), "fake-incident")
+				require.Contains(t, err.Error(), kargoapi.AnnotationKeyBreakGlass)
+			},
+		},
+		{
+			name: "stage locked but glass broken",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass:   "fake-reason",
+				kargoapi.AnnotationKeyBreakGlassBy: "fake-user",
+			},
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return lockedStage, nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				getStageFn: testCase.getStageFn,
+			}
+			testCase.assertions(
+				w.validateStageLock(
+					context.Background(),
+					&kargoapi.Promotion{
+						ObjectMeta: v1.ObjectMeta{
+							Name:        "fake-promotion",
+							Namespace:   "fake-namespace",
+							Annotations: testCase.annotations,
+						},
+						Spec: &kargoapi.PromotionSpec{
+							Stage: "fake-stage",
+						},
+					},
+				),
+			)
+		})
+	}
+}
+
+func TestValidateApprovals(t *testing.T) {
+	getProjectFn := func(
+		context.Context,
+		client.Client,
+		string,
+	) (*kargoapi.Project, error) {
+		return &kargoapi.Project{
+			Spec: &kargoapi.ProjectSpec{
+				PromotionPolicies: []kargoapi.PromotionPolicy{
+					{
+						Stage: "fake-stage",
+						ApprovalPolicy: &kargoapi.ApprovalPolicy{
+							RequiredApprovals: 1,
+						},
+					},
+				},
+			},
+		}, nil
+	}
+	testCases := []struct {
+		name         string
+		getProjectFn func(
+			context.Context,
+			client.Client,
+			string,
+		) (*kargoapi.Project, error)
+		getFreightFn func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Freight, error)
+		assertions func(error)
+	}{
+		{
+			name: "error getting project",
+			getProjectFn: func(
+				context.Context,
+				client.Client,
+				string,
+			) (*kargoapi.Project, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error finding Project")
+			},
+		},
+		{
+			name: "no approval policy",
+			getProjectFn: func(
+				context.Context,
+				client.Client,
+				string,
+			) (*kargoapi.Project, error) {
+				return &kargoapi.Project{}, nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:         "error getting freight",
+			getProjectFn: getProjectFn,
+			getFreightFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Freight, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error finding Freight")
+			},
+		},
+		{
+			name:         "freight not found",
+			getProjectFn: getProjectFn,
+			getFreightFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Freight, error) {
+				return nil, nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "could not find Freight")
+			},
+		},
+		{
+			name:         "approval policy not satisfied",
+			getProjectFn: getProjectFn,
+			getFreightFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Freight, error) {
+				return &kargoapi.Freight{}, nil
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "has 0 of 1 required approvals")
+			},
+		},
+		{
+			name:         "approval policy satisfied",
+			getProjectFn: getProjectFn,
+			getFreightFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Freight, error) {
+				return &kargoapi.Freight{
+					Status: kargoapi.FreightStatus{
+						ApprovedFor: map[string]kargoapi.ApprovedStage{
+							"fake-stage": {
+								Approvals: []kargoapi.Approval{
+									{Subject: "fake-user"},
+								},
+							},
+						},
+					},
+				}, nil
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				getProjectFn: testCase.getProjectFn,
+				getFreightFn: testCase.getFreightFn,
+			}
+			testCase.assertions(
+				w.validateApprovals(
 					context.Background(),
 					&kargoapi.Promotion{
 						ObjectMeta: v1.ObjectMeta{
@@ -402,10 +1330,10 @@ func TestAuthorize(t *testing.T) {
 							Namespace: "fake-namespace",
 						},
 						Spec: &kargoapi.PromotionSpec{
-							Stage: "fake-stage",
+							Stage:   "fake-stage",
+							Freight: "fake-freight",
 						},
 					},
-					"create",
 				),
 			)
 		})
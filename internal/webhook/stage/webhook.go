@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -14,6 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
 
@@ -22,8 +26,23 @@ var (
 		Group: kargoapi.GroupVersion.Group,
 		Kind:  "Stage",
 	}
+	stageGroupResource = schema.GroupResource{
+		Group:    kargoapi.GroupVersion.Group,
+		Resource: "Stage",
+	}
 )
 
+// resourceAccess describes access to a core resource in a Stage's namespace
+// that a user must be permitted in order to reference that resource from the
+// Stage's promotion mechanisms.
+type resourceAccess struct {
+	// kind is the kind of the resource. It is used only in messages.
+	kind     string
+	resource string
+	verb     string
+	name     string
+}
+
 type webhook struct {
 	client client.Client
 
@@ -39,6 +58,16 @@ type webhook struct {
 	validateCreateOrUpdateFn func(*kargoapi.Stage) (admission.Warnings, error)
 
 	validateSpecFn func(*field.Path, *kargoapi.StageSpec) field.ErrorList
+
+	authorizeFn func(context.Context, *kargoapi.Stage) error
+
+	admissionRequestFromContextFn func(context.Context) (admission.Request, error)
+
+	createSubjectAccessReviewFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
 }
 
 func SetupWebhookWithManager(mgr ctrl.Manager) error {
@@ -57,6 +86,9 @@ func newWebhook(kubeClient client.Client) *webhook {
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.validateCreateOrUpdateFn = w.validateCreateOrUpdate
 	w.validateSpecFn = w.validateSpec
+	w.authorizeFn = w.authorize
+	w.admissionRequestFromContextFn = admission.RequestFromContext
+	w.createSubjectAccessReviewFn = w.client.Create
 	return w
 }
 
@@ -75,16 +107,33 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, stageGroupKind, stage); err != nil {
 		return nil, err
 	}
-	return w.validateCreateOrUpdateFn(stage)
+	warnings, err := w.validateCreateOrUpdateFn(stage)
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, w.authorizeFn(ctx, stage)
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
-	_ runtime.Object,
+	ctx context.Context,
+	oldObj runtime.Object,
 	newObj runtime.Object,
 ) (admission.Warnings, error) {
-	stage := newObj.(*kargoapi.Stage) // nolint: forcetypeassert
-	return w.validateCreateOrUpdateFn(stage)
+	oldStage := oldObj.(*kargoapi.Stage) // nolint: forcetypeassert
+	stage := newObj.(*kargoapi.Stage)    // nolint: forcetypeassert
+	warnings, err := w.validateCreateOrUpdateFn(stage)
+	if err != nil {
+		return warnings, err
+	}
+	// Updates that leave the promotion mechanisms untouched, including those
+	// made by Kargo itself, cannot change what a Promotion will run or send.
+	if equality.Semantic.DeepEqual(
+		promotionMechanisms(oldStage),
+		promotionMechanisms(stage),
+	) {
+		return warnings, nil
+	}
+	return warnings, w.authorizeFn(ctx, stage)
 }
 
 func (w *webhook) ValidateDelete(
@@ -127,22 +176,41 @@ func (w *webhook) validateSubs(
 	if subs == nil { // nil subs is caught by declarative validations
 		return nil
 	}
-	// Can subscribe to Warehouse XOR upstream Stages
-	if (subs.Warehouse == "" && len(subs.UpstreamStages) == 0) ||
-		(subs.Warehouse != "" && len(subs.UpstreamStages) > 0) {
+	// Can subscribe to a Warehouse XOR multiple Warehouses XOR upstream Stages
+	var defined int
+	if subs.Warehouse != "" {
+		defined++
+	}
+	if len(subs.Warehouses) > 0 {
+		defined++
+	}
+	if len(subs.UpstreamStages) > 0 {
+		defined++
+	}
+	if defined != 1 {
 		return field.ErrorList{
 			field.Invalid(
 				f,
 				subs,
 				fmt.Sprintf(
-					"exactly one of %s.warehouse or %s.upstreamStages must be defined",
+					"exactly one of %s.warehouse, %s.warehouses, or %s.upstreamStages "+
+						"must be defined",
+					f.String(),
 					f.String(),
 					f.String(),
 				),
 			),
 		}
 	}
-	return nil
+	var errs field.ErrorList
+	warehouses := make(map[string]struct{}, len(subs.Warehouses))
+	for i, warehouse := range subs.Warehouses {
+		if _, ok := warehouses[warehouse]; ok {
+			errs = append(errs, field.Duplicate(f.Child("warehouses").Index(i), warehouse))
+		}
+		warehouses[warehouse] = struct{}{}
+	}
+	return errs
 }
 
 func (w *webhook) validatePromotionMechanisms(
@@ -154,24 +222,38 @@ func (w *webhook) validatePromotionMechanisms(
 	}
 	// Must define at least one mechanism
 	if len(promoMechs.GitRepoUpdates) == 0 &&
+		len(promoMechs.JobSteps) == 0 &&
 		len(promoMechs.ArgoCDAppUpdates) == 0 {
 		return field.ErrorList{
 			field.Invalid(
 				f,
 				promoMechs,
 				fmt.Sprintf(
-					"at least one of %s.gitRepoUpdates or %s.argoCDAppUpdates must "+
-						"be non-empty",
+					"at least one of %s.gitRepoUpdates, %s.jobSteps, or "+
+						"%s.argoCDAppUpdates must be non-empty",
+					f.String(),
 					f.String(),
 					f.String(),
 				),
 			),
 		}
 	}
-	return w.validateGitRepoUpdates(
+	errs := w.validateGitRepoUpdates(
 		f.Child("gitRepoUpdates"),
 		promoMechs.GitRepoUpdates,
 	)
+	errs = append(
+		errs,
+		w.validateJobSteps(f.Child("jobSteps"), promoMechs.JobSteps)...,
+	)
+	errs = append(
+		errs,
+		w.validatePromotionHooks(f.Child("preHooks"), promoMechs.PreHooks)...,
+	)
+	return append(
+		errs,
+		w.validatePromotionHooks(f.Child("postHooks"), promoMechs.PostHooks)...,
+	)
 }
 
 func (w *webhook) validateGitRepoUpdates(
@@ -240,3 +322,177 @@ func (w *webhook) validateHelmPromotionMechanism(
 	}
 	return nil
 }
+
+func (w *webhook) validateJobSteps(
+	f *field.Path,
+	steps []kargoapi.PromotionJobStep,
+) field.ErrorList {
+	var errs field.ErrorList
+	names := make(map[string]struct{}, len(steps))
+	for i, step := range steps {
+		if _, ok := names[step.Name]; ok {
+			errs = append(errs, field.Duplicate(f.Index(i).Child("name"), step.Name))
+		}
+		names[step.Name] = struct{}{}
+	}
+	return errs
+}
+
+func (w *webhook) validatePromotionHooks(
+	f *field.Path,
+	hooks []kargoapi.PromotionHook,
+) field.ErrorList {
+	var errs field.ErrorList
+	names := make(map[string]struct{}, len(hooks))
+	for i, hook := range hooks {
+		if _, ok := names[hook.Name]; ok {
+			errs = append(errs, field.Duplicate(f.Index(i).Child("name"), hook.Name))
+		}
+		names[hook.Name] = struct{}{}
+		if (hook.HTTP == nil) == (hook.Job == nil) {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Index(i),
+					hook,
+					fmt.Sprintf(
+						"exactly one of %s.http or %s.job must be defined",
+						f.Index(i).String(),
+						f.Index(i).String(),
+					),
+				),
+			)
+		}
+	}
+	return errs
+}
+
+// authorize verifies that the user who initiated the admission request is
+// permitted to access every Secret and ServiceAccount referenced by the
+// specified Stage's promotion mechanisms. Because Kargo uses these on the
+// Stage's behalf, referencing them is otherwise a means of escalating
+// privileges.
+func (w *webhook) authorize(ctx context.Context, stage *kargoapi.Stage) error {
+	accesses := requiredAccess(promotionMechanisms(stage))
+	if len(accesses) == 0 {
+		return nil
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+
+	req, err := w.admissionRequestFromContextFn(ctx)
+	if err != nil {
+		logger.Error(err)
+		return apierrors.NewForbidden(
+			stageGroupResource,
+			stage.Name,
+			errors.New(
+				"error retrieving admission request from context; refusing to "+
+					"admit Stage",
+			),
+		)
+	}
+
+	for _, access := range accesses {
+		accessReview := &authzv1.SubjectAccessReview{
+			Spec: authzv1.SubjectAccessReviewSpec{
+				User:   req.UserInfo.Username,
+				Groups: req.UserInfo.Groups,
+				ResourceAttributes: &authzv1.ResourceAttributes{
+					Resource:  access.resource,
+					Name:      access.name,
+					Verb:      access.verb,
+					Namespace: stage.Namespace,
+				},
+			},
+		}
+		if err := w.createSubjectAccessReviewFn(ctx, accessReview); err != nil {
+			logger.Error(err)
+			return apierrors.NewForbidden(
+				stageGroupResource,
+				stage.Name,
+				errors.New(
+					"error creating SubjectAccessReview; refusing to admit Stage",
+				),
+			)
+		}
+		if !accessReview.Status.Allowed {
+			return apierrors.NewForbidden(
+				stageGroupResource,
+				stage.Name,
+				errors.Errorf(
+					"subject %q may not reference %s %q because it is not "+
+						"permitted to %s it",
+					req.UserInfo.Username,
+					access.kind,
+					access.name,
+					access.verb,
+				),
+			)
+		}
+	}
+	return nil
+}
+
+// promotionMechanisms returns the promotion mechanisms of the specified Stage,
+// or nil if it has none.
+func promotionMechanisms(stage *kargoapi.Stage) *kargoapi.PromotionMechanisms {
+	if stage.Spec == nil {
+		return nil
+	}
+	return stage.Spec.PromotionMechanisms
+}
+
+// requiredAccess returns, without duplicates, the access a user must be
+// permitted in order to reference each Secret and ServiceAccount that the
+// provided promotion mechanisms reference. Using a Secret's data as HTTP
+// headers requires permission to get the Secret. Running a Job as a
+// ServiceAccount requires permission to impersonate the ServiceAccount.
+func requiredAccess(
+	promoMechs *kargoapi.PromotionMechanisms,
+) []resourceAccess {
+	if promoMechs == nil {
+		return nil
+	}
+	var accesses []resourceAccess
+	seen := map[resourceAccess]struct{}{}
+	add := func(access resourceAccess) {
+		if access.name == "" {
+			return
+		}
+		if _, ok := seen[access]; ok {
+			return
+		}
+		seen[access] = struct{}{}
+		accesses = append(accesses, access)
+	}
+	addJob := func(job kargoapi.PromotionJob) {
+		add(resourceAccess{
+			kind:     "ServiceAccount",
+			resource: "serviceaccounts",
+			verb:     "impersonate",
+			name:     job.ServiceAccountName,
+		})
+	}
+	hooks := append(
+		append([]kargoapi.PromotionHook(nil), promoMechs.PreHooks...),
+		promoMechs.PostHooks...,
+	)
+	for _, hook := range hooks {
+		switch {
+		case hook.HTTP != nil:
+			add(resourceAccess{
+				kind:     "Secret",
+				resource: "secrets",
+				verb:     "get",
+				name:     hook.HTTP.HeadersSecret,
+			})
+		case hook.Job != nil:
+			addJob(*hook.Job)
+		}
+	}
+	for _, step := range promoMechs.JobSteps {
+		addJob(step.Job)
+	}
+	return accesses
+}
@@ -3,9 +3,15 @@ package stage
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,6 +29,9 @@ func TestNewWebhook(t *testing.T) {
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.validateCreateOrUpdateFn)
 	require.NotNil(t, w.validateSpecFn)
+	require.NotNil(t, w.authorizeFn)
+	require.NotNil(t, w.admissionRequestFromContextFn)
+	require.NotNil(t, w.createSubjectAccessReviewFn)
 }
 
 func TestDefault(t *testing.T) {
@@ -81,6 +90,31 @@ func TestValidateCreate(t *testing.T) {
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "user not authorized",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				validateCreateOrUpdateFn: func(
+					*kargoapi.Stage,
+				) (admission.Warnings, error) {
+					return nil, nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Stage) error {
+					return errors.New("not authorized")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "not authorized", err.Error())
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -97,6 +131,9 @@ func TestValidateCreate(t *testing.T) {
 				) (admission.Warnings, error) {
 					return nil, nil
 				},
+				authorizeFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
 			},
 			assertions: func(err error) {
 				require.NoError(t, err)
@@ -115,9 +152,26 @@ func TestValidateCreate(t *testing.T) {
 }
 
 func TestValidateUpdate(t *testing.T) {
+	unchanged := &kargoapi.StageSpec{
+		PromotionMechanisms: &kargoapi.PromotionMechanisms{
+			PreHooks: []kargoapi.PromotionHook{{
+				Name: "foo",
+				Job: &kargoapi.PromotionJob{
+					Image:              "fake-image",
+					ServiceAccountName: "fake-sa",
+				},
+			}},
+		},
+	}
+	changed := unchanged.DeepCopy()
+	changed.PromotionMechanisms.PreHooks[0].Job.Image = "other-image"
+	notAuthorizedFn := func(context.Context, *kargoapi.Stage) error {
+		return errors.New("not authorized")
+	}
 	testCases := []struct {
 		name       string
 		webhook    *webhook
+		newSpec    *kargoapi.StageSpec
 		assertions func(error)
 	}{
 		{
@@ -129,11 +183,44 @@ func TestValidateUpdate(t *testing.T) {
 					return nil, errors.New("something went wrong")
 				},
 			},
+			newSpec: unchanged,
 			assertions: func(err error) {
 				require.Error(t, err)
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "promotion mechanisms changed and user not authorized",
+			webhook: &webhook{
+				validateCreateOrUpdateFn: func(
+					*kargoapi.Stage,
+				) (admission.Warnings, error) {
+					return nil, nil
+				},
+				authorizeFn: notAuthorizedFn,
+			},
+			newSpec: changed,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "not authorized", err.Error())
+			},
+		},
+		{
+			name: "promotion mechanisms unchanged",
+			webhook: &webhook{
+				validateCreateOrUpdateFn: func(
+					*kargoapi.Stage,
+				) (admission.Warnings, error) {
+					return nil, nil
+				},
+				// Authorization is not required
+				authorizeFn: notAuthorizedFn,
+			},
+			newSpec: unchanged,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -142,7 +229,11 @@ func TestValidateUpdate(t *testing.T) {
 				) (admission.Warnings, error) {
 					return nil, nil
 				},
+				authorizeFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
 			},
+			newSpec: changed,
 			assertions: func(err error) {
 				require.NoError(t, err)
 			},
@@ -152,8 +243,8 @@ func TestValidateUpdate(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			_, err := testCase.webhook.ValidateUpdate(
 				context.Background(),
-				nil,
-				&kargoapi.Stage{},
+				&kargoapi.Stage{Spec: unchanged},
+				&kargoapi.Stage{Spec: testCase.newSpec},
 			)
 			testCase.assertions(err)
 		})
@@ -245,7 +336,8 @@ func TestValidateSpec(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "spec.subscriptions",
 							BadValue: spec.Subscriptions,
-							Detail: "exactly one of spec.subscriptions.warehouse or " +
+							Detail: "exactly one of spec.subscriptions.warehouse, " +
+								"spec.subscriptions.warehouses, or " +
 								"spec.subscriptions.upstreamStages must be defined",
 						},
 						{
@@ -253,7 +345,8 @@ func TestValidateSpec(t *testing.T) {
 							Field:    "spec.promotionMechanisms",
 							BadValue: spec.PromotionMechanisms,
 							Detail: "at least one of " +
-								"spec.promotionMechanisms.gitRepoUpdates or " +
+								"spec.promotionMechanisms.gitRepoUpdates, " +
+								"spec.promotionMechanisms.jobSteps, or " +
 								"spec.promotionMechanisms.argoCDAppUpdates must be non-empty",
 						},
 					},
@@ -312,8 +405,9 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subscriptions",
 							BadValue: subs,
-							Detail: "exactly one of subscriptions.warehouse or " +
-								"subscriptions.upstreamStages must be defined",
+							Detail: "exactly one of subscriptions.warehouse, " +
+								"subscriptions.warehouses, or subscriptions.upstreamStages " +
+								"must be defined",
 						},
 					},
 					errs,
@@ -337,8 +431,33 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subscriptions",
 							BadValue: subs,
-							Detail: "exactly one of subscriptions.warehouse or " +
-								"subscriptions.upstreamStages must be defined",
+							Detail: "exactly one of subscriptions.warehouse, " +
+								"subscriptions.warehouses, or subscriptions.upstreamStages " +
+								"must be defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "has warehouse sub and warehouses sub", // Should be "one of"
+			subs: &kargoapi.Subscriptions{
+				Warehouse:  "test-warehouse",
+				Warehouses: []string{"another-test-warehouse"},
+			},
+			assertions: func(subs *kargoapi.Subscriptions, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "subscriptions",
+							BadValue: subs,
+							Detail: "exactly one of subscriptions.warehouse, " +
+								"subscriptions.warehouses, or subscriptions.upstreamStages " +
+								"must be defined",
 						},
 					},
 					errs,
@@ -346,6 +465,36 @@ func TestValidateSubs(t *testing.T) {
 			},
 		},
 
+		{
+			name: "duplicate warehouses",
+			subs: &kargoapi.Subscriptions{
+				Warehouses: []string{"test-warehouse", "test-warehouse"},
+			},
+			assertions: func(_ *kargoapi.Subscriptions, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeDuplicate,
+							Field:    "subscriptions.warehouses[1]",
+							BadValue: "test-warehouse",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "success with multiple warehouses",
+			subs: &kargoapi.Subscriptions{
+				Warehouses: []string{"test-warehouse", "another-test-warehouse"},
+			},
+			assertions: func(_ *kargoapi.Subscriptions, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
 		{
 			name: "success",
 			subs: &kargoapi.Subscriptions{
@@ -399,7 +548,8 @@ func TestValidatePromotionMechanisms(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "promotionMechanisms",
 							BadValue: promoMechs,
-							Detail: "at least one of promotionMechanisms.gitRepoUpdates or " +
+							Detail: "at least one of promotionMechanisms.gitRepoUpdates, " +
+								"promotionMechanisms.jobSteps, or " +
 								"promotionMechanisms.argoCDAppUpdates must be non-empty",
 						},
 					},
@@ -610,3 +760,371 @@ func TestValidateHelmPromotionMechanism(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePromotionHooks(t *testing.T) {
+	testCases := []struct {
+		name       string
+		hooks      []kargoapi.PromotionHook
+		assertions func([]kargoapi.PromotionHook, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(_ []kargoapi.PromotionHook, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "invalid",
+			hooks: []kargoapi.PromotionHook{
+				{
+					// Defines no action
+					Name: "foo",
+				},
+				{
+					// Duplicate name
+					Name: "foo",
+					HTTP: &kargoapi.HTTPPromotionHook{},
+				},
+				{
+					// Defines two actions
+					Name: "bar",
+					HTTP: &kargoapi.HTTPPromotionHook{},
+					Job:  &kargoapi.PromotionJob{},
+				},
+			},
+			assertions: func(hooks []kargoapi.PromotionHook, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "preHooks[0]",
+							BadValue: hooks[0],
+							Detail: "exactly one of preHooks[0].http or preHooks[0].job " +
+								"must be defined",
+						},
+						{
+							Type:     field.ErrorTypeDuplicate,
+							Field:    "preHooks[1].name",
+							BadValue: "foo",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "preHooks[2]",
+							BadValue: hooks[2],
+							Detail: "exactly one of preHooks[2].http or preHooks[2].job " +
+								"must be defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			hooks: []kargoapi.PromotionHook{
+				{
+					Name: "foo",
+					HTTP: &kargoapi.HTTPPromotionHook{},
+				},
+				{
+					Name: "bar",
+					Job:  &kargoapi.PromotionJob{},
+				},
+			},
+			assertions: func(_ []kargoapi.PromotionHook, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.hooks,
+				w.validatePromotionHooks(
+					field.NewPath("preHooks"),
+					testCase.hooks,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateJobSteps(t *testing.T) {
+	testCases := []struct {
+		name       string
+		steps      []kargoapi.PromotionJobStep
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "duplicate names",
+			steps: []kargoapi.PromotionJobStep{
+				{Name: "foo"},
+				{Name: "foo"},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeDuplicate,
+							Field:    "jobSteps[1].name",
+							BadValue: "foo",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			steps: []kargoapi.PromotionJobStep{
+				{Name: "foo"},
+				{Name: "bar"},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateJobSteps(field.NewPath("jobSteps"), testCase.steps),
+			)
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				PreHooks: []kargoapi.PromotionHook{{
+					Name: "foo",
+					HTTP: &kargoapi.HTTPPromotionHook{HeadersSecret: "fake-secret"},
+				}},
+				PostHooks: []kargoapi.PromotionHook{{
+					Name: "bar",
+					Job:  &kargoapi.PromotionJob{ServiceAccountName: "fake-sa"},
+				}},
+			},
+		},
+	}
+	admissionRequestFromContextFn := func(
+		context.Context,
+	) (admission.Request, error) {
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "fake-user"},
+			},
+		}, nil
+	}
+	testCases := []struct {
+		name    string
+		stage   *kargoapi.Stage
+		webhook *webhook
+		// allowed is the set of resources, in "resource/verb/name" form, that
+		// SubjectAccessReviews should find the user is permitted to access
+		allowed    map[string]bool
+		assertions func(reviews []authzv1.ResourceAttributes, err error)
+	}{
+		{
+			name:  "no references",
+			stage: &kargoapi.Stage{},
+			assertions: func(reviews []authzv1.ResourceAttributes, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reviews)
+			},
+		},
+		{
+			name:  "error getting admission request bound to context",
+			stage: stage,
+			webhook: &webhook{
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{}, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ []authzv1.ResourceAttributes, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"error retrieving admission request from context",
+				)
+			},
+		},
+		{
+			name:  "error creating subject access review",
+			stage: stage,
+			webhook: &webhook{
+				admissionRequestFromContextFn: admissionRequestFromContextFn,
+				createSubjectAccessReviewFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ []authzv1.ResourceAttributes, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error creating SubjectAccessReview")
+			},
+		},
+		{
+			name:  "user may not get Secret",
+			stage: stage,
+			allowed: map[string]bool{
+				"serviceaccounts/impersonate/fake-sa": true,
+			},
+			assertions: func(_ []authzv1.ResourceAttributes, err error) {
+				require.Error(t, err)
+				require.True(t, apierrors.IsForbidden(err))
+				require.Contains(
+					t,
+					err.Error(),
+					`subject "fake-user" may not reference Secret "fake-secret"`,
+				)
+			},
+		},
+		{
+			name:  "user may not impersonate ServiceAccount",
+			stage: stage,
+			allowed: map[string]bool{
+				"secrets/get/fake-secret": true,
+			},
+			assertions: func(_ []authzv1.ResourceAttributes, err error) {
+				require.Error(t, err)
+				require.True(t, apierrors.IsForbidden(err))
+				require.Contains(
+					t,
+					err.Error(),
+					`subject "fake-user" may not reference ServiceAccount "fake-sa"`,
+				)
+			},
+		},
+		{
+			name:  "user is authorized",
+			stage: stage,
+			allowed: map[string]bool{
+				"secrets/get/fake-secret":             true,
+				"serviceaccounts/impersonate/fake-sa": true,
+			},
+			assertions: func(reviews []authzv1.ResourceAttributes, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]authzv1.ResourceAttributes{
+						{
+							Namespace: "fake-namespace",
+							Verb:      "get",
+							Resource:  "secrets",
+							Name:      "fake-secret",
+						},
+						{
+							Namespace: "fake-namespace",
+							Verb:      "impersonate",
+							Resource:  "serviceaccounts",
+							Name:      "fake-sa",
+						},
+					},
+					reviews,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var reviews []authzv1.ResourceAttributes
+			w := testCase.webhook
+			if w == nil {
+				w = &webhook{
+					admissionRequestFromContextFn: admissionRequestFromContextFn,
+					createSubjectAccessReviewFn: func(
+						_ context.Context,
+						obj client.Object,
+						_ ...client.CreateOption,
+					) error {
+						review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+						require.Equal(t, "fake-user", review.Spec.User)
+						attrs := *review.Spec.ResourceAttributes
+						reviews = append(reviews, attrs)
+						review.Status.Allowed = testCase.allowed[fmt.Sprintf(
+							"%s/%s/%s",
+							attrs.Resource,
+							attrs.Verb,
+							attrs.Name,
+						)]
+						return nil
+					},
+				}
+			}
+			testCase.assertions(reviews, w.authorize(context.Background(), testCase.stage))
+		})
+	}
+}
+
+func TestRequiredAccess(t *testing.T) {
+	require.Nil(t, requiredAccess(nil))
+	require.Equal(
+		t,
+		[]resourceAccess{
+			{
+				kind:     "Secret",
+				resource: "secrets",
+				verb:     "get",
+				name:     "fake-secret",
+			},
+			{
+				kind:     "ServiceAccount",
+				resource: "serviceaccounts",
+				verb:     "impersonate",
+				name:     "fake-sa",
+			},
+			{
+				kind:     "ServiceAccount",
+				resource: "serviceaccounts",
+				verb:     "impersonate",
+				name:     "fake-step-sa",
+			},
+		},
+		requiredAccess(&kargoapi.PromotionMechanisms{
+			PreHooks: []kargoapi.PromotionHook{
+				{HTTP: &kargoapi.HTTPPromotionHook{HeadersSecret: "fake-secret"}},
+				// References nothing
+				{HTTP: &kargoapi.HTTPPromotionHook{}},
+				{Job: &kargoapi.PromotionJob{}},
+			},
+			PostHooks: []kargoapi.PromotionHook{
+				{Job: &kargoapi.PromotionJob{ServiceAccountName: "fake-sa"}},
+				// Duplicates
+				{HTTP: &kargoapi.HTTPPromotionHook{HeadersSecret: "fake-secret"}},
+				{Job: &kargoapi.PromotionJob{ServiceAccountName: "fake-sa"}},
+			},
+			JobSteps: []kargoapi.PromotionJobStep{
+				{Job: kargoapi.PromotionJob{ServiceAccountName: "fake-step-sa"}},
+				// Duplicates a hook
+				{Job: kargoapi.PromotionJob{ServiceAccountName: "fake-sa"}},
+			},
+		}),
+	)
+}
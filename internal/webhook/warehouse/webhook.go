@@ -3,7 +3,9 @@ package warehouse
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -24,6 +26,10 @@ var warehouseGroupKind = schema.GroupKind{
 	Kind:  "Warehouse",
 }
 
+// minInterval is the shortest interval at which a Warehouse may poll its
+// subscriptions.
+const minInterval = time.Minute
+
 type webhook struct {
 	client client.Client
 
@@ -109,7 +115,27 @@ func (w *webhook) validateSpec(
 	if spec == nil { // nil spec is caught by declarative validations
 		return nil
 	}
-	return w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	errs := validateInterval(f.Child("interval"), spec.Interval)
+	return append(
+		errs,
+		w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)...,
+	)
+}
+
+func validateInterval(f *field.Path, interval string) field.ErrorList {
+	if interval == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		return field.ErrorList{field.Invalid(f, interval, err.Error())}
+	}
+	if d < minInterval {
+		return field.ErrorList{
+			field.Invalid(f, interval, fmt.Sprintf("must be at least %s", minInterval)),
+		}
+	}
+	return nil
 }
 
 func (w *webhook) validateSubs(
@@ -143,6 +169,13 @@ func (w *webhook) validateSub(
 		repoTypes++
 		errs = append(errs, w.validateChartSub(f.Child("chart"), *sub.Chart)...)
 	}
+	if sub.OCIArtifact != nil {
+		repoTypes++
+		errs = append(
+			errs,
+			w.validateOCIArtifactSub(f.Child("ociArtifact"), *sub.OCIArtifact)...,
+		)
+	}
 	if repoTypes != 1 {
 		errs = append(
 			errs,
@@ -150,7 +183,9 @@ func (w *webhook) validateSub(
 				f,
 				sub,
 				fmt.Sprintf(
-					"exactly one of %s.git, %s.images, or %s.charts must be non-empty",
+					"exactly one of %s.git, %s.images, %s.charts, or %s.ociArtifact "+
+						"must be non-empty",
+					f.String(),
 					f.String(),
 					f.String(),
 					f.String(),
@@ -217,6 +252,42 @@ func (w *webhook) validateChartSub(
 	return nil
 }
 
+func (w *webhook) validateOCIArtifactSub(
+	f *field.Path,
+	sub kargoapi.OCIArtifactSubscription,
+) field.ErrorList {
+	var errs field.ErrorList
+	switch sub.SelectionStrategy {
+	case kargoapi.OCIArtifactSelectionStrategyDigest:
+		// The constraint is the name of a tag rather than a semver constraint
+		if sub.SemverConstraint == "" {
+			errs = append(
+				errs,
+				field.Required(
+					f.Child("semverConstraint"),
+					"must name a tag when selectionStrategy is Digest",
+				),
+			)
+		}
+	default:
+		if err := validateSemverConstraint(
+			f.Child("semverConstraint"),
+			sub.SemverConstraint,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if sub.AllowTags != "" {
+		if _, err := regexp.Compile(sub.AllowTags); err != nil {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("allowTags"), sub.AllowTags, err.Error()),
+			)
+		}
+	}
+	return errs
+}
+
 func validateSemverConstraint(
 	f *field.Path,
 	semverConstraint string,
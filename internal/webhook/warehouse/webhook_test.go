@@ -250,8 +250,28 @@ func TestValidateSpec(t *testing.T) {
 							Field:    "spec.subscriptions[0]",
 							BadValue: spec.Subscriptions[0],
 							Detail: "exactly one of spec.subscriptions[0].git, " +
-								"spec.subscriptions[0].images, or spec.subscriptions[0].charts " +
-								"must be non-empty",
+								"spec.subscriptions[0].images, spec.subscriptions[0].charts, " +
+								"or spec.subscriptions[0].ociArtifact must be non-empty",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "interval too short",
+			spec: &kargoapi.WarehouseSpec{
+				Interval: "30s",
+			},
+			assertions: func(_ *kargoapi.WarehouseSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.interval",
+							BadValue: "30s",
+							Detail:   "must be at least 1m0s",
 						},
 					},
 					errs,
@@ -284,6 +304,52 @@ func TestValidateSpec(t *testing.T) {
 	}
 }
 
+func TestValidateInterval(t *testing.T) {
+	testCases := []struct {
+		name     string
+		interval string
+		valid    bool
+	}{
+		{
+			name:  "not specified",
+			valid: true,
+		},
+		{
+			name:     "invalid",
+			interval: "bogus",
+		},
+		{
+			name:     "zero",
+			interval: "0s",
+		},
+		{
+			name:     "too short",
+			interval: "59s",
+		},
+		{
+			name:     "minimum",
+			interval: "1m",
+			valid:    true,
+		},
+		{
+			name:     "longer than minimum",
+			interval: "1h30m",
+			valid:    true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			errs := validateInterval(field.NewPath("interval"), testCase.interval)
+			if testCase.valid {
+				require.Empty(t, errs)
+			} else {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+			}
+		})
+	}
+}
+
 func TestValidateSubs(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -333,8 +399,8 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subs[0]",
 							BadValue: subs[0],
-							Detail: "exactly one of subs[0].git, subs[0].images, or " +
-								"subs[0].charts must be non-empty",
+							Detail: "exactly one of subs[0].git, subs[0].images, " +
+								"subs[0].charts, or subs[0].ociArtifact must be non-empty",
 						},
 					},
 					errs,
@@ -403,7 +469,8 @@ func TestValidateSub(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "sub",
 							BadValue: sub,
-							Detail:   "exactly one of sub.git, sub.images, or sub.charts must be non-empty",
+							Detail: "exactly one of sub.git, sub.images, sub.charts, or " +
+								"sub.ociArtifact must be non-empty",
 						},
 					},
 					errs,
@@ -559,6 +626,71 @@ func TestValidateChartSub(t *testing.T) {
 	}
 }
 
+func TestValidateOCIArtifactSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.OCIArtifactSubscription
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "invalid semverConstraint and allowTags",
+			sub: kargoapi.OCIArtifactSubscription{
+				SemverConstraint: "bogus",
+				AllowTags:        "(",
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 2)
+				require.Equal(t, "ociArtifact.semverConstraint", errs[0].Field)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "ociArtifact.allowTags", errs[1].Field)
+				require.Equal(t, field.ErrorTypeInvalid, errs[1].Type)
+			},
+		},
+		{
+			name: "digest selection strategy without tag",
+			sub: kargoapi.OCIArtifactSubscription{
+				SelectionStrategy: kargoapi.OCIArtifactSelectionStrategyDigest,
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "ociArtifact.semverConstraint", errs[0].Field)
+				require.Equal(t, field.ErrorTypeRequired, errs[0].Type)
+			},
+		},
+		{
+			name: "valid digest selection strategy",
+			sub: kargoapi.OCIArtifactSubscription{
+				SelectionStrategy: kargoapi.OCIArtifactSelectionStrategyDigest,
+				SemverConstraint:  "latest",
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "valid",
+			sub: kargoapi.OCIArtifactSubscription{
+				SemverConstraint: "^1.0.0",
+				AllowTags:        "^v?[0-9]",
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateOCIArtifactSub(
+					field.NewPath("ociArtifact"),
+					testCase.sub,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateSemverConstraint(t *testing.T) {
 	testCases := []struct {
 		name             string
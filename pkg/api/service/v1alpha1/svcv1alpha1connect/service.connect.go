@@ -62,6 +62,9 @@ const (
 	KargoServiceListStagesProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListStages"
 	// KargoServiceGetStageProcedure is the fully-qualified name of the KargoService's GetStage RPC.
 	KargoServiceGetStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetStage"
+	// KargoServiceListStageHistoryProcedure is the fully-qualified name of the KargoService's
+	// ListStageHistory RPC.
+	KargoServiceListStageHistoryProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListStageHistory"
 	// KargoServiceWatchStagesProcedure is the fully-qualified name of the KargoService's WatchStages
 	// RPC.
 	KargoServiceWatchStagesProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/WatchStages"
@@ -104,6 +107,11 @@ const (
 	// KargoServiceSetAutoPromotionForStageProcedure is the fully-qualified name of the KargoService's
 	// SetAutoPromotionForStage RPC.
 	KargoServiceSetAutoPromotionForStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/SetAutoPromotionForStage"
+	// KargoServiceLockStageProcedure is the fully-qualified name of the KargoService's LockStage RPC.
+	KargoServiceLockStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/LockStage"
+	// KargoServiceUnlockStageProcedure is the fully-qualified name of the KargoService's UnlockStage
+	// RPC.
+	KargoServiceUnlockStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/UnlockStage"
 	// KargoServiceQueryFreightProcedure is the fully-qualified name of the KargoService's QueryFreight
 	// RPC.
 	KargoServiceQueryFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/QueryFreight"
@@ -116,6 +124,9 @@ const (
 	// KargoServiceUpdateFreightAliasProcedure is the fully-qualified name of the KargoService's
 	// UpdateFreightAlias RPC.
 	KargoServiceUpdateFreightAliasProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/UpdateFreightAlias"
+	// KargoServiceCreateFreightProcedure is the fully-qualified name of the KargoService's
+	// CreateFreight RPC.
+	KargoServiceCreateFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateFreight"
 	// KargoServiceListWarehousesProcedure is the fully-qualified name of the KargoService's
 	// ListWarehouses RPC.
 	KargoServiceListWarehousesProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListWarehouses"
@@ -137,6 +148,9 @@ const (
 	// KargoServiceRefreshWarehouseProcedure is the fully-qualified name of the KargoService's
 	// RefreshWarehouse RPC.
 	KargoServiceRefreshWarehouseProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RefreshWarehouse"
+	// KargoServicePreviewWarehouseSubscriptionProcedure is the fully-qualified name of the
+	// KargoService's PreviewWarehouseSubscription RPC.
+	KargoServicePreviewWarehouseSubscriptionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PreviewWarehouseSubscription"
 )
 
 // KargoServiceClient is a client for the akuity.io.kargo.service.v1alpha1.KargoService service.
@@ -154,6 +168,7 @@ type KargoServiceClient interface {
 	CreateStage(context.Context, *connect.Request[v1alpha1.CreateStageRequest]) (*connect.Response[v1alpha1.CreateStageResponse], error)
 	ListStages(context.Context, *connect.Request[v1alpha1.ListStagesRequest]) (*connect.Response[v1alpha1.ListStagesResponse], error)
 	GetStage(context.Context, *connect.Request[v1alpha1.GetStageRequest]) (*connect.Response[v1alpha1.GetStageResponse], error)
+	ListStageHistory(context.Context, *connect.Request[v1alpha1.ListStageHistoryRequest]) (*connect.Response[v1alpha1.ListStageHistoryResponse], error)
 	WatchStages(context.Context, *connect.Request[v1alpha1.WatchStagesRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchStagesResponse], error)
 	UpdateStage(context.Context, *connect.Request[v1alpha1.UpdateStageRequest]) (*connect.Response[v1alpha1.UpdateStageResponse], error)
 	DeleteStage(context.Context, *connect.Request[v1alpha1.DeleteStageRequest]) (*connect.Response[v1alpha1.DeleteStageResponse], error)
@@ -169,10 +184,13 @@ type KargoServiceClient interface {
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	SetAutoPromotionForStage(context.Context, *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error)
+	LockStage(context.Context, *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error)
+	UnlockStage(context.Context, *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
 	DeleteFreight(context.Context, *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error)
 	ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error)
 	UpdateFreightAlias(context.Context, *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error)
+	CreateFreight(context.Context, *connect.Request[v1alpha1.CreateFreightRequest]) (*connect.Response[v1alpha1.CreateFreightResponse], error)
 	ListWarehouses(context.Context, *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error)
 	GetWarehouse(context.Context, *connect.Request[v1alpha1.GetWarehouseRequest]) (*connect.Response[v1alpha1.GetWarehouseResponse], error)
 	WatchWarehouses(context.Context, *connect.Request[v1alpha1.WatchWarehousesRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchWarehousesResponse], error)
@@ -180,6 +198,7 @@ type KargoServiceClient interface {
 	UpdateWarehouse(context.Context, *connect.Request[v1alpha1.UpdateWarehouseRequest]) (*connect.Response[v1alpha1.UpdateWarehouseResponse], error)
 	DeleteWarehouse(context.Context, *connect.Request[v1alpha1.DeleteWarehouseRequest]) (*connect.Response[v1alpha1.DeleteWarehouseResponse], error)
 	RefreshWarehouse(context.Context, *connect.Request[v1alpha1.RefreshWarehouseRequest]) (*connect.Response[v1alpha1.RefreshWarehouseResponse], error)
+	PreviewWarehouseSubscription(context.Context, *connect.Request[v1alpha1.PreviewWarehouseSubscriptionRequest]) (*connect.Response[v1alpha1.PreviewWarehouseSubscriptionResponse], error)
 }
 
 // NewKargoServiceClient constructs a client for the akuity.io.kargo.service.v1alpha1.KargoService
@@ -247,6 +266,11 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceGetStageProcedure,
 			opts...,
 		),
+		listStageHistory: connect.NewClient[v1alpha1.ListStageHistoryRequest, v1alpha1.ListStageHistoryResponse](
+			httpClient,
+			baseURL+KargoServiceListStageHistoryProcedure,
+			opts...,
+		),
 		watchStages: connect.NewClient[v1alpha1.WatchStagesRequest, v1alpha1.WatchStagesResponse](
 			httpClient,
 			baseURL+KargoServiceWatchStagesProcedure,
@@ -317,6 +341,16 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceSetAutoPromotionForStageProcedure,
 			opts...,
 		),
+		lockStage: connect.NewClient[v1alpha1.LockStageRequest, v1alpha1.LockStageResponse](
+			httpClient,
+			baseURL+KargoServiceLockStageProcedure,
+			opts...,
+		),
+		unlockStage: connect.NewClient[v1alpha1.UnlockStageRequest, v1alpha1.UnlockStageResponse](
+			httpClient,
+			baseURL+KargoServiceUnlockStageProcedure,
+			opts...,
+		),
 		queryFreight: connect.NewClient[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse](
 			httpClient,
 			baseURL+KargoServiceQueryFreightProcedure,
@@ -337,6 +371,11 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceUpdateFreightAliasProcedure,
 			opts...,
 		),
+		createFreight: connect.NewClient[v1alpha1.CreateFreightRequest, v1alpha1.CreateFreightResponse](
+			httpClient,
+			baseURL+KargoServiceCreateFreightProcedure,
+			opts...,
+		),
 		listWarehouses: connect.NewClient[v1alpha1.ListWarehousesRequest, v1alpha1.ListWarehousesResponse](
 			httpClient,
 			baseURL+KargoServiceListWarehousesProcedure,
@@ -372,47 +411,57 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceRefreshWarehouseProcedure,
 			opts...,
 		),
+		previewWarehouseSubscription: connect.NewClient[v1alpha1.PreviewWarehouseSubscriptionRequest, v1alpha1.PreviewWarehouseSubscriptionResponse](
+			httpClient,
+			baseURL+KargoServicePreviewWarehouseSubscriptionProcedure,
+			opts...,
+		),
 	}
 }
 
 // kargoServiceClient implements KargoServiceClient.
 type kargoServiceClient struct {
-	getVersionInfo           *connect.Client[v1alpha1.GetVersionInfoRequest, v1alpha1.GetVersionInfoResponse]
-	getConfig                *connect.Client[v1alpha1.GetConfigRequest, v1alpha1.GetConfigResponse]
-	getPublicConfig          *connect.Client[v1alpha1.GetPublicConfigRequest, v1alpha1.GetPublicConfigResponse]
-	adminLogin               *connect.Client[v1alpha1.AdminLoginRequest, v1alpha1.AdminLoginResponse]
-	createResource           *connect.Client[v1alpha1.CreateResourceRequest, v1alpha1.CreateResourceResponse]
-	createOrUpdateResource   *connect.Client[v1alpha1.CreateOrUpdateResourceRequest, v1alpha1.CreateOrUpdateResourceResponse]
-	updateResource           *connect.Client[v1alpha1.UpdateResourceRequest, v1alpha1.UpdateResourceResponse]
-	deleteResource           *connect.Client[v1alpha1.DeleteResourceRequest, v1alpha1.DeleteResourceResponse]
-	createStage              *connect.Client[v1alpha1.CreateStageRequest, v1alpha1.CreateStageResponse]
-	listStages               *connect.Client[v1alpha1.ListStagesRequest, v1alpha1.ListStagesResponse]
-	getStage                 *connect.Client[v1alpha1.GetStageRequest, v1alpha1.GetStageResponse]
-	watchStages              *connect.Client[v1alpha1.WatchStagesRequest, v1alpha1.WatchStagesResponse]
-	updateStage              *connect.Client[v1alpha1.UpdateStageRequest, v1alpha1.UpdateStageResponse]
-	deleteStage              *connect.Client[v1alpha1.DeleteStageRequest, v1alpha1.DeleteStageResponse]
-	promoteStage             *connect.Client[v1alpha1.PromoteStageRequest, v1alpha1.PromoteStageResponse]
-	promoteSubscribers       *connect.Client[v1alpha1.PromoteSubscribersRequest, v1alpha1.PromoteSubscribersResponse]
-	refreshStage             *connect.Client[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse]
-	listPromotions           *connect.Client[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse]
-	watchPromotions          *connect.Client[v1alpha1.WatchPromotionsRequest, v1alpha1.WatchPromotionsResponse]
-	getPromotion             *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
-	watchPromotion           *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
-	createProject            *connect.Client[v1alpha1.CreateProjectRequest, v1alpha1.CreateProjectResponse]
-	listProjects             *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
-	deleteProject            *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
-	setAutoPromotionForStage *connect.Client[v1alpha1.SetAutoPromotionForStageRequest, v1alpha1.SetAutoPromotionForStageResponse]
-	queryFreight             *connect.Client[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse]
-	deleteFreight            *connect.Client[v1alpha1.DeleteFreightRequest, v1alpha1.DeleteFreightResponse]
-	approveFreight           *connect.Client[v1alpha1.ApproveFreightRequest, v1alpha1.ApproveFreightResponse]
-	updateFreightAlias       *connect.Client[v1alpha1.UpdateFreightAliasRequest, v1alpha1.UpdateFreightAliasResponse]
-	listWarehouses           *connect.Client[v1alpha1.ListWarehousesRequest, v1alpha1.ListWarehousesResponse]
-	getWarehouse             *connect.Client[v1alpha1.GetWarehouseRequest, v1alpha1.GetWarehouseResponse]
-	watchWarehouses          *connect.Client[v1alpha1.WatchWarehousesRequest, v1alpha1.WatchWarehousesResponse]
-	createWarehouse          *connect.Client[v1alpha1.CreateWarehouseRequest, v1alpha1.CreateWarehouseResponse]
-	updateWarehouse          *connect.Client[v1alpha1.UpdateWarehouseRequest, v1alpha1.UpdateWarehouseResponse]
-	deleteWarehouse          *connect.Client[v1alpha1.DeleteWarehouseRequest, v1alpha1.DeleteWarehouseResponse]
-	refreshWarehouse         *connect.Client[v1alpha1.RefreshWarehouseRequest, v1alpha1.RefreshWarehouseResponse]
+	getVersionInfo               *connect.Client[v1alpha1.GetVersionInfoRequest, v1alpha1.GetVersionInfoResponse]
+	getConfig                    *connect.Client[v1alpha1.GetConfigRequest, v1alpha1.GetConfigResponse]
+	getPublicConfig              *connect.Client[v1alpha1.GetPublicConfigRequest, v1alpha1.GetPublicConfigResponse]
+	adminLogin                   *connect.Client[v1alpha1.AdminLoginRequest, v1alpha1.AdminLoginResponse]
+	createResource               *connect.Client[v1alpha1.CreateResourceRequest, v1alpha1.CreateResourceResponse]
+	createOrUpdateResource       *connect.Client[v1alpha1.CreateOrUpdateResourceRequest, v1alpha1.CreateOrUpdateResourceResponse]
+	updateResource               *connect.Client[v1alpha1.UpdateResourceRequest, v1alpha1.UpdateResourceResponse]
+	deleteResource               *connect.Client[v1alpha1.DeleteResourceRequest, v1alpha1.DeleteResourceResponse]
+	createStage                  *connect.Client[v1alpha1.CreateStageRequest, v1alpha1.CreateStageResponse]
+	listStages                   *connect.Client[v1alpha1.ListStagesRequest, v1alpha1.ListStagesResponse]
+	getStage                     *connect.Client[v1alpha1.GetStageRequest, v1alpha1.GetStageResponse]
+	listStageHistory             *connect.Client[v1alpha1.ListStageHistoryRequest, v1alpha1.ListStageHistoryResponse]
+	watchStages                  *connect.Client[v1alpha1.WatchStagesRequest, v1alpha1.WatchStagesResponse]
+	updateStage                  *connect.Client[v1alpha1.UpdateStageRequest, v1alpha1.UpdateStageResponse]
+	deleteStage                  *connect.Client[v1alpha1.DeleteStageRequest, v1alpha1.DeleteStageResponse]
+	promoteStage                 *connect.Client[v1alpha1.PromoteStageRequest, v1alpha1.PromoteStageResponse]
+	promoteSubscribers           *connect.Client[v1alpha1.PromoteSubscribersRequest, v1alpha1.PromoteSubscribersResponse]
+	refreshStage                 *connect.Client[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse]
+	listPromotions               *connect.Client[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse]
+	watchPromotions              *connect.Client[v1alpha1.WatchPromotionsRequest, v1alpha1.WatchPromotionsResponse]
+	getPromotion                 *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
+	watchPromotion               *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
+	createProject                *connect.Client[v1alpha1.CreateProjectRequest, v1alpha1.CreateProjectResponse]
+	listProjects                 *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
+	deleteProject                *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
+	setAutoPromotionForStage     *connect.Client[v1alpha1.SetAutoPromotionForStageRequest, v1alpha1.SetAutoPromotionForStageResponse]
+	lockStage                    *connect.Client[v1alpha1.LockStageRequest, v1alpha1.LockStageResponse]
+	unlockStage                  *connect.Client[v1alpha1.UnlockStageRequest, v1alpha1.UnlockStageResponse]
+	queryFreight                 *connect.Client[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse]
+	deleteFreight                *connect.Client[v1alpha1.DeleteFreightRequest, v1alpha1.DeleteFreightResponse]
+	approveFreight               *connect.Client[v1alpha1.ApproveFreightRequest, v1alpha1.ApproveFreightResponse]
+	updateFreightAlias           *connect.Client[v1alpha1.UpdateFreightAliasRequest, v1alpha1.UpdateFreightAliasResponse]
+	createFreight                *connect.Client[v1alpha1.CreateFreightRequest, v1alpha1.CreateFreightResponse]
+	listWarehouses               *connect.Client[v1alpha1.ListWarehousesRequest, v1alpha1.ListWarehousesResponse]
+	getWarehouse                 *connect.Client[v1alpha1.GetWarehouseRequest, v1alpha1.GetWarehouseResponse]
+	watchWarehouses              *connect.Client[v1alpha1.WatchWarehousesRequest, v1alpha1.WatchWarehousesResponse]
+	createWarehouse              *connect.Client[v1alpha1.CreateWarehouseRequest, v1alpha1.CreateWarehouseResponse]
+	updateWarehouse              *connect.Client[v1alpha1.UpdateWarehouseRequest, v1alpha1.UpdateWarehouseResponse]
+	deleteWarehouse              *connect.Client[v1alpha1.DeleteWarehouseRequest, v1alpha1.DeleteWarehouseResponse]
+	refreshWarehouse             *connect.Client[v1alpha1.RefreshWarehouseRequest, v1alpha1.RefreshWarehouseResponse]
+	previewWarehouseSubscription *connect.Client[v1alpha1.PreviewWarehouseSubscriptionRequest, v1alpha1.PreviewWarehouseSubscriptionResponse]
 }
 
 // GetVersionInfo calls akuity.io.kargo.service.v1alpha1.KargoService.GetVersionInfo.
@@ -471,6 +520,11 @@ func (c *kargoServiceClient) GetStage(ctx context.Context, req *connect.Request[
 	return c.getStage.CallUnary(ctx, req)
 }
 
+// ListStageHistory calls akuity.io.kargo.service.v1alpha1.KargoService.ListStageHistory.
+func (c *kargoServiceClient) ListStageHistory(ctx context.Context, req *connect.Request[v1alpha1.ListStageHistoryRequest]) (*connect.Response[v1alpha1.ListStageHistoryResponse], error) {
+	return c.listStageHistory.CallUnary(ctx, req)
+}
+
 // WatchStages calls akuity.io.kargo.service.v1alpha1.KargoService.WatchStages.
 func (c *kargoServiceClient) WatchStages(ctx context.Context, req *connect.Request[v1alpha1.WatchStagesRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchStagesResponse], error) {
 	return c.watchStages.CallServerStream(ctx, req)
@@ -542,6 +596,16 @@ func (c *kargoServiceClient) SetAutoPromotionForStage(ctx context.Context, req *
 	return c.setAutoPromotionForStage.CallUnary(ctx, req)
 }
 
+// LockStage calls akuity.io.kargo.service.v1alpha1.KargoService.LockStage.
+func (c *kargoServiceClient) LockStage(ctx context.Context, req *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error) {
+	return c.lockStage.CallUnary(ctx, req)
+}
+
+// UnlockStage calls akuity.io.kargo.service.v1alpha1.KargoService.UnlockStage.
+func (c *kargoServiceClient) UnlockStage(ctx context.Context, req *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error) {
+	return c.unlockStage.CallUnary(ctx, req)
+}
+
 // QueryFreight calls akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight.
 func (c *kargoServiceClient) QueryFreight(ctx context.Context, req *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return c.queryFreight.CallUnary(ctx, req)
@@ -562,6 +626,11 @@ func (c *kargoServiceClient) UpdateFreightAlias(ctx context.Context, req *connec
 	return c.updateFreightAlias.CallUnary(ctx, req)
 }
 
+// CreateFreight calls akuity.io.kargo.service.v1alpha1.KargoService.CreateFreight.
+func (c *kargoServiceClient) CreateFreight(ctx context.Context, req *connect.Request[v1alpha1.CreateFreightRequest]) (*connect.Response[v1alpha1.CreateFreightResponse], error) {
+	return c.createFreight.CallUnary(ctx, req)
+}
+
 // ListWarehouses calls akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses.
 func (c *kargoServiceClient) ListWarehouses(ctx context.Context, req *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error) {
 	return c.listWarehouses.CallUnary(ctx, req)
@@ -597,6 +666,12 @@ func (c *kargoServiceClient) RefreshWarehouse(ctx context.Context, req *connect.
 	return c.refreshWarehouse.CallUnary(ctx, req)
 }
 
+// PreviewWarehouseSubscription calls
+// akuity.io.kargo.service.v1alpha1.KargoService.PreviewWarehouseSubscription.
+func (c *kargoServiceClient) PreviewWarehouseSubscription(ctx context.Context, req *connect.Request[v1alpha1.PreviewWarehouseSubscriptionRequest]) (*connect.Response[v1alpha1.PreviewWarehouseSubscriptionResponse], error) {
+	return c.previewWarehouseSubscription.CallUnary(ctx, req)
+}
+
 // KargoServiceHandler is an implementation of the akuity.io.kargo.service.v1alpha1.KargoService
 // service.
 type KargoServiceHandler interface {
@@ -613,6 +688,7 @@ type KargoServiceHandler interface {
 	CreateStage(context.Context, *connect.Request[v1alpha1.CreateStageRequest]) (*connect.Response[v1alpha1.CreateStageResponse], error)
 	ListStages(context.Context, *connect.Request[v1alpha1.ListStagesRequest]) (*connect.Response[v1alpha1.ListStagesResponse], error)
 	GetStage(context.Context, *connect.Request[v1alpha1.GetStageRequest]) (*connect.Response[v1alpha1.GetStageResponse], error)
+	ListStageHistory(context.Context, *connect.Request[v1alpha1.ListStageHistoryRequest]) (*connect.Response[v1alpha1.ListStageHistoryResponse], error)
 	WatchStages(context.Context, *connect.Request[v1alpha1.WatchStagesRequest], *connect.ServerStream[v1alpha1.WatchStagesResponse]) error
 	UpdateStage(context.Context, *connect.Request[v1alpha1.UpdateStageRequest]) (*connect.Response[v1alpha1.UpdateStageResponse], error)
 	DeleteStage(context.Context, *connect.Request[v1alpha1.DeleteStageRequest]) (*connect.Response[v1alpha1.DeleteStageResponse], error)
@@ -628,10 +704,13 @@ type KargoServiceHandler interface {
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	SetAutoPromotionForStage(context.Context, *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error)
+	LockStage(context.Context, *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error)
+	UnlockStage(context.Context, *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
 	DeleteFreight(context.Context, *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error)
 	ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error)
 	UpdateFreightAlias(context.Context, *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error)
+	CreateFreight(context.Context, *connect.Request[v1alpha1.CreateFreightRequest]) (*connect.Response[v1alpha1.CreateFreightResponse], error)
 	ListWarehouses(context.Context, *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error)
 	GetWarehouse(context.Context, *connect.Request[v1alpha1.GetWarehouseRequest]) (*connect.Response[v1alpha1.GetWarehouseResponse], error)
 	WatchWarehouses(context.Context, *connect.Request[v1alpha1.WatchWarehousesRequest], *connect.ServerStream[v1alpha1.WatchWarehousesResponse]) error
@@ -639,6 +718,7 @@ type KargoServiceHandler interface {
 	UpdateWarehouse(context.Context, *connect.Request[v1alpha1.UpdateWarehouseRequest]) (*connect.Response[v1alpha1.UpdateWarehouseResponse], error)
 	DeleteWarehouse(context.Context, *connect.Request[v1alpha1.DeleteWarehouseRequest]) (*connect.Response[v1alpha1.DeleteWarehouseResponse], error)
 	RefreshWarehouse(context.Context, *connect.Request[v1alpha1.RefreshWarehouseRequest]) (*connect.Response[v1alpha1.RefreshWarehouseResponse], error)
+	PreviewWarehouseSubscription(context.Context, *connect.Request[v1alpha1.PreviewWarehouseSubscriptionRequest]) (*connect.Response[v1alpha1.PreviewWarehouseSubscriptionResponse], error)
 }
 
 // NewKargoServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -702,6 +782,11 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.GetStage,
 		opts...,
 	)
+	kargoServiceListStageHistoryHandler := connect.NewUnaryHandler(
+		KargoServiceListStageHistoryProcedure,
+		svc.ListStageHistory,
+		opts...,
+	)
 	kargoServiceWatchStagesHandler := connect.NewServerStreamHandler(
 		KargoServiceWatchStagesProcedure,
 		svc.WatchStages,
@@ -772,6 +857,16 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.SetAutoPromotionForStage,
 		opts...,
 	)
+	kargoServiceLockStageHandler := connect.NewUnaryHandler(
+		KargoServiceLockStageProcedure,
+		svc.LockStage,
+		opts...,
+	)
+	kargoServiceUnlockStageHandler := connect.NewUnaryHandler(
+		KargoServiceUnlockStageProcedure,
+		svc.UnlockStage,
+		opts...,
+	)
 	kargoServiceQueryFreightHandler := connect.NewUnaryHandler(
 		KargoServiceQueryFreightProcedure,
 		svc.QueryFreight,
@@ -792,6 +887,11 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.UpdateFreightAlias,
 		opts...,
 	)
+	kargoServiceCreateFreightHandler := connect.NewUnaryHandler(
+		KargoServiceCreateFreightProcedure,
+		svc.CreateFreight,
+		opts...,
+	)
 	kargoServiceListWarehousesHandler := connect.NewUnaryHandler(
 		KargoServiceListWarehousesProcedure,
 		svc.ListWarehouses,
@@ -827,6 +927,11 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.RefreshWarehouse,
 		opts...,
 	)
+	kargoServicePreviewWarehouseSubscriptionHandler := connect.NewUnaryHandler(
+		KargoServicePreviewWarehouseSubscriptionProcedure,
+		svc.PreviewWarehouseSubscription,
+		opts...,
+	)
 	return "/akuity.io.kargo.service.v1alpha1.KargoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case KargoServiceGetVersionInfoProcedure:
@@ -851,6 +956,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceListStagesHandler.ServeHTTP(w, r)
 		case KargoServiceGetStageProcedure:
 			kargoServiceGetStageHandler.ServeHTTP(w, r)
+		case KargoServiceListStageHistoryProcedure:
+			kargoServiceListStageHistoryHandler.ServeHTTP(w, r)
 		case KargoServiceWatchStagesProcedure:
 			kargoServiceWatchStagesHandler.ServeHTTP(w, r)
 		case KargoServiceUpdateStageProcedure:
@@ -879,6 +986,10 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceDeleteProjectHandler.ServeHTTP(w, r)
 		case KargoServiceSetAutoPromotionForStageProcedure:
 			kargoServiceSetAutoPromotionForStageHandler.ServeHTTP(w, r)
+		case KargoServiceLockStageProcedure:
+			kargoServiceLockStageHandler.ServeHTTP(w, r)
+		case KargoServiceUnlockStageProcedure:
+			kargoServiceUnlockStageHandler.ServeHTTP(w, r)
 		case KargoServiceQueryFreightProcedure:
 			kargoServiceQueryFreightHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteFreightProcedure:
@@ -887,6 +998,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceApproveFreightHandler.ServeHTTP(w, r)
 		case KargoServiceUpdateFreightAliasProcedure:
 			kargoServiceUpdateFreightAliasHandler.ServeHTTP(w, r)
+		case KargoServiceCreateFreightProcedure:
+			kargoServiceCreateFreightHandler.ServeHTTP(w, r)
 		case KargoServiceListWarehousesProcedure:
 			kargoServiceListWarehousesHandler.ServeHTTP(w, r)
 		case KargoServiceGetWarehouseProcedure:
@@ -901,6 +1014,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceDeleteWarehouseHandler.ServeHTTP(w, r)
 		case KargoServiceRefreshWarehouseProcedure:
 			kargoServiceRefreshWarehouseHandler.ServeHTTP(w, r)
+		case KargoServicePreviewWarehouseSubscriptionProcedure:
+			kargoServicePreviewWarehouseSubscriptionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -954,6 +1069,10 @@ func (UnimplementedKargoServiceHandler) GetStage(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetStage is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) ListStageHistory(context.Context, *connect.Request[v1alpha1.ListStageHistoryRequest]) (*connect.Response[v1alpha1.ListStageHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListStageHistory is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) WatchStages(context.Context, *connect.Request[v1alpha1.WatchStagesRequest], *connect.ServerStream[v1alpha1.WatchStagesResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.WatchStages is not implemented"))
 }
@@ -1010,6 +1129,14 @@ func (UnimplementedKargoServiceHandler) SetAutoPromotionForStage(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.SetAutoPromotionForStage is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) LockStage(context.Context, *connect.Request[v1alpha1.LockStageRequest]) (*connect.Response[v1alpha1.LockStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.LockStage is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) UnlockStage(context.Context, *connect.Request[v1alpha1.UnlockStageRequest]) (*connect.Response[v1alpha1.UnlockStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.UnlockStage is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight is not implemented"))
 }
@@ -1026,6 +1153,10 @@ func (UnimplementedKargoServiceHandler) UpdateFreightAlias(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.UpdateFreightAlias is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) CreateFreight(context.Context, *connect.Request[v1alpha1.CreateFreightRequest]) (*connect.Response[v1alpha1.CreateFreightResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateFreight is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ListWarehouses(context.Context, *connect.Request[v1alpha1.ListWarehousesRequest]) (*connect.Response[v1alpha1.ListWarehousesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses is not implemented"))
 }
@@ -1053,3 +1184,7 @@ func (UnimplementedKargoServiceHandler) DeleteWarehouse(context.Context, *connec
 func (UnimplementedKargoServiceHandler) RefreshWarehouse(context.Context, *connect.Request[v1alpha1.RefreshWarehouseRequest]) (*connect.Response[v1alpha1.RefreshWarehouseResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RefreshWarehouse is not implemented"))
 }
+
+func (UnimplementedKargoServiceHandler) PreviewWarehouseSubscription(context.Context, *connect.Request[v1alpha1.PreviewWarehouseSubscriptionRequest]) (*connect.Response[v1alpha1.PreviewWarehouseSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PreviewWarehouseSubscription is not implemented"))
+}
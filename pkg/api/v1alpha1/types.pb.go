@@ -545,13 +545,14 @@ type GitCommit struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RepoUrl           string  `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
-	Id                string  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Branch            string  `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
-	HealthCheckCommit *string `protobuf:"bytes,4,opt,name=health_check_commit,json=healthCheckCommit,proto3,oneof" json:"health_check_commit,omitempty"`
-	Message           string  `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	Author            string  `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
-	Tag               string  `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+	RepoUrl           string                 `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
+	Id                string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Branch            string                 `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	HealthCheckCommit *string                `protobuf:"bytes,4,opt,name=health_check_commit,json=healthCheckCommit,proto3,oneof" json:"health_check_commit,omitempty"`
+	Message           string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Author            string                 `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	Tag               string                 `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+	CommittedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=committed_at,json=committedAt,proto3,oneof" json:"committed_at,omitempty"`
 }
 
 func (x *GitCommit) Reset() {
@@ -635,18 +636,26 @@ func (x *GitCommit) GetTag() string {
 	return ""
 }
 
+func (x *GitCommit) GetCommittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommittedAt
+	}
+	return nil
+}
+
 type GitRepoUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RepoUrl     string                         `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
-	ReadBranch  *string                        `protobuf:"bytes,2,opt,name=read_branch,json=readBranch,proto3,oneof" json:"read_branch,omitempty"`
-	WriteBranch string                         `protobuf:"bytes,3,opt,name=write_branch,json=writeBranch,proto3" json:"write_branch,omitempty"`
-	Kustomize   *KustomizePromotionMechanism   `protobuf:"bytes,5,opt,name=kustomize,proto3,oneof" json:"kustomize,omitempty"`
-	Helm        *HelmPromotionMechanism        `protobuf:"bytes,6,opt,name=helm,proto3,oneof" json:"helm,omitempty"`
-	Render      *KargoRenderPromotionMechanism `protobuf:"bytes,7,opt,name=render,proto3,oneof" json:"render,omitempty"`
-	PullRequest *PullRequestPromotionMechanism `protobuf:"bytes,8,opt,name=pull_request,json=pullRequest,proto3,oneof" json:"pull_request,omitempty"`
+	RepoUrl      string                         `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
+	ReadBranch   *string                        `protobuf:"bytes,2,opt,name=read_branch,json=readBranch,proto3,oneof" json:"read_branch,omitempty"`
+	WriteBranch  string                         `protobuf:"bytes,3,opt,name=write_branch,json=writeBranch,proto3" json:"write_branch,omitempty"`
+	Kustomize    *KustomizePromotionMechanism   `protobuf:"bytes,5,opt,name=kustomize,proto3,oneof" json:"kustomize,omitempty"`
+	Helm         *HelmPromotionMechanism        `protobuf:"bytes,6,opt,name=helm,proto3,oneof" json:"helm,omitempty"`
+	Render       *KargoRenderPromotionMechanism `protobuf:"bytes,7,opt,name=render,proto3,oneof" json:"render,omitempty"`
+	PullRequest  *PullRequestPromotionMechanism `protobuf:"bytes,8,opt,name=pull_request,json=pullRequest,proto3,oneof" json:"pull_request,omitempty"`
+	OciArtifacts []*OCIArtifactUpdate           `protobuf:"bytes,9,rep,name=oci_artifacts,json=ociArtifacts,proto3" json:"oci_artifacts,omitempty"`
 }
 
 func (x *GitRepoUpdate) Reset() {
@@ -730,17 +739,31 @@ func (x *GitRepoUpdate) GetPullRequest() *PullRequestPromotionMechanism {
 	return nil
 }
 
+func (x *GitRepoUpdate) GetOciArtifacts() []*OCIArtifactUpdate {
+	if x != nil {
+		return x.OciArtifacts
+	}
+	return nil
+}
+
 type GitSubscription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RepoUrl                 string   `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
-	Branch                  string   `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	CommitSelectionStrategy string   `protobuf:"bytes,3,opt,name=commit_selection_strategy,json=commitSelectionStrategy,proto3" json:"commit_selection_strategy,omitempty"`
-	SemverConstraint        *string  `protobuf:"bytes,4,opt,name=semver_constraint,json=semverConstraint,proto3,oneof" json:"semver_constraint,omitempty"`
-	AllowTags               *string  `protobuf:"bytes,5,opt,name=allow_tags,json=allowTags,proto3,oneof" json:"allow_tags,omitempty"`
-	IgnoreTags              []string `protobuf:"bytes,6,rep,name=ignore_tags,json=ignoreTags,proto3" json:"ignore_tags,omitempty"`
+	RepoUrl                 string                    `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
+	Branch                  string                    `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	CommitSelectionStrategy string                    `protobuf:"bytes,3,opt,name=commit_selection_strategy,json=commitSelectionStrategy,proto3" json:"commit_selection_strategy,omitempty"`
+	SemverConstraint        *string                   `protobuf:"bytes,4,opt,name=semver_constraint,json=semverConstraint,proto3,oneof" json:"semver_constraint,omitempty"`
+	AllowTags               *string                   `protobuf:"bytes,5,opt,name=allow_tags,json=allowTags,proto3,oneof" json:"allow_tags,omitempty"`
+	IgnoreTags              []string                  `protobuf:"bytes,6,rep,name=ignore_tags,json=ignoreTags,proto3" json:"ignore_tags,omitempty"`
+	IncludePaths            []string                  `protobuf:"bytes,7,rep,name=include_paths,json=includePaths,proto3" json:"include_paths,omitempty"`
+	ExcludePaths            []string                  `protobuf:"bytes,8,rep,name=exclude_paths,json=excludePaths,proto3" json:"exclude_paths,omitempty"`
+	IncludeMessages         *string                   `protobuf:"bytes,9,opt,name=include_messages,json=includeMessages,proto3,oneof" json:"include_messages,omitempty"`
+	ExcludeMessages         *string                   `protobuf:"bytes,10,opt,name=exclude_messages,json=excludeMessages,proto3,oneof" json:"exclude_messages,omitempty"`
+	IncludeAuthors          []string                  `protobuf:"bytes,11,rep,name=include_authors,json=includeAuthors,proto3" json:"include_authors,omitempty"`
+	ExcludeAuthors          []string                  `protobuf:"bytes,12,rep,name=exclude_authors,json=excludeAuthors,proto3" json:"exclude_authors,omitempty"`
+	SignatureVerification   *GitSignatureVerification `protobuf:"bytes,13,opt,name=signature_verification,json=signatureVerification,proto3,oneof" json:"signature_verification,omitempty"`
 }
 
 func (x *GitSubscription) Reset() {
@@ -817,6 +840,110 @@ func (x *GitSubscription) GetIgnoreTags() []string {
 	return nil
 }
 
+func (x *GitSubscription) GetIncludePaths() []string {
+	if x != nil {
+		return x.IncludePaths
+	}
+	return nil
+}
+
+func (x *GitSubscription) GetExcludePaths() []string {
+	if x != nil {
+		return x.ExcludePaths
+	}
+	return nil
+}
+
+func (x *GitSubscription) GetIncludeMessages() string {
+	if x != nil && x.IncludeMessages != nil {
+		return *x.IncludeMessages
+	}
+	return ""
+}
+
+func (x *GitSubscription) GetExcludeMessages() string {
+	if x != nil && x.ExcludeMessages != nil {
+		return *x.ExcludeMessages
+	}
+	return ""
+}
+
+func (x *GitSubscription) GetIncludeAuthors() []string {
+	if x != nil {
+		return x.IncludeAuthors
+	}
+	return nil
+}
+
+func (x *GitSubscription) GetExcludeAuthors() []string {
+	if x != nil {
+		return x.ExcludeAuthors
+	}
+	return nil
+}
+
+func (x *GitSubscription) GetSignatureVerification() *GitSignatureVerification {
+	if x != nil {
+		return x.SignatureVerification
+	}
+	return nil
+}
+
+type GitSignatureVerification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecretName string `protobuf:"bytes,1,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	Require    string `protobuf:"bytes,2,opt,name=require,proto3" json:"require,omitempty"`
+}
+
+func (x *GitSignatureVerification) Reset() {
+	*x = GitSignatureVerification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitSignatureVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitSignatureVerification) ProtoMessage() {}
+
+func (x *GitSignatureVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitSignatureVerification.ProtoReflect.Descriptor instead.
+func (*GitSignatureVerification) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GitSignatureVerification) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *GitSignatureVerification) GetRequire() string {
+	if x != nil {
+		return x.Require
+	}
+	return ""
+}
+
 type Health struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -830,7 +957,7 @@ type Health struct {
 func (x *Health) Reset() {
 	*x = Health{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[12]
+		mi := &file_v1alpha1_types_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -843,7 +970,7 @@ func (x *Health) String() string {
 func (*Health) ProtoMessage() {}
 
 func (x *Health) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[12]
+	mi := &file_v1alpha1_types_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -856,7 +983,7 @@ func (x *Health) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Health.ProtoReflect.Descriptor instead.
 func (*Health) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{12}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Health) GetStatus() string {
@@ -894,7 +1021,7 @@ type ArgoCDAppState struct {
 func (x *ArgoCDAppState) Reset() {
 	*x = ArgoCDAppState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[13]
+		mi := &file_v1alpha1_types_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -907,7 +1034,7 @@ func (x *ArgoCDAppState) String() string {
 func (*ArgoCDAppState) ProtoMessage() {}
 
 func (x *ArgoCDAppState) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[13]
+	mi := &file_v1alpha1_types_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,7 +1047,7 @@ func (x *ArgoCDAppState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArgoCDAppState.ProtoReflect.Descriptor instead.
 func (*ArgoCDAppState) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{13}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ArgoCDAppState) GetNamespace() string {
@@ -963,7 +1090,7 @@ type ArgoCDAppHealthStatus struct {
 func (x *ArgoCDAppHealthStatus) Reset() {
 	*x = ArgoCDAppHealthStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[14]
+		mi := &file_v1alpha1_types_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -976,7 +1103,7 @@ func (x *ArgoCDAppHealthStatus) String() string {
 func (*ArgoCDAppHealthStatus) ProtoMessage() {}
 
 func (x *ArgoCDAppHealthStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[14]
+	mi := &file_v1alpha1_types_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,7 +1116,7 @@ func (x *ArgoCDAppHealthStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArgoCDAppHealthStatus.ProtoReflect.Descriptor instead.
 func (*ArgoCDAppHealthStatus) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{14}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ArgoCDAppHealthStatus) GetStatus() string {
@@ -1019,7 +1146,7 @@ type ArgoCDAppSyncStatus struct {
 func (x *ArgoCDAppSyncStatus) Reset() {
 	*x = ArgoCDAppSyncStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[15]
+		mi := &file_v1alpha1_types_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1032,7 +1159,7 @@ func (x *ArgoCDAppSyncStatus) String() string {
 func (*ArgoCDAppSyncStatus) ProtoMessage() {}
 
 func (x *ArgoCDAppSyncStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[15]
+	mi := &file_v1alpha1_types_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,7 +1172,7 @@ func (x *ArgoCDAppSyncStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArgoCDAppSyncStatus.ProtoReflect.Descriptor instead.
 func (*ArgoCDAppSyncStatus) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{15}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ArgoCDAppSyncStatus) GetStatus() string {
@@ -1082,7 +1209,7 @@ type HelmChartDependencyUpdate struct {
 func (x *HelmChartDependencyUpdate) Reset() {
 	*x = HelmChartDependencyUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[16]
+		mi := &file_v1alpha1_types_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1095,7 +1222,7 @@ func (x *HelmChartDependencyUpdate) String() string {
 func (*HelmChartDependencyUpdate) ProtoMessage() {}
 
 func (x *HelmChartDependencyUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[16]
+	mi := &file_v1alpha1_types_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,7 +1235,7 @@ func (x *HelmChartDependencyUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HelmChartDependencyUpdate.ProtoReflect.Descriptor instead.
 func (*HelmChartDependencyUpdate) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{16}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *HelmChartDependencyUpdate) GetRepository() string {
@@ -1146,7 +1273,7 @@ type HelmImageUpdate struct {
 func (x *HelmImageUpdate) Reset() {
 	*x = HelmImageUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[17]
+		mi := &file_v1alpha1_types_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1159,7 +1286,7 @@ func (x *HelmImageUpdate) String() string {
 func (*HelmImageUpdate) ProtoMessage() {}
 
 func (x *HelmImageUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[17]
+	mi := &file_v1alpha1_types_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,7 +1299,7 @@ func (x *HelmImageUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HelmImageUpdate.ProtoReflect.Descriptor instead.
 func (*HelmImageUpdate) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{17}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *HelmImageUpdate) GetImage() string {
@@ -1215,7 +1342,7 @@ type HelmPromotionMechanism struct {
 func (x *HelmPromotionMechanism) Reset() {
 	*x = HelmPromotionMechanism{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[18]
+		mi := &file_v1alpha1_types_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1228,7 +1355,7 @@ func (x *HelmPromotionMechanism) String() string {
 func (*HelmPromotionMechanism) ProtoMessage() {}
 
 func (x *HelmPromotionMechanism) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[18]
+	mi := &file_v1alpha1_types_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,7 +1368,7 @@ func (x *HelmPromotionMechanism) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HelmPromotionMechanism.ProtoReflect.Descriptor instead.
 func (*HelmPromotionMechanism) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{18}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *HelmPromotionMechanism) GetImages() []*HelmImageUpdate {
@@ -1269,7 +1396,7 @@ type PullRequestPromotionMechanism struct {
 func (x *PullRequestPromotionMechanism) Reset() {
 	*x = PullRequestPromotionMechanism{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[19]
+		mi := &file_v1alpha1_types_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1282,7 +1409,7 @@ func (x *PullRequestPromotionMechanism) String() string {
 func (*PullRequestPromotionMechanism) ProtoMessage() {}
 
 func (x *PullRequestPromotionMechanism) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[19]
+	mi := &file_v1alpha1_types_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,7 +1422,7 @@ func (x *PullRequestPromotionMechanism) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullRequestPromotionMechanism.ProtoReflect.Descriptor instead.
 func (*PullRequestPromotionMechanism) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{19}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PullRequestPromotionMechanism) GetGithub() *GitHubPullRequest {
@@ -1314,7 +1441,7 @@ type GitHubPullRequest struct {
 func (x *GitHubPullRequest) Reset() {
 	*x = GitHubPullRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[20]
+		mi := &file_v1alpha1_types_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1327,7 +1454,7 @@ func (x *GitHubPullRequest) String() string {
 func (*GitHubPullRequest) ProtoMessage() {}
 
 func (x *GitHubPullRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[20]
+	mi := &file_v1alpha1_types_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,7 +1467,7 @@ func (x *GitHubPullRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitHubPullRequest.ProtoReflect.Descriptor instead.
 func (*GitHubPullRequest) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{20}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{21}
 }
 
 type Image struct {
@@ -1356,7 +1483,7 @@ type Image struct {
 func (x *Image) Reset() {
 	*x = Image{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[21]
+		mi := &file_v1alpha1_types_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1369,7 +1496,7 @@ func (x *Image) String() string {
 func (*Image) ProtoMessage() {}
 
 func (x *Image) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[21]
+	mi := &file_v1alpha1_types_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1382,7 +1509,7 @@ func (x *Image) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Image.ProtoReflect.Descriptor instead.
 func (*Image) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{21}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Image) GetRepoUrl() string {
@@ -1422,7 +1549,7 @@ type ImageSubscription struct {
 func (x *ImageSubscription) Reset() {
 	*x = ImageSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[22]
+		mi := &file_v1alpha1_types_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1435,7 +1562,7 @@ func (x *ImageSubscription) String() string {
 func (*ImageSubscription) ProtoMessage() {}
 
 func (x *ImageSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[22]
+	mi := &file_v1alpha1_types_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,7 +1575,7 @@ func (x *ImageSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageSubscription.ProtoReflect.Descriptor instead.
 func (*ImageSubscription) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{22}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ImageSubscription) GetRepoUrl() string {
@@ -1506,7 +1633,7 @@ type KustomizeImageUpdate struct {
 func (x *KustomizeImageUpdate) Reset() {
 	*x = KustomizeImageUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[23]
+		mi := &file_v1alpha1_types_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1519,7 +1646,7 @@ func (x *KustomizeImageUpdate) String() string {
 func (*KustomizeImageUpdate) ProtoMessage() {}
 
 func (x *KustomizeImageUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[23]
+	mi := &file_v1alpha1_types_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1532,7 +1659,7 @@ func (x *KustomizeImageUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KustomizeImageUpdate.ProtoReflect.Descriptor instead.
 func (*KustomizeImageUpdate) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{23}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *KustomizeImageUpdate) GetImage() string {
@@ -1567,7 +1694,7 @@ type KustomizePromotionMechanism struct {
 func (x *KustomizePromotionMechanism) Reset() {
 	*x = KustomizePromotionMechanism{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[24]
+		mi := &file_v1alpha1_types_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1580,7 +1707,7 @@ func (x *KustomizePromotionMechanism) String() string {
 func (*KustomizePromotionMechanism) ProtoMessage() {}
 
 func (x *KustomizePromotionMechanism) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[24]
+	mi := &file_v1alpha1_types_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,7 +1720,7 @@ func (x *KustomizePromotionMechanism) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KustomizePromotionMechanism.ProtoReflect.Descriptor instead.
 func (*KustomizePromotionMechanism) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{24}
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *KustomizePromotionMechanism) GetImages() []*KustomizeImageUpdate {
@@ -1603,34 +1730,34 @@ func (x *KustomizePromotionMechanism) GetImages() []*KustomizeImageUpdate {
 	return nil
 }
 
-type Project struct {
+type OCIArtifact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApiVersion string             `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	Kind       string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	Metadata   *metav1.ObjectMeta `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Status     *ProjectStatus     `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	RepoUrl      string `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
+	Tag          string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Digest       string `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	ArtifactType string `protobuf:"bytes,4,opt,name=artifact_type,json=artifactType,proto3" json:"artifact_type,omitempty"`
 }
 
-func (x *Project) Reset() {
-	*x = Project{}
+func (x *OCIArtifact) Reset() {
+	*x = OCIArtifact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[25]
+		mi := &file_v1alpha1_types_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Project) String() string {
+func (x *OCIArtifact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Project) ProtoMessage() {}
+func (*OCIArtifact) ProtoMessage() {}
 
-func (x *Project) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[25]
+func (x *OCIArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,65 +1768,69 @@ func (x *Project) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Project.ProtoReflect.Descriptor instead.
-func (*Project) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use OCIArtifact.ProtoReflect.Descriptor instead.
+func (*OCIArtifact) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Project) GetApiVersion() string {
+func (x *OCIArtifact) GetRepoUrl() string {
 	if x != nil {
-		return x.ApiVersion
+		return x.RepoUrl
 	}
 	return ""
 }
 
-func (x *Project) GetKind() string {
+func (x *OCIArtifact) GetTag() string {
 	if x != nil {
-		return x.Kind
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *Project) GetMetadata() *metav1.ObjectMeta {
+func (x *OCIArtifact) GetDigest() string {
 	if x != nil {
-		return x.Metadata
+		return x.Digest
 	}
-	return nil
+	return ""
 }
 
-func (x *Project) GetStatus() *ProjectStatus {
+func (x *OCIArtifact) GetArtifactType() string {
 	if x != nil {
-		return x.Status
+		return x.ArtifactType
 	}
-	return nil
+	return ""
 }
 
-type ProjectStatus struct {
+type OCIArtifactSubscription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phase   string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RepoUrl           string   `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
+	SelectionStrategy string   `protobuf:"bytes,2,opt,name=selection_strategy,json=selectionStrategy,proto3" json:"selection_strategy,omitempty"`
+	SemverConstraint  *string  `protobuf:"bytes,3,opt,name=semver_constraint,json=semverConstraint,proto3,oneof" json:"semver_constraint,omitempty"`
+	AllowTags         *string  `protobuf:"bytes,4,opt,name=allow_tags,json=allowTags,proto3,oneof" json:"allow_tags,omitempty"`
+	IgnoreTags        []string `protobuf:"bytes,5,rep,name=ignore_tags,json=ignoreTags,proto3" json:"ignore_tags,omitempty"`
+	ArtifactType      *string  `protobuf:"bytes,6,opt,name=artifact_type,json=artifactType,proto3,oneof" json:"artifact_type,omitempty"`
 }
 
-func (x *ProjectStatus) Reset() {
-	*x = ProjectStatus{}
+func (x *OCIArtifactSubscription) Reset() {
+	*x = OCIArtifactSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[26]
+		mi := &file_v1alpha1_types_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProjectStatus) String() string {
+func (x *OCIArtifactSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectStatus) ProtoMessage() {}
+func (*OCIArtifactSubscription) ProtoMessage() {}
 
-func (x *ProjectStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[26]
+func (x *OCIArtifactSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1710,54 +1841,81 @@ func (x *ProjectStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectStatus.ProtoReflect.Descriptor instead.
-func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use OCIArtifactSubscription.ProtoReflect.Descriptor instead.
+func (*OCIArtifactSubscription) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ProjectStatus) GetPhase() string {
+func (x *OCIArtifactSubscription) GetRepoUrl() string {
 	if x != nil {
-		return x.Phase
+		return x.RepoUrl
 	}
 	return ""
 }
 
-func (x *ProjectStatus) GetMessage() string {
+func (x *OCIArtifactSubscription) GetSelectionStrategy() string {
 	if x != nil {
-		return x.Message
+		return x.SelectionStrategy
 	}
 	return ""
 }
 
-type Promotion struct {
+func (x *OCIArtifactSubscription) GetSemverConstraint() string {
+	if x != nil && x.SemverConstraint != nil {
+		return *x.SemverConstraint
+	}
+	return ""
+}
+
+func (x *OCIArtifactSubscription) GetAllowTags() string {
+	if x != nil && x.AllowTags != nil {
+		return *x.AllowTags
+	}
+	return ""
+}
+
+func (x *OCIArtifactSubscription) GetIgnoreTags() []string {
+	if x != nil {
+		return x.IgnoreTags
+	}
+	return nil
+}
+
+func (x *OCIArtifactSubscription) GetArtifactType() string {
+	if x != nil && x.ArtifactType != nil {
+		return *x.ArtifactType
+	}
+	return ""
+}
+
+type OCIArtifactUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApiVersion string             `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	Kind       string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	Metadata   *metav1.ObjectMeta `protobuf:"bytes,3,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
-	Spec       *PromotionSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status     *PromotionStatus   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
+	Path    string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Key     string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value   string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *Promotion) Reset() {
-	*x = Promotion{}
+func (x *OCIArtifactUpdate) Reset() {
+	*x = OCIArtifactUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[27]
+		mi := &file_v1alpha1_types_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Promotion) String() string {
+func (x *OCIArtifactUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Promotion) ProtoMessage() {}
+func (*OCIArtifactUpdate) ProtoMessage() {}
 
-func (x *Promotion) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[27]
+func (x *OCIArtifactUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1768,72 +1926,67 @@ func (x *Promotion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
-func (*Promotion) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use OCIArtifactUpdate.ProtoReflect.Descriptor instead.
+func (*OCIArtifactUpdate) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Promotion) GetApiVersion() string {
+func (x *OCIArtifactUpdate) GetRepoUrl() string {
 	if x != nil {
-		return x.ApiVersion
+		return x.RepoUrl
 	}
 	return ""
 }
 
-func (x *Promotion) GetKind() string {
+func (x *OCIArtifactUpdate) GetPath() string {
 	if x != nil {
-		return x.Kind
+		return x.Path
 	}
 	return ""
 }
 
-func (x *Promotion) GetMetadata() *metav1.ObjectMeta {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *Promotion) GetSpec() *PromotionSpec {
+func (x *OCIArtifactUpdate) GetKey() string {
 	if x != nil {
-		return x.Spec
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *Promotion) GetStatus() *PromotionStatus {
+func (x *OCIArtifactUpdate) GetValue() string {
 	if x != nil {
-		return x.Status
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-type PromotionInfo struct {
+type Project struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Freight *FreightReference `protobuf:"bytes,2,opt,name=freight,proto3" json:"freight,omitempty"`
+	ApiVersion string             `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind       string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *metav1.ObjectMeta `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Status     *ProjectStatus     `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *PromotionInfo) Reset() {
-	*x = PromotionInfo{}
+func (x *Project) Reset() {
+	*x = Project{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[28]
+		mi := &file_v1alpha1_types_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PromotionInfo) String() string {
+func (x *Project) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromotionInfo) ProtoMessage() {}
+func (*Project) ProtoMessage() {}
 
-func (x *PromotionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[28]
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1844,91 +1997,50 @@ func (x *PromotionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromotionInfo.ProtoReflect.Descriptor instead.
-func (*PromotionInfo) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *PromotionInfo) GetName() string {
+func (x *Project) GetApiVersion() string {
 	if x != nil {
-		return x.Name
+		return x.ApiVersion
 	}
 	return ""
 }
 
-func (x *PromotionInfo) GetFreight() *FreightReference {
+func (x *Project) GetKind() string {
 	if x != nil {
-		return x.Freight
-	}
-	return nil
-}
-
-type PromotionList struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Metadata *metav1.ListMeta `protobuf:"bytes,1,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
-	Items    []*Promotion     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-}
-
-func (x *PromotionList) Reset() {
-	*x = PromotionList{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *PromotionList) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PromotionList) ProtoMessage() {}
-
-func (x *PromotionList) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Kind
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PromotionList.ProtoReflect.Descriptor instead.
-func (*PromotionList) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{29}
+	return ""
 }
 
-func (x *PromotionList) GetMetadata() *metav1.ListMeta {
+func (x *Project) GetMetadata() *metav1.ObjectMeta {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *PromotionList) GetItems() []*Promotion {
+func (x *Project) GetStatus() *ProjectStatus {
 	if x != nil {
-		return x.Items
+		return x.Status
 	}
 	return nil
 }
 
-type PromotionMechanisms struct {
+type ProjectStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GitRepoUpdates   []*GitRepoUpdate   `protobuf:"bytes,1,rep,name=git_repo_updates,json=gitRepoUpdates,proto3" json:"git_repo_updates,omitempty"`
-	ArgocdAppUpdates []*ArgoCDAppUpdate `protobuf:"bytes,2,rep,name=argocd_app_updates,json=argoCDAppUpdates,proto3" json:"argocd_app_updates,omitempty"`
+	Phase   string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *PromotionMechanisms) Reset() {
-	*x = PromotionMechanisms{}
+func (x *ProjectStatus) Reset() {
+	*x = ProjectStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1936,13 +2048,13 @@ func (x *PromotionMechanisms) Reset() {
 	}
 }
 
-func (x *PromotionMechanisms) String() string {
+func (x *ProjectStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromotionMechanisms) ProtoMessage() {}
+func (*ProjectStatus) ProtoMessage() {}
 
-func (x *PromotionMechanisms) ProtoReflect() protoreflect.Message {
+func (x *ProjectStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1954,36 +2066,39 @@ func (x *PromotionMechanisms) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromotionMechanisms.ProtoReflect.Descriptor instead.
-func (*PromotionMechanisms) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProjectStatus.ProtoReflect.Descriptor instead.
+func (*ProjectStatus) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *PromotionMechanisms) GetGitRepoUpdates() []*GitRepoUpdate {
+func (x *ProjectStatus) GetPhase() string {
 	if x != nil {
-		return x.GitRepoUpdates
+		return x.Phase
 	}
-	return nil
+	return ""
 }
 
-func (x *PromotionMechanisms) GetArgocdAppUpdates() []*ArgoCDAppUpdate {
+func (x *ProjectStatus) GetMessage() string {
 	if x != nil {
-		return x.ArgocdAppUpdates
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type PromotionPolicy struct {
+type Promotion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Stage                string `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
-	AutoPromotionEnabled bool   `protobuf:"varint,5,opt,name=auto_promotion_enabled,json=autoPromotionEnabled,proto3" json:"auto_promotion_enabled,omitempty"`
+	ApiVersion string             `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind       string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *metav1.ObjectMeta `protobuf:"bytes,3,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	Spec       *PromotionSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status     *PromotionStatus   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *PromotionPolicy) Reset() {
-	*x = PromotionPolicy{}
+func (x *Promotion) Reset() {
+	*x = Promotion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1991,13 +2106,13 @@ func (x *PromotionPolicy) Reset() {
 	}
 }
 
-func (x *PromotionPolicy) String() string {
+func (x *Promotion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromotionPolicy) ProtoMessage() {}
+func (*Promotion) ProtoMessage() {}
 
-func (x *PromotionPolicy) ProtoReflect() protoreflect.Message {
+func (x *Promotion) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2009,36 +2124,57 @@ func (x *PromotionPolicy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromotionPolicy.ProtoReflect.Descriptor instead.
-func (*PromotionPolicy) Descriptor() ([]byte, []int) {
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *PromotionPolicy) GetStage() string {
+func (x *Promotion) GetApiVersion() string {
 	if x != nil {
-		return x.Stage
+		return x.ApiVersion
 	}
 	return ""
 }
 
-func (x *PromotionPolicy) GetAutoPromotionEnabled() bool {
+func (x *Promotion) GetKind() string {
 	if x != nil {
-		return x.AutoPromotionEnabled
+		return x.Kind
 	}
-	return false
+	return ""
 }
 
-type PromotionSpec struct {
+func (x *Promotion) GetMetadata() *metav1.ObjectMeta {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Promotion) GetSpec() *PromotionSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Promotion) GetStatus() *PromotionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PromotionInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Stage   string `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
-	Freight string `protobuf:"bytes,2,opt,name=freight,proto3" json:"freight,omitempty"`
+	Name    string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Freight *FreightReference `protobuf:"bytes,2,opt,name=freight,proto3" json:"freight,omitempty"`
 }
 
-func (x *PromotionSpec) Reset() {
-	*x = PromotionSpec{}
+func (x *PromotionInfo) Reset() {
+	*x = PromotionInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2046,13 +2182,13 @@ func (x *PromotionSpec) Reset() {
 	}
 }
 
-func (x *PromotionSpec) String() string {
+func (x *PromotionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromotionSpec) ProtoMessage() {}
+func (*PromotionInfo) ProtoMessage() {}
 
-func (x *PromotionSpec) ProtoReflect() protoreflect.Message {
+func (x *PromotionInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2064,37 +2200,36 @@ func (x *PromotionSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromotionSpec.ProtoReflect.Descriptor instead.
-func (*PromotionSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionInfo.ProtoReflect.Descriptor instead.
+func (*PromotionInfo) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *PromotionSpec) GetStage() string {
+func (x *PromotionInfo) GetName() string {
 	if x != nil {
-		return x.Stage
+		return x.Name
 	}
 	return ""
 }
 
-func (x *PromotionSpec) GetFreight() string {
+func (x *PromotionInfo) GetFreight() *FreightReference {
 	if x != nil {
 		return x.Freight
 	}
-	return ""
+	return nil
 }
 
-type PromotionStatus struct {
+type PromotionList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phase    string            `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
-	Message  string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Metadata *metav1.ListMeta `protobuf:"bytes,1,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	Items    []*Promotion     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *PromotionStatus) Reset() {
-	*x = PromotionStatus{}
+func (x *PromotionList) Reset() {
+	*x = PromotionList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2102,13 +2237,13 @@ func (x *PromotionStatus) Reset() {
 	}
 }
 
-func (x *PromotionStatus) String() string {
+func (x *PromotionList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromotionStatus) ProtoMessage() {}
+func (*PromotionList) ProtoMessage() {}
 
-func (x *PromotionStatus) ProtoReflect() protoreflect.Message {
+func (x *PromotionList) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2120,44 +2255,39 @@ func (x *PromotionStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromotionStatus.ProtoReflect.Descriptor instead.
-func (*PromotionStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionList.ProtoReflect.Descriptor instead.
+func (*PromotionList) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *PromotionStatus) GetPhase() string {
-	if x != nil {
-		return x.Phase
-	}
-	return ""
-}
-
-func (x *PromotionStatus) GetMessage() string {
+func (x *PromotionList) GetMetadata() *metav1.ListMeta {
 	if x != nil {
-		return x.Message
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *PromotionStatus) GetMetadata() map[string]string {
+func (x *PromotionList) GetItems() []*Promotion {
 	if x != nil {
-		return x.Metadata
+		return x.Items
 	}
 	return nil
 }
 
-type RepoSubscription struct {
+type PromotionMechanisms struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Git   *GitSubscription   `protobuf:"bytes,1,opt,name=git,proto3,oneof" json:"git,omitempty"`
-	Image *ImageSubscription `protobuf:"bytes,2,opt,name=image,proto3,oneof" json:"image,omitempty"`
-	Chart *ChartSubscription `protobuf:"bytes,3,opt,name=chart,proto3,oneof" json:"chart,omitempty"`
+	GitRepoUpdates   []*GitRepoUpdate    `protobuf:"bytes,1,rep,name=git_repo_updates,json=gitRepoUpdates,proto3" json:"git_repo_updates,omitempty"`
+	ArgocdAppUpdates []*ArgoCDAppUpdate  `protobuf:"bytes,2,rep,name=argocd_app_updates,json=argoCDAppUpdates,proto3" json:"argocd_app_updates,omitempty"`
+	PreHooks         []*PromotionHook    `protobuf:"bytes,3,rep,name=pre_hooks,json=preHooks,proto3" json:"pre_hooks,omitempty"`
+	PostHooks        []*PromotionHook    `protobuf:"bytes,4,rep,name=post_hooks,json=postHooks,proto3" json:"post_hooks,omitempty"`
+	JobSteps         []*PromotionJobStep `protobuf:"bytes,5,rep,name=job_steps,json=jobSteps,proto3" json:"job_steps,omitempty"`
 }
 
-func (x *RepoSubscription) Reset() {
-	*x = RepoSubscription{}
+func (x *PromotionMechanisms) Reset() {
+	*x = PromotionMechanisms{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2165,13 +2295,13 @@ func (x *RepoSubscription) Reset() {
 	}
 }
 
-func (x *RepoSubscription) String() string {
+func (x *PromotionMechanisms) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoSubscription) ProtoMessage() {}
+func (*PromotionMechanisms) ProtoMessage() {}
 
-func (x *RepoSubscription) ProtoReflect() protoreflect.Message {
+func (x *PromotionMechanisms) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2183,46 +2313,58 @@ func (x *RepoSubscription) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoSubscription.ProtoReflect.Descriptor instead.
-func (*RepoSubscription) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionMechanisms.ProtoReflect.Descriptor instead.
+func (*PromotionMechanisms) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RepoSubscription) GetGit() *GitSubscription {
+func (x *PromotionMechanisms) GetGitRepoUpdates() []*GitRepoUpdate {
 	if x != nil {
-		return x.Git
+		return x.GitRepoUpdates
 	}
 	return nil
 }
 
-func (x *RepoSubscription) GetImage() *ImageSubscription {
+func (x *PromotionMechanisms) GetArgocdAppUpdates() []*ArgoCDAppUpdate {
 	if x != nil {
-		return x.Image
+		return x.ArgocdAppUpdates
 	}
 	return nil
 }
 
-func (x *RepoSubscription) GetChart() *ChartSubscription {
+func (x *PromotionMechanisms) GetPreHooks() []*PromotionHook {
 	if x != nil {
-		return x.Chart
+		return x.PreHooks
 	}
 	return nil
 }
 
-type Stage struct {
+func (x *PromotionMechanisms) GetPostHooks() []*PromotionHook {
+	if x != nil {
+		return x.PostHooks
+	}
+	return nil
+}
+
+func (x *PromotionMechanisms) GetJobSteps() []*PromotionJobStep {
+	if x != nil {
+		return x.JobSteps
+	}
+	return nil
+}
+
+type PromotionJobStep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApiVersion string             `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	Kind       string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	Metadata   *metav1.ObjectMeta `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec       *StageSpec         `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status     *StageStatus       `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Name string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Git  *PromotionJobGitCheckout `protobuf:"bytes,2,opt,name=git,proto3,oneof" json:"git,omitempty"`
+	Job  *PromotionJob            `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (x *Stage) Reset() {
-	*x = Stage{}
+func (x *PromotionJobStep) Reset() {
+	*x = PromotionJobStep{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2230,13 +2372,13 @@ func (x *Stage) Reset() {
 	}
 }
 
-func (x *Stage) String() string {
+func (x *PromotionJobStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stage) ProtoMessage() {}
+func (*PromotionJobStep) ProtoMessage() {}
 
-func (x *Stage) ProtoReflect() protoreflect.Message {
+func (x *PromotionJobStep) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2248,57 +2390,43 @@ func (x *Stage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stage.ProtoReflect.Descriptor instead.
-func (*Stage) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionJobStep.ProtoReflect.Descriptor instead.
+func (*PromotionJobStep) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Stage) GetApiVersion() string {
-	if x != nil {
-		return x.ApiVersion
-	}
-	return ""
-}
-
-func (x *Stage) GetKind() string {
+func (x *PromotionJobStep) GetName() string {
 	if x != nil {
-		return x.Kind
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Stage) GetMetadata() *metav1.ObjectMeta {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *Stage) GetSpec() *StageSpec {
+func (x *PromotionJobStep) GetGit() *PromotionJobGitCheckout {
 	if x != nil {
-		return x.Spec
+		return x.Git
 	}
 	return nil
 }
 
-func (x *Stage) GetStatus() *StageStatus {
+func (x *PromotionJobStep) GetJob() *PromotionJob {
 	if x != nil {
-		return x.Status
+		return x.Job
 	}
 	return nil
 }
 
-type StageList struct {
+type PromotionJobGitCheckout struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadata *metav1.ListMeta `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Items    []*Stage         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	RepoUrl string  `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
+	Branch  *string `protobuf:"bytes,2,opt,name=branch,proto3,oneof" json:"branch,omitempty"`
 }
 
-func (x *StageList) Reset() {
-	*x = StageList{}
+func (x *PromotionJobGitCheckout) Reset() {
+	*x = PromotionJobGitCheckout{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2306,13 +2434,13 @@ func (x *StageList) Reset() {
 	}
 }
 
-func (x *StageList) String() string {
+func (x *PromotionJobGitCheckout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageList) ProtoMessage() {}
+func (*PromotionJobGitCheckout) ProtoMessage() {}
 
-func (x *StageList) ProtoReflect() protoreflect.Message {
+func (x *PromotionJobGitCheckout) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2324,37 +2452,37 @@ func (x *StageList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageList.ProtoReflect.Descriptor instead.
-func (*StageList) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionJobGitCheckout.ProtoReflect.Descriptor instead.
+func (*PromotionJobGitCheckout) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *StageList) GetMetadata() *metav1.ListMeta {
+func (x *PromotionJobGitCheckout) GetRepoUrl() string {
 	if x != nil {
-		return x.Metadata
+		return x.RepoUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *StageList) GetItems() []*Stage {
-	if x != nil {
-		return x.Items
+func (x *PromotionJobGitCheckout) GetBranch() string {
+	if x != nil && x.Branch != nil {
+		return *x.Branch
 	}
-	return nil
+	return ""
 }
 
-type StageSpec struct {
+type PromotionHook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subscriptions       *Subscriptions       `protobuf:"bytes,1,opt,name=subscriptions,proto3" json:"subscriptions,omitempty"`
-	PromotionMechanisms *PromotionMechanisms `protobuf:"bytes,2,opt,name=promotion_mechanisms,json=promotionMechanisms,proto3" json:"promotion_mechanisms,omitempty"`
-	Verification        *Verification        `protobuf:"bytes,3,opt,name=verification,proto3,oneof" json:"verification,omitempty"`
+	Name string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Http *HTTPPromotionHook `protobuf:"bytes,2,opt,name=http,proto3,oneof" json:"http,omitempty"`
+	Job  *PromotionJob      `protobuf:"bytes,3,opt,name=job,proto3,oneof" json:"job,omitempty"`
 }
 
-func (x *StageSpec) Reset() {
-	*x = StageSpec{}
+func (x *PromotionHook) Reset() {
+	*x = PromotionHook{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2362,13 +2490,13 @@ func (x *StageSpec) Reset() {
 	}
 }
 
-func (x *StageSpec) String() string {
+func (x *PromotionHook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageSpec) ProtoMessage() {}
+func (*PromotionHook) ProtoMessage() {}
 
-func (x *StageSpec) ProtoReflect() protoreflect.Message {
+func (x *PromotionHook) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2380,49 +2508,47 @@ func (x *StageSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageSpec.ProtoReflect.Descriptor instead.
-func (*StageSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionHook.ProtoReflect.Descriptor instead.
+func (*PromotionHook) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *StageSpec) GetSubscriptions() *Subscriptions {
+func (x *PromotionHook) GetName() string {
 	if x != nil {
-		return x.Subscriptions
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *StageSpec) GetPromotionMechanisms() *PromotionMechanisms {
+func (x *PromotionHook) GetHttp() *HTTPPromotionHook {
 	if x != nil {
-		return x.PromotionMechanisms
+		return x.Http
 	}
 	return nil
 }
 
-func (x *StageSpec) GetVerification() *Verification {
+func (x *PromotionHook) GetJob() *PromotionJob {
 	if x != nil {
-		return x.Verification
+		return x.Job
 	}
 	return nil
 }
 
-type Freight struct {
+type HTTPPromotionHook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApiVersion string             `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	Kind       string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	Metadata   *metav1.ObjectMeta `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Id         string             `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	Commits    []*GitCommit       `protobuf:"bytes,5,rep,name=commits,proto3" json:"commits,omitempty"`
-	Images     []*Image           `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
-	Charts     []*Chart           `protobuf:"bytes,7,rep,name=charts,proto3" json:"charts,omitempty"`
-	Status     *FreightStatus     `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Url                   string        `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Method                string        `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Headers               []*HTTPHeader `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	HeadersSecret         string        `protobuf:"bytes,4,opt,name=headers_secret,json=headersSecret,proto3" json:"headers_secret,omitempty"`
+	Body                  string        `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	InsecureSkipTlsVerify bool          `protobuf:"varint,6,opt,name=insecure_skip_tls_verify,json=insecureSkipTLSVerify,proto3" json:"insecure_skip_tls_verify,omitempty"`
 }
 
-func (x *Freight) Reset() {
-	*x = Freight{}
+func (x *HTTPPromotionHook) Reset() {
+	*x = HTTPPromotionHook{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2430,13 +2556,13 @@ func (x *Freight) Reset() {
 	}
 }
 
-func (x *Freight) String() string {
+func (x *HTTPPromotionHook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Freight) ProtoMessage() {}
+func (*HTTPPromotionHook) ProtoMessage() {}
 
-func (x *Freight) ProtoReflect() protoreflect.Message {
+func (x *HTTPPromotionHook) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2448,78 +2574,64 @@ func (x *Freight) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Freight.ProtoReflect.Descriptor instead.
-func (*Freight) Descriptor() ([]byte, []int) {
+// Deprecated: Use HTTPPromotionHook.ProtoReflect.Descriptor instead.
+func (*HTTPPromotionHook) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Freight) GetApiVersion() string {
+func (x *HTTPPromotionHook) GetUrl() string {
 	if x != nil {
-		return x.ApiVersion
+		return x.Url
 	}
 	return ""
 }
 
-func (x *Freight) GetKind() string {
+func (x *HTTPPromotionHook) GetMethod() string {
 	if x != nil {
-		return x.Kind
+		return x.Method
 	}
 	return ""
 }
 
-func (x *Freight) GetMetadata() *metav1.ObjectMeta {
+func (x *HTTPPromotionHook) GetHeaders() []*HTTPHeader {
 	if x != nil {
-		return x.Metadata
+		return x.Headers
 	}
 	return nil
 }
 
-func (x *Freight) GetId() string {
+func (x *HTTPPromotionHook) GetHeadersSecret() string {
 	if x != nil {
-		return x.Id
+		return x.HeadersSecret
 	}
 	return ""
 }
 
-func (x *Freight) GetCommits() []*GitCommit {
-	if x != nil {
-		return x.Commits
-	}
-	return nil
-}
-
-func (x *Freight) GetImages() []*Image {
-	if x != nil {
-		return x.Images
-	}
-	return nil
-}
-
-func (x *Freight) GetCharts() []*Chart {
+func (x *HTTPPromotionHook) GetBody() string {
 	if x != nil {
-		return x.Charts
+		return x.Body
 	}
-	return nil
+	return ""
 }
 
-func (x *Freight) GetStatus() *FreightStatus {
+func (x *HTTPPromotionHook) GetInsecureSkipTlsVerify() bool {
 	if x != nil {
-		return x.Status
+		return x.InsecureSkipTlsVerify
 	}
-	return nil
+	return false
 }
 
-type FreightStatus struct {
+type HTTPHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VerifiedIn  map[string]*VerifiedStage `protobuf:"bytes,1,rep,name=verified_in,json=verifiedIn,proto3" json:"verified_in,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	ApprovedFor map[string]*ApprovedStage `protobuf:"bytes,2,rep,name=approved_for,json=approvedFor,proto3" json:"approved_for,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *FreightStatus) Reset() {
-	*x = FreightStatus{}
+func (x *HTTPHeader) Reset() {
+	*x = HTTPHeader{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2527,13 +2639,13 @@ func (x *FreightStatus) Reset() {
 	}
 }
 
-func (x *FreightStatus) String() string {
+func (x *HTTPHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FreightStatus) ProtoMessage() {}
+func (*HTTPHeader) ProtoMessage() {}
 
-func (x *FreightStatus) ProtoReflect() protoreflect.Message {
+func (x *HTTPHeader) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2545,33 +2657,40 @@ func (x *FreightStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FreightStatus.ProtoReflect.Descriptor instead.
-func (*FreightStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use HTTPHeader.ProtoReflect.Descriptor instead.
+func (*HTTPHeader) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *FreightStatus) GetVerifiedIn() map[string]*VerifiedStage {
+func (x *HTTPHeader) GetName() string {
 	if x != nil {
-		return x.VerifiedIn
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *FreightStatus) GetApprovedFor() map[string]*ApprovedStage {
+func (x *HTTPHeader) GetValue() string {
 	if x != nil {
-		return x.ApprovedFor
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-type VerifiedStage struct {
+type PromotionJob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Image                 string                `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Command               []string              `protobuf:"bytes,2,rep,name=command,proto3" json:"command,omitempty"`
+	Args                  []string              `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	Env                   []*PromotionJobEnvVar `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty"`
+	ServiceAccountName    string                `protobuf:"bytes,5,opt,name=service_account_name,json=serviceAccountName,proto3" json:"service_account_name,omitempty"`
+	ActiveDeadlineSeconds *int64                `protobuf:"varint,6,opt,name=active_deadline_seconds,json=activeDeadlineSeconds,proto3,oneof" json:"active_deadline_seconds,omitempty"`
 }
 
-func (x *VerifiedStage) Reset() {
-	*x = VerifiedStage{}
+func (x *PromotionJob) Reset() {
+	*x = PromotionJob{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2579,13 +2698,13 @@ func (x *VerifiedStage) Reset() {
 	}
 }
 
-func (x *VerifiedStage) String() string {
+func (x *PromotionJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifiedStage) ProtoMessage() {}
+func (*PromotionJob) ProtoMessage() {}
 
-func (x *VerifiedStage) ProtoReflect() protoreflect.Message {
+func (x *PromotionJob) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2597,19 +2716,64 @@ func (x *VerifiedStage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifiedStage.ProtoReflect.Descriptor instead.
-func (*VerifiedStage) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionJob.ProtoReflect.Descriptor instead.
+func (*PromotionJob) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{40}
 }
 
-type ApprovedStage struct {
+func (x *PromotionJob) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *PromotionJob) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *PromotionJob) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *PromotionJob) GetEnv() []*PromotionJobEnvVar {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *PromotionJob) GetServiceAccountName() string {
+	if x != nil {
+		return x.ServiceAccountName
+	}
+	return ""
+}
+
+func (x *PromotionJob) GetActiveDeadlineSeconds() int64 {
+	if x != nil && x.ActiveDeadlineSeconds != nil {
+		return *x.ActiveDeadlineSeconds
+	}
+	return 0
+}
+
+type PromotionJobEnvVar struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ApprovedStage) Reset() {
-	*x = ApprovedStage{}
+func (x *PromotionJobEnvVar) Reset() {
+	*x = PromotionJobEnvVar{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2617,13 +2781,13 @@ func (x *ApprovedStage) Reset() {
 	}
 }
 
-func (x *ApprovedStage) String() string {
+func (x *PromotionJobEnvVar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApprovedStage) ProtoMessage() {}
+func (*PromotionJobEnvVar) ProtoMessage() {}
 
-func (x *ApprovedStage) ProtoReflect() protoreflect.Message {
+func (x *PromotionJobEnvVar) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2635,26 +2799,39 @@ func (x *ApprovedStage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApprovedStage.ProtoReflect.Descriptor instead.
-func (*ApprovedStage) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionJobEnvVar.ProtoReflect.Descriptor instead.
+func (*PromotionJobEnvVar) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{41}
 }
 
-type FreightReference struct {
+func (x *PromotionJobEnvVar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromotionJobEnvVar) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type PromotionPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstSeen        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=first_seen,json=firstSeen,proto3,oneof" json:"first_seen,omitempty"`
-	Commits          []*GitCommit           `protobuf:"bytes,4,rep,name=commits,proto3" json:"commits,omitempty"`
-	Images           []*Image               `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
-	Charts           []*Chart               `protobuf:"bytes,6,rep,name=charts,proto3" json:"charts,omitempty"`
-	VerificationInfo *VerificationInfo      `protobuf:"bytes,7,opt,name=verification_info,json=verificationInfo,proto3,oneof" json:"verification_info,omitempty"`
+	Stage                string             `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
+	AutoPromotionEnabled bool               `protobuf:"varint,5,opt,name=auto_promotion_enabled,json=autoPromotionEnabled,proto3" json:"auto_promotion_enabled,omitempty"`
+	QueuePolicy          *string            `protobuf:"bytes,6,opt,name=queue_policy,json=queuePolicy,proto3,oneof" json:"queue_policy,omitempty"`
+	Windows              []*PromotionWindow `protobuf:"bytes,7,rep,name=windows,proto3" json:"windows,omitempty"`
+	ApprovalPolicy       *ApprovalPolicy    `protobuf:"bytes,8,opt,name=approval_policy,json=approvalPolicy,proto3,oneof" json:"approval_policy,omitempty"`
 }
 
-func (x *FreightReference) Reset() {
-	*x = FreightReference{}
+func (x *PromotionPolicy) Reset() {
+	*x = PromotionPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2662,13 +2839,13 @@ func (x *FreightReference) Reset() {
 	}
 }
 
-func (x *FreightReference) String() string {
+func (x *PromotionPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FreightReference) ProtoMessage() {}
+func (*PromotionPolicy) ProtoMessage() {}
 
-func (x *FreightReference) ProtoReflect() protoreflect.Message {
+func (x *PromotionPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2680,68 +2857,60 @@ func (x *FreightReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FreightReference.ProtoReflect.Descriptor instead.
-func (*FreightReference) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionPolicy.ProtoReflect.Descriptor instead.
+func (*PromotionPolicy) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *FreightReference) GetId() string {
+func (x *PromotionPolicy) GetStage() string {
 	if x != nil {
-		return x.Id
+		return x.Stage
 	}
 	return ""
 }
 
-func (x *FreightReference) GetFirstSeen() *timestamppb.Timestamp {
-	if x != nil {
-		return x.FirstSeen
-	}
-	return nil
-}
-
-func (x *FreightReference) GetCommits() []*GitCommit {
+func (x *PromotionPolicy) GetAutoPromotionEnabled() bool {
 	if x != nil {
-		return x.Commits
+		return x.AutoPromotionEnabled
 	}
-	return nil
+	return false
 }
 
-func (x *FreightReference) GetImages() []*Image {
-	if x != nil {
-		return x.Images
+func (x *PromotionPolicy) GetQueuePolicy() string {
+	if x != nil && x.QueuePolicy != nil {
+		return *x.QueuePolicy
 	}
-	return nil
+	return ""
 }
 
-func (x *FreightReference) GetCharts() []*Chart {
+func (x *PromotionPolicy) GetWindows() []*PromotionWindow {
 	if x != nil {
-		return x.Charts
+		return x.Windows
 	}
 	return nil
 }
 
-func (x *FreightReference) GetVerificationInfo() *VerificationInfo {
+func (x *PromotionPolicy) GetApprovalPolicy() *ApprovalPolicy {
 	if x != nil {
-		return x.VerificationInfo
+		return x.ApprovalPolicy
 	}
 	return nil
 }
 
-type StageStatus struct {
+type ApprovalPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CurrentFreight   *FreightReference   `protobuf:"bytes,2,opt,name=current_freight,json=currentFreight,proto3,oneof" json:"current_freight,omitempty"`
-	History          []*FreightReference `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
-	Error            string              `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	Health           *Health             `protobuf:"bytes,5,opt,name=health,proto3,oneof" json:"health,omitempty"`
-	CurrentPromotion *PromotionInfo      `protobuf:"bytes,6,opt,name=current_promotion,json=currentPromotion,proto3,oneof" json:"current_promotion,omitempty"`
-	Phase            string              `protobuf:"bytes,7,opt,name=phase,proto3" json:"phase,omitempty"`
+	RequiredApprovals     int32    `protobuf:"varint,1,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	Groups                []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	Subjects              []string `protobuf:"bytes,3,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	Expiry                *string  `protobuf:"bytes,4,opt,name=expiry,proto3,oneof" json:"expiry,omitempty"`
+	ExcludeFreightAuthors bool     `protobuf:"varint,5,opt,name=exclude_freight_authors,json=excludeFreightAuthors,proto3" json:"exclude_freight_authors,omitempty"`
 }
 
-func (x *StageStatus) Reset() {
-	*x = StageStatus{}
+func (x *ApprovalPolicy) Reset() {
+	*x = ApprovalPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2749,13 +2918,13 @@ func (x *StageStatus) Reset() {
 	}
 }
 
-func (x *StageStatus) String() string {
+func (x *ApprovalPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageStatus) ProtoMessage() {}
+func (*ApprovalPolicy) ProtoMessage() {}
 
-func (x *StageStatus) ProtoReflect() protoreflect.Message {
+func (x *ApprovalPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2767,63 +2936,60 @@ func (x *StageStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageStatus.ProtoReflect.Descriptor instead.
-func (*StageStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApprovalPolicy.ProtoReflect.Descriptor instead.
+func (*ApprovalPolicy) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *StageStatus) GetCurrentFreight() *FreightReference {
+func (x *ApprovalPolicy) GetRequiredApprovals() int32 {
 	if x != nil {
-		return x.CurrentFreight
+		return x.RequiredApprovals
 	}
-	return nil
+	return 0
 }
 
-func (x *StageStatus) GetHistory() []*FreightReference {
+func (x *ApprovalPolicy) GetGroups() []string {
 	if x != nil {
-		return x.History
+		return x.Groups
 	}
 	return nil
 }
 
-func (x *StageStatus) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-func (x *StageStatus) GetHealth() *Health {
+func (x *ApprovalPolicy) GetSubjects() []string {
 	if x != nil {
-		return x.Health
+		return x.Subjects
 	}
 	return nil
 }
 
-func (x *StageStatus) GetCurrentPromotion() *PromotionInfo {
-	if x != nil {
-		return x.CurrentPromotion
+func (x *ApprovalPolicy) GetExpiry() string {
+	if x != nil && x.Expiry != nil {
+		return *x.Expiry
 	}
-	return nil
+	return ""
 }
 
-func (x *StageStatus) GetPhase() string {
+func (x *ApprovalPolicy) GetExcludeFreightAuthors() bool {
 	if x != nil {
-		return x.Phase
+		return x.ExcludeFreightAuthors
 	}
-	return ""
+	return false
 }
 
-type StageSubscription struct {
+type PromotionWindow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind     string  `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Schedule string  `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Duration string  `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	TimeZone *string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
+	Reason   *string `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 }
 
-func (x *StageSubscription) Reset() {
-	*x = StageSubscription{}
+func (x *PromotionWindow) Reset() {
+	*x = PromotionWindow{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2831,13 +2997,13 @@ func (x *StageSubscription) Reset() {
 	}
 }
 
-func (x *StageSubscription) String() string {
+func (x *PromotionWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StageSubscription) ProtoMessage() {}
+func (*PromotionWindow) ProtoMessage() {}
 
-func (x *StageSubscription) ProtoReflect() protoreflect.Message {
+func (x *PromotionWindow) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2849,29 +3015,59 @@ func (x *StageSubscription) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StageSubscription.ProtoReflect.Descriptor instead.
-func (*StageSubscription) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionWindow.ProtoReflect.Descriptor instead.
+func (*PromotionWindow) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *StageSubscription) GetName() string {
+func (x *PromotionWindow) GetKind() string {
 	if x != nil {
-		return x.Name
+		return x.Kind
 	}
 	return ""
 }
 
-type Subscriptions struct {
+func (x *PromotionWindow) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *PromotionWindow) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *PromotionWindow) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *PromotionWindow) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type PromotionSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UpstreamStages []*StageSubscription `protobuf:"bytes,2,rep,name=upstream_stages,json=upstreamStages,proto3" json:"upstream_stages,omitempty"`
-	Warehouse      string               `protobuf:"bytes,3,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	Stage     string                 `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Freight   string                 `protobuf:"bytes,2,opt,name=freight,proto3" json:"freight,omitempty"`
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=not_before,json=notBefore,proto3,oneof" json:"not_before,omitempty"`
+	Priority  int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *Subscriptions) Reset() {
-	*x = Subscriptions{}
+func (x *PromotionSpec) Reset() {
+	*x = PromotionSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2879,13 +3075,13 @@ func (x *Subscriptions) Reset() {
 	}
 }
 
-func (x *Subscriptions) String() string {
+func (x *PromotionSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Subscriptions) ProtoMessage() {}
+func (*PromotionSpec) ProtoMessage() {}
 
-func (x *Subscriptions) ProtoReflect() protoreflect.Message {
+func (x *PromotionSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2897,39 +3093,53 @@ func (x *Subscriptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Subscriptions.ProtoReflect.Descriptor instead.
-func (*Subscriptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionSpec.ProtoReflect.Descriptor instead.
+func (*PromotionSpec) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Subscriptions) GetUpstreamStages() []*StageSubscription {
+func (x *PromotionSpec) GetStage() string {
 	if x != nil {
-		return x.UpstreamStages
+		return x.Stage
 	}
-	return nil
+	return ""
 }
 
-func (x *Subscriptions) GetWarehouse() string {
+func (x *PromotionSpec) GetFreight() string {
 	if x != nil {
-		return x.Warehouse
+		return x.Freight
 	}
 	return ""
 }
 
-type Warehouse struct {
+func (x *PromotionSpec) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *PromotionSpec) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type PromotionStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApiVersion string             `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	Kind       string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	Metadata   *metav1.ObjectMeta `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec       *WarehouseSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status     *WarehouseStatus   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Phase              string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message            string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Metadata           map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Hooks              []*PromotionHookResult `protobuf:"bytes,4,rep,name=hooks,proto3" json:"hooks,omitempty"`
+	ControllerInstance string                 `protobuf:"bytes,5,opt,name=controller_instance,json=controllerInstance,proto3" json:"controller_instance,omitempty"`
 }
 
-func (x *Warehouse) Reset() {
-	*x = Warehouse{}
+func (x *PromotionStatus) Reset() {
+	*x = PromotionStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2937,13 +3147,13 @@ func (x *Warehouse) Reset() {
 	}
 }
 
-func (x *Warehouse) String() string {
+func (x *PromotionStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Warehouse) ProtoMessage() {}
+func (*PromotionStatus) ProtoMessage() {}
 
-func (x *Warehouse) ProtoReflect() protoreflect.Message {
+func (x *PromotionStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2955,56 +3165,62 @@ func (x *Warehouse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
-func (*Warehouse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionStatus.ProtoReflect.Descriptor instead.
+func (*PromotionStatus) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *Warehouse) GetApiVersion() string {
+func (x *PromotionStatus) GetPhase() string {
 	if x != nil {
-		return x.ApiVersion
+		return x.Phase
 	}
 	return ""
 }
 
-func (x *Warehouse) GetKind() string {
+func (x *PromotionStatus) GetMessage() string {
 	if x != nil {
-		return x.Kind
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Warehouse) GetMetadata() *metav1.ObjectMeta {
+func (x *PromotionStatus) GetMetadata() map[string]string {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-func (x *Warehouse) GetSpec() *WarehouseSpec {
+func (x *PromotionStatus) GetHooks() []*PromotionHookResult {
 	if x != nil {
-		return x.Spec
+		return x.Hooks
 	}
 	return nil
 }
 
-func (x *Warehouse) GetStatus() *WarehouseStatus {
+func (x *PromotionStatus) GetControllerInstance() string {
 	if x != nil {
-		return x.Status
+		return x.ControllerInstance
 	}
-	return nil
+	return ""
 }
 
-type WarehouseSpec struct {
+type PromotionHookResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subscriptions []*RepoSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type       string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Phase      string                 `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message    string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Job        string                 `protobuf:"bytes,5,opt,name=job,proto3" json:"job,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
 }
 
-func (x *WarehouseSpec) Reset() {
-	*x = WarehouseSpec{}
+func (x *PromotionHookResult) Reset() {
+	*x = PromotionHookResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1alpha1_types_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3012,13 +3228,13 @@ func (x *WarehouseSpec) Reset() {
 	}
 }
 
-func (x *WarehouseSpec) String() string {
+func (x *PromotionHookResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WarehouseSpec) ProtoMessage() {}
+func (*PromotionHookResult) ProtoMessage() {}
 
-func (x *WarehouseSpec) ProtoReflect() protoreflect.Message {
+func (x *PromotionHookResult) ProtoReflect() protoreflect.Message {
 	mi := &file_v1alpha1_types_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3030,100 +3246,88 @@ func (x *WarehouseSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WarehouseSpec.ProtoReflect.Descriptor instead.
-func (*WarehouseSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromotionHookResult.ProtoReflect.Descriptor instead.
+func (*PromotionHookResult) Descriptor() ([]byte, []int) {
 	return file_v1alpha1_types_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *WarehouseSpec) GetSubscriptions() []*RepoSubscription {
+func (x *PromotionHookResult) GetName() string {
 	if x != nil {
-		return x.Subscriptions
+		return x.Name
 	}
-	return nil
-}
-
-type WarehouseStatus struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Error              string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	ObservedGeneration int64  `protobuf:"varint,2,opt,name=observed_generation,json=observedGeneration,proto3" json:"observed_generation,omitempty"`
+	return ""
 }
 
-func (x *WarehouseStatus) Reset() {
-	*x = WarehouseStatus{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[48]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PromotionHookResult) GetType() string {
+	if x != nil {
+		return x.Type
 	}
+	return ""
 }
 
-func (x *WarehouseStatus) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PromotionHookResult) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
 }
 
-func (*WarehouseStatus) ProtoMessage() {}
-
-func (x *WarehouseStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[48]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PromotionHookResult) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use WarehouseStatus.ProtoReflect.Descriptor instead.
-func (*WarehouseStatus) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{48}
+func (x *PromotionHookResult) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
 }
 
-func (x *WarehouseStatus) GetError() string {
+func (x *PromotionHookResult) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Error
+		return x.StartedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *WarehouseStatus) GetObservedGeneration() int64 {
+func (x *PromotionHookResult) GetFinishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ObservedGeneration
+		return x.FinishedAt
 	}
-	return 0
+	return nil
 }
 
-type Verification struct {
+type RepoSubscription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AnalysisTemplates   []*AnalysisTemplateReference `protobuf:"bytes,1,rep,name=analysis_templates,json=analysisTemplates,proto3" json:"analysis_templates,omitempty"`
-	AnalysisRunMetadata *AnalysisRunMetadata         `protobuf:"bytes,2,opt,name=analysis_run_metadata,json=analysisRunMetadata,proto3,oneof" json:"analysis_run_metadata,omitempty"`
-	Args                []*AnalysisRunArgument       `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	Git         *GitSubscription         `protobuf:"bytes,1,opt,name=git,proto3,oneof" json:"git,omitempty"`
+	Image       *ImageSubscription       `protobuf:"bytes,2,opt,name=image,proto3,oneof" json:"image,omitempty"`
+	Chart       *ChartSubscription       `protobuf:"bytes,3,opt,name=chart,proto3,oneof" json:"chart,omitempty"`
+	OciArtifact *OCIArtifactSubscription `protobuf:"bytes,4,opt,name=oci_artifact,json=ociArtifact,proto3,oneof" json:"oci_artifact,omitempty"`
 }
 
-func (x *Verification) Reset() {
-	*x = Verification{}
+func (x *RepoSubscription) Reset() {
+	*x = RepoSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[49]
+		mi := &file_v1alpha1_types_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Verification) String() string {
+func (x *RepoSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Verification) ProtoMessage() {}
+func (*RepoSubscription) ProtoMessage() {}
 
-func (x *Verification) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[49]
+func (x *RepoSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3134,57 +3338,68 @@ func (x *Verification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Verification.ProtoReflect.Descriptor instead.
-func (*Verification) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use RepoSubscription.ProtoReflect.Descriptor instead.
+func (*RepoSubscription) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *Verification) GetAnalysisTemplates() []*AnalysisTemplateReference {
+func (x *RepoSubscription) GetGit() *GitSubscription {
 	if x != nil {
-		return x.AnalysisTemplates
+		return x.Git
 	}
 	return nil
 }
 
-func (x *Verification) GetAnalysisRunMetadata() *AnalysisRunMetadata {
+func (x *RepoSubscription) GetImage() *ImageSubscription {
 	if x != nil {
-		return x.AnalysisRunMetadata
+		return x.Image
 	}
 	return nil
 }
 
-func (x *Verification) GetArgs() []*AnalysisRunArgument {
+func (x *RepoSubscription) GetChart() *ChartSubscription {
 	if x != nil {
-		return x.Args
+		return x.Chart
 	}
 	return nil
 }
 
-type AnalysisTemplateReference struct {
+func (x *RepoSubscription) GetOciArtifact() *OCIArtifactSubscription {
+	if x != nil {
+		return x.OciArtifact
+	}
+	return nil
+}
+
+type Stage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ApiVersion string             `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind       string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *metav1.ObjectMeta `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec       *StageSpec         `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status     *StageStatus       `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AnalysisTemplateReference) Reset() {
-	*x = AnalysisTemplateReference{}
+func (x *Stage) Reset() {
+	*x = Stage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[50]
+		mi := &file_v1alpha1_types_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnalysisTemplateReference) String() string {
+func (x *Stage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalysisTemplateReference) ProtoMessage() {}
+func (*Stage) ProtoMessage() {}
 
-func (x *AnalysisTemplateReference) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[50]
+func (x *Stage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3195,99 +3410,72 @@ func (x *AnalysisTemplateReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalysisTemplateReference.ProtoReflect.Descriptor instead.
-func (*AnalysisTemplateReference) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use Stage.ProtoReflect.Descriptor instead.
+func (*Stage) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *AnalysisTemplateReference) GetName() string {
+func (x *Stage) GetApiVersion() string {
 	if x != nil {
-		return x.Name
+		return x.ApiVersion
 	}
 	return ""
 }
 
-type AnalysisRunMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Labels      map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Annotations map[string]string `protobuf:"bytes,2,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
-
-func (x *AnalysisRunMetadata) Reset() {
-	*x = AnalysisRunMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[51]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Stage) GetKind() string {
+	if x != nil {
+		return x.Kind
 	}
+	return ""
 }
 
-func (x *AnalysisRunMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AnalysisRunMetadata) ProtoMessage() {}
-
-func (x *AnalysisRunMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[51]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Stage) GetMetadata() *metav1.ObjectMeta {
+	if x != nil {
+		return x.Metadata
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AnalysisRunMetadata.ProtoReflect.Descriptor instead.
-func (*AnalysisRunMetadata) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{51}
+	return nil
 }
 
-func (x *AnalysisRunMetadata) GetLabels() map[string]string {
+func (x *Stage) GetSpec() *StageSpec {
 	if x != nil {
-		return x.Labels
+		return x.Spec
 	}
 	return nil
 }
 
-func (x *AnalysisRunMetadata) GetAnnotations() map[string]string {
+func (x *Stage) GetStatus() *StageStatus {
 	if x != nil {
-		return x.Annotations
+		return x.Status
 	}
 	return nil
 }
 
-type AnalysisRunArgument struct {
+type StageList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Metadata *metav1.ListMeta `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Items    []*Stage         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *AnalysisRunArgument) Reset() {
-	*x = AnalysisRunArgument{}
+func (x *StageList) Reset() {
+	*x = StageList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[52]
+		mi := &file_v1alpha1_types_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnalysisRunArgument) String() string {
+func (x *StageList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalysisRunArgument) ProtoMessage() {}
+func (*StageList) ProtoMessage() {}
 
-func (x *AnalysisRunArgument) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[52]
+func (x *StageList) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3298,52 +3486,53 @@ func (x *AnalysisRunArgument) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalysisRunArgument.ProtoReflect.Descriptor instead.
-func (*AnalysisRunArgument) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use StageList.ProtoReflect.Descriptor instead.
+func (*StageList) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AnalysisRunArgument) GetName() string {
+func (x *StageList) GetMetadata() *metav1.ListMeta {
 	if x != nil {
-		return x.Name
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *AnalysisRunArgument) GetValue() string {
+func (x *StageList) GetItems() []*Stage {
 	if x != nil {
-		return x.Value
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type VerificationInfo struct {
+type StageSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AnalysisRun *AnalysisRunReference `protobuf:"bytes,1,opt,name=analysis_run,json=analysisRun,proto3" json:"analysis_run,omitempty"`
-	Phase       string                `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
-	Message     string                `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Subscriptions       *Subscriptions       `protobuf:"bytes,1,opt,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	PromotionMechanisms *PromotionMechanisms `protobuf:"bytes,2,opt,name=promotion_mechanisms,json=promotionMechanisms,proto3" json:"promotion_mechanisms,omitempty"`
+	Verification        *Verification        `protobuf:"bytes,3,opt,name=verification,proto3,oneof" json:"verification,omitempty"`
+	HistoryDepth        int32                `protobuf:"varint,4,opt,name=history_depth,json=historyDepth,proto3" json:"history_depth,omitempty"`
 }
 
-func (x *VerificationInfo) Reset() {
-	*x = VerificationInfo{}
+func (x *StageSpec) Reset() {
+	*x = StageSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[53]
+		mi := &file_v1alpha1_types_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VerificationInfo) String() string {
+func (x *StageSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerificationInfo) ProtoMessage() {}
+func (*StageSpec) ProtoMessage() {}
 
-func (x *VerificationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[53]
+func (x *StageSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3354,59 +3543,72 @@ func (x *VerificationInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerificationInfo.ProtoReflect.Descriptor instead.
-func (*VerificationInfo) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use StageSpec.ProtoReflect.Descriptor instead.
+func (*StageSpec) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *VerificationInfo) GetAnalysisRun() *AnalysisRunReference {
+func (x *StageSpec) GetSubscriptions() *Subscriptions {
 	if x != nil {
-		return x.AnalysisRun
+		return x.Subscriptions
 	}
 	return nil
 }
 
-func (x *VerificationInfo) GetPhase() string {
+func (x *StageSpec) GetPromotionMechanisms() *PromotionMechanisms {
 	if x != nil {
-		return x.Phase
+		return x.PromotionMechanisms
 	}
-	return ""
+	return nil
 }
 
-func (x *VerificationInfo) GetMessage() string {
+func (x *StageSpec) GetVerification() *Verification {
 	if x != nil {
-		return x.Message
+		return x.Verification
 	}
-	return ""
+	return nil
 }
 
-type AnalysisRunReference struct {
+func (x *StageSpec) GetHistoryDepth() int32 {
+	if x != nil {
+		return x.HistoryDepth
+	}
+	return 0
+}
+
+type Freight struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Phase     string `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	ApiVersion   string             `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind         string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata     *metav1.ObjectMeta `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Id           string             `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Commits      []*GitCommit       `protobuf:"bytes,5,rep,name=commits,proto3" json:"commits,omitempty"`
+	Images       []*Image           `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
+	Charts       []*Chart           `protobuf:"bytes,7,rep,name=charts,proto3" json:"charts,omitempty"`
+	Status       *FreightStatus     `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	OciArtifacts []*OCIArtifact     `protobuf:"bytes,9,rep,name=oci_artifacts,json=ociArtifacts,proto3" json:"oci_artifacts,omitempty"`
 }
 
-func (x *AnalysisRunReference) Reset() {
-	*x = AnalysisRunReference{}
+func (x *Freight) Reset() {
+	*x = Freight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1alpha1_types_proto_msgTypes[54]
+		mi := &file_v1alpha1_types_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnalysisRunReference) String() string {
+func (x *Freight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalysisRunReference) ProtoMessage() {}
+func (*Freight) ProtoMessage() {}
 
-func (x *AnalysisRunReference) ProtoReflect() protoreflect.Message {
-	mi := &file_v1alpha1_types_proto_msgTypes[54]
+func (x *Freight) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3417,54 +3619,1750 @@ func (x *AnalysisRunReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalysisRunReference.ProtoReflect.Descriptor instead.
-func (*AnalysisRunReference) Descriptor() ([]byte, []int) {
-	return file_v1alpha1_types_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use Freight.ProtoReflect.Descriptor instead.
+func (*Freight) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AnalysisRunReference) GetNamespace() string {
+func (x *Freight) GetApiVersion() string {
 	if x != nil {
-		return x.Namespace
+		return x.ApiVersion
 	}
 	return ""
 }
 
-func (x *AnalysisRunReference) GetName() string {
+func (x *Freight) GetKind() string {
 	if x != nil {
-		return x.Name
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *AnalysisRunReference) GetPhase() string {
+func (x *Freight) GetMetadata() *metav1.ObjectMeta {
 	if x != nil {
-		return x.Phase
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Freight) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-var File_v1alpha1_types_proto protoreflect.FileDescriptor
+func (x *Freight) GetCommits() []*GitCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
 
-var file_v1alpha1_types_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e,
-	0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x12, 0x6d, 0x65, 0x74, 0x61, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x01, 0x0a, 0x0f, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44,
-	0x41, 0x70, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x61,
-	0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x63,
-	0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f,
-	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61,
+func (x *Freight) GetImages() []*Image {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Freight) GetCharts() []*Chart {
+	if x != nil {
+		return x.Charts
+	}
+	return nil
+}
+
+func (x *Freight) GetStatus() *FreightStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *Freight) GetOciArtifacts() []*OCIArtifact {
+	if x != nil {
+		return x.OciArtifacts
+	}
+	return nil
+}
+
+type FreightStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VerifiedIn  map[string]*VerifiedStage `protobuf:"bytes,1,rep,name=verified_in,json=verifiedIn,proto3" json:"verified_in,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ApprovedFor map[string]*ApprovedStage `protobuf:"bytes,2,rep,name=approved_for,json=approvedFor,proto3" json:"approved_for,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FailedIn    map[string]*FailedStage   `protobuf:"bytes,3,rep,name=failed_in,json=failedIn,proto3" json:"failed_in,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *FreightStatus) Reset() {
+	*x = FreightStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreightStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreightStatus) ProtoMessage() {}
+
+func (x *FreightStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreightStatus.ProtoReflect.Descriptor instead.
+func (*FreightStatus) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FreightStatus) GetVerifiedIn() map[string]*VerifiedStage {
+	if x != nil {
+		return x.VerifiedIn
+	}
+	return nil
+}
+
+func (x *FreightStatus) GetApprovedFor() map[string]*ApprovedStage {
+	if x != nil {
+		return x.ApprovedFor
+	}
+	return nil
+}
+
+func (x *FreightStatus) GetFailedIn() map[string]*FailedStage {
+	if x != nil {
+		return x.FailedIn
+	}
+	return nil
+}
+
+type VerifiedStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VerifiedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=verified_at,json=verifiedAt,proto3,oneof" json:"verified_at,omitempty"`
+}
+
+func (x *VerifiedStage) Reset() {
+	*x = VerifiedStage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifiedStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifiedStage) ProtoMessage() {}
+
+func (x *VerifiedStage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifiedStage.ProtoReflect.Descriptor instead.
+func (*VerifiedStage) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *VerifiedStage) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+type ApprovedStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approvals []*Approval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+}
+
+func (x *ApprovedStage) Reset() {
+	*x = ApprovedStage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApprovedStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovedStage) ProtoMessage() {}
+
+func (x *ApprovedStage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovedStage.ProtoReflect.Descriptor instead.
+func (*ApprovedStage) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ApprovedStage) GetApprovals() []*Approval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type Approval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject    string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email      *string                `protobuf:"bytes,2,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	ApprovedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=approved_at,json=approvedAt,proto3,oneof" json:"approved_at,omitempty"`
+	Comment    *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+}
+
+func (x *Approval) Reset() {
+	*x = Approval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Approval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Approval) ProtoMessage() {}
+
+func (x *Approval) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Approval.ProtoReflect.Descriptor instead.
+func (*Approval) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Approval) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Approval) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *Approval) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+func (x *Approval) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type FailedStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FailedStage) Reset() {
+	*x = FailedStage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailedStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedStage) ProtoMessage() {}
+
+func (x *FailedStage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedStage.ProtoReflect.Descriptor instead.
+func (*FailedStage) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{57}
+}
+
+type FreightReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstSeen        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=first_seen,json=firstSeen,proto3,oneof" json:"first_seen,omitempty"`
+	Commits          []*GitCommit           `protobuf:"bytes,4,rep,name=commits,proto3" json:"commits,omitempty"`
+	Images           []*Image               `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
+	Charts           []*Chart               `protobuf:"bytes,6,rep,name=charts,proto3" json:"charts,omitempty"`
+	VerificationInfo *VerificationInfo      `protobuf:"bytes,7,opt,name=verification_info,json=verificationInfo,proto3,oneof" json:"verification_info,omitempty"`
+	Promotion        string                 `protobuf:"bytes,8,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	PromotedBy       string                 `protobuf:"bytes,9,opt,name=promoted_by,json=promotedBy,proto3" json:"promoted_by,omitempty"`
+	PromotedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=promoted_at,json=promotedAt,proto3,oneof" json:"promoted_at,omitempty"`
+	OciArtifacts     []*OCIArtifact         `protobuf:"bytes,11,rep,name=oci_artifacts,json=ociArtifacts,proto3" json:"oci_artifacts,omitempty"`
+}
+
+func (x *FreightReference) Reset() {
+	*x = FreightReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreightReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreightReference) ProtoMessage() {}
+
+func (x *FreightReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreightReference.ProtoReflect.Descriptor instead.
+func (*FreightReference) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FreightReference) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FreightReference) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *FreightReference) GetCommits() []*GitCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *FreightReference) GetImages() []*Image {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *FreightReference) GetCharts() []*Chart {
+	if x != nil {
+		return x.Charts
+	}
+	return nil
+}
+
+func (x *FreightReference) GetVerificationInfo() *VerificationInfo {
+	if x != nil {
+		return x.VerificationInfo
+	}
+	return nil
+}
+
+func (x *FreightReference) GetPromotion() string {
+	if x != nil {
+		return x.Promotion
+	}
+	return ""
+}
+
+func (x *FreightReference) GetPromotedBy() string {
+	if x != nil {
+		return x.PromotedBy
+	}
+	return ""
+}
+
+func (x *FreightReference) GetPromotedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PromotedAt
+	}
+	return nil
+}
+
+func (x *FreightReference) GetOciArtifacts() []*OCIArtifact {
+	if x != nil {
+		return x.OciArtifacts
+	}
+	return nil
+}
+
+type StageStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentFreight   *FreightReference   `protobuf:"bytes,2,opt,name=current_freight,json=currentFreight,proto3,oneof" json:"current_freight,omitempty"`
+	History          []*FreightReference `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
+	Error            string              `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Health           *Health             `protobuf:"bytes,5,opt,name=health,proto3,oneof" json:"health,omitempty"`
+	CurrentPromotion *PromotionInfo      `protobuf:"bytes,6,opt,name=current_promotion,json=currentPromotion,proto3,oneof" json:"current_promotion,omitempty"`
+	Phase            string              `protobuf:"bytes,7,opt,name=phase,proto3" json:"phase,omitempty"`
+	Lock             *StageLock          `protobuf:"bytes,8,opt,name=lock,proto3,oneof" json:"lock,omitempty"`
+	Message          string              `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *StageStatus) Reset() {
+	*x = StageStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StageStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageStatus) ProtoMessage() {}
+
+func (x *StageStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageStatus.ProtoReflect.Descriptor instead.
+func (*StageStatus) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *StageStatus) GetCurrentFreight() *FreightReference {
+	if x != nil {
+		return x.CurrentFreight
+	}
+	return nil
+}
+
+func (x *StageStatus) GetHistory() []*FreightReference {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *StageStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StageStatus) GetHealth() *Health {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *StageStatus) GetCurrentPromotion() *PromotionInfo {
+	if x != nil {
+		return x.CurrentPromotion
+	}
+	return nil
+}
+
+func (x *StageStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *StageStatus) GetLock() *StageLock {
+	if x != nil {
+		return x.Lock
+	}
+	return nil
+}
+
+func (x *StageStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type StageLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason   string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	LockedBy string                 `protobuf:"bytes,2,opt,name=locked_by,json=lockedBy,proto3" json:"locked_by,omitempty"`
+	LockedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=locked_at,json=lockedAt,proto3,oneof" json:"locked_at,omitempty"`
+}
+
+func (x *StageLock) Reset() {
+	*x = StageLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StageLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageLock) ProtoMessage() {}
+
+func (x *StageLock) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageLock.ProtoReflect.Descriptor instead.
+func (*StageLock) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *StageLock) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StageLock) GetLockedBy() string {
+	if x != nil {
+		return x.LockedBy
+	}
+	return ""
+}
+
+func (x *StageLock) GetLockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedAt
+	}
+	return nil
+}
+
+type StageSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RequiredSoakTime *string `protobuf:"bytes,2,opt,name=required_soak_time,json=requiredSoakTime,proto3,oneof" json:"required_soak_time,omitempty"`
+}
+
+func (x *StageSubscription) Reset() {
+	*x = StageSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StageSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageSubscription) ProtoMessage() {}
+
+func (x *StageSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageSubscription.ProtoReflect.Descriptor instead.
+func (*StageSubscription) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *StageSubscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StageSubscription) GetRequiredSoakTime() string {
+	if x != nil && x.RequiredSoakTime != nil {
+		return *x.RequiredSoakTime
+	}
+	return ""
+}
+
+type Subscriptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpstreamStages     []*StageSubscription `protobuf:"bytes,2,rep,name=upstream_stages,json=upstreamStages,proto3" json:"upstream_stages,omitempty"`
+	Warehouse          string               `protobuf:"bytes,3,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	UpstreamStagesMode *string              `protobuf:"bytes,4,opt,name=upstream_stages_mode,json=upstreamStagesMode,proto3,oneof" json:"upstream_stages_mode,omitempty"`
+	Warehouses         []string             `protobuf:"bytes,5,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+}
+
+func (x *Subscriptions) Reset() {
+	*x = Subscriptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscriptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscriptions) ProtoMessage() {}
+
+func (x *Subscriptions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscriptions.ProtoReflect.Descriptor instead.
+func (*Subscriptions) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Subscriptions) GetUpstreamStages() []*StageSubscription {
+	if x != nil {
+		return x.UpstreamStages
+	}
+	return nil
+}
+
+func (x *Subscriptions) GetWarehouse() string {
+	if x != nil {
+		return x.Warehouse
+	}
+	return ""
+}
+
+func (x *Subscriptions) GetUpstreamStagesMode() string {
+	if x != nil && x.UpstreamStagesMode != nil {
+		return *x.UpstreamStagesMode
+	}
+	return ""
+}
+
+func (x *Subscriptions) GetWarehouses() []string {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+type Warehouse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string             `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind       string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *metav1.ObjectMeta `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec       *WarehouseSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status     *WarehouseStatus   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Warehouse) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *Warehouse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Warehouse) GetMetadata() *metav1.ObjectMeta {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Warehouse) GetSpec() *WarehouseSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Warehouse) GetStatus() *WarehouseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type WarehouseSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscriptions   []*RepoSubscription   `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Interval        *string               `protobuf:"bytes,2,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	FreightAssembly *FreightAssemblyRules `protobuf:"bytes,3,opt,name=freight_assembly,json=freightAssembly,proto3,oneof" json:"freight_assembly,omitempty"`
+}
+
+func (x *WarehouseSpec) Reset() {
+	*x = WarehouseSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarehouseSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseSpec) ProtoMessage() {}
+
+func (x *WarehouseSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseSpec.ProtoReflect.Descriptor instead.
+func (*WarehouseSpec) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *WarehouseSpec) GetSubscriptions() []*RepoSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *WarehouseSpec) GetInterval() string {
+	if x != nil && x.Interval != nil {
+		return *x.Interval
+	}
+	return ""
+}
+
+func (x *WarehouseSpec) GetFreightAssembly() *FreightAssemblyRules {
+	if x != nil {
+		return x.FreightAssembly
+	}
+	return nil
+}
+
+type FreightAssemblyRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SettleTime  *string                      `protobuf:"bytes,1,opt,name=settle_time,json=settleTime,proto3,oneof" json:"settle_time,omitempty"`
+	Constraints []*FreightAssemblyConstraint `protobuf:"bytes,2,rep,name=constraints,proto3" json:"constraints,omitempty"`
+}
+
+func (x *FreightAssemblyRules) Reset() {
+	*x = FreightAssemblyRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreightAssemblyRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreightAssemblyRules) ProtoMessage() {}
+
+func (x *FreightAssemblyRules) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreightAssemblyRules.ProtoReflect.Descriptor instead.
+func (*FreightAssemblyRules) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *FreightAssemblyRules) GetSettleTime() string {
+	if x != nil && x.SettleTime != nil {
+		return *x.SettleTime
+	}
+	return ""
+}
+
+func (x *FreightAssemblyRules) GetConstraints() []*FreightAssemblyConstraint {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+type FreightAssemblyConstraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Match []*ArtifactVersionReference `protobuf:"bytes,1,rep,name=match,proto3" json:"match,omitempty"`
+}
+
+func (x *FreightAssemblyConstraint) Reset() {
+	*x = FreightAssemblyConstraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreightAssemblyConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreightAssemblyConstraint) ProtoMessage() {}
+
+func (x *FreightAssemblyConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreightAssemblyConstraint.ProtoReflect.Descriptor instead.
+func (*FreightAssemblyConstraint) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *FreightAssemblyConstraint) GetMatch() []*ArtifactVersionReference {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type ArtifactVersionReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RepoUrl    string  `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
+	Chart      *string `protobuf:"bytes,2,opt,name=chart,proto3,oneof" json:"chart,omitempty"`
+	Field      string  `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	TrimPrefix *string `protobuf:"bytes,4,opt,name=trim_prefix,json=trimPrefix,proto3,oneof" json:"trim_prefix,omitempty"`
+}
+
+func (x *ArtifactVersionReference) Reset() {
+	*x = ArtifactVersionReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactVersionReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactVersionReference) ProtoMessage() {}
+
+func (x *ArtifactVersionReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactVersionReference.ProtoReflect.Descriptor instead.
+func (*ArtifactVersionReference) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ArtifactVersionReference) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *ArtifactVersionReference) GetChart() string {
+	if x != nil && x.Chart != nil {
+		return *x.Chart
+	}
+	return ""
+}
+
+func (x *ArtifactVersionReference) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ArtifactVersionReference) GetTrimPrefix() string {
+	if x != nil && x.TrimPrefix != nil {
+		return *x.TrimPrefix
+	}
+	return ""
+}
+
+type WarehouseStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error               string                   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ObservedGeneration  int64                    `protobuf:"varint,2,opt,name=observed_generation,json=observedGeneration,proto3" json:"observed_generation,omitempty"`
+	UnverifiedRevisions []*UnverifiedGitRevision `protobuf:"bytes,3,rep,name=unverified_revisions,json=unverifiedRevisions,proto3" json:"unverified_revisions,omitempty"`
+	Conditions          []*metav1.Condition      `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Subscriptions       []*SubscriptionStatus    `protobuf:"bytes,5,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	PendingFreight      *PendingFreight          `protobuf:"bytes,6,opt,name=pending_freight,json=pendingFreight,proto3,oneof" json:"pending_freight,omitempty"`
+}
+
+func (x *WarehouseStatus) Reset() {
+	*x = WarehouseStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarehouseStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseStatus) ProtoMessage() {}
+
+func (x *WarehouseStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseStatus.ProtoReflect.Descriptor instead.
+func (*WarehouseStatus) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *WarehouseStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WarehouseStatus) GetObservedGeneration() int64 {
+	if x != nil {
+		return x.ObservedGeneration
+	}
+	return 0
+}
+
+func (x *WarehouseStatus) GetUnverifiedRevisions() []*UnverifiedGitRevision {
+	if x != nil {
+		return x.UnverifiedRevisions
+	}
+	return nil
+}
+
+func (x *WarehouseStatus) GetConditions() []*metav1.Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *WarehouseStatus) GetSubscriptions() []*SubscriptionStatus {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *WarehouseStatus) GetPendingFreight() *PendingFreight {
+	if x != nil {
+		return x.PendingFreight
+	}
+	return nil
+}
+
+type PendingFreight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Since  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	Reason *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+}
+
+func (x *PendingFreight) Reset() {
+	*x = PendingFreight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingFreight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingFreight) ProtoMessage() {}
+
+func (x *PendingFreight) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingFreight.ProtoReflect.Descriptor instead.
+func (*PendingFreight) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PendingFreight) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PendingFreight) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *PendingFreight) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type SubscriptionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RepoUrl        string                 `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
+	Chart          *string                `protobuf:"bytes,2,opt,name=chart,proto3,oneof" json:"chart,omitempty"`
+	LastPolled     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_polled,json=lastPolled,proto3,oneof" json:"last_polled,omitempty"`
+	LatestVersion  *string                `protobuf:"bytes,4,opt,name=latest_version,json=latestVersion,proto3,oneof" json:"latest_version,omitempty"`
+	RecentVersions []string               `protobuf:"bytes,5,rep,name=recent_versions,json=recentVersions,proto3" json:"recent_versions,omitempty"`
+	Error          *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+}
+
+func (x *SubscriptionStatus) Reset() {
+	*x = SubscriptionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionStatus) ProtoMessage() {}
+
+func (x *SubscriptionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionStatus.ProtoReflect.Descriptor instead.
+func (*SubscriptionStatus) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SubscriptionStatus) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *SubscriptionStatus) GetChart() string {
+	if x != nil && x.Chart != nil {
+		return *x.Chart
+	}
+	return ""
+}
+
+func (x *SubscriptionStatus) GetLastPolled() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPolled
+	}
+	return nil
+}
+
+func (x *SubscriptionStatus) GetLatestVersion() string {
+	if x != nil && x.LatestVersion != nil {
+		return *x.LatestVersion
+	}
+	return ""
+}
+
+func (x *SubscriptionStatus) GetRecentVersions() []string {
+	if x != nil {
+		return x.RecentVersions
+	}
+	return nil
+}
+
+func (x *SubscriptionStatus) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type UnverifiedGitRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoURL,proto3" json:"repo_url,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Tag     string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Reason  string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *UnverifiedGitRevision) Reset() {
+	*x = UnverifiedGitRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnverifiedGitRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnverifiedGitRevision) ProtoMessage() {}
+
+func (x *UnverifiedGitRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnverifiedGitRevision.ProtoReflect.Descriptor instead.
+func (*UnverifiedGitRevision) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UnverifiedGitRevision) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *UnverifiedGitRevision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnverifiedGitRevision) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *UnverifiedGitRevision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type Verification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnalysisTemplates   []*AnalysisTemplateReference `protobuf:"bytes,1,rep,name=analysis_templates,json=analysisTemplates,proto3" json:"analysis_templates,omitempty"`
+	AnalysisRunMetadata *AnalysisRunMetadata         `protobuf:"bytes,2,opt,name=analysis_run_metadata,json=analysisRunMetadata,proto3,oneof" json:"analysis_run_metadata,omitempty"`
+	Args                []*AnalysisRunArgument       `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	AutoRollback        bool                         `protobuf:"varint,4,opt,name=auto_rollback,json=autoRollback,proto3" json:"auto_rollback,omitempty"`
+}
+
+func (x *Verification) Reset() {
+	*x = Verification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Verification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Verification) ProtoMessage() {}
+
+func (x *Verification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Verification.ProtoReflect.Descriptor instead.
+func (*Verification) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Verification) GetAnalysisTemplates() []*AnalysisTemplateReference {
+	if x != nil {
+		return x.AnalysisTemplates
+	}
+	return nil
+}
+
+func (x *Verification) GetAnalysisRunMetadata() *AnalysisRunMetadata {
+	if x != nil {
+		return x.AnalysisRunMetadata
+	}
+	return nil
+}
+
+func (x *Verification) GetArgs() []*AnalysisRunArgument {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Verification) GetAutoRollback() bool {
+	if x != nil {
+		return x.AutoRollback
+	}
+	return false
+}
+
+type AnalysisTemplateReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *AnalysisTemplateReference) Reset() {
+	*x = AnalysisTemplateReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalysisTemplateReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisTemplateReference) ProtoMessage() {}
+
+func (x *AnalysisTemplateReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisTemplateReference.ProtoReflect.Descriptor instead.
+func (*AnalysisTemplateReference) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AnalysisTemplateReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AnalysisRunMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Labels      map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Annotations map[string]string `protobuf:"bytes,2,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *AnalysisRunMetadata) Reset() {
+	*x = AnalysisRunMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalysisRunMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisRunMetadata) ProtoMessage() {}
+
+func (x *AnalysisRunMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisRunMetadata.ProtoReflect.Descriptor instead.
+func (*AnalysisRunMetadata) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AnalysisRunMetadata) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *AnalysisRunMetadata) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type AnalysisRunArgument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AnalysisRunArgument) Reset() {
+	*x = AnalysisRunArgument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalysisRunArgument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisRunArgument) ProtoMessage() {}
+
+func (x *AnalysisRunArgument) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisRunArgument.ProtoReflect.Descriptor instead.
+func (*AnalysisRunArgument) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AnalysisRunArgument) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AnalysisRunArgument) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type VerificationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnalysisRun *AnalysisRunReference `protobuf:"bytes,1,opt,name=analysis_run,json=analysisRun,proto3" json:"analysis_run,omitempty"`
+	Phase       string                `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message     string                `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *VerificationInfo) Reset() {
+	*x = VerificationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerificationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationInfo) ProtoMessage() {}
+
+func (x *VerificationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationInfo.ProtoReflect.Descriptor instead.
+func (*VerificationInfo) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *VerificationInfo) GetAnalysisRun() *AnalysisRunReference {
+	if x != nil {
+		return x.AnalysisRun
+	}
+	return nil
+}
+
+func (x *VerificationInfo) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *VerificationInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AnalysisRunReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phase     string `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+}
+
+func (x *AnalysisRunReference) Reset() {
+	*x = AnalysisRunReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1alpha1_types_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalysisRunReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisRunReference) ProtoMessage() {}
+
+func (x *AnalysisRunReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1alpha1_types_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisRunReference.ProtoReflect.Descriptor instead.
+func (*AnalysisRunReference) Descriptor() ([]byte, []int) {
+	return file_v1alpha1_types_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AnalysisRunReference) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AnalysisRunReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AnalysisRunReference) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+var File_v1alpha1_types_proto protoreflect.FileDescriptor
+
+var file_v1alpha1_types_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e,
+	0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x12, 0x6d, 0x65, 0x74, 0x61, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x01, 0x0a, 0x0f, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44,
+	0x41, 0x70, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x61,
+	0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x63,
+	0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
 	0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x65, 0x0a, 0x0a, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x48,
 	0x65, 0x6c, 0x6d, 0x12, 0x57, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
@@ -3530,7 +5428,7 @@ var file_v1alpha1_types_proto_rawDesc = []byte{
 	0x48, 0x01, 0x52, 0x10, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72,
 	0x61, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
 	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
-	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x43, 0x6f,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x22, 0xb4, 0x02, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x43, 0x6f,
 	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
@@ -3543,147 +5441,232 @@ var file_v1alpha1_types_proto_rawDesc = []byte{
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
 	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x10,
 	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
-	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0xd2, 0x04, 0x0a, 0x0d, 0x47, 0x69, 0x74,
-	0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65,
-	0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65,
-	0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x24, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65,
-	0x61, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x68,
-	0x0a, 0x09, 0x6b, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x45, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
-	0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x48, 0x01, 0x52, 0x09, 0x6b, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x59, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x6d,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x12, 0x42, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xb4, 0x05,
+	0x0a, 0x0d, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x24, 0x0a, 0x0b, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x12, 0x68, 0x0a, 0x09, 0x6b, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f,
 	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x48, 0x02, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x6d,
-	0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x06, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b,
-	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b,
-	0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x48, 0x03, 0x52, 0x06,
-	0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x6f, 0x0a, 0x0c, 0x70, 0x75, 0x6c,
-	0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x47, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75,
-	0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x48, 0x04, 0x52, 0x0b, 0x70, 0x75, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72,
-	0x65, 0x61, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6b,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68, 0x65, 0x6c,
-	0x6d, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x42, 0x0f, 0x0a, 0x0d,
-	0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9c, 0x02,
-	0x0a, 0x0f, 0x47, 0x69, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06,
-	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x12, 0x30, 0x0a, 0x11, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x73,
-	0x65, 0x6d, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x54,
-	0x61, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
-	0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x67, 0x6e,
-	0x6f, 0x72, 0x65, 0x54, 0x61, 0x67, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x65, 0x6d, 0x76,
-	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x22, 0x93, 0x01, 0x0a,
-	0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x61, 0x72, 0x67, 0x6f, 0x63,
-	0x64, 0x5f, 0x61, 0x70, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79,
-	0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70,
-	0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70,
-	0x70, 0x73, 0x22, 0x88, 0x02, 0x0a, 0x0e, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70, 0x70,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x64, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69,
-	0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44,
-	0x41, 0x70, 0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5e, 0x0a,
-	0x0b, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x72,
-	0x67, 0x6f, 0x43, 0x44, 0x41, 0x70, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x49, 0x0a,
-	0x15, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70, 0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x67, 0x0a, 0x13, 0x41, 0x72, 0x67, 0x6f,
-	0x43, 0x44, 0x41, 0x70, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x71, 0x0a, 0x19, 0x48, 0x65, 0x6c, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x74, 0x44, 0x65,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x21,
-	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52,
-	0x4c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x74, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x74,
-	0x50, 0x61, 0x74, 0x68, 0x22, 0x79, 0x0a, 0x0f, 0x48, 0x65, 0x6c, 0x6d, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a,
-	0x10, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x46,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0xc8, 0x01, 0x0a, 0x16, 0x48, 0x65, 0x6c, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x51, 0x0a, 0x06, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74,
+	0x31, 0x2e, 0x4b, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x48, 0x01, 0x52,
+	0x09, 0x6b, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x59, 0x0a,
+	0x04, 0x68, 0x65, 0x6c, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e,
+	0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x48, 0x02, 0x52,
+	0x04, 0x68, 0x65, 0x6c, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x06, 0x72, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72,
+	0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4b, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73,
+	0x6d, 0x48, 0x03, 0x52, 0x06, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x6f,
+	0x0a, 0x0c, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70,
+	0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x48, 0x04, 0x52,
+	0x0b, 0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x60, 0x0a, 0x0d, 0x6f, 0x63, 0x69, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4f, 0x43, 0x49, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x0c, 0x6f, 0x63, 0x69, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6b, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x68, 0x65, 0x6c, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xdd, 0x05, 0x0a, 0x0f, 0x47, 0x69, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f,
+	0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x19, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x30, 0x0a, 0x11, 0x73, 0x65, 0x6d, 0x76, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x61, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x2e, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x7e, 0x0a, 0x16, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
 	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x5b, 0x0a,
-	0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
-	0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x43, 0x68, 0x61,
-	0x72, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x22, 0x74, 0x0a, 0x1d, 0x50, 0x75,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x53, 0x0a, 0x06, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x04,
+	0x52, 0x15, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73,
+	0x65, 0x6d, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x18, 0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x06,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x61, 0x72, 0x67, 0x6f, 0x63, 0x64,
+	0x5f, 0x61, 0x70, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x69,
 	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e,
 	0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x50, 0x75, 0x6c,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x22, 0x13, 0x0a, 0x11, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x19,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70, 0x70,
+	0x73, 0x22, 0x88, 0x02, 0x0a, 0x0e, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x64, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
+	0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41,
+	0x70, 0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5e, 0x0a, 0x0b,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
+	0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x72, 0x67,
+	0x6f, 0x43, 0x44, 0x41, 0x70, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x49, 0x0a, 0x15,
+	0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70, 0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x67, 0x0a, 0x13, 0x41, 0x72, 0x67, 0x6f, 0x43,
+	0x44, 0x41, 0x70, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x71, 0x0a, 0x19, 0x48, 0x65, 0x6c, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x74, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52, 0x4c,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x22, 0x79, 0x0a, 0x0f, 0x48, 0x65, 0x6c, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x46, 0x69,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc8,
+	0x01, 0x0a, 0x16, 0x48, 0x65, 0x6c, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x51, 0x0a, 0x06, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61,
+	0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x06,
+	0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79,
+	0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x43, 0x68, 0x61, 0x72,
+	0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x22, 0x74, 0x0a, 0x1d, 0x50, 0x75, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x53, 0x0a, 0x06, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
+	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x50, 0x75, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x22,
+	0x13, 0x0a, 0x11, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x22, 0xb2, 0x02, 0x0a, 0x11, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f,
+	0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x30, 0x0a,
+	0x11, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x6d, 0x76,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x61, 0x67, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x5f, 0x0a, 0x14, 0x4b, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x69, 0x7a, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65,
+	0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75,
+	0x73, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x75, 0x0a, 0x1b, 0x4b, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x56, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67,
+	0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4b, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22,
+	0x77, 0x0a, 0x0b, 0x4f, 0x43, 0x49, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x19,
 	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x64,
 	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67,
-	0x65, 0x73, 0x74, 0x22, 0xb2, 0x02, 0x0a, 0x11, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70,
-	0x6f, 0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6c,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xbb, 0x02, 0x0a, 0x17, 0x4f, 0x43, 0x49,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12,
+	0x2d, 0x0a, 0x12, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x6c,
 	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x30,
 	0x0a, 0x11, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
 	0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x6d,
@@ -3692,142 +5675,363 @@ var file_v1alpha1_types_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x61, 0x67,
 	0x73, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x74,
 	0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72,
-	0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x65, 0x6d, 0x76, 0x65,
-	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x5f, 0x0a, 0x14, 0x4b, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73,
-	0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x75, 0x73, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x75, 0x0a, 0x1b, 0x4b, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x56, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72,
-	0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x4b, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x22, 0xdf, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x12, 0x4e, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x74, 0x61, 0x67, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x6a, 0x0a, 0x11, 0x4f, 0x43, 0x49, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e,
+	0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x76, 0x31, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x3f, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc2, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x53, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
+	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e,
+	0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x51, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
+	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x79, 0x0a, 0x0d, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x54, 0x0a, 0x07, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b,
+	0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x66, 0x72,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61,
+	0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61,
+	0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xe8, 0x03, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x73, 0x12, 0x61, 0x0a, 0x10, 0x67, 0x69,
+	0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70,
+	0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x67,
+	0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x67, 0x0a,
+	0x12, 0x61, 0x72, 0x67, 0x6f, 0x63, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61,
+	0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70, 0x70, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x10, 0x61, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70, 0x70, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x5f, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61,
+	0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f,
+	0x6f, 0x6b, 0x52, 0x08, 0x70, 0x72, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x56, 0x0a, 0x0a,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b,
+	0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x48,
+	0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x57, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67,
+	0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x53,
+	0x74, 0x65, 0x70, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x73, 0x22, 0xd2, 0x01,
+	0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x53, 0x74,
+	0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x58, 0x0a, 0x03, 0x67, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b,
-	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x47, 0x69, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x48, 0x00, 0x52, 0x03, 0x67, 0x69, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x48, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
+	0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x67,
+	0x69, 0x74, 0x22, 0x5c, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4a,
+	0x6f, 0x62, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x1b, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x22, 0xd9, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f,
+	0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x54, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70,
+	0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x48, 0x54, 0x54, 0x50, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f,
+	0x6b, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x03,
+	0x6a, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61,
+	0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f,
+	0x62, 0x48, 0x01, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x68, 0x74, 0x74, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6a, 0x6f, 0x62, 0x22, 0x81, 0x02, 0x0a,
+	0x11, 0x48, 0x54, 0x54, 0x50, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f,
+	0x6f, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x4e, 0x0a, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
+	0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x69, 0x6e, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x6e, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x54, 0x4c, 0x53, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x22, 0x36, 0x0a, 0x0a, 0x48, 0x54, 0x54, 0x50, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xad, 0x02, 0x0a, 0x0c, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x4e, 0x0a,
+	0x03, 0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
+	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4a,
+	0x6f, 0x62, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x30, 0x0a,
+	0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x3b, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x1a, 0x0a, 0x18,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x3e, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe7, 0x02, 0x0a, 0x0f, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x61, 0x75, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x53, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
 	0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x3f, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0xc2, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x53, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72,
-	0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x76,
-	0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x04, 0x73,
-	0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x66, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69,
+	0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x01, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0xd3, 0x01, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46,
+	0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0xb5, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0xe9, 0x02,
+	0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x63, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x53, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e,
+	0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa0, 0x02, 0x0a, 0x13, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x3e, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xac, 0x03, 0x0a,
+	0x10, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x50, 0x0a, 0x03, 0x67, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69,
+	0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x67, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x56, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x01, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x56, 0x0a, 0x05, 0x63,
+	0x68, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
+	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x69, 0x0a, 0x0c, 0x6f, 0x63, 0x69, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61,
 	0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70,
-	0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x51, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x43, 0x49, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x03, 0x52, 0x0b,
+	0x6f, 0x63, 0x69, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42, 0x06,
+	0x0a, 0x04, 0x5f, 0x67, 0x69, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6f,
+	0x63, 0x69, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0xa4, 0x02, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79,
+	0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72,
 	0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x79, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d,
-	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x54, 0x0a,
-	0x07, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69,
-	0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x66, 0x72, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67,
-	0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67,
-	0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0xe1, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
-	0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x73, 0x12, 0x61, 0x0a, 0x10, 0x67, 0x69, 0x74, 0x5f,
-	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73,
+	0x70, 0x65, 0x63, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
 	0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x69,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x67, 0x69, 0x74,
-	0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x67, 0x0a, 0x12, 0x61,
-	0x72, 0x67, 0x6f, 0x63, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67,
-	0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x41, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70, 0x70, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x10, 0x61, 0x72, 0x67, 0x6f, 0x43, 0x44, 0x41, 0x70, 0x70, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a,
-	0x16, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61,
-	0x75, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x63, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x67, 0x69, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x45,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
+	0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xf3, 0x02, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x5d, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
+	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x70, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b,
+	0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x73, 0x52,
+	0x13, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e,
+	0x69, 0x73, 0x6d, 0x73, 0x12, 0x5f, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
 	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a,
-	0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x02, 0x0a, 0x10, 0x52,
-	0x65, 0x70, 0x6f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x50, 0x0a, 0x03, 0x67, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79,
-	0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x67, 0x69, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x56, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x3b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b,
-	0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52,
-	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x56, 0x0a, 0x05, 0x63, 0x68, 0x61,
-	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72,
-	0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x88, 0x01,
-	0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x67, 0x69, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x22, 0xa4, 0x02,
-	0x0a, 0x05, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x70, 0x74, 0x68, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x04, 0x0a, 0x07,
+	0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76,
 	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70,
 	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x08,
@@ -3835,139 +6039,147 @@ var file_v1alpha1_types_proto_rawDesc = []byte{
 	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69,
 	0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x04,
-	0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
-	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52,
-	0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e,
-	0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70,
-	0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x45, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75,
-	0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xce, 0x02, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x67,
-	0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x5d, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79,
-	0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x70, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72,
-	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d,
-	0x73, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x63, 0x68,
-	0x61, 0x6e, 0x69, 0x73, 0x6d, 0x73, 0x12, 0x5f, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79,
-	0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd0, 0x03, 0x0a, 0x07, 0x46, 0x72, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e,
-	0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x65,
-	0x74, 0x61, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
-	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72,
-	0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x73, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
-	0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61,
-	0x72, 0x74, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
-	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd7, 0x03, 0x0a, 0x0d,
-	0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x68, 0x0a,
-	0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x47, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x72,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x6b, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
-	0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x46,
-	0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x64, 0x46, 0x6f, 0x72, 0x1a, 0x76, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x49, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72,
-	0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x74, 0x61, 0x67,
-	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x77, 0x0a, 0x10,
-	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x4d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
-	0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x0f, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x53, 0x74, 0x61, 0x67, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x64, 0x53, 0x74, 0x61, 0x67, 0x65, 0x22, 0xd6, 0x03, 0x0a, 0x10, 0x46, 0x72, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0a,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
 	0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
 	0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x06, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x69,
 	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e,
 	0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x18, 0x06,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x18, 0x07,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
 	0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70,
 	0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x12, 0x6c, 0x0a,
-	0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72,
-	0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x01, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x12, 0x4f, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
+	0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5a,
+	0x0a, 0x0d, 0x6f, 0x63, 0x69, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e,
+	0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4f, 0x43, 0x49, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x0c, 0x6f, 0x63,
+	0x69, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x22, 0xaf, 0x05, 0x0a, 0x0d, 0x46,
+	0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x68, 0x0a, 0x0b,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x47, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
+	0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x72, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x49, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x6b, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79,
+	0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x46, 0x6f,
+	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x46, 0x6f, 0x72, 0x12, 0x62, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x6e, 0x1a, 0x76, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x49, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4d, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e,
+	0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x77, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70,
+	0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x72, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x49, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4b, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
+	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x61, 0x0a, 0x0d,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a,
+	0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
+	0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22,
+	0x61, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x50, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x67, 0x65, 0x22, 0xc3, 0x05, 0x0a, 0x10, 0x46,
+	0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x3e, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x00, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x4d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b,
+	0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x47,
+	0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69,
+	0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67,
+	0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73,
+	0x12, 0x6c, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e,
+	0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x01, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x40, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x5a, 0x0a, 0x0d, 0x6f, 0x63, 0x69, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4f, 0x43, 0x49, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x0c, 0x6f,
+	0x63, 0x69, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
 	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x76,
 	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x22, 0xe8, 0x03, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x22, 0xd9, 0x04, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
 	0x12, 0x68, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x72, 0x65, 0x69,
 	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61,
@@ -3994,142 +6206,273 @@ var file_v1alpha1_types_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x02, 0x52, 0x10, 0x63, 0x75, 0x72,
 	0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
 	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x5f, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x53,
+	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70,
+	0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x48, 0x03, 0x52, 0x04, 0x6c, 0x6f, 0x63,
+	0x6b, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x72, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x8c, 0x01, 0x0a,
+	0x09, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x3c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x71, 0x0a, 0x11, 0x53,
 	0x74, 0x61, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x64, 0x0a, 0x0f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75,
-	0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x75, 0x70,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x09, 0x57,
-	0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
-	0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x4e, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x32, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75,
-	0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4b, 0x0a,
-	0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e,
-	0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
-	0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x51, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
-	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x71, 0x0a,
-	0x0d, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x60,
-	0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x73, 0x6f, 0x61, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x6f, 0x61, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x6f, 0x61, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x83,
+	0x02, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x64, 0x0a, 0x0f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61,
+	0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x72, 0x65, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x14, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x0a, 0x77,
+	0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x09, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61,
+	0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4b, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
 	0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e,
 	0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x58, 0x0a, 0x0f, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe7, 0x02, 0x0a, 0x0c, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x72, 0x0a, 0x12, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x12, 0x51, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70,
+	0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa4, 0x02, 0x0a, 0x0d, 0x57, 0x61, 0x72, 0x65,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x60, 0x0a, 0x0d, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b,
+	0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x6e, 0x0a, 0x10,
+	0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x01, 0x52, 0x0f, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x66, 0x72,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x22, 0xb3,
+	0x01, 0x0a, 0x14, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
+	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x65, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x43, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x72,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x75, 0x0a, 0x19, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x12, 0x58, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x42, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b,
+	0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0xa6, 0x01, 0x0a, 0x18,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f,
+	0x55, 0x52, 0x4c, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x74, 0x72, 0x69,
+	0x6d, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x22, 0xff, 0x03, 0x0a, 0x0f, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2f,
+	0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x72, 0x0a, 0x14, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
+	0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x47, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x13,
+	0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67,
-	0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x61, 0x6e,
-	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12,
-	0x76, 0x0a, 0x15, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x5f,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d,
+	0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x62, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
+	0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x66, 0x0a, 0x0f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x48, 0x00, 0x52,
+	0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66,
+	0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x7a, 0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x46, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0xb3, 0x02, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70,
+	0x6f, 0x55, 0x52, 0x4c, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x40, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x01, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0d, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6c, 0x0a, 0x15, 0x55, 0x6e, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x47, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x8c, 0x03, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x72, 0x0a, 0x12, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x76, 0x0a, 0x15, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
+	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x75,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x13, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
+	0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61,
+	0x75, 0x74, 0x6f, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x42, 0x18, 0x0a, 0x16, 0x5f,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2f, 0x0a, 0x19, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe5, 0x02, 0x0a, 0x13, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x61,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49,
 	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69,
 	0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
-	0x69, 0x73, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
-	0x13, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e,
-	0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x67, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x2f, 0x0a, 0x19, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe5, 0x02, 0x0a, 0x13, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
-	0x69, 0x73, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x61, 0x0a,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e,
+	0x69, 0x73, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0x70, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e,
+	0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3f,
+	0x0a, 0x13, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xa5, 0x01, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x61, 0x0a, 0x0c, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b,
+	0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x75,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x14, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x42, 0xad, 0x02, 0x0a, 0x2c, 0x63, 0x6f, 0x6d, 0x2e,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74,
 	0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
-	0x73, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x12, 0x70, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e,
-	0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a,
-	0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3f, 0x0a,
-	0x13, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x67, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa5,
-	0x01, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x61, 0x0a, 0x0c, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f,
-	0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x6b, 0x61,
-	0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x75, 0x6e,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x61, 0x6e, 0x61, 0x6c, 0x79,
-	0x73, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x14, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
-	0x69, 0x73, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x42, 0xad, 0x02, 0x0a, 0x2c, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79,
-	0x2e, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2f, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2,
-	0x02, 0x06, 0x47, 0x43, 0x41, 0x4b, 0x50, 0x41, 0xaa, 0x02, 0x28, 0x47, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x2e, 0x41, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x4b, 0x61, 0x72,
-	0x67, 0x6f, 0x2e, 0x50, 0x6b, 0x67, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0xca, 0x02, 0x28, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5c, 0x43, 0x6f, 0x6d,
-	0x5c, 0x41, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x5c, 0x4b, 0x61, 0x72, 0x67, 0x6f, 0x5c, 0x50, 0x6b,
-	0x67, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02,
-	0x34, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5c, 0x43, 0x6f, 0x6d, 0x5c, 0x41, 0x6b, 0x75, 0x69,
-	0x74, 0x79, 0x5c, 0x4b, 0x61, 0x72, 0x67, 0x6f, 0x5c, 0x50, 0x6b, 0x67, 0x5c, 0x41, 0x70, 0x69,
-	0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x3a, 0x3a,
-	0x43, 0x6f, 0x6d, 0x3a, 0x3a, 0x41, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x3a, 0x3a, 0x4b, 0x61, 0x72,
-	0x67, 0x6f, 0x3a, 0x3a, 0x50, 0x6b, 0x67, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2f, 0x6b, 0x61, 0x72, 0x67, 0x6f, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0xa2, 0x02, 0x06, 0x47, 0x43, 0x41, 0x4b, 0x50, 0x41, 0xaa, 0x02, 0x28, 0x47, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x2e, 0x41, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x2e, 0x4b, 0x61,
+	0x72, 0x67, 0x6f, 0x2e, 0x50, 0x6b, 0x67, 0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x28, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5c, 0x43, 0x6f,
+	0x6d, 0x5c, 0x41, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x5c, 0x4b, 0x61, 0x72, 0x67, 0x6f, 0x5c, 0x50,
+	0x6b, 0x67, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2,
+	0x02, 0x34, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5c, 0x43, 0x6f, 0x6d, 0x5c, 0x41, 0x6b, 0x75,
+	0x69, 0x74, 0x79, 0x5c, 0x4b, 0x61, 0x72, 0x67, 0x6f, 0x5c, 0x50, 0x6b, 0x67, 0x5c, 0x41, 0x70,
+	0x69, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x3a,
+	0x3a, 0x43, 0x6f, 0x6d, 0x3a, 0x3a, 0x41, 0x6b, 0x75, 0x69, 0x74, 0x79, 0x3a, 0x3a, 0x4b, 0x61,
+	0x72, 0x67, 0x6f, 0x3a, 0x3a, 0x50, 0x6b, 0x67, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4144,7 +6487,7 @@ func file_v1alpha1_types_proto_rawDescGZIP() []byte {
 	return file_v1alpha1_types_proto_rawDescData
 }
 
-var file_v1alpha1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
+var file_v1alpha1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
 var file_v1alpha1_types_proto_goTypes = []interface{}{
 	(*ArgoCDAppUpdate)(nil),               // 0: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppUpdate
 	(*ArgoCDHelm)(nil),                    // 1: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDHelm
@@ -4158,131 +6501,194 @@ var file_v1alpha1_types_proto_goTypes = []interface{}{
 	(*GitCommit)(nil),                     // 9: github.com.akuity.kargo.pkg.api.v1alpha1.GitCommit
 	(*GitRepoUpdate)(nil),                 // 10: github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate
 	(*GitSubscription)(nil),               // 11: github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription
-	(*Health)(nil),                        // 12: github.com.akuity.kargo.pkg.api.v1alpha1.Health
-	(*ArgoCDAppState)(nil),                // 13: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppState
-	(*ArgoCDAppHealthStatus)(nil),         // 14: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppHealthStatus
-	(*ArgoCDAppSyncStatus)(nil),           // 15: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppSyncStatus
-	(*HelmChartDependencyUpdate)(nil),     // 16: github.com.akuity.kargo.pkg.api.v1alpha1.HelmChartDependencyUpdate
-	(*HelmImageUpdate)(nil),               // 17: github.com.akuity.kargo.pkg.api.v1alpha1.HelmImageUpdate
-	(*HelmPromotionMechanism)(nil),        // 18: github.com.akuity.kargo.pkg.api.v1alpha1.HelmPromotionMechanism
-	(*PullRequestPromotionMechanism)(nil), // 19: github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestPromotionMechanism
-	(*GitHubPullRequest)(nil),             // 20: github.com.akuity.kargo.pkg.api.v1alpha1.GitHubPullRequest
-	(*Image)(nil),                         // 21: github.com.akuity.kargo.pkg.api.v1alpha1.Image
-	(*ImageSubscription)(nil),             // 22: github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription
-	(*KustomizeImageUpdate)(nil),          // 23: github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeImageUpdate
-	(*KustomizePromotionMechanism)(nil),   // 24: github.com.akuity.kargo.pkg.api.v1alpha1.KustomizePromotionMechanism
-	(*Project)(nil),                       // 25: github.com.akuity.kargo.pkg.api.v1alpha1.Project
-	(*ProjectStatus)(nil),                 // 26: github.com.akuity.kargo.pkg.api.v1alpha1.ProjectStatus
-	(*Promotion)(nil),                     // 27: github.com.akuity.kargo.pkg.api.v1alpha1.Promotion
-	(*PromotionInfo)(nil),                 // 28: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionInfo
-	(*PromotionList)(nil),                 // 29: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionList
-	(*PromotionMechanisms)(nil),           // 30: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms
-	(*PromotionPolicy)(nil),               // 31: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy
-	(*PromotionSpec)(nil),                 // 32: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec
-	(*PromotionStatus)(nil),               // 33: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
-	(*RepoSubscription)(nil),              // 34: github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription
-	(*Stage)(nil),                         // 35: github.com.akuity.kargo.pkg.api.v1alpha1.Stage
-	(*StageList)(nil),                     // 36: github.com.akuity.kargo.pkg.api.v1alpha1.StageList
-	(*StageSpec)(nil),                     // 37: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec
-	(*Freight)(nil),                       // 38: github.com.akuity.kargo.pkg.api.v1alpha1.Freight
-	(*FreightStatus)(nil),                 // 39: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus
-	(*VerifiedStage)(nil),                 // 40: github.com.akuity.kargo.pkg.api.v1alpha1.VerifiedStage
-	(*ApprovedStage)(nil),                 // 41: github.com.akuity.kargo.pkg.api.v1alpha1.ApprovedStage
-	(*FreightReference)(nil),              // 42: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference
-	(*StageStatus)(nil),                   // 43: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus
-	(*StageSubscription)(nil),             // 44: github.com.akuity.kargo.pkg.api.v1alpha1.StageSubscription
-	(*Subscriptions)(nil),                 // 45: github.com.akuity.kargo.pkg.api.v1alpha1.Subscriptions
-	(*Warehouse)(nil),                     // 46: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse
-	(*WarehouseSpec)(nil),                 // 47: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec
-	(*WarehouseStatus)(nil),               // 48: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseStatus
-	(*Verification)(nil),                  // 49: github.com.akuity.kargo.pkg.api.v1alpha1.Verification
-	(*AnalysisTemplateReference)(nil),     // 50: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisTemplateReference
-	(*AnalysisRunMetadata)(nil),           // 51: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata
-	(*AnalysisRunArgument)(nil),           // 52: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunArgument
-	(*VerificationInfo)(nil),              // 53: github.com.akuity.kargo.pkg.api.v1alpha1.VerificationInfo
-	(*AnalysisRunReference)(nil),          // 54: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunReference
-	nil,                                   // 55: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus.MetadataEntry
-	nil,                                   // 56: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.VerifiedInEntry
-	nil,                                   // 57: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.ApprovedForEntry
-	nil,                                   // 58: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.LabelsEntry
-	nil,                                   // 59: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.AnnotationsEntry
-	(*metav1.ObjectMeta)(nil),             // 60: github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
-	(*metav1.ListMeta)(nil),               // 61: github.com.akuity.kargo.pkg.api.metav1.ListMeta
-	(*timestamppb.Timestamp)(nil),         // 62: google.protobuf.Timestamp
+	(*GitSignatureVerification)(nil),      // 12: github.com.akuity.kargo.pkg.api.v1alpha1.GitSignatureVerification
+	(*Health)(nil),                        // 13: github.com.akuity.kargo.pkg.api.v1alpha1.Health
+	(*ArgoCDAppState)(nil),                // 14: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppState
+	(*ArgoCDAppHealthStatus)(nil),         // 15: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppHealthStatus
+	(*ArgoCDAppSyncStatus)(nil),           // 16: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppSyncStatus
+	(*HelmChartDependencyUpdate)(nil),     // 17: github.com.akuity.kargo.pkg.api.v1alpha1.HelmChartDependencyUpdate
+	(*HelmImageUpdate)(nil),               // 18: github.com.akuity.kargo.pkg.api.v1alpha1.HelmImageUpdate
+	(*HelmPromotionMechanism)(nil),        // 19: github.com.akuity.kargo.pkg.api.v1alpha1.HelmPromotionMechanism
+	(*PullRequestPromotionMechanism)(nil), // 20: github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestPromotionMechanism
+	(*GitHubPullRequest)(nil),             // 21: github.com.akuity.kargo.pkg.api.v1alpha1.GitHubPullRequest
+	(*Image)(nil),                         // 22: github.com.akuity.kargo.pkg.api.v1alpha1.Image
+	(*ImageSubscription)(nil),             // 23: github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription
+	(*KustomizeImageUpdate)(nil),          // 24: github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeImageUpdate
+	(*KustomizePromotionMechanism)(nil),   // 25: github.com.akuity.kargo.pkg.api.v1alpha1.KustomizePromotionMechanism
+	(*OCIArtifact)(nil),                   // 26: github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifact
+	(*OCIArtifactSubscription)(nil),       // 27: github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifactSubscription
+	(*OCIArtifactUpdate)(nil),             // 28: github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifactUpdate
+	(*Project)(nil),                       // 29: github.com.akuity.kargo.pkg.api.v1alpha1.Project
+	(*ProjectStatus)(nil),                 // 30: github.com.akuity.kargo.pkg.api.v1alpha1.ProjectStatus
+	(*Promotion)(nil),                     // 31: github.com.akuity.kargo.pkg.api.v1alpha1.Promotion
+	(*PromotionInfo)(nil),                 // 32: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionInfo
+	(*PromotionList)(nil),                 // 33: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionList
+	(*PromotionMechanisms)(nil),           // 34: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms
+	(*PromotionJobStep)(nil),              // 35: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobStep
+	(*PromotionJobGitCheckout)(nil),       // 36: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobGitCheckout
+	(*PromotionHook)(nil),                 // 37: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook
+	(*HTTPPromotionHook)(nil),             // 38: github.com.akuity.kargo.pkg.api.v1alpha1.HTTPPromotionHook
+	(*HTTPHeader)(nil),                    // 39: github.com.akuity.kargo.pkg.api.v1alpha1.HTTPHeader
+	(*PromotionJob)(nil),                  // 40: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJob
+	(*PromotionJobEnvVar)(nil),            // 41: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobEnvVar
+	(*PromotionPolicy)(nil),               // 42: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy
+	(*ApprovalPolicy)(nil),                // 43: github.com.akuity.kargo.pkg.api.v1alpha1.ApprovalPolicy
+	(*PromotionWindow)(nil),               // 44: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionWindow
+	(*PromotionSpec)(nil),                 // 45: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec
+	(*PromotionStatus)(nil),               // 46: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
+	(*PromotionHookResult)(nil),           // 47: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHookResult
+	(*RepoSubscription)(nil),              // 48: github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription
+	(*Stage)(nil),                         // 49: github.com.akuity.kargo.pkg.api.v1alpha1.Stage
+	(*StageList)(nil),                     // 50: github.com.akuity.kargo.pkg.api.v1alpha1.StageList
+	(*StageSpec)(nil),                     // 51: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec
+	(*Freight)(nil),                       // 52: github.com.akuity.kargo.pkg.api.v1alpha1.Freight
+	(*FreightStatus)(nil),                 // 53: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus
+	(*VerifiedStage)(nil),                 // 54: github.com.akuity.kargo.pkg.api.v1alpha1.VerifiedStage
+	(*ApprovedStage)(nil),                 // 55: github.com.akuity.kargo.pkg.api.v1alpha1.ApprovedStage
+	(*Approval)(nil),                      // 56: github.com.akuity.kargo.pkg.api.v1alpha1.Approval
+	(*FailedStage)(nil),                   // 57: github.com.akuity.kargo.pkg.api.v1alpha1.FailedStage
+	(*FreightReference)(nil),              // 58: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference
+	(*StageStatus)(nil),                   // 59: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus
+	(*StageLock)(nil),                     // 60: github.com.akuity.kargo.pkg.api.v1alpha1.StageLock
+	(*StageSubscription)(nil),             // 61: github.com.akuity.kargo.pkg.api.v1alpha1.StageSubscription
+	(*Subscriptions)(nil),                 // 62: github.com.akuity.kargo.pkg.api.v1alpha1.Subscriptions
+	(*Warehouse)(nil),                     // 63: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse
+	(*WarehouseSpec)(nil),                 // 64: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec
+	(*FreightAssemblyRules)(nil),          // 65: github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyRules
+	(*FreightAssemblyConstraint)(nil),     // 66: github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyConstraint
+	(*ArtifactVersionReference)(nil),      // 67: github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactVersionReference
+	(*WarehouseStatus)(nil),               // 68: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseStatus
+	(*PendingFreight)(nil),                // 69: github.com.akuity.kargo.pkg.api.v1alpha1.PendingFreight
+	(*SubscriptionStatus)(nil),            // 70: github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionStatus
+	(*UnverifiedGitRevision)(nil),         // 71: github.com.akuity.kargo.pkg.api.v1alpha1.UnverifiedGitRevision
+	(*Verification)(nil),                  // 72: github.com.akuity.kargo.pkg.api.v1alpha1.Verification
+	(*AnalysisTemplateReference)(nil),     // 73: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisTemplateReference
+	(*AnalysisRunMetadata)(nil),           // 74: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata
+	(*AnalysisRunArgument)(nil),           // 75: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunArgument
+	(*VerificationInfo)(nil),              // 76: github.com.akuity.kargo.pkg.api.v1alpha1.VerificationInfo
+	(*AnalysisRunReference)(nil),          // 77: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunReference
+	nil,                                   // 78: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus.MetadataEntry
+	nil,                                   // 79: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.VerifiedInEntry
+	nil,                                   // 80: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.ApprovedForEntry
+	nil,                                   // 81: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.FailedInEntry
+	nil,                                   // 82: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.LabelsEntry
+	nil,                                   // 83: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.AnnotationsEntry
+	(*timestamppb.Timestamp)(nil),         // 84: google.protobuf.Timestamp
+	(*metav1.ObjectMeta)(nil),             // 85: github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
+	(*metav1.ListMeta)(nil),               // 86: github.com.akuity.kargo.pkg.api.metav1.ListMeta
+	(*metav1.Condition)(nil),              // 87: github.com.akuity.kargo.pkg.api.metav1.Condition
 }
 var file_v1alpha1_types_proto_depIdxs = []int32{
-	5,  // 0: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppUpdate.source_updates:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSourceUpdate
-	2,  // 1: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDHelm.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDHelmImageUpdate
-	4,  // 2: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDKustomize.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDKustomizeImageUpdate
-	3,  // 3: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSourceUpdate.kustomize:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDKustomize
-	1,  // 4: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSourceUpdate.helm:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDHelm
-	24, // 5: github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate.kustomize:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.KustomizePromotionMechanism
-	18, // 6: github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate.helm:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.HelmPromotionMechanism
-	6,  // 7: github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate.render:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.KargoRenderPromotionMechanism
-	19, // 8: github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate.pull_request:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestPromotionMechanism
-	13, // 9: github.com.akuity.kargo.pkg.api.v1alpha1.Health.argocd_apps:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppState
-	14, // 10: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppState.health_status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppHealthStatus
-	15, // 11: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppState.sync_status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppSyncStatus
-	17, // 12: github.com.akuity.kargo.pkg.api.v1alpha1.HelmPromotionMechanism.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.HelmImageUpdate
-	16, // 13: github.com.akuity.kargo.pkg.api.v1alpha1.HelmPromotionMechanism.charts:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.HelmChartDependencyUpdate
-	20, // 14: github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestPromotionMechanism.github:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.GitHubPullRequest
-	23, // 15: github.com.akuity.kargo.pkg.api.v1alpha1.KustomizePromotionMechanism.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeImageUpdate
-	60, // 16: github.com.akuity.kargo.pkg.api.v1alpha1.Project.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
-	26, // 17: github.com.akuity.kargo.pkg.api.v1alpha1.Project.status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ProjectStatus
-	60, // 18: github.com.akuity.kargo.pkg.api.v1alpha1.Promotion.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
-	32, // 19: github.com.akuity.kargo.pkg.api.v1alpha1.Promotion.spec:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec
-	33, // 20: github.com.akuity.kargo.pkg.api.v1alpha1.Promotion.status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
-	42, // 21: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionInfo.freight:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference
-	61, // 22: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionList.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ListMeta
-	27, // 23: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionList.items:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Promotion
-	10, // 24: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms.git_repo_updates:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate
-	0,  // 25: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms.argocd_app_updates:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppUpdate
-	55, // 26: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus.metadata:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus.MetadataEntry
-	11, // 27: github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription.git:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription
-	22, // 28: github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription.image:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription
-	8,  // 29: github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription.chart:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ChartSubscription
-	60, // 30: github.com.akuity.kargo.pkg.api.v1alpha1.Stage.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
-	37, // 31: github.com.akuity.kargo.pkg.api.v1alpha1.Stage.spec:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec
-	43, // 32: github.com.akuity.kargo.pkg.api.v1alpha1.Stage.status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus
-	61, // 33: github.com.akuity.kargo.pkg.api.v1alpha1.StageList.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ListMeta
-	35, // 34: github.com.akuity.kargo.pkg.api.v1alpha1.StageList.items:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Stage
-	45, // 35: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec.subscriptions:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Subscriptions
-	30, // 36: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec.promotion_mechanisms:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms
-	49, // 37: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec.verification:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Verification
-	60, // 38: github.com.akuity.kargo.pkg.api.v1alpha1.Freight.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
-	9,  // 39: github.com.akuity.kargo.pkg.api.v1alpha1.Freight.commits:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.GitCommit
-	21, // 40: github.com.akuity.kargo.pkg.api.v1alpha1.Freight.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Image
-	7,  // 41: github.com.akuity.kargo.pkg.api.v1alpha1.Freight.charts:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Chart
-	39, // 42: github.com.akuity.kargo.pkg.api.v1alpha1.Freight.status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus
-	56, // 43: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.verified_in:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.VerifiedInEntry
-	57, // 44: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.approved_for:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.ApprovedForEntry
-	62, // 45: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.first_seen:type_name -> google.protobuf.Timestamp
-	9,  // 46: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.commits:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.GitCommit
-	21, // 47: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Image
-	7,  // 48: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.charts:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Chart
-	53, // 49: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.verification_info:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.VerificationInfo
-	42, // 50: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus.current_freight:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference
-	42, // 51: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus.history:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference
-	12, // 52: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus.health:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Health
-	28, // 53: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus.current_promotion:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionInfo
-	44, // 54: github.com.akuity.kargo.pkg.api.v1alpha1.Subscriptions.upstream_stages:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.StageSubscription
-	60, // 55: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
-	47, // 56: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse.spec:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec
-	48, // 57: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse.status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseStatus
-	34, // 58: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec.subscriptions:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription
-	50, // 59: github.com.akuity.kargo.pkg.api.v1alpha1.Verification.analysis_templates:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisTemplateReference
-	51, // 60: github.com.akuity.kargo.pkg.api.v1alpha1.Verification.analysis_run_metadata:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata
-	52, // 61: github.com.akuity.kargo.pkg.api.v1alpha1.Verification.args:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunArgument
-	58, // 62: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.labels:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.LabelsEntry
-	59, // 63: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.annotations:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.AnnotationsEntry
-	54, // 64: github.com.akuity.kargo.pkg.api.v1alpha1.VerificationInfo.analysis_run:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunReference
-	40, // 65: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.VerifiedInEntry.value:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.VerifiedStage
-	41, // 66: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.ApprovedForEntry.value:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ApprovedStage
-	67, // [67:67] is the sub-list for method output_type
-	67, // [67:67] is the sub-list for method input_type
-	67, // [67:67] is the sub-list for extension type_name
-	67, // [67:67] is the sub-list for extension extendee
-	0,  // [0:67] is the sub-list for field type_name
+	5,   // 0: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppUpdate.source_updates:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSourceUpdate
+	2,   // 1: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDHelm.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDHelmImageUpdate
+	4,   // 2: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDKustomize.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDKustomizeImageUpdate
+	3,   // 3: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSourceUpdate.kustomize:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDKustomize
+	1,   // 4: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDSourceUpdate.helm:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDHelm
+	84,  // 5: github.com.akuity.kargo.pkg.api.v1alpha1.GitCommit.committed_at:type_name -> google.protobuf.Timestamp
+	25,  // 6: github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate.kustomize:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.KustomizePromotionMechanism
+	19,  // 7: github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate.helm:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.HelmPromotionMechanism
+	6,   // 8: github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate.render:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.KargoRenderPromotionMechanism
+	20,  // 9: github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate.pull_request:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestPromotionMechanism
+	28,  // 10: github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate.oci_artifacts:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifactUpdate
+	12,  // 11: github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription.signature_verification:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.GitSignatureVerification
+	14,  // 12: github.com.akuity.kargo.pkg.api.v1alpha1.Health.argocd_apps:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppState
+	15,  // 13: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppState.health_status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppHealthStatus
+	16,  // 14: github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppState.sync_status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppSyncStatus
+	18,  // 15: github.com.akuity.kargo.pkg.api.v1alpha1.HelmPromotionMechanism.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.HelmImageUpdate
+	17,  // 16: github.com.akuity.kargo.pkg.api.v1alpha1.HelmPromotionMechanism.charts:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.HelmChartDependencyUpdate
+	21,  // 17: github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestPromotionMechanism.github:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.GitHubPullRequest
+	24,  // 18: github.com.akuity.kargo.pkg.api.v1alpha1.KustomizePromotionMechanism.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeImageUpdate
+	85,  // 19: github.com.akuity.kargo.pkg.api.v1alpha1.Project.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
+	30,  // 20: github.com.akuity.kargo.pkg.api.v1alpha1.Project.status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ProjectStatus
+	85,  // 21: github.com.akuity.kargo.pkg.api.v1alpha1.Promotion.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
+	45,  // 22: github.com.akuity.kargo.pkg.api.v1alpha1.Promotion.spec:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec
+	46,  // 23: github.com.akuity.kargo.pkg.api.v1alpha1.Promotion.status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
+	58,  // 24: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionInfo.freight:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference
+	86,  // 25: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionList.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ListMeta
+	31,  // 26: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionList.items:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Promotion
+	10,  // 27: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms.git_repo_updates:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate
+	0,   // 28: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms.argocd_app_updates:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppUpdate
+	37,  // 29: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms.pre_hooks:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook
+	37,  // 30: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms.post_hooks:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook
+	35,  // 31: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms.job_steps:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobStep
+	36,  // 32: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobStep.git:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobGitCheckout
+	40,  // 33: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobStep.job:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJob
+	38,  // 34: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook.http:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.HTTPPromotionHook
+	40,  // 35: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook.job:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJob
+	39,  // 36: github.com.akuity.kargo.pkg.api.v1alpha1.HTTPPromotionHook.headers:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.HTTPHeader
+	41,  // 37: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJob.env:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobEnvVar
+	44,  // 38: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy.windows:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionWindow
+	43,  // 39: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy.approval_policy:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ApprovalPolicy
+	84,  // 40: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec.not_before:type_name -> google.protobuf.Timestamp
+	78,  // 41: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus.metadata:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus.MetadataEntry
+	47,  // 42: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus.hooks:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHookResult
+	84,  // 43: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHookResult.started_at:type_name -> google.protobuf.Timestamp
+	84,  // 44: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHookResult.finished_at:type_name -> google.protobuf.Timestamp
+	11,  // 45: github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription.git:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.GitSubscription
+	23,  // 46: github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription.image:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription
+	8,   // 47: github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription.chart:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ChartSubscription
+	27,  // 48: github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription.oci_artifact:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifactSubscription
+	85,  // 49: github.com.akuity.kargo.pkg.api.v1alpha1.Stage.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
+	51,  // 50: github.com.akuity.kargo.pkg.api.v1alpha1.Stage.spec:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec
+	59,  // 51: github.com.akuity.kargo.pkg.api.v1alpha1.Stage.status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus
+	86,  // 52: github.com.akuity.kargo.pkg.api.v1alpha1.StageList.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ListMeta
+	49,  // 53: github.com.akuity.kargo.pkg.api.v1alpha1.StageList.items:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Stage
+	62,  // 54: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec.subscriptions:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Subscriptions
+	34,  // 55: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec.promotion_mechanisms:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms
+	72,  // 56: github.com.akuity.kargo.pkg.api.v1alpha1.StageSpec.verification:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Verification
+	85,  // 57: github.com.akuity.kargo.pkg.api.v1alpha1.Freight.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
+	9,   // 58: github.com.akuity.kargo.pkg.api.v1alpha1.Freight.commits:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.GitCommit
+	22,  // 59: github.com.akuity.kargo.pkg.api.v1alpha1.Freight.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Image
+	7,   // 60: github.com.akuity.kargo.pkg.api.v1alpha1.Freight.charts:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Chart
+	53,  // 61: github.com.akuity.kargo.pkg.api.v1alpha1.Freight.status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus
+	26,  // 62: github.com.akuity.kargo.pkg.api.v1alpha1.Freight.oci_artifacts:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifact
+	79,  // 63: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.verified_in:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.VerifiedInEntry
+	80,  // 64: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.approved_for:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.ApprovedForEntry
+	81,  // 65: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.failed_in:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.FailedInEntry
+	84,  // 66: github.com.akuity.kargo.pkg.api.v1alpha1.VerifiedStage.verified_at:type_name -> google.protobuf.Timestamp
+	56,  // 67: github.com.akuity.kargo.pkg.api.v1alpha1.ApprovedStage.approvals:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Approval
+	84,  // 68: github.com.akuity.kargo.pkg.api.v1alpha1.Approval.approved_at:type_name -> google.protobuf.Timestamp
+	84,  // 69: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.first_seen:type_name -> google.protobuf.Timestamp
+	9,   // 70: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.commits:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.GitCommit
+	22,  // 71: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.images:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Image
+	7,   // 72: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.charts:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Chart
+	76,  // 73: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.verification_info:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.VerificationInfo
+	84,  // 74: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.promoted_at:type_name -> google.protobuf.Timestamp
+	26,  // 75: github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference.oci_artifacts:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifact
+	58,  // 76: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus.current_freight:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference
+	58,  // 77: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus.history:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference
+	13,  // 78: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus.health:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.Health
+	32,  // 79: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus.current_promotion:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PromotionInfo
+	60,  // 80: github.com.akuity.kargo.pkg.api.v1alpha1.StageStatus.lock:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.StageLock
+	84,  // 81: github.com.akuity.kargo.pkg.api.v1alpha1.StageLock.locked_at:type_name -> google.protobuf.Timestamp
+	61,  // 82: github.com.akuity.kargo.pkg.api.v1alpha1.Subscriptions.upstream_stages:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.StageSubscription
+	85,  // 83: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse.metadata:type_name -> github.com.akuity.kargo.pkg.api.metav1.ObjectMeta
+	64,  // 84: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse.spec:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec
+	68,  // 85: github.com.akuity.kargo.pkg.api.v1alpha1.Warehouse.status:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseStatus
+	48,  // 86: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec.subscriptions:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription
+	65,  // 87: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec.freight_assembly:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyRules
+	66,  // 88: github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyRules.constraints:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyConstraint
+	67,  // 89: github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyConstraint.match:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactVersionReference
+	71,  // 90: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseStatus.unverified_revisions:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.UnverifiedGitRevision
+	87,  // 91: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseStatus.conditions:type_name -> github.com.akuity.kargo.pkg.api.metav1.Condition
+	70,  // 92: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseStatus.subscriptions:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionStatus
+	69,  // 93: github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseStatus.pending_freight:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.PendingFreight
+	84,  // 94: github.com.akuity.kargo.pkg.api.v1alpha1.PendingFreight.since:type_name -> google.protobuf.Timestamp
+	84,  // 95: github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionStatus.last_polled:type_name -> google.protobuf.Timestamp
+	73,  // 96: github.com.akuity.kargo.pkg.api.v1alpha1.Verification.analysis_templates:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisTemplateReference
+	74,  // 97: github.com.akuity.kargo.pkg.api.v1alpha1.Verification.analysis_run_metadata:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata
+	75,  // 98: github.com.akuity.kargo.pkg.api.v1alpha1.Verification.args:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunArgument
+	82,  // 99: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.labels:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.LabelsEntry
+	83,  // 100: github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.annotations:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunMetadata.AnnotationsEntry
+	77,  // 101: github.com.akuity.kargo.pkg.api.v1alpha1.VerificationInfo.analysis_run:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.AnalysisRunReference
+	54,  // 102: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.VerifiedInEntry.value:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.VerifiedStage
+	55,  // 103: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.ApprovedForEntry.value:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.ApprovedStage
+	57,  // 104: github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus.FailedInEntry.value:type_name -> github.com.akuity.kargo.pkg.api.v1alpha1.FailedStage
+	105, // [105:105] is the sub-list for method output_type
+	105, // [105:105] is the sub-list for method input_type
+	105, // [105:105] is the sub-list for extension type_name
+	105, // [105:105] is the sub-list for extension extendee
+	0,   // [0:105] is the sub-list for field type_name
 }
 
 func init() { file_v1alpha1_types_proto_init() }
@@ -4303,8 +6709,164 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArgoCDHelm); i {
+		file_v1alpha1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgoCDHelm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgoCDHelmImageUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgoCDKustomize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgoCDKustomizeImageUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgoCDSourceUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KargoRenderPromotionMechanism); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChartSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitRepoUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitSignatureVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Health); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgoCDAppState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4315,8 +6877,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArgoCDHelmImageUpdate); i {
+		file_v1alpha1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgoCDAppHealthStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4327,8 +6889,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArgoCDKustomize); i {
+		file_v1alpha1_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgoCDAppSyncStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4339,8 +6901,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArgoCDKustomizeImageUpdate); i {
+		file_v1alpha1_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelmChartDependencyUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4351,8 +6913,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArgoCDSourceUpdate); i {
+		file_v1alpha1_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelmImageUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4363,8 +6925,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KargoRenderPromotionMechanism); i {
+		file_v1alpha1_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelmPromotionMechanism); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4375,8 +6937,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Chart); i {
+		file_v1alpha1_types_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullRequestPromotionMechanism); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4387,8 +6949,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChartSubscription); i {
+		file_v1alpha1_types_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitHubPullRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4399,8 +6961,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitCommit); i {
+		file_v1alpha1_types_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Image); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4411,8 +6973,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitRepoUpdate); i {
+		file_v1alpha1_types_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4423,8 +6985,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitSubscription); i {
+		file_v1alpha1_types_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KustomizeImageUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4435,8 +6997,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Health); i {
+		file_v1alpha1_types_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KustomizePromotionMechanism); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4447,8 +7009,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArgoCDAppState); i {
+		file_v1alpha1_types_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OCIArtifact); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4459,8 +7021,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArgoCDAppHealthStatus); i {
+		file_v1alpha1_types_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OCIArtifactSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4471,8 +7033,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArgoCDAppSyncStatus); i {
+		file_v1alpha1_types_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OCIArtifactUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4483,8 +7045,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HelmChartDependencyUpdate); i {
+		file_v1alpha1_types_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Project); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4495,8 +7057,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HelmImageUpdate); i {
+		file_v1alpha1_types_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4507,8 +7069,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HelmPromotionMechanism); i {
+		file_v1alpha1_types_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Promotion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4519,8 +7081,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullRequestPromotionMechanism); i {
+		file_v1alpha1_types_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4531,8 +7093,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitHubPullRequest); i {
+		file_v1alpha1_types_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4543,8 +7105,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Image); i {
+		file_v1alpha1_types_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionMechanisms); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4555,8 +7117,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImageSubscription); i {
+		file_v1alpha1_types_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionJobStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4567,8 +7129,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KustomizeImageUpdate); i {
+		file_v1alpha1_types_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionJobGitCheckout); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4579,8 +7141,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KustomizePromotionMechanism); i {
+		file_v1alpha1_types_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionHook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4591,8 +7153,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Project); i {
+		file_v1alpha1_types_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPPromotionHook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4603,8 +7165,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectStatus); i {
+		file_v1alpha1_types_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4615,8 +7177,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Promotion); i {
+		file_v1alpha1_types_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4627,8 +7189,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PromotionInfo); i {
+		file_v1alpha1_types_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionJobEnvVar); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4639,8 +7201,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PromotionList); i {
+		file_v1alpha1_types_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4651,8 +7213,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PromotionMechanisms); i {
+		file_v1alpha1_types_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApprovalPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4663,8 +7225,8 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PromotionPolicy); i {
+		file_v1alpha1_types_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionWindow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4675,7 +7237,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PromotionSpec); i {
 			case 0:
 				return &v.state
@@ -4687,7 +7249,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PromotionStatus); i {
 			case 0:
 				return &v.state
@@ -4699,7 +7261,19 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionHookResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepoSubscription); i {
 			case 0:
 				return &v.state
@@ -4711,7 +7285,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Stage); i {
 			case 0:
 				return &v.state
@@ -4723,7 +7297,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StageList); i {
 			case 0:
 				return &v.state
@@ -4735,7 +7309,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StageSpec); i {
 			case 0:
 				return &v.state
@@ -4747,7 +7321,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Freight); i {
 			case 0:
 				return &v.state
@@ -4759,7 +7333,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FreightStatus); i {
 			case 0:
 				return &v.state
@@ -4771,7 +7345,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerifiedStage); i {
 			case 0:
 				return &v.state
@@ -4783,7 +7357,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApprovedStage); i {
 			case 0:
 				return &v.state
@@ -4795,7 +7369,31 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Approval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailedStage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FreightReference); i {
 			case 0:
 				return &v.state
@@ -4807,7 +7405,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StageStatus); i {
 			case 0:
 				return &v.state
@@ -4819,7 +7417,19 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StageSubscription); i {
 			case 0:
 				return &v.state
@@ -4831,7 +7441,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Subscriptions); i {
 			case 0:
 				return &v.state
@@ -4843,7 +7453,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Warehouse); i {
 			case 0:
 				return &v.state
@@ -4855,7 +7465,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WarehouseSpec); i {
 			case 0:
 				return &v.state
@@ -4867,7 +7477,43 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreightAssemblyRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreightAssemblyConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactVersionReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WarehouseStatus); i {
 			case 0:
 				return &v.state
@@ -4879,7 +7525,43 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingFreight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnverifiedGitRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1alpha1_types_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Verification); i {
 			case 0:
 				return &v.state
@@ -4891,7 +7573,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AnalysisTemplateReference); i {
 			case 0:
 				return &v.state
@@ -4903,7 +7585,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AnalysisRunMetadata); i {
 			case 0:
 				return &v.state
@@ -4915,7 +7597,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AnalysisRunArgument); i {
 			case 0:
 				return &v.state
@@ -4927,7 +7609,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerificationInfo); i {
 			case 0:
 				return &v.state
@@ -4939,7 +7621,7 @@ func file_v1alpha1_types_proto_init() {
 				return nil
 			}
 		}
-		file_v1alpha1_types_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_v1alpha1_types_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AnalysisRunReference); i {
 			case 0:
 				return &v.state
@@ -4958,21 +7640,42 @@ func file_v1alpha1_types_proto_init() {
 	file_v1alpha1_types_proto_msgTypes[9].OneofWrappers = []interface{}{}
 	file_v1alpha1_types_proto_msgTypes[10].OneofWrappers = []interface{}{}
 	file_v1alpha1_types_proto_msgTypes[11].OneofWrappers = []interface{}{}
-	file_v1alpha1_types_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[23].OneofWrappers = []interface{}{}
 	file_v1alpha1_types_proto_msgTypes[27].OneofWrappers = []interface{}{}
-	file_v1alpha1_types_proto_msgTypes[29].OneofWrappers = []interface{}{}
-	file_v1alpha1_types_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[35].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[36].OneofWrappers = []interface{}{}
 	file_v1alpha1_types_proto_msgTypes[37].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[40].OneofWrappers = []interface{}{}
 	file_v1alpha1_types_proto_msgTypes[42].OneofWrappers = []interface{}{}
 	file_v1alpha1_types_proto_msgTypes[43].OneofWrappers = []interface{}{}
-	file_v1alpha1_types_proto_msgTypes[49].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[44].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[45].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[47].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[51].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[54].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[56].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[58].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[59].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[60].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[61].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[64].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[65].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[67].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[68].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[69].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_v1alpha1_types_proto_msgTypes[72].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1alpha1_types_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   60,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
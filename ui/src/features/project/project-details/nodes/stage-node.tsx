@@ -3,6 +3,7 @@ import {
   faBullseye,
   faCircleCheck,
   faGear,
+  faLock,
   faTruckArrowRight
 } from '@fortawesome/free-solid-svg-icons';
 import { FontAwesomeIcon } from '@fortawesome/react-fontawesome';
@@ -75,6 +76,13 @@ export const StageNode = ({
         <h3 className='flex items-center text-white justify-between'>
           <div className='truncate pb-1'>{stage.metadata?.name}</div>
           <div className='pb-1'>
+            {stage.status?.lock && (
+              <Tooltip
+                title={`Locked by ${stage.status.lock.lockedBy}: ${stage.status.lock.reason}`}
+              >
+                <FontAwesomeIcon icon={faLock} className='mr-2' />
+              </Tooltip>
+            )}
             {stage.status?.currentPromotion ? (
               <Tooltip
                 title={`Freight ${stage.status?.currentPromotion.freight?.id} is being promoted`}
@@ -234,8 +234,13 @@ export const ProjectDetails = () => {
           }
         ] as NodesItemType[];
 
-        const warehouseName = stage.spec?.subscriptions?.warehouse;
-        if (warehouseName) {
+        const subscriptions = stage.spec?.subscriptions;
+        const warehouseNames = subscriptions?.warehouses.length
+          ? subscriptions.warehouses
+          : subscriptions?.warehouse
+            ? [subscriptions.warehouse]
+            : [];
+        warehouseNames.forEach((warehouseName) => {
           const cur = warehouseMap[warehouseName];
           n.push({
             data: cur?.metadata?.name || '',
@@ -260,7 +265,7 @@ export const ProjectDetails = () => {
               });
             });
           }
-        }
+        });
 
         return n;
       });
@@ -3,6 +3,7 @@ import {
   faCircleCheck,
   faCircleExclamation,
   faCircleNotch,
+  faForward,
   faHourglassStart
 } from '@fortawesome/free-solid-svg-icons';
 import { FontAwesomeIcon } from '@fortawesome/react-fontawesome';
@@ -117,6 +118,16 @@ export const Promotions = () => {
                 />
               </Popover>
             );
+          case 'Superseded':
+            return (
+              <Popover
+                content={promotion.status?.message}
+                title={promotion.status?.phase}
+                placement='right'
+              >
+                <FontAwesomeIcon color='#aaa' icon={faForward} size='lg' />
+              </Popover>
+            );
           case 'Running':
             return (
               <Tooltip title={promotion.status?.phase} placement='right'>
@@ -126,7 +137,7 @@ export const Promotions = () => {
           case 'Pending':
           default:
             return (
-              <Tooltip title='Pending' placement='right'>
+              <Tooltip title={promotion.status?.message || 'Pending'} placement='right'>
                 <FontAwesomeIcon color='#aaa' icon={faHourglassStart} size='lg' />
               </Tooltip>
             );
@@ -140,6 +151,13 @@ export const Promotions = () => {
         return date ? format(date, 'MMM do yyyy HH:mm:ss') : '';
       }
     },
+    {
+      title: 'Scheduled',
+      render: (_, promotion) => {
+        const date = promotion.spec?.notBefore?.toDate();
+        return date ? format(date, 'MMM do yyyy HH:mm:ss') : '';
+      }
+    },
     {
       title: 'Name',
       dataIndex: ['metadata', 'name']
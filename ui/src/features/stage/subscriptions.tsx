@@ -24,10 +24,25 @@ export const Subscriptions = (props: {
         </Descriptions>
       )}
 
+      {!!subscriptions.warehouses.length && (
+        <Descriptions bordered size='small' column={1} style={{ width: '50%' }}>
+          {subscriptions.warehouses.map((warehouse) => (
+            <Descriptions.Item label='Warehouse' key={warehouse}>
+              {warehouse}
+            </Descriptions.Item>
+          ))}
+        </Descriptions>
+      )}
+
       {!!subscriptions.upstreamStages.length && (
         <>
           <Typography.Title level={5} style={{ marginTop: '.8em' }}>
             Upstream Stages
+            {subscriptions.upstreamStagesMode === 'All' && (
+              <Typography.Text type='secondary' style={{ marginLeft: '.5em' }}>
+                (verification in all required)
+              </Typography.Text>
+            )}
           </Typography.Title>
           <Space direction='vertical' style={{ width: '50%' }}>
             {subscriptions?.upstreamStages.map((stage) => (
@@ -5,7 +5,7 @@
 
 import { createQueryService } from "@bufbuild/connect-query";
 import { MethodKind } from "@bufbuild/protobuf";
-import { AdminLoginRequest, AdminLoginResponse, ApproveFreightRequest, ApproveFreightResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteFreightRequest, DeleteFreightResponse, DeleteProjectRequest, DeleteProjectResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, GetConfigRequest, GetConfigResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UpdateFreightAliasRequest, UpdateFreightAliasResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse } from "./service_pb.js";
+import { AdminLoginRequest, AdminLoginResponse, ApproveFreightRequest, ApproveFreightResponse, CreateFreightRequest, CreateFreightResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteFreightRequest, DeleteFreightResponse, DeleteProjectRequest, DeleteProjectResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, GetConfigRequest, GetConfigResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionsRequest, ListPromotionsResponse, ListStageHistoryRequest, ListStageHistoryResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, LockStageRequest, LockStageResponse, PreviewWarehouseSubscriptionRequest, PreviewWarehouseSubscriptionResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UnlockStageRequest, UnlockStageResponse, UpdateFreightAliasRequest, UpdateFreightAliasResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse } from "./service_pb.js";
 
 export const typeName = "akuity.io.kargo.service.v1alpha1.KargoService";
 
@@ -199,6 +199,23 @@ export const getStage = createQueryService({
   },
 }).getStage;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListStageHistory
+ */
+export const listStageHistory = createQueryService({
+  service: {
+    methods: {
+      listStageHistory: {
+        name: "ListStageHistory",
+        kind: MethodKind.Unary,
+        I: ListStageHistoryRequest,
+        O: ListStageHistoryResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).listStageHistory;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UpdateStage
  */
@@ -388,6 +405,40 @@ export const setAutoPromotionForStage = createQueryService({
   },
 }).setAutoPromotionForStage;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.LockStage
+ */
+export const lockStage = createQueryService({
+  service: {
+    methods: {
+      lockStage: {
+        name: "LockStage",
+        kind: MethodKind.Unary,
+        I: LockStageRequest,
+        O: LockStageResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).lockStage;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UnlockStage
+ */
+export const unlockStage = createQueryService({
+  service: {
+    methods: {
+      unlockStage: {
+        name: "UnlockStage",
+        kind: MethodKind.Unary,
+        I: UnlockStageRequest,
+        O: UnlockStageResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).unlockStage;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight
  */
@@ -456,6 +507,23 @@ export const updateFreightAlias = createQueryService({
   },
 }).updateFreightAlias;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateFreight
+ */
+export const createFreight = createQueryService({
+  service: {
+    methods: {
+      createFreight: {
+        name: "CreateFreight",
+        kind: MethodKind.Unary,
+        I: CreateFreightRequest,
+        O: CreateFreightResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).createFreight;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses
  */
@@ -557,3 +625,20 @@ export const refreshWarehouse = createQueryService({
     typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
   },
 }).refreshWarehouse;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PreviewWarehouseSubscription
+ */
+export const previewWarehouseSubscription = createQueryService({
+  service: {
+    methods: {
+      previewWarehouseSubscription: {
+        name: "PreviewWarehouseSubscription",
+        kind: MethodKind.Unary,
+        I: PreviewWarehouseSubscriptionRequest,
+        O: PreviewWarehouseSubscriptionResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).previewWarehouseSubscription;
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AdminLoginRequest, AdminLoginResponse, ApproveFreightRequest, ApproveFreightResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteFreightRequest, DeleteFreightResponse, DeleteProjectRequest, DeleteProjectResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, GetConfigRequest, GetConfigResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UpdateFreightAliasRequest, UpdateFreightAliasResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse, WatchPromotionRequest, WatchPromotionResponse, WatchPromotionsRequest, WatchPromotionsResponse, WatchStagesRequest, WatchStagesResponse, WatchWarehousesRequest, WatchWarehousesResponse } from "./service_pb.js";
+import { AdminLoginRequest, AdminLoginResponse, ApproveFreightRequest, ApproveFreightResponse, CreateFreightRequest, CreateFreightResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteFreightRequest, DeleteFreightResponse, DeleteProjectRequest, DeleteProjectResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, GetConfigRequest, GetConfigResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionsRequest, ListPromotionsResponse, ListStageHistoryRequest, ListStageHistoryResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, LockStageRequest, LockStageResponse, PreviewWarehouseSubscriptionRequest, PreviewWarehouseSubscriptionResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UnlockStageRequest, UnlockStageResponse, UpdateFreightAliasRequest, UpdateFreightAliasResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse, WatchPromotionRequest, WatchPromotionResponse, WatchPromotionsRequest, WatchPromotionsResponse, WatchStagesRequest, WatchStagesResponse, WatchWarehousesRequest, WatchWarehousesResponse } from "./service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -114,6 +114,15 @@ export const KargoService = {
       O: GetStageResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListStageHistory
+     */
+    listStageHistory: {
+      name: "ListStageHistory",
+      I: ListStageHistoryRequest,
+      O: ListStageHistoryResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.WatchStages
      */
@@ -242,6 +251,24 @@ export const KargoService = {
       O: SetAutoPromotionForStageResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.LockStage
+     */
+    lockStage: {
+      name: "LockStage",
+      I: LockStageRequest,
+      O: LockStageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.UnlockStage
+     */
+    unlockStage: {
+      name: "UnlockStage",
+      I: UnlockStageRequest,
+      O: UnlockStageResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight
      */
@@ -278,6 +305,15 @@ export const KargoService = {
       O: UpdateFreightAliasResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateFreight
+     */
+    createFreight: {
+      name: "CreateFreight",
+      I: CreateFreightRequest,
+      O: CreateFreightResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListWarehouses
      */
@@ -341,6 +377,15 @@ export const KargoService = {
       O: RefreshWarehouseResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PreviewWarehouseSubscription
+     */
+    previewWarehouseSubscription: {
+      name: "PreviewWarehouseSubscription",
+      I: PreviewWarehouseSubscriptionRequest,
+      O: PreviewWarehouseSubscriptionResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
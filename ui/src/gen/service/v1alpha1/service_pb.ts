@@ -4,8 +4,8 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
-import { Freight, Project, Promotion, Stage, StageSpec, Warehouse, WarehouseSpec } from "../../v1alpha1/types_pb.js";
+import { Duration, Message, proto3, Timestamp } from "@bufbuild/protobuf";
+import { Freight, FreightReference, Project, Promotion, RepoSubscription, Stage, StageSpec, UnverifiedGitRevision, Warehouse, WarehouseSpec } from "../../v1alpha1/types_pb.js";
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ComponentVersions
@@ -1298,6 +1298,113 @@ export class GetStageResponse extends Message<GetStageResponse> {
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListStageHistoryRequest
+ */
+export class ListStageHistoryRequest extends Message<ListStageHistoryRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * limit is the maximum number of history entries to return. If zero, all
+   * remaining entries are returned.
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit = 0;
+
+  /**
+   * offset is the number of most recent history entries to skip.
+   *
+   * @generated from field: int32 offset = 4;
+   */
+  offset = 0;
+
+  constructor(data?: PartialMessage<ListStageHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListStageHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "offset", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStageHistoryRequest {
+    return new ListStageHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListStageHistoryRequest {
+    return new ListStageHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListStageHistoryRequest {
+    return new ListStageHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListStageHistoryRequest | PlainMessage<ListStageHistoryRequest> | undefined, b: ListStageHistoryRequest | PlainMessage<ListStageHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(ListStageHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListStageHistoryResponse
+ */
+export class ListStageHistoryResponse extends Message<ListStageHistoryResponse> {
+  /**
+   * history lists the Stage's Freight history, most recent first.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference history = 1;
+   */
+  history: FreightReference[] = [];
+
+  /**
+   * total is the total number of entries in the Stage's Freight history.
+   *
+   * @generated from field: int32 total = 2;
+   */
+  total = 0;
+
+  constructor(data?: PartialMessage<ListStageHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ListStageHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "history", kind: "message", T: FreightReference, repeated: true },
+    { no: 2, name: "total", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStageHistoryResponse {
+    return new ListStageHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListStageHistoryResponse {
+    return new ListStageHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListStageHistoryResponse {
+    return new ListStageHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListStageHistoryResponse | PlainMessage<ListStageHistoryResponse> | undefined, b: ListStageHistoryResponse | PlainMessage<ListStageHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(ListStageHistoryResponse, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.WatchStagesRequest
  */
@@ -1566,6 +1673,16 @@ export class PromoteStageRequest extends Message<PromoteStageRequest> {
    */
   freight = "";
 
+  /**
+   * @generated from field: optional google.protobuf.Timestamp not_before = 4;
+   */
+  notBefore?: Timestamp;
+
+  /**
+   * @generated from field: int32 priority = 5;
+   */
+  priority = 0;
+
   constructor(data?: PartialMessage<PromoteStageRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1577,6 +1694,8 @@ export class PromoteStageRequest extends Message<PromoteStageRequest> {
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "not_before", kind: "message", T: Timestamp, opt: true },
+    { no: 5, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteStageRequest {
@@ -2268,6 +2387,172 @@ export class SetAutoPromotionForStageResponse extends Message<SetAutoPromotionFo
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.LockStageRequest
+ */
+export class LockStageRequest extends Message<LockStageRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<LockStageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.LockStageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LockStageRequest {
+    return new LockStageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LockStageRequest {
+    return new LockStageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LockStageRequest {
+    return new LockStageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LockStageRequest | PlainMessage<LockStageRequest> | undefined, b: LockStageRequest | PlainMessage<LockStageRequest> | undefined): boolean {
+    return proto3.util.equals(LockStageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.LockStageResponse
+ */
+export class LockStageResponse extends Message<LockStageResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Stage stage = 1;
+   */
+  stage?: Stage;
+
+  constructor(data?: PartialMessage<LockStageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.LockStageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "message", T: Stage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LockStageResponse {
+    return new LockStageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LockStageResponse {
+    return new LockStageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LockStageResponse {
+    return new LockStageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LockStageResponse | PlainMessage<LockStageResponse> | undefined, b: LockStageResponse | PlainMessage<LockStageResponse> | undefined): boolean {
+    return proto3.util.equals(LockStageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UnlockStageRequest
+ */
+export class UnlockStageRequest extends Message<UnlockStageRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<UnlockStageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UnlockStageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnlockStageRequest {
+    return new UnlockStageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnlockStageRequest {
+    return new UnlockStageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnlockStageRequest {
+    return new UnlockStageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnlockStageRequest | PlainMessage<UnlockStageRequest> | undefined, b: UnlockStageRequest | PlainMessage<UnlockStageRequest> | undefined): boolean {
+    return proto3.util.equals(UnlockStageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.UnlockStageResponse
+ */
+export class UnlockStageResponse extends Message<UnlockStageResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Stage stage = 1;
+   */
+  stage?: Stage;
+
+  constructor(data?: PartialMessage<UnlockStageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.UnlockStageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "message", T: Stage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnlockStageResponse {
+    return new UnlockStageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnlockStageResponse {
+    return new UnlockStageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnlockStageResponse {
+    return new UnlockStageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnlockStageResponse | PlainMessage<UnlockStageResponse> | undefined, b: UnlockStageResponse | PlainMessage<UnlockStageResponse> | undefined): boolean {
+    return proto3.util.equals(UnlockStageResponse, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateProjectRequest
  */
@@ -2558,6 +2843,15 @@ export class QueryFreightResponse extends Message<QueryFreightResponse> {
    */
   groups: { [key: string]: FreightList } = {};
 
+  /**
+   * soak_time_remaining maps the names of Freight that have been verified
+   * upstream of the requested Stage, but that have not yet soaked for long
+   * enough to be available to it, to how much longer they must soak.
+   *
+   * @generated from field: map<string, google.protobuf.Duration> soak_time_remaining = 2;
+   */
+  soakTimeRemaining: { [key: string]: Duration } = {};
+
   constructor(data?: PartialMessage<QueryFreightResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2567,6 +2861,7 @@ export class QueryFreightResponse extends Message<QueryFreightResponse> {
   static readonly typeName = "akuity.io.kargo.service.v1alpha1.QueryFreightResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "groups", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: FreightList} },
+    { no: 2, name: "soak_time_remaining", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: Duration} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryFreightResponse {
@@ -2781,6 +3076,152 @@ export class UpdateFreightAliasResponse extends Message<UpdateFreightAliasRespon
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateFreightRequest
+ */
+export class CreateFreightRequest extends Message<CreateFreightRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string warehouse = 2;
+   */
+  warehouse = "";
+
+  /**
+   * versions specifies the versions of artifacts to be taken from some or all
+   * of the Warehouse's subscriptions. Subscriptions for which no version is
+   * specified contribute the version the Warehouse would currently select.
+   *
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.ArtifactVersion versions = 3;
+   */
+  versions: ArtifactVersion[] = [];
+
+  constructor(data?: PartialMessage<CreateFreightRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateFreightRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "warehouse", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "versions", kind: "message", T: ArtifactVersion, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateFreightRequest {
+    return new CreateFreightRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateFreightRequest {
+    return new CreateFreightRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateFreightRequest {
+    return new CreateFreightRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateFreightRequest | PlainMessage<CreateFreightRequest> | undefined, b: CreateFreightRequest | PlainMessage<CreateFreightRequest> | undefined): boolean {
+    return proto3.util.equals(CreateFreightRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ArtifactVersion
+ */
+export class ArtifactVersion extends Message<ArtifactVersion> {
+  /**
+   * repo_url is the URL of a repository the Warehouse subscribes to.
+   *
+   * @generated from field: string repo_url = 1;
+   */
+  repoUrl = "";
+
+  /**
+   * chart is the name of a chart in a classic (HTTP/S) chart repository.
+   *
+   * @generated from field: optional string chart = 2;
+   */
+  chart?: string;
+
+  /**
+   * version is a tag or, for a Git subscription that selects commits from a
+   * branch, a commit ID, or the version of a chart.
+   *
+   * @generated from field: string version = 3;
+   */
+  version = "";
+
+  constructor(data?: PartialMessage<ArtifactVersion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ArtifactVersion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "chart", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArtifactVersion {
+    return new ArtifactVersion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArtifactVersion {
+    return new ArtifactVersion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArtifactVersion {
+    return new ArtifactVersion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArtifactVersion | PlainMessage<ArtifactVersion> | undefined, b: ArtifactVersion | PlainMessage<ArtifactVersion> | undefined): boolean {
+    return proto3.util.equals(ArtifactVersion, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateFreightResponse
+ */
+export class CreateFreightResponse extends Message<CreateFreightResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Freight freight = 1;
+   */
+  freight?: Freight;
+
+  constructor(data?: PartialMessage<CreateFreightResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.CreateFreightResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "freight", kind: "message", T: Freight },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateFreightResponse {
+    return new CreateFreightResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateFreightResponse {
+    return new CreateFreightResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateFreightResponse {
+    return new CreateFreightResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateFreightResponse | PlainMessage<CreateFreightResponse> | undefined, b: CreateFreightResponse | PlainMessage<CreateFreightResponse> | undefined): boolean {
+    return proto3.util.equals(CreateFreightResponse, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListWarehousesRequest
  */
@@ -3400,6 +3841,98 @@ export class RefreshWarehouseResponse extends Message<RefreshWarehouseResponse>
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.PreviewWarehouseSubscriptionRequest
+ */
+export class PreviewWarehouseSubscriptionRequest extends Message<PreviewWarehouseSubscriptionRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription subscription = 2;
+   */
+  subscription?: RepoSubscription;
+
+  constructor(data?: PartialMessage<PreviewWarehouseSubscriptionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PreviewWarehouseSubscriptionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "subscription", kind: "message", T: RepoSubscription },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewWarehouseSubscriptionRequest {
+    return new PreviewWarehouseSubscriptionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewWarehouseSubscriptionRequest {
+    return new PreviewWarehouseSubscriptionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewWarehouseSubscriptionRequest {
+    return new PreviewWarehouseSubscriptionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewWarehouseSubscriptionRequest | PlainMessage<PreviewWarehouseSubscriptionRequest> | undefined, b: PreviewWarehouseSubscriptionRequest | PlainMessage<PreviewWarehouseSubscriptionRequest> | undefined): boolean {
+    return proto3.util.equals(PreviewWarehouseSubscriptionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.PreviewWarehouseSubscriptionResponse
+ */
+export class PreviewWarehouseSubscriptionResponse extends Message<PreviewWarehouseSubscriptionResponse> {
+  /**
+   * @generated from field: repeated string candidates = 1;
+   */
+  candidates: string[] = [];
+
+  /**
+   * @generated from field: string selected = 2;
+   */
+  selected = "";
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.UnverifiedGitRevision unverified_revisions = 3;
+   */
+  unverifiedRevisions: UnverifiedGitRevision[] = [];
+
+  constructor(data?: PartialMessage<PreviewWarehouseSubscriptionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PreviewWarehouseSubscriptionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "candidates", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "selected", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "unverified_revisions", kind: "message", T: UnverifiedGitRevision, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewWarehouseSubscriptionResponse {
+    return new PreviewWarehouseSubscriptionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewWarehouseSubscriptionResponse {
+    return new PreviewWarehouseSubscriptionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewWarehouseSubscriptionResponse {
+    return new PreviewWarehouseSubscriptionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewWarehouseSubscriptionResponse | PlainMessage<PreviewWarehouseSubscriptionResponse> | undefined, b: PreviewWarehouseSubscriptionResponse | PlainMessage<PreviewWarehouseSubscriptionResponse> | undefined): boolean {
+    return proto3.util.equals(PreviewWarehouseSubscriptionResponse, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ApproveFreightRequest
  */
@@ -3419,6 +3952,11 @@ export class ApproveFreightRequest extends Message<ApproveFreightRequest> {
    */
   stage = "";
 
+  /**
+   * @generated from field: string comment = 4;
+   */
+  comment = "";
+
   constructor(data?: PartialMessage<ApproveFreightRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3430,6 +3968,7 @@ export class ApproveFreightRequest extends Message<ApproveFreightRequest> {
     { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveFreightRequest {
@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
-import { ListMeta, ObjectMeta } from "../metav1/types_pb.js";
+import { Condition, ListMeta, ObjectMeta } from "../metav1/types_pb.js";
 
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppUpdate
@@ -451,6 +451,11 @@ export class GitCommit extends Message<GitCommit> {
    */
   tag = "";
 
+  /**
+   * @generated from field: optional google.protobuf.Timestamp committed_at = 8;
+   */
+  committedAt?: Timestamp;
+
   constructor(data?: PartialMessage<GitCommit>) {
     super();
     proto3.util.initPartial(data, this);
@@ -466,6 +471,7 @@ export class GitCommit extends Message<GitCommit> {
     { no: 5, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "author", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "committed_at", kind: "message", T: Timestamp, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitCommit {
@@ -524,6 +530,11 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
    */
   pullRequest?: PullRequestPromotionMechanism;
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifactUpdate oci_artifacts = 9;
+   */
+  ociArtifacts: OCIArtifactUpdate[] = [];
+
   constructor(data?: PartialMessage<GitRepoUpdate>) {
     super();
     proto3.util.initPartial(data, this);
@@ -539,6 +550,7 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
     { no: 6, name: "helm", kind: "message", T: HelmPromotionMechanism, opt: true },
     { no: 7, name: "render", kind: "message", T: KargoRenderPromotionMechanism, opt: true },
     { no: 8, name: "pull_request", kind: "message", T: PullRequestPromotionMechanism, opt: true },
+    { no: 9, name: "oci_artifacts", kind: "message", T: OCIArtifactUpdate, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitRepoUpdate {
@@ -592,6 +604,41 @@ export class GitSubscription extends Message<GitSubscription> {
    */
   ignoreTags: string[] = [];
 
+  /**
+   * @generated from field: repeated string include_paths = 7;
+   */
+  includePaths: string[] = [];
+
+  /**
+   * @generated from field: repeated string exclude_paths = 8;
+   */
+  excludePaths: string[] = [];
+
+  /**
+   * @generated from field: optional string include_messages = 9;
+   */
+  includeMessages?: string;
+
+  /**
+   * @generated from field: optional string exclude_messages = 10;
+   */
+  excludeMessages?: string;
+
+  /**
+   * @generated from field: repeated string include_authors = 11;
+   */
+  includeAuthors: string[] = [];
+
+  /**
+   * @generated from field: repeated string exclude_authors = 12;
+   */
+  excludeAuthors: string[] = [];
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.GitSignatureVerification signature_verification = 13;
+   */
+  signatureVerification?: GitSignatureVerification;
+
   constructor(data?: PartialMessage<GitSubscription>) {
     super();
     proto3.util.initPartial(data, this);
@@ -606,6 +653,13 @@ export class GitSubscription extends Message<GitSubscription> {
     { no: 4, name: "semver_constraint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 5, name: "allow_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 6, name: "ignore_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "include_paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "exclude_paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "include_messages", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 10, name: "exclude_messages", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 11, name: "include_authors", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 12, name: "exclude_authors", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 13, name: "signature_verification", kind: "message", T: GitSignatureVerification, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitSubscription {
@@ -625,6 +679,49 @@ export class GitSubscription extends Message<GitSubscription> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.GitSignatureVerification
+ */
+export class GitSignatureVerification extends Message<GitSignatureVerification> {
+  /**
+   * @generated from field: string secret_name = 1;
+   */
+  secretName = "";
+
+  /**
+   * @generated from field: string require = 2;
+   */
+  require = "";
+
+  constructor(data?: PartialMessage<GitSignatureVerification>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.GitSignatureVerification";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "secret_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "require", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitSignatureVerification {
+    return new GitSignatureVerification().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GitSignatureVerification {
+    return new GitSignatureVerification().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GitSignatureVerification {
+    return new GitSignatureVerification().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GitSignatureVerification | PlainMessage<GitSignatureVerification> | undefined, b: GitSignatureVerification | PlainMessage<GitSignatureVerification> | undefined): boolean {
+    return proto3.util.equals(GitSignatureVerification, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Health
  */
@@ -1238,6 +1335,183 @@ export class KustomizePromotionMechanism extends Message<KustomizePromotionMecha
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifact
+ */
+export class OCIArtifact extends Message<OCIArtifact> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string tag = 2;
+   */
+  tag = "";
+
+  /**
+   * @generated from field: string digest = 3;
+   */
+  digest = "";
+
+  /**
+   * @generated from field: string artifact_type = 4;
+   */
+  artifactType = "";
+
+  constructor(data?: PartialMessage<OCIArtifact>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifact";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "digest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "artifact_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OCIArtifact {
+    return new OCIArtifact().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OCIArtifact {
+    return new OCIArtifact().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OCIArtifact {
+    return new OCIArtifact().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OCIArtifact | PlainMessage<OCIArtifact> | undefined, b: OCIArtifact | PlainMessage<OCIArtifact> | undefined): boolean {
+    return proto3.util.equals(OCIArtifact, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifactSubscription
+ */
+export class OCIArtifactSubscription extends Message<OCIArtifactSubscription> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string selection_strategy = 2;
+   */
+  selectionStrategy = "";
+
+  /**
+   * @generated from field: optional string semver_constraint = 3;
+   */
+  semverConstraint?: string;
+
+  /**
+   * @generated from field: optional string allow_tags = 4;
+   */
+  allowTags?: string;
+
+  /**
+   * @generated from field: repeated string ignore_tags = 5;
+   */
+  ignoreTags: string[] = [];
+
+  /**
+   * @generated from field: optional string artifact_type = 6;
+   */
+  artifactType?: string;
+
+  constructor(data?: PartialMessage<OCIArtifactSubscription>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifactSubscription";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "selection_strategy", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "semver_constraint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "allow_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "ignore_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "artifact_type", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OCIArtifactSubscription {
+    return new OCIArtifactSubscription().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OCIArtifactSubscription {
+    return new OCIArtifactSubscription().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OCIArtifactSubscription {
+    return new OCIArtifactSubscription().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OCIArtifactSubscription | PlainMessage<OCIArtifactSubscription> | undefined, b: OCIArtifactSubscription | PlainMessage<OCIArtifactSubscription> | undefined): boolean {
+    return proto3.util.equals(OCIArtifactSubscription, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifactUpdate
+ */
+export class OCIArtifactUpdate extends Message<OCIArtifactUpdate> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string path = 2;
+   */
+  path = "";
+
+  /**
+   * @generated from field: string key = 3;
+   */
+  key = "";
+
+  /**
+   * @generated from field: string value = 4;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<OCIArtifactUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifactUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OCIArtifactUpdate {
+    return new OCIArtifactUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OCIArtifactUpdate {
+    return new OCIArtifactUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OCIArtifactUpdate {
+    return new OCIArtifactUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OCIArtifactUpdate | PlainMessage<OCIArtifactUpdate> | undefined, b: OCIArtifactUpdate | PlainMessage<OCIArtifactUpdate> | undefined): boolean {
+    return proto3.util.equals(OCIArtifactUpdate, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Project
  */
@@ -1497,6 +1771,21 @@ export class PromotionMechanisms extends Message<PromotionMechanisms> {
    */
   argocdAppUpdates: ArgoCDAppUpdate[] = [];
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook pre_hooks = 3;
+   */
+  preHooks: PromotionHook[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook post_hooks = 4;
+   */
+  postHooks: PromotionHook[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobStep job_steps = 5;
+   */
+  jobSteps: PromotionJobStep[] = [];
+
   constructor(data?: PartialMessage<PromotionMechanisms>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1507,6 +1796,9 @@ export class PromotionMechanisms extends Message<PromotionMechanisms> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "git_repo_updates", kind: "message", T: GitRepoUpdate, repeated: true },
     { no: 2, name: "argocd_app_updates", jsonName: "argoCDAppUpdates", kind: "message", T: ArgoCDAppUpdate, repeated: true },
+    { no: 3, name: "pre_hooks", kind: "message", T: PromotionHook, repeated: true },
+    { no: 4, name: "post_hooks", kind: "message", T: PromotionHook, repeated: true },
+    { no: 5, name: "job_steps", kind: "message", T: PromotionJobStep, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionMechanisms {
@@ -1527,125 +1819,650 @@ export class PromotionMechanisms extends Message<PromotionMechanisms> {
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobStep
  */
-export class PromotionPolicy extends Message<PromotionPolicy> {
+export class PromotionJobStep extends Message<PromotionJobStep> {
   /**
-   * @generated from field: string stage = 4;
+   * @generated from field: string name = 1;
    */
-  stage = "";
+  name = "";
 
   /**
-   * @generated from field: bool auto_promotion_enabled = 5;
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobGitCheckout git = 2;
    */
-  autoPromotionEnabled = false;
+  git?: PromotionJobGitCheckout;
 
-  constructor(data?: PartialMessage<PromotionPolicy>) {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJob job = 3;
+   */
+  job?: PromotionJob;
+
+  constructor(data?: PartialMessage<PromotionJobStep>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobStep";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 4, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "auto_promotion_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "git", kind: "message", T: PromotionJobGitCheckout, opt: true },
+    { no: 3, name: "job", kind: "message", T: PromotionJob },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionPolicy {
-    return new PromotionPolicy().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionJobStep {
+    return new PromotionJobStep().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionPolicy {
-    return new PromotionPolicy().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionJobStep {
+    return new PromotionJobStep().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionPolicy {
-    return new PromotionPolicy().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionJobStep {
+    return new PromotionJobStep().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined, b: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined): boolean {
-    return proto3.util.equals(PromotionPolicy, a, b);
+  static equals(a: PromotionJobStep | PlainMessage<PromotionJobStep> | undefined, b: PromotionJobStep | PlainMessage<PromotionJobStep> | undefined): boolean {
+    return proto3.util.equals(PromotionJobStep, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobGitCheckout
  */
-export class PromotionSpec extends Message<PromotionSpec> {
+export class PromotionJobGitCheckout extends Message<PromotionJobGitCheckout> {
   /**
-   * @generated from field: string stage = 1;
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
    */
-  stage = "";
+  repoUrl = "";
 
   /**
-   * @generated from field: string freight = 2;
+   * @generated from field: optional string branch = 2;
    */
-  freight = "";
+  branch?: string;
 
-  constructor(data?: PartialMessage<PromotionSpec>) {
+  constructor(data?: PartialMessage<PromotionJobGitCheckout>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobGitCheckout";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionSpec {
-    return new PromotionSpec().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionJobGitCheckout {
+    return new PromotionJobGitCheckout().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionSpec {
-    return new PromotionSpec().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionJobGitCheckout {
+    return new PromotionJobGitCheckout().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionSpec {
-    return new PromotionSpec().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionJobGitCheckout {
+    return new PromotionJobGitCheckout().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionSpec | PlainMessage<PromotionSpec> | undefined, b: PromotionSpec | PlainMessage<PromotionSpec> | undefined): boolean {
-    return proto3.util.equals(PromotionSpec, a, b);
+  static equals(a: PromotionJobGitCheckout | PlainMessage<PromotionJobGitCheckout> | undefined, b: PromotionJobGitCheckout | PlainMessage<PromotionJobGitCheckout> | undefined): boolean {
+    return proto3.util.equals(PromotionJobGitCheckout, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook
  */
-export class PromotionStatus extends Message<PromotionStatus> {
+export class PromotionHook extends Message<PromotionHook> {
   /**
-   * @generated from field: string phase = 1;
+   * @generated from field: string name = 1;
    */
-  phase = "";
+  name = "";
 
   /**
-   * @generated from field: string message = 2;
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.HTTPPromotionHook http = 2;
    */
-  message = "";
+  http?: HTTPPromotionHook;
 
   /**
-   * @generated from field: map<string, string> metadata = 3;
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJob job = 3;
    */
-  metadata: { [key: string]: string } = {};
+  job?: PromotionJob;
 
-  constructor(data?: PartialMessage<PromotionStatus>) {
+  constructor(data?: PartialMessage<PromotionHook>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHook";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "metadata", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "http", kind: "message", T: HTTPPromotionHook, opt: true },
+    { no: 3, name: "job", kind: "message", T: PromotionJob, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStatus {
-    return new PromotionStatus().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionHook {
+    return new PromotionHook().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionHook {
+    return new PromotionHook().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionHook {
+    return new PromotionHook().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionHook | PlainMessage<PromotionHook> | undefined, b: PromotionHook | PlainMessage<PromotionHook> | undefined): boolean {
+    return proto3.util.equals(PromotionHook, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HTTPPromotionHook
+ */
+export class HTTPPromotionHook extends Message<HTTPPromotionHook> {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * @generated from field: string method = 2;
+   */
+  method = "";
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.HTTPHeader headers = 3;
+   */
+  headers: HTTPHeader[] = [];
+
+  /**
+   * @generated from field: string headers_secret = 4;
+   */
+  headersSecret = "";
+
+  /**
+   * @generated from field: string body = 5;
+   */
+  body = "";
+
+  /**
+   * @generated from field: bool insecure_skip_tls_verify = 6 [json_name = "insecureSkipTLSVerify"];
+   */
+  insecureSkipTlsVerify = false;
+
+  constructor(data?: PartialMessage<HTTPPromotionHook>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HTTPPromotionHook";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "headers", kind: "message", T: HTTPHeader, repeated: true },
+    { no: 4, name: "headers_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "insecure_skip_tls_verify", jsonName: "insecureSkipTLSVerify", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HTTPPromotionHook {
+    return new HTTPPromotionHook().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HTTPPromotionHook {
+    return new HTTPPromotionHook().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HTTPPromotionHook {
+    return new HTTPPromotionHook().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HTTPPromotionHook | PlainMessage<HTTPPromotionHook> | undefined, b: HTTPPromotionHook | PlainMessage<HTTPPromotionHook> | undefined): boolean {
+    return proto3.util.equals(HTTPPromotionHook, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HTTPHeader
+ */
+export class HTTPHeader extends Message<HTTPHeader> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<HTTPHeader>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HTTPHeader";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HTTPHeader {
+    return new HTTPHeader().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HTTPHeader {
+    return new HTTPHeader().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HTTPHeader {
+    return new HTTPHeader().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HTTPHeader | PlainMessage<HTTPHeader> | undefined, b: HTTPHeader | PlainMessage<HTTPHeader> | undefined): boolean {
+    return proto3.util.equals(HTTPHeader, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJob
+ */
+export class PromotionJob extends Message<PromotionJob> {
+  /**
+   * @generated from field: string image = 1;
+   */
+  image = "";
+
+  /**
+   * @generated from field: repeated string command = 2;
+   */
+  command: string[] = [];
+
+  /**
+   * @generated from field: repeated string args = 3;
+   */
+  args: string[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobEnvVar env = 4;
+   */
+  env: PromotionJobEnvVar[] = [];
+
+  /**
+   * @generated from field: string service_account_name = 5;
+   */
+  serviceAccountName = "";
+
+  /**
+   * @generated from field: optional int64 active_deadline_seconds = 6;
+   */
+  activeDeadlineSeconds?: bigint;
+
+  constructor(data?: PartialMessage<PromotionJob>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJob";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "command", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "args", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "env", kind: "message", T: PromotionJobEnvVar, repeated: true },
+    { no: 5, name: "service_account_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "active_deadline_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionJob {
+    return new PromotionJob().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionJob {
+    return new PromotionJob().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionJob {
+    return new PromotionJob().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionJob | PlainMessage<PromotionJob> | undefined, b: PromotionJob | PlainMessage<PromotionJob> | undefined): boolean {
+    return proto3.util.equals(PromotionJob, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobEnvVar
+ */
+export class PromotionJobEnvVar extends Message<PromotionJobEnvVar> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<PromotionJobEnvVar>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJobEnvVar";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionJobEnvVar {
+    return new PromotionJobEnvVar().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionJobEnvVar {
+    return new PromotionJobEnvVar().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionJobEnvVar {
+    return new PromotionJobEnvVar().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionJobEnvVar | PlainMessage<PromotionJobEnvVar> | undefined, b: PromotionJobEnvVar | PlainMessage<PromotionJobEnvVar> | undefined): boolean {
+    return proto3.util.equals(PromotionJobEnvVar, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy
+ */
+export class PromotionPolicy extends Message<PromotionPolicy> {
+  /**
+   * @generated from field: string stage = 4;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: bool auto_promotion_enabled = 5;
+   */
+  autoPromotionEnabled = false;
+
+  /**
+   * @generated from field: optional string queue_policy = 6;
+   */
+  queuePolicy?: string;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionWindow windows = 7;
+   */
+  windows: PromotionWindow[] = [];
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ApprovalPolicy approval_policy = 8;
+   */
+  approvalPolicy?: ApprovalPolicy;
+
+  constructor(data?: PartialMessage<PromotionPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 4, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "auto_promotion_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "queue_policy", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "windows", kind: "message", T: PromotionWindow, repeated: true },
+    { no: 8, name: "approval_policy", kind: "message", T: ApprovalPolicy, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionPolicy {
+    return new PromotionPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionPolicy {
+    return new PromotionPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionPolicy {
+    return new PromotionPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined, b: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined): boolean {
+    return proto3.util.equals(PromotionPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ApprovalPolicy
+ */
+export class ApprovalPolicy extends Message<ApprovalPolicy> {
+  /**
+   * @generated from field: int32 required_approvals = 1;
+   */
+  requiredApprovals = 0;
+
+  /**
+   * @generated from field: repeated string groups = 2;
+   */
+  groups: string[] = [];
+
+  /**
+   * @generated from field: repeated string subjects = 3;
+   */
+  subjects: string[] = [];
+
+  /**
+   * @generated from field: optional string expiry = 4;
+   */
+  expiry?: string;
+
+  /**
+   * @generated from field: bool exclude_freight_authors = 5;
+   */
+  excludeFreightAuthors = false;
+
+  constructor(data?: PartialMessage<ApprovalPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ApprovalPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "required_approvals", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "groups", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "subjects", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "expiry", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "exclude_freight_authors", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApprovalPolicy {
+    return new ApprovalPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApprovalPolicy {
+    return new ApprovalPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApprovalPolicy {
+    return new ApprovalPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApprovalPolicy | PlainMessage<ApprovalPolicy> | undefined, b: ApprovalPolicy | PlainMessage<ApprovalPolicy> | undefined): boolean {
+    return proto3.util.equals(ApprovalPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionWindow
+ */
+export class PromotionWindow extends Message<PromotionWindow> {
+  /**
+   * @generated from field: string kind = 1;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string schedule = 2;
+   */
+  schedule = "";
+
+  /**
+   * @generated from field: string duration = 3;
+   */
+  duration = "";
+
+  /**
+   * @generated from field: optional string time_zone = 4;
+   */
+  timeZone?: string;
+
+  /**
+   * @generated from field: optional string reason = 5;
+   */
+  reason?: string;
+
+  constructor(data?: PartialMessage<PromotionWindow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionWindow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "schedule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionWindow {
+    return new PromotionWindow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionWindow {
+    return new PromotionWindow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionWindow {
+    return new PromotionWindow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionWindow | PlainMessage<PromotionWindow> | undefined, b: PromotionWindow | PlainMessage<PromotionWindow> | undefined): boolean {
+    return proto3.util.equals(PromotionWindow, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec
+ */
+export class PromotionSpec extends Message<PromotionSpec> {
+  /**
+   * @generated from field: string stage = 1;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: string freight = 2;
+   */
+  freight = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp not_before = 3;
+   */
+  notBefore?: Timestamp;
+
+  /**
+   * @generated from field: int32 priority = 4;
+   */
+  priority = 0;
+
+  constructor(data?: PartialMessage<PromotionSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "not_before", kind: "message", T: Timestamp, opt: true },
+    { no: 4, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionSpec {
+    return new PromotionSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionSpec {
+    return new PromotionSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionSpec {
+    return new PromotionSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionSpec | PlainMessage<PromotionSpec> | undefined, b: PromotionSpec | PlainMessage<PromotionSpec> | undefined): boolean {
+    return proto3.util.equals(PromotionSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
+ */
+export class PromotionStatus extends Message<PromotionStatus> {
+  /**
+   * @generated from field: string phase = 1;
+   */
+  phase = "";
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  /**
+   * @generated from field: map<string, string> metadata = 3;
+   */
+  metadata: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHookResult hooks = 4;
+   */
+  hooks: PromotionHookResult[] = [];
+
+  /**
+   * @generated from field: string controller_instance = 5;
+   */
+  controllerInstance = "";
+
+  constructor(data?: PartialMessage<PromotionStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metadata", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "hooks", kind: "message", T: PromotionHookResult, repeated: true },
+    { no: 5, name: "controller_instance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStatus {
+    return new PromotionStatus().fromBinary(bytes, options);
   }
 
   static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionStatus {
@@ -1661,6 +2478,79 @@ export class PromotionStatus extends Message<PromotionStatus> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHookResult
+ */
+export class PromotionHookResult extends Message<PromotionHookResult> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  /**
+   * @generated from field: string phase = 3;
+   */
+  phase = "";
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message = "";
+
+  /**
+   * @generated from field: string job = 5;
+   */
+  job = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp started_at = 6;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp finished_at = 7;
+   */
+  finishedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<PromotionHookResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionHookResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "job", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "started_at", kind: "message", T: Timestamp, opt: true },
+    { no: 7, name: "finished_at", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionHookResult {
+    return new PromotionHookResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionHookResult {
+    return new PromotionHookResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionHookResult {
+    return new PromotionHookResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionHookResult | PlainMessage<PromotionHookResult> | undefined, b: PromotionHookResult | PlainMessage<PromotionHookResult> | undefined): boolean {
+    return proto3.util.equals(PromotionHookResult, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.RepoSubscription
  */
@@ -1680,6 +2570,11 @@ export class RepoSubscription extends Message<RepoSubscription> {
    */
   chart?: ChartSubscription;
 
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifactSubscription oci_artifact = 4;
+   */
+  ociArtifact?: OCIArtifactSubscription;
+
   constructor(data?: PartialMessage<RepoSubscription>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1691,6 +2586,7 @@ export class RepoSubscription extends Message<RepoSubscription> {
     { no: 1, name: "git", kind: "message", T: GitSubscription, opt: true },
     { no: 2, name: "image", kind: "message", T: ImageSubscription, opt: true },
     { no: 3, name: "chart", kind: "message", T: ChartSubscription, opt: true },
+    { no: 4, name: "oci_artifact", kind: "message", T: OCIArtifactSubscription, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoSubscription {
@@ -1833,6 +2729,11 @@ export class StageSpec extends Message<StageSpec> {
    */
   verification?: Verification;
 
+  /**
+   * @generated from field: int32 history_depth = 4;
+   */
+  historyDepth = 0;
+
   constructor(data?: PartialMessage<StageSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1844,6 +2745,7 @@ export class StageSpec extends Message<StageSpec> {
     { no: 1, name: "subscriptions", kind: "message", T: Subscriptions },
     { no: 2, name: "promotion_mechanisms", kind: "message", T: PromotionMechanisms },
     { no: 3, name: "verification", kind: "message", T: Verification, opt: true },
+    { no: 4, name: "history_depth", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageSpec {
@@ -1907,6 +2809,11 @@ export class Freight extends Message<Freight> {
    */
   status?: FreightStatus;
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifact oci_artifacts = 9;
+   */
+  ociArtifacts: OCIArtifact[] = [];
+
   constructor(data?: PartialMessage<Freight>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1923,6 +2830,7 @@ export class Freight extends Message<Freight> {
     { no: 6, name: "images", kind: "message", T: Image, repeated: true },
     { no: 7, name: "charts", kind: "message", T: Chart, repeated: true },
     { no: 8, name: "status", kind: "message", T: FreightStatus },
+    { no: 9, name: "oci_artifacts", kind: "message", T: OCIArtifact, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Freight {
@@ -1956,6 +2864,11 @@ export class FreightStatus extends Message<FreightStatus> {
    */
   approvedFor: { [key: string]: ApprovedStage } = {};
 
+  /**
+   * @generated from field: map<string, github.com.akuity.kargo.pkg.api.v1alpha1.FailedStage> failed_in = 3;
+   */
+  failedIn: { [key: string]: FailedStage } = {};
+
   constructor(data?: PartialMessage<FreightStatus>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1966,6 +2879,7 @@ export class FreightStatus extends Message<FreightStatus> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "verified_in", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: VerifiedStage} },
     { no: 2, name: "approved_for", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: ApprovedStage} },
+    { no: 3, name: "failed_in", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: FailedStage} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightStatus {
@@ -1989,6 +2903,11 @@ export class FreightStatus extends Message<FreightStatus> {
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.VerifiedStage
  */
 export class VerifiedStage extends Message<VerifiedStage> {
+  /**
+   * @generated from field: optional google.protobuf.Timestamp verified_at = 1;
+   */
+  verifiedAt?: Timestamp;
+
   constructor(data?: PartialMessage<VerifiedStage>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1997,6 +2916,7 @@ export class VerifiedStage extends Message<VerifiedStage> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.VerifiedStage";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verified_at", kind: "message", T: Timestamp, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifiedStage {
@@ -2020,6 +2940,11 @@ export class VerifiedStage extends Message<VerifiedStage> {
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ApprovedStage
  */
 export class ApprovedStage extends Message<ApprovedStage> {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Approval approvals = 1;
+   */
+  approvals: Approval[] = [];
+
   constructor(data?: PartialMessage<ApprovedStage>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2028,6 +2953,7 @@ export class ApprovedStage extends Message<ApprovedStage> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ApprovedStage";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "approvals", kind: "message", T: Approval, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApprovedStage {
@@ -2047,6 +2973,92 @@ export class ApprovedStage extends Message<ApprovedStage> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Approval
+ */
+export class Approval extends Message<Approval> {
+  /**
+   * @generated from field: string subject = 1;
+   */
+  subject = "";
+
+  /**
+   * @generated from field: optional string email = 2;
+   */
+  email?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp approved_at = 3;
+   */
+  approvedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional string comment = 4;
+   */
+  comment?: string;
+
+  constructor(data?: PartialMessage<Approval>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Approval";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "subject", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "approved_at", kind: "message", T: Timestamp, opt: true },
+    { no: 4, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Approval {
+    return new Approval().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Approval {
+    return new Approval().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Approval {
+    return new Approval().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Approval | PlainMessage<Approval> | undefined, b: Approval | PlainMessage<Approval> | undefined): boolean {
+    return proto3.util.equals(Approval, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FailedStage
+ */
+export class FailedStage extends Message<FailedStage> {
+  constructor(data?: PartialMessage<FailedStage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FailedStage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FailedStage {
+    return new FailedStage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FailedStage {
+    return new FailedStage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FailedStage {
+    return new FailedStage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FailedStage | PlainMessage<FailedStage> | undefined, b: FailedStage | PlainMessage<FailedStage> | undefined): boolean {
+    return proto3.util.equals(FailedStage, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference
  */
@@ -2081,6 +3093,26 @@ export class FreightReference extends Message<FreightReference> {
    */
   verificationInfo?: VerificationInfo;
 
+  /**
+   * @generated from field: string promotion = 8;
+   */
+  promotion = "";
+
+  /**
+   * @generated from field: string promoted_by = 9;
+   */
+  promotedBy = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp promoted_at = 10;
+   */
+  promotedAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.OCIArtifact oci_artifacts = 11;
+   */
+  ociArtifacts: OCIArtifact[] = [];
+
   constructor(data?: PartialMessage<FreightReference>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2095,6 +3127,10 @@ export class FreightReference extends Message<FreightReference> {
     { no: 5, name: "images", kind: "message", T: Image, repeated: true },
     { no: 6, name: "charts", kind: "message", T: Chart, repeated: true },
     { no: 7, name: "verification_info", kind: "message", T: VerificationInfo, opt: true },
+    { no: 8, name: "promotion", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "promoted_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "promoted_at", kind: "message", T: Timestamp, opt: true },
+    { no: 11, name: "oci_artifacts", kind: "message", T: OCIArtifact, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightReference {
@@ -2146,7 +3182,17 @@ export class StageStatus extends Message<StageStatus> {
   /**
    * @generated from field: string phase = 7;
    */
-  phase = "";
+  phase = "";
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.StageLock lock = 8;
+   */
+  lock?: StageLock;
+
+  /**
+   * @generated from field: string message = 9;
+   */
+  message = "";
 
   constructor(data?: PartialMessage<StageStatus>) {
     super();
@@ -2162,6 +3208,8 @@ export class StageStatus extends Message<StageStatus> {
     { no: 5, name: "health", kind: "message", T: Health, opt: true },
     { no: 6, name: "current_promotion", kind: "message", T: PromotionInfo, opt: true },
     { no: 7, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "lock", kind: "message", T: StageLock, opt: true },
+    { no: 9, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageStatus {
@@ -2181,6 +3229,55 @@ export class StageStatus extends Message<StageStatus> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageLock
+ */
+export class StageLock extends Message<StageLock> {
+  /**
+   * @generated from field: string reason = 1;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: string locked_by = 2;
+   */
+  lockedBy = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp locked_at = 3;
+   */
+  lockedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<StageLock>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageLock";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "locked_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "locked_at", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageLock {
+    return new StageLock().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageLock {
+    return new StageLock().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageLock {
+    return new StageLock().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StageLock | PlainMessage<StageLock> | undefined, b: StageLock | PlainMessage<StageLock> | undefined): boolean {
+    return proto3.util.equals(StageLock, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.StageSubscription
  */
@@ -2190,6 +3287,11 @@ export class StageSubscription extends Message<StageSubscription> {
    */
   name = "";
 
+  /**
+   * @generated from field: optional string required_soak_time = 2;
+   */
+  requiredSoakTime?: string;
+
   constructor(data?: PartialMessage<StageSubscription>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2199,6 +3301,7 @@ export class StageSubscription extends Message<StageSubscription> {
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.StageSubscription";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "required_soak_time", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageSubscription {
@@ -2232,6 +3335,16 @@ export class Subscriptions extends Message<Subscriptions> {
    */
   warehouse = "";
 
+  /**
+   * @generated from field: optional string upstream_stages_mode = 4;
+   */
+  upstreamStagesMode?: string;
+
+  /**
+   * @generated from field: repeated string warehouses = 5;
+   */
+  warehouses: string[] = [];
+
   constructor(data?: PartialMessage<Subscriptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2242,6 +3355,8 @@ export class Subscriptions extends Message<Subscriptions> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 2, name: "upstream_stages", kind: "message", T: StageSubscription, repeated: true },
     { no: 3, name: "warehouse", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "upstream_stages_mode", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "warehouses", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Subscriptions {
@@ -2331,6 +3446,16 @@ export class WarehouseSpec extends Message<WarehouseSpec> {
    */
   subscriptions: RepoSubscription[] = [];
 
+  /**
+   * @generated from field: optional string interval = 2;
+   */
+  interval?: string;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyRules freight_assembly = 3;
+   */
+  freightAssembly?: FreightAssemblyRules;
+
   constructor(data?: PartialMessage<WarehouseSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2340,6 +3465,8 @@ export class WarehouseSpec extends Message<WarehouseSpec> {
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "subscriptions", kind: "message", T: RepoSubscription, repeated: true },
+    { no: 2, name: "interval", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "freight_assembly", kind: "message", T: FreightAssemblyRules, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WarehouseSpec {
@@ -2359,6 +3486,141 @@ export class WarehouseSpec extends Message<WarehouseSpec> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyRules
+ */
+export class FreightAssemblyRules extends Message<FreightAssemblyRules> {
+  /**
+   * @generated from field: optional string settle_time = 1;
+   */
+  settleTime?: string;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyConstraint constraints = 2;
+   */
+  constraints: FreightAssemblyConstraint[] = [];
+
+  constructor(data?: PartialMessage<FreightAssemblyRules>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyRules";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "settle_time", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "constraints", kind: "message", T: FreightAssemblyConstraint, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightAssemblyRules {
+    return new FreightAssemblyRules().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FreightAssemblyRules {
+    return new FreightAssemblyRules().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FreightAssemblyRules {
+    return new FreightAssemblyRules().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FreightAssemblyRules | PlainMessage<FreightAssemblyRules> | undefined, b: FreightAssemblyRules | PlainMessage<FreightAssemblyRules> | undefined): boolean {
+    return proto3.util.equals(FreightAssemblyRules, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyConstraint
+ */
+export class FreightAssemblyConstraint extends Message<FreightAssemblyConstraint> {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactVersionReference match = 1;
+   */
+  match: ArtifactVersionReference[] = [];
+
+  constructor(data?: PartialMessage<FreightAssemblyConstraint>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FreightAssemblyConstraint";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "match", kind: "message", T: ArtifactVersionReference, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightAssemblyConstraint {
+    return new FreightAssemblyConstraint().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FreightAssemblyConstraint {
+    return new FreightAssemblyConstraint().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FreightAssemblyConstraint {
+    return new FreightAssemblyConstraint().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FreightAssemblyConstraint | PlainMessage<FreightAssemblyConstraint> | undefined, b: FreightAssemblyConstraint | PlainMessage<FreightAssemblyConstraint> | undefined): boolean {
+    return proto3.util.equals(FreightAssemblyConstraint, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactVersionReference
+ */
+export class ArtifactVersionReference extends Message<ArtifactVersionReference> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: optional string chart = 2;
+   */
+  chart?: string;
+
+  /**
+   * @generated from field: string field = 3;
+   */
+  field = "";
+
+  /**
+   * @generated from field: optional string trim_prefix = 4;
+   */
+  trimPrefix?: string;
+
+  constructor(data?: PartialMessage<ArtifactVersionReference>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ArtifactVersionReference";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "chart", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "trim_prefix", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArtifactVersionReference {
+    return new ArtifactVersionReference().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArtifactVersionReference {
+    return new ArtifactVersionReference().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArtifactVersionReference {
+    return new ArtifactVersionReference().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArtifactVersionReference | PlainMessage<ArtifactVersionReference> | undefined, b: ArtifactVersionReference | PlainMessage<ArtifactVersionReference> | undefined): boolean {
+    return proto3.util.equals(ArtifactVersionReference, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseStatus
  */
@@ -2373,6 +3635,26 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
    */
   observedGeneration = protoInt64.zero;
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.UnverifiedGitRevision unverified_revisions = 3;
+   */
+  unverifiedRevisions: UnverifiedGitRevision[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.metav1.Condition conditions = 4;
+   */
+  conditions: Condition[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionStatus subscriptions = 5;
+   */
+  subscriptions: SubscriptionStatus[] = [];
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.PendingFreight pending_freight = 6;
+   */
+  pendingFreight?: PendingFreight;
+
   constructor(data?: PartialMessage<WarehouseStatus>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2383,6 +3665,10 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "observed_generation", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "unverified_revisions", kind: "message", T: UnverifiedGitRevision, repeated: true },
+    { no: 4, name: "conditions", kind: "message", T: Condition, repeated: true },
+    { no: 5, name: "subscriptions", kind: "message", T: SubscriptionStatus, repeated: true },
+    { no: 6, name: "pending_freight", kind: "message", T: PendingFreight, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WarehouseStatus {
@@ -2402,6 +3688,177 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PendingFreight
+ */
+export class PendingFreight extends Message<PendingFreight> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp since = 2;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: optional string reason = 3;
+   */
+  reason?: string;
+
+  constructor(data?: PartialMessage<PendingFreight>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PendingFreight";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "since", kind: "message", T: Timestamp },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PendingFreight {
+    return new PendingFreight().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PendingFreight {
+    return new PendingFreight().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PendingFreight {
+    return new PendingFreight().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PendingFreight | PlainMessage<PendingFreight> | undefined, b: PendingFreight | PlainMessage<PendingFreight> | undefined): boolean {
+    return proto3.util.equals(PendingFreight, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionStatus
+ */
+export class SubscriptionStatus extends Message<SubscriptionStatus> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: optional string chart = 2;
+   */
+  chart?: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_polled = 3;
+   */
+  lastPolled?: Timestamp;
+
+  /**
+   * @generated from field: optional string latest_version = 4;
+   */
+  latestVersion?: string;
+
+  /**
+   * @generated from field: repeated string recent_versions = 5;
+   */
+  recentVersions: string[] = [];
+
+  /**
+   * @generated from field: optional string error = 6;
+   */
+  error?: string;
+
+  constructor(data?: PartialMessage<SubscriptionStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "chart", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "last_polled", kind: "message", T: Timestamp, opt: true },
+    { no: 4, name: "latest_version", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "recent_versions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubscriptionStatus {
+    return new SubscriptionStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubscriptionStatus {
+    return new SubscriptionStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubscriptionStatus {
+    return new SubscriptionStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SubscriptionStatus | PlainMessage<SubscriptionStatus> | undefined, b: SubscriptionStatus | PlainMessage<SubscriptionStatus> | undefined): boolean {
+    return proto3.util.equals(SubscriptionStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.UnverifiedGitRevision
+ */
+export class UnverifiedGitRevision extends Message<UnverifiedGitRevision> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string tag = 3;
+   */
+  tag = "";
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<UnverifiedGitRevision>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.UnverifiedGitRevision";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnverifiedGitRevision {
+    return new UnverifiedGitRevision().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnverifiedGitRevision {
+    return new UnverifiedGitRevision().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnverifiedGitRevision {
+    return new UnverifiedGitRevision().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnverifiedGitRevision | PlainMessage<UnverifiedGitRevision> | undefined, b: UnverifiedGitRevision | PlainMessage<UnverifiedGitRevision> | undefined): boolean {
+    return proto3.util.equals(UnverifiedGitRevision, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Verification
  */
@@ -2421,6 +3878,11 @@ export class Verification extends Message<Verification> {
    */
   args: AnalysisRunArgument[] = [];
 
+  /**
+   * @generated from field: bool auto_rollback = 4;
+   */
+  autoRollback = false;
+
   constructor(data?: PartialMessage<Verification>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2432,6 +3894,7 @@ export class Verification extends Message<Verification> {
     { no: 1, name: "analysis_templates", kind: "message", T: AnalysisTemplateReference, repeated: true },
     { no: 2, name: "analysis_run_metadata", kind: "message", T: AnalysisRunMetadata, opt: true },
     { no: 3, name: "args", kind: "message", T: AnalysisRunArgument, repeated: true },
+    { no: 4, name: "auto_rollback", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Verification {